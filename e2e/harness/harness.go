@@ -95,6 +95,8 @@ const (
 	ExitNetwork   = 6
 	ExitAPI       = 7
 	ExitAmbiguous = 8
+	ExitPartial   = 9
+	ExitFindings  = 10
 
 	// Deprecated aliases — kept for compilation.
 	ExitError       = ExitAPI
@@ -0,0 +1,169 @@
+// Package cache provides a small file-backed cache for API lookups that
+// change rarely, such as a board's column list.
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/fsutil"
+)
+
+// testDir is used to override the cache directory for testing.
+var testDir string
+
+// SetTestDir sets a custom cache directory for testing.
+func SetTestDir(dir string) {
+	testDir = dir
+	resetPending()
+}
+
+// ResetTestDir resets the cache directory to default.
+func ResetTestDir() {
+	testDir = ""
+	resetPending()
+}
+
+// entry is the on-disk envelope for a cached value.
+type entry struct {
+	StoredAt time.Time       `json:"stored_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// Dir returns the cache directory (~/.cache/fizzy on Linux).
+func Dir() (string, error) {
+	if testDir != "" {
+		return testDir, nil
+	}
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, "fizzy"), nil
+}
+
+// Load reads key into v if an entry exists and is younger than maxAge.
+// It reports whether v was populated; a missing, expired, or unreadable
+// entry is a miss.
+func Load(key string, maxAge time.Duration, v any) (hit bool) {
+	defer func() { record(key, hit) }()
+	path, err := keyPath(key)
+	if err != nil {
+		return false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	var e entry
+	if json.Unmarshal(data, &e) != nil {
+		return false
+	}
+	if maxAge > 0 && time.Since(e.StoredAt) > maxAge {
+		return false
+	}
+	return json.Unmarshal(e.Data, v) == nil
+}
+
+// LoadStored reads key into v regardless of age and returns when the entry
+// was stored. It reports false on a missing or unreadable entry.
+func LoadStored(key string, v any) (storedAt time.Time, hit bool) {
+	defer func() { record(key, hit) }()
+	path, err := keyPath(key)
+	if err != nil {
+		return time.Time{}, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return time.Time{}, false
+	}
+	var e entry
+	if json.Unmarshal(data, &e) != nil || json.Unmarshal(e.Data, v) != nil {
+		return time.Time{}, false
+	}
+	return e.StoredAt, true
+}
+
+// Store writes v under key, replacing any existing entry atomically.
+func Store(key string, v any) error {
+	path, err := keyPath(key)
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry{StoredAt: time.Now(), Data: raw})
+	if err != nil {
+		return err
+	}
+	return fsutil.WriteFileAtomic(path, data, 0600)
+}
+
+// Invalidate removes the entry for key. Removing a missing entry is not an error.
+func Invalidate(key string) error {
+	path, err := keyPath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// keyPath maps a slash-separated key to a file under Dir. Each segment is
+// sanitized so keys built from user input can't escape the cache directory.
+func keyPath(key string) (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return fsutil.KeyPath(dir, key), nil
+}
+
+// RemoveCorrupt deletes cache files that cannot be decoded, along with any
+// temporary files left behind by an interrupted Store. It returns the paths
+// removed. A missing cache directory is not an error.
+func RemoveCorrupt() ([]string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	var removed []string
+	err = filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if !fsutil.IsAtomicTemp(d.Name()) {
+			if !strings.HasSuffix(d.Name(), ".json") {
+				return nil
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			var e entry
+			if json.Unmarshal(data, &e) == nil && !e.StoredAt.IsZero() {
+				return nil
+			}
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		removed = append(removed, path)
+		return nil
+	})
+	return removed, err
+}
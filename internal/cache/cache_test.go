@@ -0,0 +1,240 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStoreAndLoad(t *testing.T) {
+	SetTestDir(t.TempDir())
+	defer ResetTestDir()
+
+	if err := Store("columns/acct/board-1", []string{"a", "b"}); err != nil {
+		t.Fatalf("Store failed: %v", err)
+	}
+
+	var got []string
+	if !Load("columns/acct/board-1", time.Minute, &got) {
+		t.Fatal("expected cache hit")
+	}
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("unexpected cached value: %v", got)
+	}
+}
+
+func TestLoadMiss(t *testing.T) {
+	SetTestDir(t.TempDir())
+	defer ResetTestDir()
+
+	var got []string
+	if Load("missing", time.Minute, &got) {
+		t.Error("expected miss for missing key")
+	}
+}
+
+func TestLoadExpired(t *testing.T) {
+	dir := t.TempDir()
+	SetTestDir(dir)
+	defer ResetTestDir()
+
+	if err := Store("k", "v"); err != nil {
+		t.Fatalf("Store failed: %v", err)
+	}
+	old := time.Now().Add(-time.Hour)
+	data := `{"stored_at":"` + old.Format(time.RFC3339Nano) + `","data":"v"}`
+	if err := os.WriteFile(filepath.Join(dir, "k.json"), []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var got string
+	if Load("k", time.Minute, &got) {
+		t.Error("expected miss for expired entry")
+	}
+	if !Load("k", 0, &got) || got != "v" {
+		t.Error("expected hit when maxAge is zero")
+	}
+}
+
+func TestLoadStored(t *testing.T) {
+	SetTestDir(t.TempDir())
+	defer ResetTestDir()
+
+	var got []string
+	if _, ok := LoadStored("missing", &got); ok {
+		t.Error("expected miss for missing key")
+	}
+
+	before := time.Now().Add(-time.Second)
+	if err := Store("store/acct/cards/1", []string{"a"}); err != nil {
+		t.Fatalf("Store failed: %v", err)
+	}
+	storedAt, ok := LoadStored("store/acct/cards/1", &got)
+	if !ok || len(got) != 1 || got[0] != "a" {
+		t.Fatalf("expected stored value, got %v (ok=%v)", got, ok)
+	}
+	if storedAt.Before(before) {
+		t.Errorf("expected stored time after %v, got %v", before, storedAt)
+	}
+}
+
+func TestInvalidate(t *testing.T) {
+	SetTestDir(t.TempDir())
+	defer ResetTestDir()
+
+	if err := Store("k", "v"); err != nil {
+		t.Fatalf("Store failed: %v", err)
+	}
+	if err := Invalidate("k"); err != nil {
+		t.Fatalf("Invalidate failed: %v", err)
+	}
+	var got string
+	if Load("k", time.Minute, &got) {
+		t.Error("expected miss after invalidate")
+	}
+	if err := Invalidate("k"); err != nil {
+		t.Errorf("invalidating a missing key should not error: %v", err)
+	}
+}
+
+func TestKeyPathStaysInsideDir(t *testing.T) {
+	dir := t.TempDir()
+	SetTestDir(dir)
+	defer ResetTestDir()
+
+	path, err := keyPath("../../etc/passwd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(path, dir+string(filepath.Separator)) {
+		t.Errorf("expected path inside %s, got %s", dir, path)
+	}
+}
+
+func TestRemoveCorrupt(t *testing.T) {
+	dir := t.TempDir()
+	SetTestDir(dir)
+	defer ResetTestDir()
+
+	if err := Store("columns/acct/good", []string{"a"}); err != nil {
+		t.Fatalf("Store failed: %v", err)
+	}
+	bad := filepath.Join(dir, "columns", "acct", "bad.json")
+	if err := os.WriteFile(bad, []byte("{not json"), 0600); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	tmp := filepath.Join(dir, "columns", "acct", ".tmp-123")
+	if err := os.WriteFile(tmp, []byte("partial"), 0600); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+
+	removed, err := RemoveCorrupt()
+	if err != nil {
+		t.Fatalf("RemoveCorrupt failed: %v", err)
+	}
+	if len(removed) != 2 {
+		t.Fatalf("expected 2 removed files, got %v", removed)
+	}
+	var got []string
+	if !Load("columns/acct/good", time.Minute, &got) {
+		t.Error("expected valid entry to survive")
+	}
+	for _, path := range []string{bad, tmp} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be removed", path)
+		}
+	}
+}
+
+func TestRemoveCorruptMissingDir(t *testing.T) {
+	SetTestDir(filepath.Join(t.TempDir(), "absent"))
+	defer ResetTestDir()
+
+	removed, err := RemoveCorrupt()
+	if err != nil || len(removed) != 0 {
+		t.Errorf("expected no-op for missing dir, got %v (%v)", removed, err)
+	}
+}
+
+func TestUsageAndStats(t *testing.T) {
+	SetTestDir(t.TempDir())
+	defer ResetTestDir()
+
+	if err := Store("columns/acme/board-1", []string{"a"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Store("columns/globex/board-2", []string{"b"}); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	Load("columns/acme/board-1", time.Minute, &got)
+	Load("columns/acme/board-9", time.Minute, &got)
+	if err := FlushStats(); err != nil {
+		t.Fatal(err)
+	}
+
+	areas, err := Usage("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(areas) != 1 {
+		t.Fatalf("expected only acme's area, got %+v", areas)
+	}
+	a := areas[0]
+	if a.Kind != "columns" || a.Entries != 1 || a.Bytes == 0 || a.Hits != 1 || a.Misses != 1 || a.Oldest.IsZero() {
+		t.Errorf("unexpected usage: %+v", a)
+	}
+
+	if all, _ := Usage(""); len(all) != 2 {
+		t.Errorf("expected both accounts, got %+v", all)
+	}
+}
+
+func TestClear(t *testing.T) {
+	dir := t.TempDir()
+	SetTestDir(dir)
+	defer ResetTestDir()
+
+	_ = Store("columns/acme/board-1", "a")
+	_ = Store("store/acme/cards/1", "b")
+	_ = Store("columns/globex/board-2", "c")
+
+	removed, err := Clear("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 2 {
+		t.Errorf("expected 2 entries removed, got %d", removed)
+	}
+	var got string
+	if !Load("columns/globex/board-2", 0, &got) {
+		t.Error("expected other accounts to be kept")
+	}
+}
+
+func TestGC(t *testing.T) {
+	dir := t.TempDir()
+	SetTestDir(dir)
+	defer ResetTestDir()
+
+	_ = Store("columns/acme/fresh", "a")
+	old := time.Now().Add(-48 * time.Hour)
+	data := `{"stored_at":"` + old.Format(time.RFC3339Nano) + `","data":"b"}`
+	if err := os.WriteFile(filepath.Join(dir, "columns", "acme", "old.json"), []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := GC("", 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 1 {
+		t.Errorf("expected 1 entry removed, got %d", removed)
+	}
+	var got string
+	if !Load("columns/acme/fresh", 0, &got) {
+		t.Error("expected the fresh entry to be kept")
+	}
+}
@@ -0,0 +1,289 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/fsutil"
+)
+
+// statsFile holds lookup counters across runs. It has no .json suffix so it
+// is never mistaken for an entry.
+const statsFile = ".stats"
+
+// Counts are the lookups made against one cache area.
+type Counts struct {
+	Hits   int `json:"hits"`
+	Misses int `json:"misses"`
+}
+
+var (
+	statsMu sync.Mutex
+	pending = map[string]Counts{}
+)
+
+// Area is what one cache kind holds for one account, e.g. the column lists
+// cached for "acme".
+type Area struct {
+	Kind    string    `json:"kind"`
+	Account string    `json:"account"`
+	Entries int       `json:"entries"`
+	Bytes   int64     `json:"bytes"`
+	Oldest  time.Time `json:"oldest,omitzero"`
+	Newest  time.Time `json:"newest,omitzero"`
+	Counts
+}
+
+// areaOf returns the "kind/account" area a key belongs to, or "" for keys
+// with fewer than three segments.
+func areaOf(key string) string {
+	parts := strings.Split(key, "/")
+	if len(parts) < 3 {
+		return ""
+	}
+	return fsutil.SanitizeSegment(parts[0]) + "/" + fsutil.SanitizeSegment(parts[1])
+}
+
+// record counts a lookup of key until FlushStats writes it out.
+func record(key string, hit bool) {
+	area := areaOf(key)
+	if area == "" {
+		return
+	}
+	statsMu.Lock()
+	defer statsMu.Unlock()
+	c := pending[area]
+	if hit {
+		c.Hits++
+	} else {
+		c.Misses++
+	}
+	pending[area] = c
+}
+
+// resetPending drops lookups not yet flushed, so counts from one cache
+// directory never land in another.
+func resetPending() {
+	statsMu.Lock()
+	defer statsMu.Unlock()
+	pending = map[string]Counts{}
+}
+
+// FlushStats adds the lookups made by this process to the stored counters.
+func FlushStats() error {
+	statsMu.Lock()
+	defer statsMu.Unlock()
+	if len(pending) == 0 {
+		return nil
+	}
+	stats, err := loadStats()
+	if err != nil {
+		return err
+	}
+	for area, c := range pending {
+		total := stats[area]
+		total.Hits += c.Hits
+		total.Misses += c.Misses
+		stats[area] = total
+	}
+	pending = map[string]Counts{}
+	return saveStats(stats)
+}
+
+func loadStats() (map[string]Counts, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	stats := map[string]Counts{}
+	data, err := os.ReadFile(filepath.Join(dir, statsFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if len(data) > 0 {
+		// Unreadable counters start over rather than failing the command.
+		_ = json.Unmarshal(data, &stats)
+	}
+	return stats, nil
+}
+
+func saveStats(stats map[string]Counts) error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, statsFile), data, 0600)
+}
+
+// Usage reports the entries, size, age, and lookup counts of each area.
+// With account set, only that account's areas are included.
+func Usage(account string) ([]Area, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	stats, err := loadStats()
+	if err != nil {
+		return nil, err
+	}
+
+	areas := map[string]*Area{}
+	get := func(kind, acct string) *Area {
+		name := kind + "/" + acct
+		if areas[name] == nil {
+			areas[name] = &Area{Kind: kind, Account: acct, Counts: stats[name]}
+		}
+		return areas[name]
+	}
+	for name := range stats {
+		kind, acct, _ := strings.Cut(name, "/")
+		if account == "" || acct == fsutil.SanitizeSegment(account) {
+			get(kind, acct)
+		}
+	}
+
+	err = walkEntries(dir, account, func(kind, acct, path string, info os.FileInfo) error {
+		a := get(kind, acct)
+		a.Entries++
+		a.Bytes += info.Size()
+		if storedAt, ok := storedAtOf(path); ok {
+			if a.Oldest.IsZero() || storedAt.Before(a.Oldest) {
+				a.Oldest = storedAt
+			}
+			if storedAt.After(a.Newest) {
+				a.Newest = storedAt
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Area, 0, len(areas))
+	for _, a := range areas {
+		result = append(result, *a)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Kind != result[j].Kind {
+			return result[i].Kind < result[j].Kind
+		}
+		return result[i].Account < result[j].Account
+	})
+	return result, nil
+}
+
+// Clear removes every entry, and the lookup counters, for account, or for
+// all accounts when account is empty. It returns the number of entries
+// removed.
+func Clear(account string) (int, error) {
+	dir, err := Dir()
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	err = walkEntries(dir, account, func(kind, acct, path string, info os.FileInfo) error {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		removed++
+		return nil
+	})
+	if err != nil {
+		return removed, err
+	}
+
+	stats, err := loadStats()
+	if err != nil {
+		return removed, err
+	}
+	for name := range stats {
+		_, acct, _ := strings.Cut(name, "/")
+		if account == "" || acct == fsutil.SanitizeSegment(account) {
+			delete(stats, name)
+		}
+	}
+	return removed, saveStats(stats)
+}
+
+// GC removes entries for account (or all accounts) stored longer ago than
+// maxAge, along with corrupt entries and leftover temporary files. It
+// returns the number of entries removed.
+func GC(account string, maxAge time.Duration) (int, error) {
+	corrupt, err := RemoveCorrupt()
+	if err != nil {
+		return 0, err
+	}
+	dir, err := Dir()
+	if err != nil {
+		return len(corrupt), err
+	}
+	removed := len(corrupt)
+	err = walkEntries(dir, account, func(kind, acct, path string, info os.FileInfo) error {
+		storedAt, ok := storedAtOf(path)
+		if ok && time.Since(storedAt) <= maxAge {
+			return nil
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		removed++
+		return nil
+	})
+	return removed, err
+}
+
+// walkEntries calls fn for each entry under dir/<kind>/<account>, limited to
+// account when it is set. A missing cache directory has no entries.
+func walkEntries(dir, account string, fn func(kind, account, path string, info os.FileInfo) error) error {
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".json") {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		parts := strings.Split(filepath.ToSlash(rel), "/")
+		if len(parts) < 3 || (account != "" && parts[1] != fsutil.SanitizeSegment(account)) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return fn(parts[0], parts[1], path, info)
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func storedAtOf(path string) (time.Time, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return time.Time{}, false
+	}
+	var e entry
+	if json.Unmarshal(data, &e) != nil || e.StoredAt.IsZero() {
+		return time.Time{}, false
+	}
+	return e.StoredAt, true
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/basecamp/cli/output"
 	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/fsutil"
 )
 
 // Client is an HTTP client for the Fizzy API.
@@ -252,8 +253,10 @@ const maxRetries = 3
 
 var linkNextRe = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
 
-// doWithRetry wraps HTTPClient.Do with retry logic for 429 and 5xx responses.
-// Only retries GET/DELETE/PUT on 5xx; POST/PATCH only retry on 429.
+// doWithRetry wraps HTTPClient.Do with retry logic for 429, 423, and 5xx
+// responses. Only retries GET/DELETE/PUT on 423 and 5xx; POST/PATCH only
+// retry on 429. 409 conflicts are never retried: the same request would
+// conflict again.
 func (c *Client) doWithRetry(req *http.Request) (*http.Response, error) {
 	idempotent := req.Method == "GET" || req.Method == "DELETE" || req.Method == "PUT"
 
@@ -281,6 +284,19 @@ func (c *Client) doWithRetry(req *http.Request) (*http.Response, error) {
 			}
 		}
 
+		// 423: the resource is locked by another operation; wait as long as
+		// the server asks, then retry idempotent methods
+		if resp.StatusCode == 423 && idempotent && attempt < maxRetries {
+			delay := time.Duration(1<<uint(attempt)) * time.Second
+			if ra := resp.Header.Get("Retry-After"); ra != "" {
+				delay = parseRetryAfter(ra)
+			}
+			_ = resp.Body.Close()
+			c.sleep(delay)
+			resetBody(req)
+			continue
+		}
+
 		// 5xx: retry idempotent methods with exponential backoff
 		if resp.StatusCode >= 500 && idempotent && attempt < maxRetries {
 			_ = resp.Body.Close()
@@ -668,7 +684,7 @@ func (c *Client) DownloadFile(urlPath string, destPath string) error {
 	}
 
 	// Create the destination file
-	out, err := os.Create(destPath)
+	out, err := os.Create(fsutil.LongPath(destPath))
 	if err != nil {
 		return errors.NewError(fmt.Sprintf("Failed to create file: %v", err))
 	}
@@ -677,7 +693,7 @@ func (c *Client) DownloadFile(urlPath string, destPath string) error {
 	_, err = io.Copy(out, resp.Body)
 	if err != nil {
 		_ = out.Close()
-		_ = os.Remove(destPath)
+		_ = os.Remove(fsutil.LongPath(destPath))
 		return errors.NewError(fmt.Sprintf("Failed to write file: %v", err))
 	}
 
@@ -775,6 +775,58 @@ func TestRetryPostOn429(t *testing.T) {
 	}
 }
 
+func TestRetryOn423(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts <= 1 {
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(423)
+			w.Write([]byte(`{"error":"board is being moved"}`))
+			return
+		}
+		w.WriteHeader(200)
+		json.NewEncoder(w).Encode(map[string]string{"id": "1"})
+	}))
+	defer server.Close()
+
+	var sleepCalls []time.Duration
+	c := New(server.URL, "test-token", "")
+	c.Sleeper = func(d time.Duration) { sleepCalls = append(sleepCalls, d) }
+
+	if _, err := c.Get("/resource.json"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+	if len(sleepCalls) != 1 || sleepCalls[0] != 2*time.Second {
+		t.Errorf("expected one 2s delay from Retry-After, got %v", sleepCalls)
+	}
+}
+
+func TestNoRetryOn409(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(409)
+		w.Write([]byte(`{"error":"card was changed"}`))
+	}))
+	defer server.Close()
+
+	c := New(server.URL, "test-token", "")
+	c.Sleeper = func(d time.Duration) {}
+
+	_, err := c.Put("/resource.json", map[string]string{"name": "test"})
+	e, ok := err.(*errors.CLIError)
+	if !ok || e.Code != errors.CodeConflict || e.Message != "card was changed" {
+		t.Fatalf("expected a conflict error, got %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt (no retry on 409), got %d", attempts)
+	}
+}
+
 func TestParseRetryAfter(t *testing.T) {
 	tests := []struct {
 		name     string
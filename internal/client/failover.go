@@ -0,0 +1,85 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+// failoverTransport retries requests rejected with 401 using a secondary
+// bearer token, and keeps using the secondary for the rest of the process.
+type failoverTransport struct {
+	base       http.RoundTripper
+	primary    string
+	secondary  string
+	onFailover func()
+	failedOver atomic.Bool
+}
+
+// NewFailoverTransport wraps base so that a request authenticated with the
+// primary token and rejected with 401 Unauthorized is retried once with the
+// secondary token. After a successful retry every later request uses the
+// secondary token, and onFailover (if set) is called once. Requests carrying
+// any other credentials are passed through untouched. A nil base uses
+// http.DefaultTransport.
+func NewFailoverTransport(base http.RoundTripper, primary, secondary string, onFailover func()) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if primary == "" || secondary == "" || primary == secondary {
+		return base
+	}
+	return &failoverTransport{base: base, primary: primary, secondary: secondary, onFailover: onFailover}
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Authorization") != "Bearer "+t.primary {
+		return t.base.RoundTrip(req)
+	}
+	if t.failedOver.Load() {
+		return t.base.RoundTrip(t.withSecondary(req))
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	// A consumed body can only be replayed if the request knows how.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+
+	retry := t.withSecondary(req)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	retryResp, err := t.base.RoundTrip(retry)
+	if err != nil {
+		return resp, nil
+	}
+	if retryResp.StatusCode == http.StatusUnauthorized {
+		drainAndClose(retryResp)
+		return resp, nil
+	}
+
+	drainAndClose(resp)
+	if t.failedOver.CompareAndSwap(false, true) && t.onFailover != nil {
+		t.onFailover()
+	}
+	return retryResp, nil
+}
+
+func (t *failoverTransport) withSecondary(req *http.Request) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", "Bearer "+t.secondary)
+	return r
+}
+
+func drainAndClose(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
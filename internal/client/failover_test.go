@@ -0,0 +1,92 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewFailoverTransport(t *testing.T) {
+	var auths []string
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auths = append(auths, r.Header.Get("Authorization"))
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if r.Header.Get("Authorization") != "Bearer new" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	failovers := 0
+	c := New(server.URL, "old", "account")
+	c.HTTPClient.Transport = NewFailoverTransport(nil, "old", "new", func() { failovers++ })
+
+	if _, err := c.Post("/cards.json", map[string]any{"title": "x"}); err != nil {
+		t.Fatalf("Post failed: %v", err)
+	}
+	if _, err := c.Get("/boards.json"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	want := []string{"Bearer old", "Bearer new", "Bearer new"}
+	if strings.Join(auths, ",") != strings.Join(want, ",") {
+		t.Errorf("expected authorization sequence %v, got %v", want, auths)
+	}
+	if bodies[0] == "" || bodies[1] != bodies[0] {
+		t.Errorf("expected request body to be replayed, got %q", bodies)
+	}
+	if failovers != 1 {
+		t.Errorf("expected onFailover once, got %d", failovers)
+	}
+}
+
+func TestNewFailoverTransport_SecondaryAlsoRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	failovers := 0
+	c := New(server.URL, "old", "account")
+	c.HTTPClient.Transport = NewFailoverTransport(nil, "old", "new", func() { failovers++ })
+
+	if _, err := c.Get("/boards.json"); err == nil {
+		t.Fatal("expected an authentication error")
+	}
+	if failovers != 0 {
+		t.Errorf("expected no failover when the secondary is rejected, got %d", failovers)
+	}
+}
+
+func TestNewFailoverTransport_OtherCredentialsUntouched(t *testing.T) {
+	var auths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auths = append(auths, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	c := New(server.URL, "candidate", "account")
+	c.HTTPClient.Transport = NewFailoverTransport(nil, "old", "new", nil)
+
+	_, _ = c.Get("/my/identity.json")
+	if len(auths) != 1 || auths[0] != "Bearer candidate" {
+		t.Errorf("expected a single request with the candidate token, got %v", auths)
+	}
+}
+
+func TestNewFailoverTransport_Unconfigured(t *testing.T) {
+	base := &http.Transport{}
+	if rt := NewFailoverTransport(base, "old", "", nil); rt != base {
+		t.Error("expected base transport without a secondary token")
+	}
+	if rt := NewFailoverTransport(base, "same", "same", nil); rt != base {
+		t.Error("expected base transport when tokens match")
+	}
+}
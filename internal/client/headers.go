@@ -0,0 +1,35 @@
+package client
+
+import (
+	"net/http"
+)
+
+// headerTransport adds fixed headers to every outgoing request.
+type headerTransport struct {
+	base    http.RoundTripper
+	headers map[string]string
+}
+
+// NewHeaderTransport wraps base so every request carries headers, e.g. the
+// service-token headers an auth proxy in front of a self-hosted instance
+// expects. Headers already set on a request (such as Authorization) are
+// never overwritten. A nil base uses http.DefaultTransport.
+func NewHeaderTransport(base http.RoundTripper, headers map[string]string) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if len(headers) == 0 {
+		return base
+	}
+	return &headerTransport{base: base, headers: headers}
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for name, value := range t.headers {
+		if req.Header.Get(name) == "" {
+			req.Header.Set(name, value)
+		}
+	}
+	return t.base.RoundTrip(req)
+}
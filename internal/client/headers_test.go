@@ -0,0 +1,39 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewHeaderTransport(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := New(server.URL, "token", "account")
+	c.HTTPClient.Transport = NewHeaderTransport(nil, map[string]string{
+		"CF-Access-Client-Id": "client-id",
+		"Authorization":       "Bearer proxy",
+	})
+
+	if _, err := c.Get("/boards.json"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if v := got.Get("CF-Access-Client-Id"); v != "client-id" {
+		t.Errorf("expected injected header, got %q", v)
+	}
+	if v := got.Get("Authorization"); v != "Bearer token" {
+		t.Errorf("expected Authorization to be preserved, got %q", v)
+	}
+}
+
+func TestNewHeaderTransport_NoHeaders(t *testing.T) {
+	base := &http.Transport{}
+	if rt := NewHeaderTransport(base, nil); rt != base {
+		t.Error("expected base transport to be returned unchanged")
+	}
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Limiter bounds the requests in flight and the rate at which they start.
+// One Limiter is shared by every transport that talks to the same instance,
+// so concurrent pagination, bulk operations, and downloads count together.
+type Limiter struct {
+	slots    chan struct{}
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// NewLimiter returns a Limiter allowing maxParallel requests in flight and
+// perSecond requests to start each second. Zero disables either limit; when
+// both are zero NewLimiter returns nil, which limits nothing.
+func NewLimiter(maxParallel int, perSecond float64) *Limiter {
+	if maxParallel <= 0 && perSecond <= 0 {
+		return nil
+	}
+	l := &Limiter{}
+	if maxParallel > 0 {
+		l.slots = make(chan struct{}, maxParallel)
+	}
+	if perSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / perSecond)
+	}
+	return l
+}
+
+// acquire waits for a free slot and for the request's turn under the rate
+// limit. The returned release must be called once the request is finished.
+func (l *Limiter) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		release = sync.OnceFunc(func() { <-l.slots })
+	}
+
+	if l.interval > 0 {
+		l.mu.Lock()
+		now := time.Now()
+		start := l.next
+		if start.Before(now) {
+			start = now
+		}
+		l.next = start.Add(l.interval)
+		l.mu.Unlock()
+
+		if wait := time.Until(start); wait > 0 {
+			timer := time.NewTimer(wait)
+			defer timer.Stop()
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				release()
+				return nil, ctx.Err()
+			}
+		}
+	}
+	return release, nil
+}
+
+type limitTransport struct {
+	base    http.RoundTripper
+	limiter *Limiter
+}
+
+// NewLimitTransport wraps base so every request waits for limiter. A request
+// holds its slot until its response body is closed, so a slow download
+// counts against the limit for as long as it runs. A nil limiter returns
+// base unchanged, and a nil base uses http.DefaultTransport.
+func NewLimitTransport(base http.RoundTripper, limiter *Limiter) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if limiter == nil {
+		return base
+	}
+	return &limitTransport{base: base, limiter: limiter}
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releasingBody frees a request's slot when its body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewLimitTransport_MaxParallel(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: NewLimitTransport(nil, NewLimiter(2, 0))}
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			resp, err := httpClient.Get(server.URL)
+			if err != nil {
+				t.Errorf("Get failed: %v", err)
+				return
+			}
+			_ = resp.Body.Close()
+		})
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > 2 {
+		t.Errorf("expected at most 2 requests in flight, saw %d", got)
+	}
+}
+
+func TestNewLimitTransport_RequestsPerSecond(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: NewLimitTransport(nil, NewLimiter(0, 50))}
+	start := time.Now()
+	for range 4 {
+		resp, err := httpClient.Get(server.URL)
+		if err != nil {
+			t.Fatalf("Get failed: %v", err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	// Four requests at 50/s start at 0, 20, 40, and 60ms.
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Errorf("expected requests to be spaced out, took %s", elapsed)
+	}
+}
+
+func TestNewLimitTransport_NoLimits(t *testing.T) {
+	base := &http.Transport{}
+	if rt := NewLimitTransport(base, NewLimiter(0, 0)); rt != base {
+		t.Error("expected base transport to be returned unchanged")
+	}
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func newClientCert(t *testing.T, dir string) (certPath, keyPath string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "fizzy-cli-test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return writePEM(t, dir, "client.pem", "CERTIFICATE", der), writePEM(t, dir, "client-key.pem", "EC PRIVATE KEY", keyDER)
+}
+
+func TestNewTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+			w.Header().Set("X-Client-Cert", r.TLS.PeerCertificates[0].Subject.CommonName)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS.ClientAuth = tls.RequestClientCert
+	defer server.Close()
+
+	dir := t.TempDir()
+	caPath := writePEM(t, dir, "ca.pem", "CERTIFICATE", server.Certificate().Raw)
+	certPath, keyPath := newClientCert(t, dir)
+
+	get := func(opts TLSOptions) (*http.Response, error) {
+		transport, err := NewTransport(opts)
+		if err != nil {
+			t.Fatalf("NewTransport failed: %v", err)
+		}
+		return (&http.Client{Transport: transport, Timeout: 5 * time.Second}).Get(server.URL)
+	}
+
+	t.Run("rejects unknown CA by default", func(t *testing.T) {
+		if _, err := get(TLSOptions{}); err == nil {
+			t.Error("expected certificate verification error")
+		}
+	})
+
+	t.Run("trusts custom CA", func(t *testing.T) {
+		resp, err := get(TLSOptions{CACert: caPath})
+		if err != nil {
+			t.Fatalf("expected success with custom CA, got %v", err)
+		}
+		resp.Body.Close()
+	})
+
+	t.Run("insecure skip verify", func(t *testing.T) {
+		resp, err := get(TLSOptions{InsecureSkipVerify: true})
+		if err != nil {
+			t.Fatalf("expected success with verification disabled, got %v", err)
+		}
+		resp.Body.Close()
+	})
+
+	t.Run("presents client certificate", func(t *testing.T) {
+		resp, err := get(TLSOptions{CACert: caPath, ClientCert: certPath, ClientKey: keyPath})
+		if err != nil {
+			t.Fatalf("expected success, got %v", err)
+		}
+		resp.Body.Close()
+		if got := resp.Header.Get("X-Client-Cert"); got != "fizzy-cli-test" {
+			t.Errorf("expected client cert to be presented, got %q", got)
+		}
+	})
+}
+
+func TestNewTransportErrors(t *testing.T) {
+	dir := t.TempDir()
+	notPEM := filepath.Join(dir, "ca.txt")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	certPath, _ := newClientCert(t, dir)
+
+	tests := []struct {
+		name string
+		opts TLSOptions
+	}{
+		{"missing CA file", TLSOptions{CACert: filepath.Join(dir, "missing.pem")}},
+		{"CA file without certificates", TLSOptions{CACert: notPEM}},
+		{"client cert without key", TLSOptions{ClientCert: certPath}},
+		{"client key without cert", TLSOptions{ClientKey: certPath}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTransport(tt.opts); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
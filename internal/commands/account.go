@@ -10,7 +10,7 @@ import (
 var accountCmd = &cobra.Command{
 	Use:   "account",
 	Short: "Manage account settings",
-	Long:  "Commands for managing account settings.",
+	Long:  "Commands for managing account settings and switching between accounts.",
 }
 
 var accountShowCmd = &cobra.Command{
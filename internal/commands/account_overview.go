@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/spf13/cobra"
+)
+
+// overviewActivityLimit caps how many recent activities account overview
+// includes.
+const overviewActivityLimit = 5
+
+var accountOverviewCmd = &cobra.Command{
+	Use:   "overview",
+	Short: "Summarize boards, cards, users, and recent activity",
+	Long: `Gathers a usage summary for the active account: board count, open card
+count, user counts, and the most recent activity. The underlying requests run
+concurrently, so this is quick even on large accounts.
+
+Useful for admins auditing several workspaces; pair with --profile to check
+each one.`,
+	Example: `  fizzy account overview
+  fizzy account overview --profile acme --jq '.data.open_cards'`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		overview, err := gatherAccountOverview(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		summary := fmt.Sprintf("%v boards, %v open cards, %v users", overview["boards"], overview["open_cards"], overview["users"])
+		if name, ok := overview["name"].(string); ok && name != "" {
+			summary = fmt.Sprintf("%s: %s", name, summary)
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("boards", "fizzy board list", "List boards"),
+			breadcrumb("users", "fizzy user list --all", "List users"),
+			breadcrumb("activity", "fizzy activity list", "View recent activity"),
+		}
+
+		printDetail(overview, summary, breadcrumbs)
+		return nil
+	},
+}
+
+// gatherAccountOverview fetches the account's settings, boards, open cards,
+// users, and latest activity in parallel and combines them into one summary.
+func gatherAccountOverview(ctx context.Context) (map[string]any, error) {
+	ac := getSDK()
+
+	var (
+		name       string
+		boards     any
+		cards      any
+		users      any
+		activities any
+	)
+	fetches := []func() error{
+		func() error {
+			data, _, err := ac.Account().GetSettings(ctx)
+			if err != nil {
+				return err
+			}
+			if settings, ok := normalizeAny(data).(map[string]any); ok {
+				name, _ = settings["name"].(string)
+			}
+			return nil
+		},
+		func() error {
+			pages, err := ac.GetAll(ctx, "/boards.json")
+			boards = jsonAnySlice(pages)
+			return err
+		},
+		func() error {
+			pages, err := ac.GetAll(ctx, "/cards.json")
+			cards = jsonAnySlice(pages)
+			return err
+		},
+		func() error {
+			pages, err := ac.GetAll(ctx, "/users.json")
+			users = jsonAnySlice(pages)
+			return err
+		},
+		func() error {
+			data, _, err := ac.Cards().ListActivities(ctx, "/activities.json")
+			activities = normalizeAny(data)
+			return err
+		},
+	}
+
+	errs := make([]error, len(fetches))
+	var wg sync.WaitGroup
+	for i, fetch := range fetches {
+		wg.Go(func() { errs[i] = fetch() })
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, convertSDKError(err)
+		}
+	}
+
+	openCards := 0
+	for _, card := range toMaps(cards) {
+		if closed, _ := card["closed"].(bool); !closed {
+			openCards++
+		}
+	}
+	activeUsers := 0
+	for _, user := range toMaps(users) {
+		if active, ok := user["active"].(bool); !ok || active {
+			activeUsers++
+		}
+	}
+
+	recent := make([]any, 0, overviewActivityLimit)
+	for _, activity := range toMaps(activities) {
+		if len(recent) == overviewActivityLimit {
+			break
+		}
+		entry := map[string]any{
+			"action":     activity["action"],
+			"created_at": activity["created_at"],
+		}
+		if creator, ok := activity["creator"].(map[string]any); ok {
+			entry["creator"] = creator["name"]
+		}
+		if eventable, ok := activity["eventable"].(map[string]any); ok {
+			if number, ok := eventable["number"]; ok {
+				entry["card"] = number
+			}
+		}
+		recent = append(recent, entry)
+	}
+
+	overview := map[string]any{
+		"account":         cfg.Account,
+		"boards":          dataCount(boards),
+		"open_cards":      openCards,
+		"users":           dataCount(users),
+		"active_users":    activeUsers,
+		"recent_activity": recent,
+	}
+	if name != "" {
+		overview["name"] = name
+	}
+	return overview, nil
+}
+
+func init() {
+	accountCmd.AddCommand(accountOverviewCmd)
+}
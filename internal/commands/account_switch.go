@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+var accountListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List accounts you can access",
+	Long:  "Lists the accounts your token can access, from the identity endpoint, and marks the active one.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		accounts, err := fetchIdentityAccounts(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		active := strings.TrimPrefix(cfg.Account, "/")
+		entries := make([]any, 0, len(accounts))
+		for _, a := range accounts {
+			entry := map[string]any{
+				"id":     a.ID,
+				"name":   a.Name,
+				"slug":   a.Slug,
+				"active": a.Slug == active,
+			}
+			if profiles != nil {
+				_, err := profiles.Get(a.Slug)
+				entry["has_profile"] = err == nil
+			}
+			entries = append(entries, entry)
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("use", "fizzy account use <slug>", "Switch account"),
+			breadcrumb("show", "fizzy account show", "Show active account settings"),
+		}
+
+		printList(entries, accountColumns, fmt.Sprintf("%d account(s)", len(entries)), breadcrumbs)
+		return nil
+	},
+}
+
+var accountUseCmd = &cobra.Command{
+	Use:   "use SLUG",
+	Short: "Switch the active account",
+	Long: `Switches the active profile to another account your token can access.
+
+The account is looked up by slug, ID, or exact name in the identity endpoint, so
+switching fails if you are not a member. If the account has no saved profile
+yet, one is created with the current token.`,
+	Example: `  fizzy account list
+  fizzy account use 6086023`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		accounts, err := fetchIdentityAccounts(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		account, err := matchAccount(accounts, args[0])
+		if err != nil {
+			return err
+		}
+
+		created := false
+		if creds != nil {
+			if _, err := credsLoadProfileToken(account.Slug); err != nil {
+				if err := credsSaveProfileToken(account.Slug, cfg.Token); err != nil {
+					return &output.Error{Code: output.CodeAPI, Message: err.Error()}
+				}
+				created = true
+			}
+		}
+		if err := activateProfile(account.Slug); err != nil {
+			return err
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("boards", "fizzy board list", "List boards"),
+			breadcrumb("status", "fizzy auth status", "Check auth status"),
+		}
+
+		printMutation(map[string]any{
+			"profile":         account.Slug,
+			"account":         map[string]any{"id": account.ID, "name": account.Name, "slug": account.Slug},
+			"profile_created": created,
+			"message":         fmt.Sprintf("Switched to account %s", accountLabel(account)),
+		}, "", breadcrumbs)
+		return nil
+	},
+}
+
+// fetchIdentityAccounts returns the accounts the current token can access.
+func fetchIdentityAccounts(ctx context.Context) ([]Account, error) {
+	if err := requireAuth(); err != nil {
+		return nil, err
+	}
+	if err := requireSDK(); err != nil {
+		return nil, err
+	}
+
+	_, resp, err := getSDKClient().Identity().GetMyIdentity(ctx)
+	if err != nil {
+		return nil, convertSDKError(err)
+	}
+	accounts, err := parseAccounts(resp.Data)
+	if err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not read accounts: %v", err))
+	}
+	return accounts, nil
+}
+
+// matchAccount finds arg among accounts by slug, ID, or case-insensitive name.
+func matchAccount(accounts []Account, arg string) (Account, error) {
+	arg = strings.TrimPrefix(strings.TrimSpace(arg), "/")
+	for _, a := range accounts {
+		if a.Slug == arg || a.ID == arg {
+			return a, nil
+		}
+	}
+
+	var matches []Account
+	for _, a := range accounts {
+		if strings.EqualFold(a.Name, arg) {
+			matches = append(matches, a)
+		}
+	}
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		e := errors.NewNotFoundError(fmt.Sprintf("account %q not found among your accounts", arg))
+		e.Hint = "Run 'fizzy account list' to see accounts you can access"
+		return Account{}, e
+	default:
+		slugs := make([]string, 0, len(matches))
+		for _, a := range matches {
+			slugs = append(slugs, a.Slug)
+		}
+		return Account{}, errors.NewAmbiguousError("account "+arg, slugs)
+	}
+}
+
+func accountLabel(a Account) string {
+	if a.Name == "" {
+		return a.Slug
+	}
+	return fmt.Sprintf("%s (%s)", a.Name, a.Slug)
+}
+
+func init() {
+	accountCmd.AddCommand(accountListCmd)
+	accountCmd.AddCommand(accountUseCmd)
+}
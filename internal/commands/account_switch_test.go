@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/basecamp/cli/profile"
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/config"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"gopkg.in/yaml.v3"
+)
+
+func identityWithAccounts() *client.APIResponse {
+	return &client.APIResponse{StatusCode: 200, Data: map[string]any{
+		"id": "user-1",
+		"accounts": []any{
+			map[string]any{"id": "1", "slug": "/acme", "name": "Acme"},
+			map[string]any{"id": "2", "slug": "/globex", "name": "Globex"},
+		},
+	}}
+}
+
+func TestAccountList(t *testing.T) {
+	mock := NewMockClient()
+	mock.OnGet("/my/identity.json", identityWithAccounts())
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "acme", "https://api.example.com")
+	defer resetTest()
+
+	err := accountListCmd.RunE(accountListCmd, []string{})
+	assertExitCode(t, err, 0)
+
+	accounts := result.Response.Data.([]any)
+	if len(accounts) != 2 {
+		t.Fatalf("expected 2 accounts, got %d", len(accounts))
+	}
+	first := accounts[0].(map[string]any)
+	if first["slug"] != "acme" || first["active"] != true {
+		t.Errorf("expected acme to be active, got %v", first)
+	}
+	if accounts[1].(map[string]any)["active"] != false {
+		t.Errorf("expected globex to be inactive, got %v", accounts[1])
+	}
+}
+
+func TestAccountUse(t *testing.T) {
+	t.Run("switches to a member account and creates its profile", func(t *testing.T) {
+		tempDir := t.TempDir()
+		config.SetTestConfigDir(tempDir)
+		defer config.ResetTestConfigDir()
+
+		profileStore := profile.NewStore(filepath.Join(t.TempDir(), "config.json"))
+		profileStore.Create(&profile.Profile{Name: "acme", BaseURL: "https://app.fizzy.do"})
+		store := newRotateTestStore(t)
+
+		mock := NewMockClient()
+		mock.OnGet("/my/identity.json", identityWithAccounts())
+		result := SetTestModeWithSDK(mock)
+		SetTestCreds(store)
+		SetTestProfiles(profileStore)
+		SetTestConfig("old-token", "acme", "https://app.fizzy.do")
+		defer resetTest()
+
+		err := accountUseCmd.RunE(accountUseCmd, []string{"Globex"})
+		assertExitCode(t, err, 0)
+
+		data := result.Response.Data.(map[string]any)
+		if data["profile"] != "globex" || data["profile_created"] != true {
+			t.Errorf("expected new globex profile, got %v", data)
+		}
+		if token, err := credsLoadProfileToken("globex"); err != nil || token != "old-token" {
+			t.Errorf("expected current token saved for globex, got %q (%v)", token, err)
+		}
+		if _, defaultName, _ := profileStore.List(); defaultName != "globex" {
+			t.Errorf("expected default profile globex, got %q", defaultName)
+		}
+
+		raw, _ := os.ReadFile(filepath.Join(tempDir, "config.yaml"))
+		var saved config.Config
+		yaml.Unmarshal(raw, &saved)
+		if saved.Account != "globex" {
+			t.Errorf("expected config account globex, got %q", saved.Account)
+		}
+	})
+
+	t.Run("rejects accounts the token cannot access", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/my/identity.json", identityWithAccounts())
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "acme", "https://app.fizzy.do")
+		defer resetTest()
+
+		err := accountUseCmd.RunE(accountUseCmd, []string{"initech"})
+		assertExitCode(t, err, errors.ExitNotFound)
+	})
+
+	t.Run("requires authentication", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("", "acme", "https://app.fizzy.do")
+		defer resetTest()
+
+		err := accountUseCmd.RunE(accountUseCmd, []string{"globex"})
+		assertExitCode(t, err, errors.ExitAuthFailure)
+	})
+}
@@ -293,3 +293,59 @@ func TestAccountJoinCodeUpdate(t *testing.T) {
 		assertExitCode(t, err, errors.ExitInvalidArgs)
 	})
 }
+
+func TestAccountOverview(t *testing.T) {
+	t.Run("combines counts and recent activity", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/account/settings.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{"name": "Acme"}})
+		mock.OnGet("/boards.json", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"id": "b1"}, map[string]any{"id": "b2"},
+		}})
+		mock.OnGet("/cards.json", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"number": 1}, map[string]any{"number": 2, "closed": true}, map[string]any{"number": 3},
+		}})
+		mock.OnGet("/users.json", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"id": "u1", "active": true}, map[string]any{"id": "u2", "active": false},
+		}})
+		mock.OnGet("/activities.json", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"id": "a1", "action": "card_closed", "created_at": "2026-01-02T10:00:00Z", "creator": map[string]any{"name": "Ada"}, "eventable": map[string]any{"number": 2}},
+		}})
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := accountOverviewCmd.RunE(accountOverviewCmd, []string{})
+		assertExitCode(t, err, 0)
+
+		data := result.Response.Data.(map[string]any)
+		expected := map[string]any{
+			"name":         "Acme",
+			"boards":       float64(2),
+			"open_cards":   float64(2),
+			"users":        float64(2),
+			"active_users": float64(1),
+		}
+		for key, want := range expected {
+			if data[key] != want {
+				t.Errorf("expected %s=%v, got %v", key, want, data[key])
+			}
+		}
+		recent := data["recent_activity"].([]any)
+		if len(recent) != 1 || recent[0].(map[string]any)["creator"] != "Ada" {
+			t.Errorf("unexpected recent_activity: %v", recent)
+		}
+	})
+
+	t.Run("fails when any request fails", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetError = errors.NewNotFoundError("not found")
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := accountOverviewCmd.RunE(accountOverviewCmd, []string{})
+		assertExitCode(t, err, errors.ExitNotFound)
+	})
+}
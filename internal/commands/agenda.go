@@ -0,0 +1,234 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// Agenda sections, in priority order.
+const (
+	agendaPinned       = "pinned"
+	agendaGolden       = "golden"
+	agendaMoved        = "moved_today"
+	agendaNotification = "notification"
+)
+
+// agendaMoveActions are the activities that put a card in a column.
+var agendaMoveActions = map[string]bool{
+	"card_triaged":             true,
+	"card_board_changed":       true,
+	"card_sent_back_to_triage": true,
+}
+
+var agendaCmd = &cobra.Command{
+	Use:   "agenda",
+	Short: "Show your day: pinned, golden, and moved cards, and unread notifications",
+	Long: `Shows a prioritized personal view to start the day with, in this order:
+
+  pinned         cards you pinned
+  golden         golden cards assigned to you
+  moved_today    cards assigned to you that moved to a column today
+  notification   your unread notifications
+
+Each card is listed once, under its first section. The requests run
+concurrently.`,
+	Example: `  fizzy agenda
+  fizzy agenda --jq '[.data[] | select(.section == "notification")] | length'`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		items, err := gatherAgenda(cmd.Context(), today)
+		if err != nil {
+			return err
+		}
+
+		counts := map[string]int{}
+		for _, item := range items {
+			counts[item["section"].(string)]++
+		}
+		summary := fmt.Sprintf("%d pinned, %d golden, %d moved today, %d unread %s",
+			counts[agendaPinned], counts[agendaGolden], counts[agendaMoved],
+			counts[agendaNotification], pluralize(counts[agendaNotification], "notification", "notifications"))
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("show", "fizzy card show <number>", "View card"),
+			breadcrumb("read-all", "fizzy notification read-all", "Mark all notifications as read"),
+			breadcrumb("notifications", "fizzy notification list", "List notifications"),
+		}
+
+		list := make([]any, len(items))
+		for i, item := range items {
+			list[i] = item
+		}
+		printList(list, agendaColumns, summary, breadcrumbs)
+		return nil
+	},
+}
+
+// gatherAgenda fetches pins, your assigned and golden cards, recent activity,
+// and unread notifications concurrently, and orders them into agenda items.
+// Cards count as moved today when they moved at or after since.
+func gatherAgenda(ctx context.Context, since time.Time) ([]map[string]any, error) {
+	me, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ac := getSDK()
+	assignee := "assignee_ids[]=" + url.QueryEscape(me)
+
+	var pins, golden, assigned, activities, notifications any
+	fetches := []func() error{
+		func() error {
+			data, _, err := ac.Pins().List(ctx)
+			pins = normalizeAny(data)
+			return err
+		},
+		func() error {
+			pages, err := ac.GetAll(ctx, "/cards.json?indexed_by=golden&"+assignee)
+			golden = jsonAnySlice(pages)
+			return err
+		},
+		func() error {
+			pages, err := ac.GetAll(ctx, "/cards.json?"+assignee)
+			assigned = jsonAnySlice(pages)
+			return err
+		},
+		func() error {
+			data, _, err := ac.Cards().ListActivities(ctx, "/activities.json")
+			activities = normalizeAny(data)
+			return err
+		},
+		func() error {
+			data, _, err := ac.Notifications().GetTray(ctx, nil)
+			notifications = normalizeAny(data)
+			return err
+		},
+	}
+
+	errs := make([]error, len(fetches))
+	var wg sync.WaitGroup
+	for i, fetch := range fetches {
+		wg.Go(func() { errs[i] = fetch() })
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, convertSDKError(err)
+		}
+	}
+
+	var items []map[string]any
+	listed := map[string]bool{}
+	addCard := func(section string, card map[string]any, detail string) {
+		number := fmt.Sprint(card["number"])
+		if listed[number] {
+			return
+		}
+		listed[number] = true
+		item := map[string]any{
+			"section": section,
+			"number":  card["number"],
+			"title":   card["title"],
+			"detail":  detail,
+		}
+		if board, ok := card["board"].(map[string]any); ok {
+			item["board"] = board["name"]
+		}
+		items = append(items, item)
+	}
+
+	for _, card := range toMaps(pins) {
+		addCard(agendaPinned, card, cardColumnName(card))
+	}
+	for _, card := range toMaps(golden) {
+		addCard(agendaGolden, card, cardColumnName(card))
+	}
+	for _, moved := range agendaMovedCards(toMaps(activities), toMaps(assigned), since) {
+		addCard(agendaMoved, moved.card, moved.detail)
+	}
+	for _, notification := range toMaps(notifications) {
+		if read, _ := notification["read"].(bool); read {
+			continue
+		}
+		item := map[string]any{
+			"section": agendaNotification,
+			"id":      notification["id"],
+			"title":   notification["title"],
+			"detail":  notification["body"],
+		}
+		if card, ok := notification["card"].(map[string]any); ok && card["id"] != "" {
+			item["number"] = card["number"]
+			item["board"] = card["board_name"]
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+type agendaMove struct {
+	card   map[string]any
+	detail string
+}
+
+// agendaMovedCards returns the assigned cards that moved to a column at or
+// after since, most recent move first.
+func agendaMovedCards(activities, assigned []map[string]any, since time.Time) []agendaMove {
+	cards := map[string]map[string]any{}
+	for _, card := range assigned {
+		cards[fmt.Sprint(card["number"])] = card
+	}
+
+	var moves []agendaMove
+	seen := map[string]bool{}
+	for _, activity := range activities {
+		if !agendaMoveActions[getStringField(activity, "action")] {
+			continue
+		}
+		created, err := time.Parse(time.RFC3339, getStringField(activity, "created_at"))
+		if err != nil || created.Before(since) {
+			continue
+		}
+		eventable, _ := activity["eventable"].(map[string]any)
+		number := fmt.Sprint(eventable["number"])
+		card, ok := cards[number]
+		if !ok || seen[number] {
+			continue
+		}
+		seen[number] = true
+
+		column := cardColumnName(card)
+		if particulars, ok := activity["particulars"].(map[string]any); ok {
+			if name := getStringField(particulars, "column"); name != "" {
+				column = name
+			}
+		}
+		detail := "moved at " + created.Local().Format("15:04")
+		if column != "" {
+			detail = fmt.Sprintf("moved to %s at %s", column, created.Local().Format("15:04"))
+		}
+		moves = append(moves, agendaMove{card: card, detail: detail})
+	}
+	return moves
+}
+
+// cardColumnName is the name of the column a card is in, if any.
+func cardColumnName(card map[string]any) string {
+	if column, ok := card["column"].(map[string]any); ok {
+		return getStringField(column, "name")
+	}
+	return ""
+}
+
+func init() {
+	rootCmd.AddCommand(agendaCmd)
+}
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+)
+
+func TestAgenda(t *testing.T) {
+	card := func(number float64, title, column string) map[string]any {
+		return map[string]any{
+			"number": number,
+			"title":  title,
+			"board":  map[string]any{"name": "Roadmap"},
+			"column": map[string]any{"name": column},
+		}
+	}
+
+	mock := NewMockClient()
+	mock.OnGet("/my/identity.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+		"accounts": []any{map[string]any{"id": "1", "slug": "/account", "user": map[string]any{"id": "me"}}},
+	}})
+	mock.OnGet("/my/pins.json", &client.APIResponse{StatusCode: 200, Data: []any{card(1, "Pinned", "Doing")}})
+	mock.OnGet("/cards.json?indexed_by=golden&assignee_ids[]=me", &client.APIResponse{StatusCode: 200, Data: []any{
+		card(1, "Pinned", "Doing"),
+		card(2, "Golden", "Next"),
+	}})
+	mock.OnGet("/cards.json?assignee_ids[]=me", &client.APIResponse{StatusCode: 200, Data: []any{
+		card(2, "Golden", "Next"),
+		card(3, "Moved", "Review"),
+		card(4, "Old move", "Doing"),
+	}})
+	now := time.Now().UTC()
+	mock.OnGet("/activities.json", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"action": "card_triaged", "created_at": now.Format(time.RFC3339), "eventable": map[string]any{"number": 3}, "particulars": map[string]any{"column": "Review"}},
+		map[string]any{"action": "card_triaged", "created_at": now.Format(time.RFC3339), "eventable": map[string]any{"number": 99}},
+		map[string]any{"action": "comment_created", "created_at": now.Format(time.RFC3339), "eventable": map[string]any{"number": 4}},
+		map[string]any{"action": "card_triaged", "created_at": now.AddDate(0, 0, -3).Format(time.RFC3339), "eventable": map[string]any{"number": 4}},
+	}})
+	mock.OnGet("/notifications/tray.json", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"id": "n1", "title": "Ann commented", "read": false, "card": map[string]any{"id": "c5", "number": 5, "board_name": "Roadmap"}},
+		map[string]any{"id": "n2", "title": "Old news", "read": true},
+	}})
+
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	err := agendaCmd.RunE(agendaCmd, []string{})
+	assertExitCode(t, err, 0)
+
+	items := result.Response.Data.([]any)
+	want := []struct {
+		section string
+		number  float64
+	}{{"pinned", 1}, {"golden", 2}, {"moved_today", 3}, {"notification", 5}}
+	if len(items) != len(want) {
+		t.Fatalf("expected %d agenda items, got %v", len(want), items)
+	}
+	for i, w := range want {
+		item := items[i].(map[string]any)
+		if item["section"] != w.section || item["number"] != w.number {
+			t.Errorf("item %d: expected %s #%v, got %v", i, w.section, w.number, item)
+		}
+	}
+	if detail := items[2].(map[string]any)["detail"].(string); detail[:15] != "moved to Review" {
+		t.Errorf("expected move detail, got %q", detail)
+	}
+	if result.Response.Summary != "1 pinned, 1 golden, 1 moved today, 1 unread notification" {
+		t.Errorf("unexpected summary %q", result.Response.Summary)
+	}
+}
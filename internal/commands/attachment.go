@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/fsutil"
 	"github.com/spf13/cobra"
 )
 
@@ -92,7 +93,8 @@ If ATTACHMENT_INDEX is omitted, downloads all attachments.
 Use --include-comments to also download attachments from comments on the card.
 
 Use 'fizzy card attachments show CARD_NUMBER' to see available attachments and their indices.`,
-	Args: cobra.RangeArgs(1, 2),
+	Args:        cobra.RangeArgs(1, 2),
+	Annotations: map[string]string{downloadOutputAnnotation: "true"},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
@@ -284,9 +286,9 @@ func extractAttr(attrs, name string) string {
 // buildOutputPath determines the output filename for a download.
 // For a single file, outputFlag is used as the exact filename.
 // For multiple files, outputFlag is used as a prefix: prefix_1.ext, prefix_2.ext, etc.
-// If outputFlag is empty, the original filename is used (sanitized).
+// If outputFlag is empty, the original filename is used (sanitized for this platform).
 func buildOutputPath(outputFlag, originalFilename string, index, total int) string {
-	safeName := filepath.Base(originalFilename)
+	safeName := fsutil.SafeFilename(originalFilename)
 	if outputFlag == "" {
 		return safeName
 	}
@@ -305,7 +307,7 @@ func init() {
 	attachmentsShowCmd.Flags().BoolVar(&attachmentsShowIncludeComments, "include-comments", false, "Also include attachments from comments")
 	attachmentsCmd.AddCommand(attachmentsShowCmd)
 
-	attachmentsDownloadCmd.Flags().StringVarP(&attachmentDownloadOutput, "output", "o", "", "Output filename (single file) or prefix (multiple files, e.g. -o test produces test_1.png)")
+	attachmentsDownloadCmd.Flags().StringVar(&attachmentDownloadOutput, "output", "", "Output filename (single file) or prefix (multiple files, e.g. -o test produces test_1.png)")
 	attachmentsDownloadCmd.Flags().BoolVar(&attachmentsDownloadIncludeComments, "include-comments", false, "Also include attachments from comments")
 	attachmentsCmd.AddCommand(attachmentsDownloadCmd)
 }
@@ -0,0 +1,141 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/fsutil"
+	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
+	"github.com/spf13/cobra"
+)
+
+// Attachment rehost flags
+var attachmentsRehostDryRun bool
+
+var attachmentsRehostCmd = &cobra.Command{
+	Use:   "rehost CARD_NUMBER",
+	Short: "Re-upload a card's attachments and rewrite their references",
+	Long: `Downloads each attachment embedded in a card's description, uploads it again
+to the same account, and rewrites the description to point at the new
+uploads. This repairs cards whose attachments were orphaned by past
+migrations or imports.
+
+Attachments that fail are reported under "failed" and keep their old
+reference; the command then exits with code 9. --dry-run lists the
+attachments without changing anything.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		cardNumber := args[0]
+		ac := getSDK()
+		cardData, _, err := ac.Cards().Get(cmd.Context(), cardNumber)
+		if err != nil {
+			return convertSDKError(err)
+		}
+		cardMap := toMap(cardData)
+		if cardMap == nil {
+			return errors.NewError("Invalid card response")
+		}
+
+		descriptionHTML, _ := cardMap["description_html"].(string)
+		var attachments []Attachment
+		for _, attachment := range parseAttachments(descriptionHTML) {
+			if attachment.DownloadURL != "" && attachment.SGID != "" {
+				attachments = append(attachments, attachment)
+			}
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("attachments", fmt.Sprintf("fizzy card attachments show %s", cardNumber), "List attachments"),
+			breadcrumb("show", fmt.Sprintf("fizzy card show %s", cardNumber), "View card"),
+		}
+
+		if len(attachments) == 0 || attachmentsRehostDryRun {
+			files := make([]any, len(attachments))
+			for i, attachment := range attachments {
+				files[i] = map[string]any{"filename": attachment.Filename, "sgid": attachment.SGID}
+			}
+			summary := fmt.Sprintf("Would re-host %d %s on card #%s", len(attachments), pluralize(len(attachments), "attachment", "attachments"), cardNumber)
+			if len(attachments) == 0 {
+				summary = fmt.Sprintf("No attachments to re-host on card #%s", cardNumber)
+			}
+			printMutation(map[string]any{"card": cardNumber, "attachments": files, "dry_run": attachmentsRehostDryRun}, summary, breadcrumbs)
+			return nil
+		}
+
+		c := getClient()
+		result := &bulkResult{}
+		description := descriptionHTML
+		for i, attachment := range attachments {
+			newSGID, err := reuploadAttachment(c, c, attachment)
+			if err != nil {
+				if result.fail(attachment.Filename, err) {
+					for _, rest := range attachments[i+1:] {
+						result.skip(rest.Filename)
+					}
+					break
+				}
+				continue
+			}
+			description = strings.Replace(description, attachment.SGID, newSGID, 1)
+			result.succeed(map[string]any{"filename": attachment.Filename, "previous_sgid": attachment.SGID, "sgid": newSGID})
+		}
+
+		if len(result.succeeded) > 0 {
+			if _, _, err := ac.Cards().Update(cmd.Context(), cardNumber, &generated.UpdateCardRequest{Description: description}); err != nil {
+				return convertSDKError(err)
+			}
+		}
+
+		summary := fmt.Sprintf("Re-hosted %d of %d %s on card #%s", len(result.succeeded), len(attachments), pluralize(len(attachments), "attachment", "attachments"), cardNumber)
+		return printBulkResult(map[string]any{"card": cardNumber, "rehosted": len(result.succeeded)}, result, "attachments", summary, breadcrumbs)
+	},
+}
+
+// reuploadAttachment downloads an attachment with source and uploads it with
+// target, returning the new upload's SGID.
+func reuploadAttachment(source, target client.API, attachment Attachment) (string, error) {
+	dir, err := os.MkdirTemp("", "fizzy-attachment-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+
+	name := fsutil.SafeFilename(attachment.Filename)
+	if name == "" {
+		name = fmt.Sprintf("attachment-%d", attachment.Index)
+	}
+	path := filepath.Join(dir, name)
+	if err := source.DownloadFile(attachment.DownloadURL, path); err != nil {
+		return "", fmt.Errorf("download failed: %w", err)
+	}
+	uploadResp, err := target.UploadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("upload failed: %w", err)
+	}
+
+	uploadData, ok := uploadResp.Data.(map[string]any)
+	if !ok {
+		return "", errors.NewError("Invalid upload response")
+	}
+	sgid := getStringField(uploadData, "attachable_sgid")
+	if sgid == "" {
+		sgid = getStringField(uploadData, "signed_id")
+	}
+	if sgid == "" {
+		return "", errors.NewError("No SGID in upload response")
+	}
+	return sgid, nil
+}
+
+func init() {
+	attachmentsRehostCmd.Flags().BoolVar(&attachmentsRehostDryRun, "dry-run", false, "List the attachments without changing anything")
+	attachmentsCmd.AddCommand(attachmentsRehostCmd)
+}
@@ -1,7 +1,12 @@
 package commands
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
 )
 
 func TestParseAttachments(t *testing.T) {
@@ -313,6 +318,14 @@ func TestBuildOutputPath(t *testing.T) {
 			total:    1,
 			expected: "passwd",
 		},
+		{
+			name:     "sanitizes backslash traversal in original filename",
+			flag:     "",
+			filename: `..\..\Windows\evil.dll`,
+			index:    1,
+			total:    1,
+			expected: "evil.dll",
+		},
 	}
 
 	for _, tt := range tests {
@@ -637,3 +650,86 @@ func TestAttachmentDownloadSanitizesFilename(t *testing.T) {
 		})
 	}
 }
+
+func TestCardAttachmentsRehostCommand(t *testing.T) {
+	newMock := func() *MockClient {
+		mock := NewMockClient()
+		mock.OnGet("/cards/241", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+			"number": 241,
+			"description_html": `<p>Before</p><action-text-attachment sgid="old-1" content-type="image/png" filename="a.png" filesize="10">
+				<a href="/blobs/blob1/a.png?disposition=attachment">Download</a>
+			</action-text-attachment><action-text-attachment sgid="old-2" content-type="application/pdf" filename="b.pdf" filesize="20">
+				<a href="/blobs/blob2/b.pdf?disposition=attachment">Download</a>
+			</action-text-attachment>`,
+		}})
+		mock.UploadFileResponses = []*client.APIResponse{
+			{StatusCode: 200, Data: map[string]any{"attachable_sgid": "new-1"}},
+			{StatusCode: 200, Data: map[string]any{"attachable_sgid": "new-2"}},
+		}
+		return mock
+	}
+
+	t.Run("re-uploads and rewrites the description", func(t *testing.T) {
+		mock := newMock()
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := attachmentsRehostCmd.RunE(attachmentsRehostCmd, []string{"241"})
+		assertExitCode(t, err, 0)
+
+		if len(mock.DownloadFileCalls) != 2 || len(mock.UploadFileCalls) != 2 {
+			t.Fatalf("expected 2 downloads and uploads, got %d and %d", len(mock.DownloadFileCalls), len(mock.UploadFileCalls))
+		}
+		if len(mock.PatchCalls) != 1 {
+			t.Fatalf("expected one card update, got %+v", mock.PatchCalls)
+		}
+		body, _ := json.Marshal(mock.PatchCalls[0].Body)
+		if !strings.Contains(string(body), "new-1") || !strings.Contains(string(body), "new-2") || strings.Contains(string(body), "old-1") {
+			t.Errorf("expected rewritten SGIDs in description, got %s", body)
+		}
+		data := result.Response.Data.(map[string]any)
+		if data["rehosted"] != float64(2) {
+			t.Errorf("expected 2 re-hosted, got %v", data)
+		}
+	})
+
+	t.Run("reports failed uploads", func(t *testing.T) {
+		mock := newMock()
+		mock.UploadFileError = errors.NewError("upload rejected")
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := attachmentsRehostCmd.RunE(attachmentsRehostCmd, []string{"241"})
+		if errors.ExitCodeOf(err) != errors.ExitPartial {
+			t.Fatalf("expected exit code %d, got %v", errors.ExitPartial, err)
+		}
+
+		if len(mock.PatchCalls) != 0 {
+			t.Errorf("expected no card update, got %+v", mock.PatchCalls)
+		}
+		if failed := result.Response.Data.(map[string]any)["failed"].([]any); len(failed) != 2 {
+			t.Errorf("expected 2 failures, got %v", failed)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		mock := newMock()
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		attachmentsRehostDryRun = true
+		defer func() { attachmentsRehostDryRun = false }()
+		err := attachmentsRehostCmd.RunE(attachmentsRehostCmd, []string{"241"})
+		assertExitCode(t, err, 0)
+
+		if len(mock.UploadFileCalls) != 0 {
+			t.Errorf("expected no uploads, got %v", mock.UploadFileCalls)
+		}
+		if result.Response.Summary != "Would re-host 2 attachments on card #241" {
+			t.Errorf("unexpected summary %q", result.Response.Summary)
+		}
+	})
+}
@@ -19,8 +19,11 @@ var authCmd = &cobra.Command{
 var authLoginCmd = &cobra.Command{
 	Use:   "login TOKEN",
 	Short: "Save API token",
-	Long:  "Saves the provided API token to the system keyring (or fallback file).",
-	Args:  cobra.ExactArgs(1),
+	Long: `Saves the provided API token to the system keyring (or fallback file).
+
+With --secondary, the token is saved as the profile's failover token instead,
+used automatically when the API rejects the primary token.`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		token := args[0]
 		profileName := cfg.Account
@@ -29,6 +32,22 @@ var authLoginCmd = &cobra.Command{
 			return errors.NewInvalidArgsError("No profile configured. Set --profile flag, FIZZY_PROFILE, or run 'fizzy setup'")
 		}
 
+		if secondary, _ := cmd.Flags().GetBool("secondary"); secondary {
+			if creds == nil {
+				return errors.NewError("Credential store unavailable")
+			}
+			if err := credsSaveSecondaryToken(profileName, token); err != nil {
+				return &output.Error{Code: output.CodeAPI, Message: err.Error()}
+			}
+			printMutation(map[string]any{
+				"profile": profileName,
+				"message": "Secondary token saved",
+			}, "", []Breadcrumb{
+				breadcrumb("status", "fizzy auth status", "Check auth status"),
+			})
+			return nil
+		}
+
 		if creds != nil {
 			if err := credsSaveProfileToken(profileName, token); err != nil {
 				return &output.Error{Code: output.CodeAPI, Message: err.Error()}
@@ -102,6 +121,7 @@ var authLogoutCmd = &cobra.Command{
 		// Preserve legacy keys for downgrade compatibility.
 		if creds != nil {
 			_ = credsDeleteProfileToken(profileName)
+			_ = credsDeleteSecondaryToken(profileName)
 		}
 
 		// Remove profile from store
@@ -149,8 +169,9 @@ func authLogoutAll() error {
 
 	for name := range names {
 		if creds != nil {
-			_ = credsDeleteProfileToken(name) // "profile:<name>"
-			_ = creds.Delete("token:" + name) // legacy "token:<account>"
+			_ = credsDeleteProfileToken(name)   // "profile:<name>"
+			_ = credsDeleteSecondaryToken(name) // "profile:<name>:secondary"
+			_ = creds.Delete("token:" + name)   // legacy "token:<account>"
 		}
 		if profiles != nil {
 			_ = profiles.Delete(name)
@@ -181,7 +202,7 @@ func authLogoutAll() error {
 var authStatusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Show authentication status",
-	Long:  "Shows whether you are currently authenticated.",
+	Long:  "Shows whether you are currently authenticated, and the token's permission when the server reports it.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		effectiveCfg := cfg
 		if effectiveCfg == nil {
@@ -200,6 +221,12 @@ var authStatusCmd = &cobra.Command{
 			if effectiveCfg.APIURL != "" && effectiveCfg.APIURL != config.DefaultAPIURL {
 				status["api_url"] = effectiveCfg.APIURL
 			}
+			if effectiveCfg.SecondaryToken != "" {
+				status["secondary_token_configured"] = true
+			}
+			if permission := fetchTokenPermission(cmd.Context()); permission != "" {
+				status["token_permission"] = permission
+			}
 		}
 
 		if creds != nil {
@@ -315,50 +342,8 @@ var authSwitchCmd = &cobra.Command{
 			return errors.NewError(fmt.Sprintf("No credentials found for profile %q. Run 'fizzy auth login <token> --profile %s' or 'fizzy signup'", profileName, profileName))
 		}
 
-		// Ensure profile exists in store
-		if profiles != nil {
-			ensureProfile(profileName, cfg.APIURL, "")
-			if err := profiles.SetDefault(profileName); err != nil {
-				return &output.Error{Code: output.CodeAPI, Message: err.Error()}
-			}
-		}
-
-		// Read the target profile's board from Extra
-		var profileBoard string
-		if profiles != nil {
-			if p, err := profiles.Get(profileName); err == nil {
-				if boardRaw, ok := p.Extra["board"]; ok {
-					_ = json.Unmarshal(boardRaw, &profileBoard)
-				}
-			}
-		}
-
-		// Update YAML config for backward compat
-		globalCfg := config.LoadGlobal()
-		globalCfg.Account = profileName
-		globalCfg.Board = profileBoard
-		if err := globalCfg.Save(); err != nil {
-			return &output.Error{Code: output.CodeAPI, Message: err.Error()}
-		}
-
-		// Update in-memory config
-		if cfg != nil {
-			cfg.Account = profileName
-			cfg.Board = profileBoard
-			if creds != nil {
-				if t, err := credsLoadProfileToken(profileName); err == nil {
-					cfg.Token = t
-				} else if t, err := credsLoadLegacyToken(profileName); err == nil {
-					cfg.Token = t
-				}
-			}
-
-			// Apply profile's BaseURL
-			if profiles != nil {
-				if p, err := profiles.Get(profileName); err == nil && p.BaseURL != "" {
-					cfg.APIURL = p.BaseURL
-				}
-			}
+		if err := activateProfile(profileName); err != nil {
+			return err
 		}
 
 		breadcrumbs := []Breadcrumb{
@@ -374,6 +359,57 @@ var authSwitchCmd = &cobra.Command{
 	},
 }
 
+// activateProfile makes profileName the default profile, carries its board into
+// the global config, and points the in-memory config at its credentials.
+func activateProfile(profileName string) error {
+	// Ensure profile exists in store
+	if profiles != nil {
+		ensureProfile(profileName, cfg.APIURL, "")
+		if err := profiles.SetDefault(profileName); err != nil {
+			return &output.Error{Code: output.CodeAPI, Message: err.Error()}
+		}
+	}
+
+	// Read the target profile's board from Extra
+	var profileBoard string
+	if profiles != nil {
+		if p, err := profiles.Get(profileName); err == nil {
+			if boardRaw, ok := p.Extra["board"]; ok {
+				_ = json.Unmarshal(boardRaw, &profileBoard)
+			}
+		}
+	}
+
+	// Update YAML config for backward compat
+	globalCfg := config.LoadGlobal()
+	globalCfg.Account = profileName
+	globalCfg.Board = profileBoard
+	if err := globalCfg.Save(); err != nil {
+		return &output.Error{Code: output.CodeAPI, Message: err.Error()}
+	}
+
+	// Update in-memory config
+	if cfg != nil {
+		cfg.Account = profileName
+		cfg.Board = profileBoard
+		if creds != nil {
+			if t, err := credsLoadProfileToken(profileName); err == nil {
+				cfg.Token = t
+			} else if t, err := credsLoadLegacyToken(profileName); err == nil {
+				cfg.Token = t
+			}
+		}
+
+		// Apply profile's BaseURL
+		if profiles != nil {
+			if p, err := profiles.Get(profileName); err == nil && p.BaseURL != "" {
+				cfg.APIURL = p.BaseURL
+			}
+		}
+	}
+	return nil
+}
+
 func init() {
 	rootCmd.AddCommand(authCmd)
 	authCmd.AddCommand(authLoginCmd)
@@ -383,4 +419,5 @@ func init() {
 	authCmd.AddCommand(authSwitchCmd)
 
 	authLogoutCmd.Flags().Bool("all", false, "Log out of all profiles")
+	authLoginCmd.Flags().Bool("secondary", false, "Save as the failover token used when the primary is rejected")
 }
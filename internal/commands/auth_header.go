@@ -0,0 +1,233 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/cli/profile"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+// headerNamePattern matches an HTTP header field name (RFC 9110 token).
+var headerNamePattern = regexp.MustCompile("^[A-Za-z0-9!#$%&'*+.^_`|~-]+$")
+
+var authHeaderCmd = &cobra.Command{
+	Use:   "header",
+	Short: "Manage extra request headers",
+	Long: `Manage extra headers sent with every API request for the active profile.
+
+Use this for self-hosted instances behind an auth proxy, such as Cloudflare
+Access service tokens or gateway keys. Values may reference environment
+variables as $VAR or ${VAR} so secrets need not be stored in the profile:
+
+  fizzy auth header set CF-Access-Client-Id abc123.access
+  fizzy auth header set CF-Access-Client-Secret '${CF_ACCESS_CLIENT_SECRET}'
+
+Headers can also be set for every profile with the headers map in config.yaml.`,
+}
+
+var authHeaderListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List extra request headers",
+	Long:  "Lists the extra request headers configured for the active profile. Literal values are masked.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		p, err := activeProfile()
+		if err != nil {
+			return err
+		}
+
+		headers := profileHeaders(p)
+		names := make([]string, 0, len(headers))
+		for name := range headers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		entries := make([]any, 0, len(names))
+		for _, name := range names {
+			entries = append(entries, map[string]any{
+				"name":  name,
+				"value": maskHeaderValue(headers[name]),
+			})
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("set", "fizzy auth header set <name> <value>", "Add a header"),
+			breadcrumb("unset", "fizzy auth header unset <name>", "Remove a header"),
+		}
+		printList(entries, authHeaderColumns, fmt.Sprintf("%d header(s) for profile %s", len(entries), p.Name), breadcrumbs)
+		return nil
+	},
+}
+
+var authHeaderSetCmd = &cobra.Command{
+	Use:   "set NAME VALUE",
+	Short: "Set an extra request header",
+	Long:  "Sets a header sent with every API request for the active profile.",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := http.CanonicalHeaderKey(strings.TrimSpace(args[0]))
+		value := args[1]
+		if !headerNamePattern.MatchString(name) {
+			return errors.NewInvalidArgsError(fmt.Sprintf("invalid header name %q", args[0]))
+		}
+		if strings.EqualFold(name, "Authorization") {
+			return errors.NewInvalidArgsError("the Authorization header is managed by 'fizzy auth login'")
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return errors.NewInvalidArgsError("header value must not contain newlines")
+		}
+
+		p, err := activeProfile()
+		if err != nil {
+			return err
+		}
+		headers := profileHeaders(p)
+		if headers == nil {
+			headers = map[string]string{}
+		}
+		headers[name] = value
+		if err := saveProfileHeaders(p, headers); err != nil {
+			return err
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("list", "fizzy auth header list", "List headers"),
+			breadcrumb("doctor", "fizzy doctor", "Check API connectivity"),
+		}
+		printMutation(map[string]any{
+			"profile": p.Name,
+			"name":    name,
+			"value":   maskHeaderValue(value),
+		}, "", breadcrumbs)
+		return nil
+	},
+}
+
+var authHeaderUnsetCmd = &cobra.Command{
+	Use:   "unset NAME",
+	Short: "Remove an extra request header",
+	Long:  "Removes a header from the active profile.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := http.CanonicalHeaderKey(strings.TrimSpace(args[0]))
+
+		p, err := activeProfile()
+		if err != nil {
+			return err
+		}
+		headers := profileHeaders(p)
+		if _, ok := headers[name]; !ok {
+			return errors.NewNotFoundError(fmt.Sprintf("header %q is not set for profile %s", name, p.Name))
+		}
+		delete(headers, name)
+		if err := saveProfileHeaders(p, headers); err != nil {
+			return err
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("list", "fizzy auth header list", "List headers"),
+		}
+		printMutation(map[string]any{
+			"profile": p.Name,
+			"name":    name,
+			"removed": true,
+		}, "", breadcrumbs)
+		return nil
+	},
+}
+
+// activeProfile returns the stored profile for the current account.
+func activeProfile() (*profile.Profile, error) {
+	if cfg.Account == "" {
+		return nil, errors.NewInvalidArgsError("No profile configured. Set --profile flag, FIZZY_PROFILE, or run 'fizzy setup'")
+	}
+	if profiles == nil {
+		return nil, errors.NewError("Profile store unavailable")
+	}
+	ensureProfile(cfg.Account, cfg.APIURL, "")
+	p, err := profiles.Get(cfg.Account)
+	if err != nil {
+		return nil, &output.Error{Code: output.CodeAPI, Message: err.Error()}
+	}
+	return p, nil
+}
+
+// profileHeaders returns the extra request headers stored on a profile.
+func profileHeaders(p *profile.Profile) map[string]string {
+	raw, ok := p.Extra["headers"]
+	if !ok {
+		return nil
+	}
+	var headers map[string]string
+	if json.Unmarshal(raw, &headers) != nil {
+		return nil
+	}
+	return headers
+}
+
+// saveProfileHeaders replaces the extra request headers stored on a profile,
+// keeping it the default profile if it was one.
+func saveProfileHeaders(p *profile.Profile, headers map[string]string) error {
+	if len(headers) == 0 {
+		return saveProfileExtra(p, "headers", nil)
+	}
+	return saveProfileExtra(p, "headers", headers)
+}
+
+// saveProfileExtra sets one extra setting stored on a profile, or removes it
+// when value is nil, keeping it the default profile if it was one.
+func saveProfileExtra(p *profile.Profile, key string, value any) error {
+	extra := map[string]json.RawMessage{}
+	for k, v := range p.Extra {
+		extra[k] = v
+	}
+	if value == nil {
+		delete(extra, key)
+	} else {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		extra[key] = raw
+	}
+
+	updated := &profile.Profile{Name: p.Name, BaseURL: p.BaseURL}
+	if len(extra) > 0 {
+		updated.Extra = extra
+	}
+
+	_, defaultName, _ := profiles.List()
+	if err := profiles.Delete(p.Name); err != nil {
+		return &output.Error{Code: output.CodeAPI, Message: err.Error()}
+	}
+	if err := profiles.Create(updated); err != nil {
+		return &output.Error{Code: output.CodeAPI, Message: err.Error()}
+	}
+	if defaultName == p.Name {
+		_ = profiles.SetDefault(p.Name)
+	}
+	return nil
+}
+
+// maskHeaderValue hides literal header values; environment references are
+// shown as-is since they carry no secret.
+func maskHeaderValue(value string) string {
+	if strings.HasPrefix(value, "$") {
+		return value
+	}
+	return "********"
+}
+
+func init() {
+	authCmd.AddCommand(authHeaderCmd)
+	authHeaderCmd.AddCommand(authHeaderListCmd)
+	authHeaderCmd.AddCommand(authHeaderSetCmd)
+	authHeaderCmd.AddCommand(authHeaderUnsetCmd)
+}
@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/basecamp/cli/profile"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestAuthHeader(t *testing.T) {
+	setup := func(t *testing.T) (*profile.Store, *CommandResult) {
+		t.Helper()
+		store := profile.NewStore(filepath.Join(t.TempDir(), "config.json"))
+		if err := store.Create(&profile.Profile{Name: "acme", BaseURL: "https://fizzy.example.com"}); err != nil {
+			t.Fatal(err)
+		}
+		result := SetTestModeWithSDK(NewMockClient())
+		SetTestProfiles(store)
+		SetTestConfig("token", "acme", "https://fizzy.example.com")
+		return store, result
+	}
+
+	t.Run("set stores header on the profile and keeps it default", func(t *testing.T) {
+		store, result := setup(t)
+		defer resetTest()
+
+		err := authHeaderSetCmd.RunE(authHeaderSetCmd, []string{"cf-access-client-id", "abc.access"})
+		assertExitCode(t, err, 0)
+
+		p, err := store.Get("acme")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := profileHeaders(p)["Cf-Access-Client-Id"]; got != "abc.access" {
+			t.Errorf("expected header stored, got %q", got)
+		}
+		if _, def, _ := store.List(); def != "acme" {
+			t.Errorf("expected acme to remain default, got %q", def)
+		}
+		data := result.Response.Data.(map[string]any)
+		if data["value"] != "********" {
+			t.Errorf("expected masked value in output, got %v", data["value"])
+		}
+	})
+
+	t.Run("rejects Authorization", func(t *testing.T) {
+		setup(t)
+		defer resetTest()
+
+		err := authHeaderSetCmd.RunE(authHeaderSetCmd, []string{"Authorization", "Bearer x"})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+
+	t.Run("list masks literal values", func(t *testing.T) {
+		_, result := setup(t)
+		defer resetTest()
+
+		assertExitCode(t, authHeaderSetCmd.RunE(authHeaderSetCmd, []string{"X-Gateway-Key", "secret"}), 0)
+		assertExitCode(t, authHeaderSetCmd.RunE(authHeaderSetCmd, []string{"X-Gateway-Secret", "${GATEWAY_SECRET}"}), 0)
+		err := authHeaderListCmd.RunE(authHeaderListCmd, []string{})
+		assertExitCode(t, err, 0)
+
+		items := result.Response.Data.([]any)
+		if len(items) != 2 {
+			t.Fatalf("expected 2 headers, got %d", len(items))
+		}
+		first := items[0].(map[string]any)
+		second := items[1].(map[string]any)
+		if first["name"] != "X-Gateway-Key" || first["value"] != "********" {
+			t.Errorf("unexpected first header: %v", first)
+		}
+		if second["value"] != "${GATEWAY_SECRET}" {
+			t.Errorf("expected env reference shown as-is, got %v", second["value"])
+		}
+	})
+
+	t.Run("unset removes header", func(t *testing.T) {
+		store, _ := setup(t)
+		defer resetTest()
+
+		assertExitCode(t, authHeaderSetCmd.RunE(authHeaderSetCmd, []string{"X-Gateway-Key", "secret"}), 0)
+		err := authHeaderUnsetCmd.RunE(authHeaderUnsetCmd, []string{"x-gateway-key"})
+		assertExitCode(t, err, 0)
+
+		p, _ := store.Get("acme")
+		if _, ok := p.Extra["headers"]; ok {
+			t.Error("expected headers removed from profile")
+		}
+
+		err = authHeaderUnsetCmd.RunE(authHeaderUnsetCmd, []string{"x-gateway-key"})
+		assertExitCode(t, err, errors.ExitNotFound)
+	})
+}
+
+func TestRequestHeaders(t *testing.T) {
+	SetTestConfig("token", "acme", "https://fizzy.example.com")
+	defer resetTest()
+	t.Setenv("FIZZY_TEST_GATEWAY_SECRET", "s3cret")
+
+	cfg.Headers = map[string]string{"X-Gateway-Secret": "${FIZZY_TEST_GATEWAY_SECRET}"}
+	if got := requestHeaders()["X-Gateway-Secret"]; got != "s3cret" {
+		t.Errorf("expected env reference expanded, got %q", got)
+	}
+
+	transport, err := httpTransport()
+	if err != nil || transport == nil {
+		t.Fatalf("expected header transport, got %v, %v", transport, err)
+	}
+}
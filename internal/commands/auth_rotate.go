@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	authRotateNewToken string
+	authRotateDropOld  bool
+)
+
+var authRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Replace the saved token after validating the new one",
+	Long: `Validates a new token against the API and, only if it works for the active
+profile's account, saves it as the primary token.
+
+The previous token is kept as the secondary token, which is tried automatically
+whenever the API rejects the primary with 401 Unauthorized. This lets
+long-running processes keep working while the old token is revoked. Use
+--drop-old to discard the previous token instead.
+
+A secondary token can also be supplied with FIZZY_SECONDARY_TOKEN, or saved
+directly with 'fizzy auth login TOKEN --secondary'.`,
+	Example: `  fizzy auth rotate --new-token fizzy_abc123
+  fizzy auth rotate --new-token "$NEW_TOKEN" --drop-old`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		newToken := strings.TrimSpace(authRotateNewToken)
+		if newToken == "" {
+			return newRequiredFlagError("new-token")
+		}
+		profileName := cfg.Account
+		if profileName == "" {
+			return errors.NewInvalidArgsError("No profile configured. Set --profile flag, FIZZY_PROFILE, or run 'fizzy setup'")
+		}
+		if creds == nil {
+			return errors.NewError("Credential store unavailable")
+		}
+
+		oldToken, _ := credsLoadProfileToken(profileName)
+		if oldToken == newToken {
+			return errors.NewInvalidArgsError("the new token is the same as the saved token")
+		}
+
+		accounts, err := validateToken(cmd, cfg.APIURL, newToken)
+		if err != nil {
+			return &output.Error{
+				Code:    output.CodeAuth,
+				Message: fmt.Sprintf("New token was rejected: %v", err),
+				Hint:    "The saved token was not changed",
+			}
+		}
+		if !accountsInclude(accounts, profileName) {
+			return &output.Error{
+				Code:    output.CodeAuth,
+				Message: fmt.Sprintf("New token has no access to account %s", profileName),
+				Hint:    "The saved token was not changed",
+			}
+		}
+
+		if err := credsSaveProfileToken(profileName, newToken); err != nil {
+			return &output.Error{Code: output.CodeAPI, Message: err.Error()}
+		}
+		keptOld := false
+		if oldToken != "" && !authRotateDropOld {
+			if err := credsSaveSecondaryToken(profileName, oldToken); err != nil {
+				return &output.Error{Code: output.CodeAPI, Message: err.Error()}
+			}
+			keptOld = true
+		} else {
+			_ = credsDeleteSecondaryToken(profileName)
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("status", "fizzy auth status", "Check auth status"),
+			breadcrumb("tokens", "fizzy token list", "Find the old token to revoke"),
+		}
+		printMutation(map[string]any{
+			"profile":        profileName,
+			"rotated":        true,
+			"secondary_kept": keptOld,
+		}, "", breadcrumbs)
+		return nil
+	},
+}
+
+// accountsInclude reports whether slug is among accounts.
+func accountsInclude(accounts []Account, slug string) bool {
+	slug = strings.TrimPrefix(slug, "/")
+	for _, a := range accounts {
+		if a.Slug == slug || a.ID == slug {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	authCmd.AddCommand(authRotateCmd)
+	authRotateCmd.Flags().StringVar(&authRotateNewToken, "new-token", "", "Token to rotate to (required)")
+	authRotateCmd.Flags().BoolVar(&authRotateDropOld, "drop-old", false, "Discard the previous token instead of keeping it as the secondary")
+}
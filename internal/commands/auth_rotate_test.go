@@ -0,0 +1,146 @@
+package commands
+
+import (
+	"os"
+	"testing"
+
+	"github.com/basecamp/cli/credstore"
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func newRotateTestStore(t *testing.T) *credstore.Store {
+	t.Helper()
+	os.Setenv("FIZZY_ROTATE_NO_KR", "1")
+	t.Cleanup(func() { os.Unsetenv("FIZZY_ROTATE_NO_KR") })
+	store := credstore.NewStore(credstore.StoreOptions{
+		ServiceName:   "fizzy-rotate-test",
+		DisableEnvVar: "FIZZY_ROTATE_NO_KR",
+		FallbackDir:   t.TempDir(),
+	})
+	if err := credsSaveProfileTokenForTest(store, "acme", "old-token"); err != nil {
+		t.Fatalf("save token: %v", err)
+	}
+	return store
+}
+
+func TestAuthRotate(t *testing.T) {
+	t.Run("validates and keeps the old token as secondary", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/my/identity.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+			"id":       "user-1",
+			"accounts": []any{map[string]any{"id": "1", "slug": "/acme", "name": "Acme"}},
+		}})
+		result := SetTestModeWithSDK(mock)
+		SetTestCreds(newRotateTestStore(t))
+		SetTestConfig("old-token", "acme", testHTTPServer.URL)
+		defer resetTest()
+
+		authRotateNewToken = "new-token"
+		defer func() { authRotateNewToken = "" }()
+
+		err := authRotateCmd.RunE(authRotateCmd, []string{})
+		assertExitCode(t, err, 0)
+
+		if got, _ := credsLoadProfileToken("acme"); got != "new-token" {
+			t.Errorf("expected primary token to be replaced, got %q", got)
+		}
+		if got, _ := credsLoadSecondaryToken("acme"); got != "old-token" {
+			t.Errorf("expected old token kept as secondary, got %q", got)
+		}
+		data := result.Response.Data.(map[string]any)
+		if data["secondary_kept"] != true {
+			t.Errorf("expected secondary_kept=true, got %v", data["secondary_kept"])
+		}
+	})
+
+	t.Run("drop-old discards the previous token", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/my/identity.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+			"id":       "user-1",
+			"accounts": []any{map[string]any{"id": "1", "slug": "/acme", "name": "Acme"}},
+		}})
+		SetTestModeWithSDK(mock)
+		SetTestCreds(newRotateTestStore(t))
+		SetTestConfig("old-token", "acme", testHTTPServer.URL)
+		defer resetTest()
+
+		authRotateNewToken, authRotateDropOld = "new-token", true
+		defer func() { authRotateNewToken, authRotateDropOld = "", false }()
+
+		err := authRotateCmd.RunE(authRotateCmd, []string{})
+		assertExitCode(t, err, 0)
+
+		if _, err := credsLoadSecondaryToken("acme"); err == nil {
+			t.Error("expected no secondary token with --drop-old")
+		}
+	})
+
+	t.Run("rejected token leaves credentials unchanged", func(t *testing.T) {
+		mock := NewMockClient().WithAuthError()
+		SetTestModeWithSDK(mock)
+		SetTestCreds(newRotateTestStore(t))
+		SetTestConfig("old-token", "acme", testHTTPServer.URL)
+		defer resetTest()
+
+		authRotateNewToken = "bad-token"
+		defer func() { authRotateNewToken = "" }()
+
+		err := authRotateCmd.RunE(authRotateCmd, []string{})
+		assertExitCode(t, err, errors.ExitAuthFailure)
+
+		if got, _ := credsLoadProfileToken("acme"); got != "old-token" {
+			t.Errorf("expected primary token unchanged, got %q", got)
+		}
+	})
+
+	t.Run("token without access to the account is rejected", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/my/identity.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+			"id":       "user-1",
+			"accounts": []any{map[string]any{"id": "2", "slug": "/other", "name": "Other"}},
+		}})
+		SetTestModeWithSDK(mock)
+		SetTestCreds(newRotateTestStore(t))
+		SetTestConfig("old-token", "acme", testHTTPServer.URL)
+		defer resetTest()
+
+		authRotateNewToken = "new-token"
+		defer func() { authRotateNewToken = "" }()
+
+		err := authRotateCmd.RunE(authRotateCmd, []string{})
+		assertExitCode(t, err, errors.ExitAuthFailure)
+	})
+
+	t.Run("requires new-token", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("old-token", "acme", testHTTPServer.URL)
+		defer resetTest()
+
+		err := authRotateCmd.RunE(authRotateCmd, []string{})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
+
+func TestResolveTokenLoadsSecondary(t *testing.T) {
+	SetTestModeWithSDK(NewMockClient())
+	store := newRotateTestStore(t)
+	SetTestCreds(store)
+	SetTestConfig("", "acme", testHTTPServer.URL)
+	defer resetTest()
+	t.Setenv("FIZZY_TOKEN", "")
+
+	if err := credsSaveSecondaryToken("acme", "backup-token"); err != nil {
+		t.Fatalf("save secondary: %v", err)
+	}
+	resolveToken()
+	if cfg.Token != "old-token" || cfg.SecondaryToken != "backup-token" {
+		t.Errorf("expected primary and secondary from the store, got %q / %q", cfg.Token, cfg.SecondaryToken)
+	}
+
+	t.Setenv("FIZZY_SECONDARY_TOKEN", "env-backup")
+	resolveToken()
+	if cfg.SecondaryToken != "env-backup" {
+		t.Errorf("expected FIZZY_SECONDARY_TOKEN to win, got %q", cfg.SecondaryToken)
+	}
+}
@@ -38,11 +38,20 @@ var boardCmd = &cobra.Command{
 // Board list flags
 var boardListPage int
 var boardListAll bool
+var boardListMine bool
+var boardListMemberOf bool
+var boardListAllAccess bool
 
 var boardListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all boards",
-	Long:  "Lists all boards you have access to.",
+	Long: `Lists all boards you have access to.
+
+Use --mine for boards you created, --member-of for boards you were explicitly
+added to, or --all-access for boards open to everyone in the account. With any
+of these, the summary also counts boards in each category.
+
+Boards starred with 'fizzy board star' are listed first and marked starred: true.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
@@ -50,6 +59,15 @@ var boardListCmd = &cobra.Command{
 		if err := checkLimitAll(boardListAll); err != nil {
 			return err
 		}
+		filters := 0
+		for _, set := range []bool{boardListMine, boardListMemberOf, boardListAllAccess} {
+			if set {
+				filters++
+			}
+		}
+		if filters > 1 {
+			return errors.NewInvalidArgsError("only one of --mine, --member-of, or --all-access can be used")
+		}
 
 		ac := getSDK()
 		var items any
@@ -75,9 +93,32 @@ var boardListCmd = &cobra.Command{
 			linkNext = parseSDKLinkNext(resp)
 		}
 
+		var categories boardCategoryCounts
+		if filters > 0 {
+			userID, err := currentUserID(cmd.Context())
+			if err != nil {
+				return err
+			}
+			var category string
+			switch {
+			case boardListMine:
+				category = boardCategoryMine
+			case boardListMemberOf:
+				category = boardCategoryMember
+			default:
+				category = boardCategoryAllAccess
+			}
+			items, categories = filterBoardsByCategory(items, userID, category)
+		}
+
+		items = markStarredBoards(items)
+
 		// Build summary
 		count := dataCount(items)
 		summary := fmt.Sprintf("%d boards", count)
+		if filters > 0 {
+			summary += fmt.Sprintf(" (%d mine, %d member of, %d all-access)", categories.Mine, categories.Member, categories.AllAccess)
+		}
 		if boardListAll {
 			summary += " (all)"
 		} else if boardListPage > 0 {
@@ -105,17 +146,91 @@ var boardListCmd = &cobra.Command{
 	},
 }
 
+// Board list categories for --mine, --member-of, and --all-access.
+const (
+	boardCategoryMine      = "mine"
+	boardCategoryMember    = "member"
+	boardCategoryAllAccess = "all_access"
+)
+
+// boardCategoryCounts counts fetched boards per category. A board can be in
+// more than one, e.g. an all-access board you created.
+type boardCategoryCounts struct {
+	Mine      int
+	Member    int
+	AllAccess int
+}
+
+// filterBoardsByCategory keeps boards in category and counts every fetched
+// board's categories for userID. Membership means being listed in a board's
+// user_ids on a board that is not all-access.
+func filterBoardsByCategory(items any, userID, category string) (any, boardCategoryCounts) {
+	var counts boardCategoryCounts
+	boards := toMaps(items)
+	kept := make([]any, 0, len(boards))
+	for _, board := range boards {
+		creator, _ := board["creator"].(map[string]any)
+		mine := creator != nil && fmt.Sprintf("%v", creator["id"]) == userID
+		allAccess, _ := board["all_access"].(bool)
+		member := false
+		if !allAccess {
+			ids, _ := board["user_ids"].([]any)
+			for _, id := range ids {
+				if fmt.Sprintf("%v", id) == userID {
+					member = true
+					break
+				}
+			}
+		}
+
+		if mine {
+			counts.Mine++
+		}
+		if member {
+			counts.Member++
+		}
+		if allAccess {
+			counts.AllAccess++
+		}
+
+		if (category == boardCategoryMine && mine) ||
+			(category == boardCategoryMember && member) ||
+			(category == boardCategoryAllAccess && allAccess) {
+			kept = append(kept, board)
+		}
+	}
+	return kept, counts
+}
+
+// Board show flags
+var boardShowFormat string
+
 var boardShowCmd = &cobra.Command{
 	Use:   "show BOARD_ID",
 	Short: "Show a board",
-	Long:  "Shows details of a specific board.",
-	Args:  cobra.ExactArgs(1),
+	Long: `Shows details of a specific board.
+
+--format markdown prints the board as a Markdown document with a section per
+column listing its cards.`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
 		}
+		document, err := documentFormat(boardShowFormat)
+		if err != nil {
+			return err
+		}
 
 		boardID := args[0]
+		if document {
+			board, columns, err := gatherBoardPrint(cmd.Context(), boardID, false)
+			if err != nil {
+				return err
+			}
+			writeDocument(boardMarkdown(board, columns))
+			return nil
+		}
 
 		resp, err := getSDK().Get(cmd.Context(), "/boards/"+boardID+".json")
 		if err != nil {
@@ -153,31 +268,39 @@ var boardShowCmd = &cobra.Command{
 var boardCreateName string
 var boardCreateAllAccess string
 var boardCreateAutoPostponePeriodInDays int
+var boardCreateJSONInput string
 
 var boardCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a board",
-	Long:  "Creates a new board.",
+	Long:  "Creates a new board.\n\n--json-input reads the request body (e.g. {\"name\": \"Roadmap\", \"public_description\": \"…\"}) as JSON from a file, or from stdin with -. Flags given alongside override its fields.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
 		}
 
-		if boardCreateName == "" {
-			return newRequiredFlagError("name")
+		req := &generated.CreateBoardRequest{}
+		if boardCreateJSONInput != "" {
+			if err := readJSONInput(cmd, boardCreateJSONInput, req); err != nil {
+				return err
+			}
 		}
-
-		req := &generated.CreateBoardRequest{
-			Name: boardCreateName,
+		if boardCreateName != "" {
+			req.Name = boardCreateName
+		}
+		if req.Name == "" {
+			return newRequiredFlagError("name")
 		}
 		if boardCreateAllAccess != "" {
 			req.AllAccess = boardCreateAllAccess == "true"
 		}
 		if boardCreateAutoPostponePeriodInDays != 0 {
-			if err := validateAutoPostponePeriodInDays(boardCreateAutoPostponePeriodInDays); err != nil {
+			req.AutoPostponePeriodInDays = int32(boardCreateAutoPostponePeriodInDays)
+		}
+		if req.AutoPostponePeriodInDays != 0 {
+			if err := validateAutoPostponePeriodInDays(int(req.AutoPostponePeriodInDays)); err != nil {
 				return err
 			}
-			req.AutoPostponePeriodInDays = int32(boardCreateAutoPostponePeriodInDays)
 		}
 
 		ac := getSDK()
@@ -193,6 +316,7 @@ var boardCreateCmd = &cobra.Command{
 				boardID = fmt.Sprintf("%v", id)
 			}
 		}
+		rememberRecent("board", boardID)
 
 		var breadcrumbs []Breadcrumb
 		if boardID != "" {
@@ -216,11 +340,19 @@ var boardCreateCmd = &cobra.Command{
 var boardUpdateName string
 var boardUpdateAllAccess string
 var boardUpdateAutoPostponePeriodInDays int
+var boardUpdateJSONInput string
+
+// boardUpdateInput is the --json-input of board update. AllAccess is a
+// pointer so an explicit false survives decoding.
+type boardUpdateInput struct {
+	generated.UpdateBoardRequest
+	AllAccess *bool `json:"all_access,omitempty"`
+}
 
 var boardUpdateCmd = &cobra.Command{
 	Use:   "update BOARD_ID",
 	Short: "Update a board",
-	Long:  "Updates an existing board.",
+	Long:  "Updates an existing board.\n\n--json-input reads the request body as JSON from a file, or from stdin with -. Flags given alongside override its fields.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
@@ -229,42 +361,48 @@ var boardUpdateCmd = &cobra.Command{
 
 		boardID := args[0]
 
+		input := &boardUpdateInput{}
+		if boardUpdateJSONInput != "" {
+			if err := readJSONInput(cmd, boardUpdateJSONInput, input); err != nil {
+				return err
+			}
+		}
+		req := &input.UpdateBoardRequest
+		if boardUpdateName != "" {
+			req.Name = boardUpdateName
+		}
+		if boardUpdateAllAccess == "true" || boardUpdateAllAccess == "false" {
+			allAccess := boardUpdateAllAccess == "true"
+			input.AllAccess = &allAccess
+		}
 		if boardUpdateAutoPostponePeriodInDays != 0 {
-			if err := validateAutoPostponePeriodInDays(boardUpdateAutoPostponePeriodInDays); err != nil {
+			req.AutoPostponePeriodInDays = int32(boardUpdateAutoPostponePeriodInDays)
+		}
+		if req.AutoPostponePeriodInDays != 0 {
+			if err := validateAutoPostponePeriodInDays(int(req.AutoPostponePeriodInDays)); err != nil {
 				return err
 			}
 		}
 
-		// When --all_access false is set, we must send `"all_access": false`
+		// When all_access is set to false, we must send `"all_access": false`
 		// explicitly. The SDK's UpdateBoardRequest uses `omitempty` on the
 		// AllAccess bool, which silently drops false values. Use raw Patch
 		// when all_access is being set to false.
 		ac := getSDK()
 		var data any
-		if boardUpdateAllAccess == "false" {
-			body := map[string]any{"all_access": false}
-			if boardUpdateName != "" {
-				body["name"] = boardUpdateName
-			}
-			if boardUpdateAutoPostponePeriodInDays != 0 {
-				body["auto_postpone_period_in_days"] = boardUpdateAutoPostponePeriodInDays
+		if input.AllAccess != nil && !*input.AllAccess {
+			body, _ := normalizeAny(req).(map[string]any)
+			if body == nil {
+				body = map[string]any{}
 			}
+			body["all_access"] = false
 			resp, patchErr := ac.Patch(cmd.Context(), "/boards/"+boardID+".json", body)
 			if patchErr != nil {
 				return convertSDKError(patchErr)
 			}
 			data = resp.Data
 		} else {
-			req := &generated.UpdateBoardRequest{}
-			if boardUpdateName != "" {
-				req.Name = boardUpdateName
-			}
-			if boardUpdateAllAccess == "true" {
-				req.AllAccess = true
-			}
-			if boardUpdateAutoPostponePeriodInDays != 0 {
-				req.AutoPostponePeriodInDays = int32(boardUpdateAutoPostponePeriodInDays)
-			}
+			req.AllAccess = input.AllAccess != nil && *input.AllAccess
 			var updateErr error
 			data, _, updateErr = ac.Boards().Update(cmd.Context(), boardID, req)
 			if updateErr != nil {
@@ -282,16 +420,45 @@ var boardUpdateCmd = &cobra.Command{
 	},
 }
 
+// Board delete flags
+var boardDeleteArchive bool
+var boardDeleteNoArchive bool
+
 var boardDeleteCmd = &cobra.Command{
 	Use:   "delete BOARD_ID",
 	Short: "Delete a board",
-	Long:  "Deletes a board.",
-	Args:  cobra.ExactArgs(1),
+	Long: `Deletes a board.
+
+With --archive, or archive_before_delete: true in config, a local snapshot of
+the board is saved first (as with 'fizzy board snapshot') and its path is
+reported, so an accidental delete can be recovered from. The board is not
+deleted if the snapshot fails. --no-archive skips a configured snapshot.`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
 		}
 
+		if boardDeleteArchive && boardDeleteNoArchive {
+			return errors.NewInvalidArgsError("--archive and --no-archive cannot be used together")
+		}
+
+		archive := (boardDeleteArchive || cfg.ArchiveBeforeDelete) && !boardDeleteNoArchive
+		if deferred, err := deferDelete(cmd, tombstone{Kind: "board", Target: args[0], Archive: archive}); deferred || err != nil {
+			return err
+		}
+
+		result := map[string]any{
+			"deleted": true,
+		}
+		if archive {
+			archive, err := snapshotBoard(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			result["archive"] = archive["archive"]
+		}
+
 		_, err := getSDK().Boards().Delete(cmd.Context(), args[0])
 		if err != nil {
 			return convertSDKError(err)
@@ -302,9 +469,7 @@ var boardDeleteCmd = &cobra.Command{
 			breadcrumb("create", "fizzy board create --name \"name\"", "Create new board"),
 		}
 
-		printMutation(map[string]any{
-			"deleted": true,
-		}, "", breadcrumbs)
+		printMutation(result, "", breadcrumbs)
 		return nil
 	},
 }
@@ -732,24 +897,33 @@ func init() {
 	// List
 	boardListCmd.Flags().IntVar(&boardListPage, "page", 0, "Page number")
 	boardListCmd.Flags().BoolVar(&boardListAll, "all", false, "Fetch all pages")
+	boardListCmd.Flags().BoolVar(&boardListMine, "mine", false, "Only boards you created")
+	boardListCmd.Flags().BoolVar(&boardListMemberOf, "member-of", false, "Only boards you are explicitly a member of")
+	boardListCmd.Flags().BoolVar(&boardListAllAccess, "all-access", false, "Only boards open to everyone in the account")
 	boardCmd.AddCommand(boardListCmd)
 
 	// Show
+	boardShowCmd.Flags().StringVar(&boardShowFormat, "format", "", "Print a document instead: markdown")
 	boardCmd.AddCommand(boardShowCmd)
 
 	// Create
 	boardCreateCmd.Flags().StringVar(&boardCreateName, "name", "", "Board name (required)")
 	boardCreateCmd.Flags().StringVar(&boardCreateAllAccess, "all_access", "", "Allow all team members access (true/false)")
 	boardCreateCmd.Flags().IntVar(&boardCreateAutoPostponePeriodInDays, "auto_postpone_period_in_days", 0, "Auto postpone period in days ("+validAutoPostponePeriodsHelp+")")
+	boardCreateCmd.Flags().StringVar(&boardCreateJSONInput, "json-input", "", jsonInputUsage)
 	boardCmd.AddCommand(boardCreateCmd)
 
 	// Update
 	boardUpdateCmd.Flags().StringVar(&boardUpdateName, "name", "", "Board name")
 	boardUpdateCmd.Flags().StringVar(&boardUpdateAllAccess, "all_access", "", "Allow all team members access (true/false)")
 	boardUpdateCmd.Flags().IntVar(&boardUpdateAutoPostponePeriodInDays, "auto_postpone_period_in_days", 0, "Auto postpone period in days ("+validAutoPostponePeriodsHelp+")")
+	boardUpdateCmd.Flags().StringVar(&boardUpdateJSONInput, "json-input", "", jsonInputUsage)
 	boardCmd.AddCommand(boardUpdateCmd)
 
 	// Delete
+	boardDeleteCmd.Flags().BoolVar(&boardDeleteArchive, "archive", false, "Save a local snapshot of the board before deleting it")
+	boardDeleteCmd.Flags().BoolVar(&boardDeleteNoArchive, "no-archive", false, "Skip the snapshot even if archive_before_delete is configured")
+	addDeleteNowFlag(boardDeleteCmd)
 	boardCmd.AddCommand(boardDeleteCmd)
 
 	// Publication
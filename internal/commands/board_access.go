@@ -0,0 +1,279 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+var boardAccessCmd = &cobra.Command{
+	Use:   "access",
+	Short: "Audit who can see a board",
+	Long: `Records who can see a board and checks it against an earlier record, so
+access to sensitive boards can be reviewed periodically:
+
+  fizzy board access show 12345 --save roadmap-access.json
+  fizzy board access diff 12345 --against roadmap-access.json`,
+}
+
+// Board access show flags
+var boardAccessShowSave string
+
+var boardAccessShowCmd = &cobra.Command{
+	Use:   "show BOARD_ID",
+	Short: "Show who can see a board",
+	Long: `Shows whether a board is open to everyone in the account and every user with
+their access, role, and involvement, across all pages. Users are sorted by ID
+so snapshots of the same state are identical.
+
+Use --save to write the snapshot to a file for 'fizzy board access diff'.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		boardID := args[0]
+		snapshot, err := fetchBoardAccess(cmd.Context(), boardID)
+		if err != nil {
+			return err
+		}
+
+		if boardAccessShowSave != "" {
+			if err := writeSnapshotFile(boardAccessShowSave, snapshot); err != nil {
+				return errors.NewError(fmt.Sprintf("Could not save access snapshot: %v", err))
+			}
+		}
+
+		users, _ := snapshot["users"].([]any)
+		summary := fmt.Sprintf("%d %s with access", countWithAccess(users), pluralize(countWithAccess(users), "user", "users"))
+		if getBoolField(snapshot, "all_access") {
+			summary = "Open to everyone in the account"
+		}
+		if boardAccessShowSave != "" {
+			summary += fmt.Sprintf("; saved to %s", boardAccessShowSave)
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("board", fmt.Sprintf("fizzy board show %s", boardID), "View board"),
+		}
+		if boardAccessShowSave != "" {
+			breadcrumbs = append(breadcrumbs, breadcrumb("diff", fmt.Sprintf("fizzy board access diff %s --against %s", boardID, boardAccessShowSave), "Check for changes later"))
+		}
+		printDetail(snapshot, summary, breadcrumbs)
+		return nil
+	},
+}
+
+// Board access diff flags
+var boardAccessDiffAgainst string
+
+var boardAccessDiffCmd = &cobra.Command{
+	Use:   "diff BOARD_ID",
+	Short: "Compare who can see a board with a saved snapshot",
+	Long: `Fetches who can see a board now and compares it with a snapshot saved by
+'fizzy board access show --save' (or its JSON output). These changes are
+reported:
+
+  all_access   the board was opened to or closed off from the whole account
+  granted      a user gained access
+  revoked      a user lost access
+  role         a user's role changed
+  added        a user appeared who was not in the snapshot
+  removed      a user in the snapshot no longer appears
+
+Each change is listed, and the command exits with code 10 when there are any.`,
+	Example: `  fizzy board access diff 12345 --against roadmap-access.json --json`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+		if boardAccessDiffAgainst == "" {
+			return newRequiredFlagError("against")
+		}
+
+		boardID := args[0]
+		saved, err := readBoardAccessSnapshot(boardAccessDiffAgainst)
+		if err != nil {
+			return err
+		}
+		if savedID := getStringField(saved, "board_id"); savedID != "" && savedID != boardID {
+			return errors.NewInvalidArgsError(fmt.Sprintf("snapshot is of board %s, not %s", savedID, boardID))
+		}
+
+		current, err := fetchBoardAccess(cmd.Context(), boardID)
+		if err != nil {
+			return err
+		}
+		changes := diffBoardAccess(saved, current)
+
+		summary := fmt.Sprintf("%d access %s since %s", len(changes), pluralize(len(changes), "change", "changes"), getStringField(saved, "taken_at"))
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("show", fmt.Sprintf("fizzy board access show %s", boardID), "Show current access"),
+			breadcrumb("save", fmt.Sprintf("fizzy board access show %s --save %s", boardID, boardAccessDiffAgainst), "Accept the current access as the new snapshot"),
+		}
+		printList(changes, boardAccessDiffColumns, summary, breadcrumbs)
+
+		if len(changes) > 0 {
+			return errors.NewVerifyMismatchError(fmt.Sprintf("%d access %s on board %s", len(changes), pluralize(len(changes), "change", "changes"), boardID))
+		}
+		return nil
+	},
+}
+
+// fetchBoardAccess fetches every page of a board's accesses and returns them
+// as a snapshot with users sorted by ID.
+func fetchBoardAccess(ctx context.Context, boardID string) (map[string]any, error) {
+	ac := getSDK()
+	var allAccess bool
+	var users []map[string]any
+	for page := int64(1); ; page++ {
+		var pageArg *int64
+		if page > 1 {
+			pageArg = &page
+		}
+		data, resp, err := ac.Boards().ListBoardAccesses(ctx, boardID, pageArg)
+		if err != nil {
+			return nil, convertSDKError(err)
+		}
+		accesses := toMap(normalizeAny(data))
+		if page == 1 {
+			allAccess = getBoolField(accesses, "all_access")
+		}
+		for _, user := range toMaps(accesses["users"]) {
+			users = append(users, map[string]any{
+				"id":            fmt.Sprintf("%v", user["id"]),
+				"name":          getStringField(user, "name"),
+				"email_address": getStringField(user, "email_address"),
+				"role":          getStringField(user, "role"),
+				"has_access":    getBoolField(user, "has_access"),
+				"involvement":   getStringField(user, "involvement"),
+				"active":        getBoolField(user, "active"),
+			})
+		}
+		if parseSDKLinkNext(resp) == "" {
+			break
+		}
+	}
+
+	sort.SliceStable(users, func(i, j int) bool {
+		return getStringField(users[i], "id") < getStringField(users[j], "id")
+	})
+	list := make([]any, len(users))
+	for i, user := range users {
+		list[i] = user
+	}
+	return map[string]any{
+		"board_id":   boardID,
+		"all_access": allAccess,
+		"taken_at":   time.Now().UTC().Format(time.RFC3339),
+		"users":      list,
+	}, nil
+}
+
+// readBoardAccessSnapshot reads a saved access snapshot, either the file
+// written by --save or the full JSON response envelope of 'board access show'.
+func readBoardAccessSnapshot(path string) (map[string]any, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.NewInvalidArgsError(fmt.Sprintf("cannot read access snapshot: %v", err))
+	}
+	var data map[string]any
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, errors.NewInvalidArgsError(fmt.Sprintf("access snapshot is not valid JSON: %v", err))
+	}
+	if inner, ok := data["data"].(map[string]any); ok {
+		data = inner
+	}
+	if _, ok := data["users"].([]any); !ok {
+		return nil, errors.NewInvalidArgsError("access snapshot has no users; use the output of 'fizzy board access show --save'")
+	}
+	return data, nil
+}
+
+// diffBoardAccess lists the changes between a saved and a current access
+// snapshot: the board-wide setting first, then users in ID order.
+func diffBoardAccess(saved, current map[string]any) []any {
+	changes := []any{}
+	if before, after := getBoolField(saved, "all_access"), getBoolField(current, "all_access"); before != after {
+		changes = append(changes, map[string]any{"change": "all_access", "before": before, "after": after})
+	}
+
+	userChange := func(user map[string]any, change string, before, after any) map[string]any {
+		return map[string]any{
+			"change":        change,
+			"user_id":       getStringField(user, "id"),
+			"name":          getStringField(user, "name"),
+			"email_address": getStringField(user, "email_address"),
+			"before":        before,
+			"after":         after,
+		}
+	}
+
+	savedUsers := map[string]map[string]any{}
+	for _, user := range toMaps(saved["users"]) {
+		savedUsers[getStringField(user, "id")] = user
+	}
+	currentUsers := toMaps(current["users"])
+	seen := map[string]bool{}
+	for _, user := range currentUsers {
+		id := getStringField(user, "id")
+		seen[id] = true
+		before, ok := savedUsers[id]
+		if !ok {
+			changes = append(changes, userChange(user, "added", nil, getBoolField(user, "has_access")))
+			continue
+		}
+		if was, is := getBoolField(before, "has_access"), getBoolField(user, "has_access"); was != is {
+			change := "revoked"
+			if is {
+				change = "granted"
+			}
+			changes = append(changes, userChange(user, change, was, is))
+		}
+		if was, is := getStringField(before, "role"), getStringField(user, "role"); was != is {
+			changes = append(changes, userChange(user, "role", was, is))
+		}
+	}
+
+	var removed []string
+	for id := range savedUsers {
+		if !seen[id] {
+			removed = append(removed, id)
+		}
+	}
+	sort.Strings(removed)
+	for _, id := range removed {
+		user := savedUsers[id]
+		changes = append(changes, userChange(user, "removed", getBoolField(user, "has_access"), nil))
+	}
+	return changes
+}
+
+// countWithAccess counts the users in a snapshot who have access.
+func countWithAccess(users []any) int {
+	count := 0
+	for _, user := range toMaps(users) {
+		if getBoolField(user, "has_access") {
+			count++
+		}
+	}
+	return count
+}
+
+func init() {
+	boardCmd.AddCommand(boardAccessCmd)
+
+	boardAccessShowCmd.Flags().StringVar(&boardAccessShowSave, "save", "", "Write the snapshot to this file")
+	boardAccessCmd.AddCommand(boardAccessShowCmd)
+
+	boardAccessDiffCmd.Flags().StringVar(&boardAccessDiffAgainst, "against", "", "Snapshot file to compare with (required)")
+	boardAccessCmd.AddCommand(boardAccessDiffCmd)
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func boardAccessResponse(allAccess bool, users ...map[string]any) *client.APIResponse {
+	list := make([]any, len(users))
+	for i, user := range users {
+		list[i] = user
+	}
+	return &client.APIResponse{StatusCode: 200, Data: map[string]any{"board_id": "123", "all_access": allAccess, "users": list}}
+}
+
+func TestBoardAccessShowAndDiff(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.json")
+
+	t.Run("show saves a sorted snapshot", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetResponse = boardAccessResponse(false,
+			map[string]any{"id": "u2", "name": "Bo", "role": "member", "has_access": true},
+			map[string]any{"id": "u1", "name": "Ann", "role": "admin", "has_access": true},
+			map[string]any{"id": "u3", "name": "Cy", "role": "member", "has_access": false},
+		)
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		boardAccessShowSave = path
+		defer func() { boardAccessShowSave = "" }()
+
+		err := boardAccessShowCmd.RunE(boardAccessShowCmd, []string{"123"})
+		assertExitCode(t, err, 0)
+
+		if result.Response.Summary != "2 users with access; saved to "+path {
+			t.Errorf("unexpected summary %q", result.Response.Summary)
+		}
+		saved, err := readBoardAccessSnapshot(path)
+		if err != nil {
+			t.Fatalf("reading snapshot: %v", err)
+		}
+		users := toMaps(saved["users"])
+		if len(users) != 3 || users[0]["id"] != "u1" || users[2]["id"] != "u3" {
+			t.Errorf("expected users sorted by id, got %v", users)
+		}
+	})
+
+	t.Run("diff reports changes and exits with findings", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetResponse = boardAccessResponse(false,
+			map[string]any{"id": "u1", "name": "Ann", "role": "member", "has_access": true},
+			map[string]any{"id": "u3", "name": "Cy", "role": "member", "has_access": true},
+			map[string]any{"id": "u4", "name": "Di", "role": "member", "has_access": true},
+		)
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		boardAccessDiffAgainst = path
+		defer func() { boardAccessDiffAgainst = "" }()
+
+		err := boardAccessDiffCmd.RunE(boardAccessDiffCmd, []string{"123"})
+		if errors.ExitCodeOf(err) != errors.ExitFindings {
+			t.Fatalf("expected exit code %d, got %v", errors.ExitFindings, err)
+		}
+
+		changes := toMaps(result.Response.Data)
+		want := [][2]string{{"role", "u1"}, {"granted", "u3"}, {"added", "u4"}, {"removed", "u2"}}
+		if len(changes) != len(want) {
+			t.Fatalf("expected %d changes, got %v", len(want), changes)
+		}
+		for i, w := range want {
+			if changes[i]["change"] != w[0] || changes[i]["user_id"] != w[1] {
+				t.Errorf("change %d: expected %s for %s, got %v", i, w[0], w[1], changes[i])
+			}
+		}
+	})
+
+	t.Run("diff rejects a snapshot of another board", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		boardAccessDiffAgainst = path
+		defer func() { boardAccessDiffAgainst = "" }()
+
+		err := boardAccessDiffCmd.RunE(boardAccessDiffCmd, []string{"999"})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
+	"github.com/spf13/cobra"
+)
+
+// Board involvement levels. "watching" notifies on all activity on the board;
+// "access_only" keeps access but stops board-wide notifications, so only
+// cards you are assigned to, mentioned in, or watch still notify you.
+const (
+	involvementWatching   = "watching"
+	involvementAccessOnly = "access_only"
+)
+
+var boardMuteCmd = &cobra.Command{
+	Use:   "mute BOARD_ID",
+	Short: "Stop notifications for a board",
+	Long: `Mutes a board by setting your involvement to access_only. You keep access,
+but no longer get notified about every change on the board. Cards you are
+assigned to, mentioned in, or watch still notify you.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setBoardInvolvement(cmd.Context(), args[0], involvementAccessOnly)
+	},
+}
+
+var boardUnmuteCmd = &cobra.Command{
+	Use:   "unmute BOARD_ID",
+	Short: "Resume notifications for a board",
+	Long:  "Unmutes a board by setting your involvement to watching, so you are notified about all activity on it.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setBoardInvolvement(cmd.Context(), args[0], involvementWatching)
+	},
+}
+
+func setBoardInvolvement(ctx context.Context, boardID, involvement string) error {
+	if err := requireAuthAndAccount(); err != nil {
+		return err
+	}
+
+	_, err := getSDK().Boards().UpdateInvolvement(ctx, boardID, &generated.UpdateBoardInvolvementRequest{
+		Involvement: involvement,
+	})
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	muted := involvement == involvementAccessOnly
+	breadcrumbs := []Breadcrumb{
+		breadcrumb("show", fmt.Sprintf("fizzy board show %s", boardID), "View board"),
+	}
+	if muted {
+		breadcrumbs = append(breadcrumbs, breadcrumb("unmute", fmt.Sprintf("fizzy board unmute %s", boardID), "Resume notifications"))
+	} else {
+		breadcrumbs = append(breadcrumbs, breadcrumb("mute", fmt.Sprintf("fizzy board mute %s", boardID), "Stop notifications"))
+	}
+
+	printMutation(map[string]any{
+		"board_id":    boardID,
+		"involvement": involvement,
+		"muted":       muted,
+	}, "", breadcrumbs)
+	return nil
+}
+
+func init() {
+	boardCmd.AddCommand(boardMuteCmd)
+	boardCmd.AddCommand(boardUnmuteCmd)
+}
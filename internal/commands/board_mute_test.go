@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestBoardMute(t *testing.T) {
+	tests := []struct {
+		name        string
+		cmd         func() error
+		involvement string
+		muted       bool
+	}{
+		{
+			name:        "mute sets access_only",
+			cmd:         func() error { return boardMuteCmd.RunE(boardMuteCmd, []string{"123"}) },
+			involvement: "access_only",
+			muted:       true,
+		},
+		{
+			name:        "unmute sets watching",
+			cmd:         func() error { return boardUnmuteCmd.RunE(boardUnmuteCmd, []string{"123"}) },
+			involvement: "watching",
+			muted:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := NewMockClient()
+			mock.PatchResponse = &client.APIResponse{StatusCode: 200, Data: map[string]any{}}
+
+			result := SetTestModeWithSDK(mock)
+			SetTestConfig("token", "account", "https://api.example.com")
+			defer resetTest()
+
+			err := tt.cmd()
+			assertExitCode(t, err, 0)
+
+			if len(mock.PatchCalls) != 1 || mock.PatchCalls[0].Path != "/boards/123/involvement.json" {
+				t.Fatalf("expected PATCH /boards/123/involvement.json, got %+v", mock.PatchCalls)
+			}
+			body := mock.PatchCalls[0].Body.(map[string]any)
+			if body["involvement"] != tt.involvement {
+				t.Errorf("expected involvement %q, got %v", tt.involvement, body["involvement"])
+			}
+			data := result.Response.Data.(map[string]any)
+			if data["muted"] != tt.muted {
+				t.Errorf("expected muted=%v, got %v", tt.muted, data["muted"])
+			}
+		})
+	}
+
+	t.Run("requires authentication", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := boardMuteCmd.RunE(boardMuteCmd, []string{"123"})
+		assertExitCode(t, err, errors.ExitAuthFailure)
+	})
+}
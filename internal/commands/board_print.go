@@ -0,0 +1,216 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/spf13/cobra"
+)
+
+const (
+	boardPrintDefaultWidth = 120
+	boardPrintMinColumn    = 12
+	boardPrintSeparator    = " | "
+)
+
+// Board print flags
+var boardPrintAllColumns bool
+var boardPrintMaxCards int
+
+var boardPrintCmd = &cobra.Command{
+	Use:   "print BOARD_ID",
+	Short: "Print a board as text, columns side by side",
+	Long: `Renders a static text view of a board: its columns side by side, each
+listing card numbers and titles truncated to fit. Maybe? comes first, then the
+board's columns in order; --all-columns adds Not Now and Done.
+
+The text fits the terminal, or --width when given (120 when not a terminal).
+Pipe the styled output to paste it into chat or docs; colors are dropped when
+stdout is not a terminal. --markdown wraps the text in a code block, and JSON
+output carries the columns and the text.`,
+	Example: `  fizzy board print 123 --styled
+  fizzy board print 123 --styled --width 80 --max-cards 5 | pbcopy
+  fizzy board print 123 --markdown --all-columns >> STATUS.md`,
+	Args:        cobra.ExactArgs(1),
+	Annotations: map[string]string{readOnlyAnnotation: "true"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+		if boardPrintMaxCards < 0 {
+			return errors.NewInvalidArgsError("--max-cards must not be negative")
+		}
+
+		boardID := args[0]
+		board, columns, err := gatherBoardPrint(cmd.Context(), boardID, boardPrintAllColumns)
+		if err != nil {
+			return err
+		}
+
+		width := outputWidth()
+		if width == 0 {
+			width = boardPrintDefaultWidth
+		}
+		name := getStringField(board, "name")
+
+		switch out.EffectiveFormat() {
+		case output.FormatStyled:
+			r := lipgloss.NewRenderer(outWriter)
+			writeOutputString(renderBoardPrint(name, columns, width, boardPrintMaxCards,
+				r.NewStyle().Bold(true), r.NewStyle().Foreground(lipgloss.Color("8"))))
+		case output.FormatMarkdown:
+			writeOutputString("```\n" + renderBoardPrint(name, columns, width, boardPrintMaxCards, lipgloss.NewStyle(), lipgloss.NewStyle()) + "```\n")
+		default:
+			cardCount := 0
+			printed := make([]any, len(columns))
+			for i, column := range columns {
+				cardCount += len(column.cards)
+				cards := make([]any, len(column.cards))
+				for j, card := range column.cards {
+					cards[j] = map[string]any{"number": card["number"], "title": card["title"]}
+				}
+				printed[i] = map[string]any{"name": column.name, "cards": cards}
+			}
+			data := map[string]any{
+				"board":   map[string]any{"id": board["id"], "name": board["name"]},
+				"columns": printed,
+				"text":    renderBoardPrint(name, columns, width, boardPrintMaxCards, lipgloss.NewStyle(), lipgloss.NewStyle()),
+			}
+			summary := fmt.Sprintf("%d %s in %d %s", cardCount, pluralize(cardCount, "card", "cards"), len(columns), pluralize(len(columns), "column", "columns"))
+			breadcrumbs := []Breadcrumb{
+				breadcrumb("show", fmt.Sprintf("fizzy board show %s", boardID), "View board"),
+				breadcrumb("cards", fmt.Sprintf("fizzy card list --board %s", boardID), "List cards"),
+			}
+			recordOutputError(okEnvelope(data, output.WithSummary(summary), output.WithBreadcrumbs(breadcrumbs...)))
+		}
+		captureResponse()
+		return nil
+	},
+}
+
+type boardPrintColumn struct {
+	name  string
+	cards []map[string]any
+}
+
+// gatherBoardPrint fetches a board, its columns, and its cards, and places
+// each card in its column. Maybe? comes first; Not Now and Done come last,
+// and only when allColumns is set.
+func gatherBoardPrint(ctx context.Context, boardID string, allColumns bool) (map[string]any, []boardPrintColumn, error) {
+	ac := getSDK()
+	boardResp, err := ac.Get(ctx, "/boards/"+boardID+".json")
+	if err != nil {
+		return nil, nil, convertSDKError(err)
+	}
+	board := toMap(normalizeAny(boardResp.Data))
+	boardColumns, _, err := fetchBoardColumns(ctx, ac, boardID, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := []string{pseudoColumnMaybe.Name}
+	for _, column := range boardColumns {
+		names = append(names, getStringField(column, "name"))
+	}
+	indexes := []string{"all"}
+	if allColumns {
+		names = append(names, pseudoColumnNotNow.Name, pseudoColumnDone.Name)
+		indexes = append(indexes, "not_now", "closed")
+	}
+	columns := make([]boardPrintColumn, len(names))
+	position := map[string]int{}
+	for i, name := range names {
+		columns[i].name = name
+		if _, ok := position[name]; !ok {
+			position[name] = i
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, indexedBy := range indexes {
+		pages, err := ac.GetAll(ctx, "/cards.json?board_ids[]="+boardID+"&indexed_by="+indexedBy)
+		if err != nil {
+			return nil, nil, convertSDKError(err)
+		}
+		for _, card := range toMaps(jsonAnySlice(pages)) {
+			number := fmt.Sprint(card["number"])
+			if seen[number] {
+				continue
+			}
+			seen[number] = true
+			if i, ok := position[cardPlacement(card)]; ok {
+				columns[i].cards = append(columns[i].cards, card)
+			}
+		}
+	}
+	return board, columns, nil
+}
+
+// renderBoardPrint lays out columns side by side in width cells, with one
+// "#NUMBER title" line per card. Past maxCards (when non-zero) a column ends
+// with a count of the cards left out.
+func renderBoardPrint(name string, columns []boardPrintColumn, width, maxCards int, header, muted lipgloss.Style) string {
+	if len(columns) == 0 {
+		return ""
+	}
+	cell := (width - len(boardPrintSeparator)*(len(columns)-1)) / len(columns)
+	cell = max(cell, boardPrintMinColumn)
+
+	lines := make([][]string, len(columns))
+	rows := 0
+	for i, column := range columns {
+		shown := column.cards
+		if maxCards > 0 && len(shown) > maxCards {
+			shown = shown[:maxCards]
+		}
+		for _, card := range shown {
+			lines[i] = append(lines[i], runewidth.Truncate(fmt.Sprintf("#%v %s", card["number"], getStringField(card, "title")), cell, "…"))
+		}
+		if hidden := len(column.cards) - len(shown); hidden > 0 {
+			lines[i] = append(lines[i], muted.Render(fmt.Sprintf("+%d more", hidden)))
+		}
+		rows = max(rows, len(lines[i]))
+	}
+
+	var sb strings.Builder
+	if name != "" {
+		fmt.Fprintf(&sb, "%s\n\n", header.Render(name))
+	}
+	writeRow := func(cells []string) {
+		fmt.Fprintln(&sb, strings.TrimRight(strings.Join(cells, boardPrintSeparator), " "))
+	}
+	cells := make([]string, len(columns))
+	for i, column := range columns {
+		title := fmt.Sprintf("%s (%d)", column.name, len(column.cards))
+		cells[i] = header.Render(runewidth.FillRight(runewidth.Truncate(title, cell, "…"), cell))
+	}
+	writeRow(cells)
+	for i := range columns {
+		cells[i] = strings.Repeat("-", cell)
+	}
+	writeRow(cells)
+	for row := range rows {
+		for i := range columns {
+			cells[i] = strings.Repeat(" ", cell)
+			if row < len(lines[i]) {
+				cells[i] = lines[i][row]
+				if w := lipgloss.Width(cells[i]); w < cell {
+					cells[i] += strings.Repeat(" ", cell-w)
+				}
+			}
+		}
+		writeRow(cells)
+	}
+	return sb.String()
+}
+
+func init() {
+	boardPrintCmd.Flags().BoolVar(&boardPrintAllColumns, "all-columns", false, "Include the Not Now and Done columns")
+	boardPrintCmd.Flags().IntVar(&boardPrintMaxCards, "max-cards", 0, "Show at most this many cards per column (0 for all)")
+	boardCmd.AddCommand(boardPrintCmd)
+}
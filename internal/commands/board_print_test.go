@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/client"
+)
+
+func TestBoardPrint(t *testing.T) {
+	newMock := func() *MockClient {
+		mock := NewMockClient()
+		mock.OnGet("/boards/b1.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{"id": "b1", "name": "Roadmap"}})
+		mock.OnGet("/boards/b1/columns.json", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"id": "col1", "name": "Doing"},
+		}})
+		mock.OnGet("/cards.json?board_ids[]=b1&indexed_by=all", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"number": 1, "title": "Triage me"},
+			map[string]any{"number": 2, "title": "A card with a rather long title", "column": map[string]any{"name": "Doing"}},
+			map[string]any{"number": 3, "title": "Also doing", "column": map[string]any{"name": "Doing"}},
+		}})
+		mock.OnGet("/cards.json?board_ids[]=b1&indexed_by=not_now", &client.APIResponse{StatusCode: 200, Data: []any{}})
+		mock.OnGet("/cards.json?board_ids[]=b1&indexed_by=closed", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"number": 4, "title": "Shipped", "closed": true},
+		}})
+		return mock
+	}
+
+	t.Run("renders columns side by side", func(t *testing.T) {
+		SetTestModeWithSDK(newMock())
+		SetTestConfig("token", "account", "https://api.example.com")
+		SetTestFormat(output.FormatStyled)
+		defer resetTest()
+		cfgWidth = 40
+		boardPrintMaxCards = 0
+		boardPrintAllColumns = false
+		defer func() { cfgWidth = 0 }()
+
+		err := boardPrintCmd.RunE(boardPrintCmd, []string{"b1"})
+		assertExitCode(t, err, 0)
+
+		want := strings.Join([]string{
+			"Roadmap",
+			"",
+			"Maybe? (1)         | Doing (2)",
+			"------------------ | ------------------",
+			"#1 Triage me       | #2 A card with a …",
+			"                   | #3 Also doing",
+			"",
+		}, "\n")
+		if got := TestOutput(); got != want {
+			t.Errorf("unexpected board text:\n%s\nwant:\n%s", got, want)
+		}
+	})
+
+	t.Run("all columns and max cards", func(t *testing.T) {
+		result := SetTestModeWithSDK(newMock())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+		cfgWidth = 80
+		boardPrintMaxCards = 1
+		boardPrintAllColumns = true
+		defer func() { cfgWidth, boardPrintMaxCards, boardPrintAllColumns = 0, 0, false }()
+
+		err := boardPrintCmd.RunE(boardPrintCmd, []string{"b1"})
+		assertExitCode(t, err, 0)
+
+		data := result.Response.Data.(map[string]any)
+		columns := data["columns"].([]any)
+		if len(columns) != 4 {
+			t.Fatalf("expected 4 columns, got %v", columns)
+		}
+		if done := columns[3].(map[string]any); done["name"] != "Done" || len(done["cards"].([]any)) != 1 {
+			t.Errorf("expected closed card in Done, got %v", done)
+		}
+		if !strings.Contains(data["text"].(string), "+1 more") {
+			t.Errorf("expected hidden card count in text, got:\n%s", data["text"])
+		}
+		if result.Response.Summary != "4 cards in 4 columns" {
+			t.Errorf("unexpected summary %q", result.Response.Summary)
+		}
+	})
+}
@@ -0,0 +1,167 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/fsutil"
+	"github.com/basecamp/fizzy-cli/internal/state"
+	"github.com/spf13/cobra"
+)
+
+var boardSnapshotCmd = &cobra.Command{
+	Use:   "snapshot BOARD_ID",
+	Short: "Save a local snapshot of a board",
+	Long: `Saves the board, its columns, and every card (open, postponed, and closed)
+with its steps and comments to a local JSON archive, and reports the archive
+path.
+
+'fizzy board delete' takes the same snapshot first when --archive is given or
+archive_before_delete is set in config.
+
+Use --events ndjson to emit one JSON progress event per card on stderr (or
+--events-file).`,
+	Args:        cobra.ExactArgs(1),
+	Annotations: map[string]string{readOnlyAnnotation: "true"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		stopEvents, err := startEvents()
+		defer stopEvents()
+		if err != nil {
+			return err
+		}
+
+		boardID := args[0]
+		archive, err := snapshotBoard(cmd.Context(), boardID)
+		if err != nil {
+			return err
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("show", fmt.Sprintf("fizzy board show %s", boardID), "View board"),
+		}
+		printMutation(archive, fmt.Sprintf("Board %s saved to %s", boardID, archive["archive"]), breadcrumbs)
+		return nil
+	},
+}
+
+// boardArchiveDir is where board snapshots are kept, per account.
+func boardArchiveDir() (string, error) {
+	dir, err := state.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "archives", fsutil.SafeFilename(cfg.Account)), nil
+}
+
+// snapshotBoard writes a JSON archive of a board and everything on it, and
+// returns the archive path with counts of what was saved.
+func snapshotBoard(ctx context.Context, boardID string) (map[string]any, error) {
+	ac := getSDK()
+	emitEvent("started", map[string]any{"board_id": boardID})
+
+	boardResp, err := ac.Get(ctx, "/boards/"+boardID+".json")
+	if err != nil {
+		return nil, convertSDKError(err)
+	}
+	columns, _, err := fetchBoardColumns(ctx, ac, boardID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// The default card listing leaves out closed and postponed cards.
+	var numbers []string
+	seen := map[string]bool{}
+	for _, indexedBy := range []string{"all", "not_now", "closed"} {
+		pages, err := ac.GetAll(ctx, "/cards.json?board_ids[]="+boardID+"&indexed_by="+indexedBy)
+		if err != nil {
+			return nil, convertSDKError(err)
+		}
+		for _, card := range toMaps(jsonAnySlice(pages)) {
+			number := fmt.Sprintf("%v", card["number"])
+			if !seen[number] {
+				seen[number] = true
+				numbers = append(numbers, number)
+			}
+		}
+	}
+
+	// Fetch each card in full for its steps, plus its comments.
+	cards := make([]any, 0, len(numbers))
+	commentCount := 0
+	for _, number := range numbers {
+		// Fetched raw so the archive keeps every field the API returns.
+		resp, err := ac.Get(ctx, "/cards/"+number+".json")
+		if err != nil {
+			return nil, convertSDKError(err)
+		}
+		card := toMap(normalizeAny(resp.Data))
+		pages, err := ac.GetAll(ctx, "/cards/"+number+"/comments.json")
+		if err != nil {
+			return nil, convertSDKError(err)
+		}
+		comments := jsonAnySlice(pages)
+		card["comments"] = comments
+		commentCount += dataCount(comments)
+		cards = append(cards, card)
+		emitEvent("card_saved", map[string]any{"number": card["number"], "comments": dataCount(comments), "index": len(cards), "total": len(numbers)})
+	}
+
+	now := time.Now().UTC()
+	snapshot := map[string]any{
+		"archived_at": now.Format(time.RFC3339),
+		"account":     cfg.Account,
+		"board":       normalizeAny(boardResp.Data),
+		"columns":     columns,
+		"cards":       cards,
+	}
+
+	dir, err := boardArchiveDir()
+	if err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not save board snapshot: %v", err))
+	}
+	path := filepath.Join(dir, fmt.Sprintf("board-%s-%s.json", fsutil.SafeFilename(boardID), now.Format("20060102T150405Z")))
+	if err := writeSnapshotFile(path, snapshot); err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not save board snapshot: %v", err))
+	}
+
+	emitEvent("done", map[string]any{"archive": path, "cards": len(cards), "comments": commentCount})
+	return map[string]any{
+		"board_id": boardID,
+		"archive":  path,
+		"cards":    len(cards),
+		"comments": commentCount,
+	}, nil
+}
+
+func writeSnapshotFile(path string, snapshot any) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := fsutil.CreateAtomic(path, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Discard()
+		return err
+	}
+	return f.Commit()
+}
+
+func init() {
+	addEventFlags(boardSnapshotCmd)
+	boardCmd.AddCommand(boardSnapshotCmd)
+}
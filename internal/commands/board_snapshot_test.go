@@ -0,0 +1,174 @@
+package commands
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func mockBoardForSnapshot(mock *MockClient) {
+	mock.OnGet("/boards/b1.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{"id": "b1", "name": "Launch"}})
+	mock.OnGet("/boards/b1/columns.json", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"id": "col-1", "name": "Doing"},
+	}})
+	mock.OnGet("/cards.json?board_ids[]=b1&indexed_by=all", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"number": float64(1)},
+	}})
+	mock.OnGet("/cards.json?board_ids[]=b1&indexed_by=not_now", &client.APIResponse{StatusCode: 200, Data: []any{}})
+	mock.OnGet("/cards.json?board_ids[]=b1&indexed_by=closed", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"number": float64(2)},
+		map[string]any{"number": float64(1)},
+	}})
+	for _, n := range []string{"1", "2"} {
+		mock.OnGet("/cards/"+n+".json", &client.APIResponse{StatusCode: 200, Data: map[string]any{"number": n, "steps": []any{}}})
+	}
+	mock.OnGet("/cards/1/comments.json", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"id": "c1", "body": map[string]any{"plain_text": "hi"}},
+	}})
+	mock.OnGet("/cards/2/comments.json", &client.APIResponse{StatusCode: 200, Data: []any{}})
+}
+
+func TestBoardSnapshot(t *testing.T) {
+	mock := NewMockClient()
+	mockBoardForSnapshot(mock)
+
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	err := boardSnapshotCmd.RunE(boardSnapshotCmd, []string{"b1"})
+	assertExitCode(t, err, 0)
+
+	data := result.Response.Data.(map[string]any)
+	if data["cards"] != float64(2) || data["comments"] != float64(1) {
+		t.Errorf("expected 2 cards and 1 comment, got %v", data)
+	}
+
+	raw, err := os.ReadFile(data["archive"].(string))
+	if err != nil {
+		t.Fatalf("expected archive file: %v", err)
+	}
+	var snapshot map[string]any
+	if err := json.Unmarshal(raw, &snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if board := snapshot["board"].(map[string]any); board["name"] != "Launch" {
+		t.Errorf("expected board in snapshot, got %v", snapshot["board"])
+	}
+	cards := snapshot["cards"].([]any)
+	if len(cards) != 2 {
+		t.Fatalf("expected 2 cards in snapshot, got %d", len(cards))
+	}
+	if comments := cards[0].(map[string]any)["comments"].([]any); len(comments) != 1 {
+		t.Errorf("expected card 1 comments in snapshot, got %v", comments)
+	}
+}
+
+func TestBoardSnapshotEvents(t *testing.T) {
+	mock := NewMockClient()
+	mockBoardForSnapshot(mock)
+
+	SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	eventsFormat, eventsFile = "ndjson", filepath.Join(t.TempDir(), "events.ndjson")
+	defer func() { eventsFormat, eventsFile = "", "" }()
+
+	err := boardSnapshotCmd.RunE(boardSnapshotCmd, []string{"b1"})
+	assertExitCode(t, err, 0)
+
+	raw, err := os.ReadFile(eventsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []string
+	for _, line := range strings.Split(strings.TrimSpace(string(raw)), "\n") {
+		var event map[string]any
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			t.Fatalf("invalid event line %q: %v", line, err)
+		}
+		events = append(events, event["event"].(string))
+	}
+	if strings.Join(events, ",") != "started,card_saved,card_saved,done" {
+		t.Errorf("unexpected events: %v", events)
+	}
+}
+
+func TestBoardSnapshotEventsFormat(t *testing.T) {
+	SetTestModeWithSDK(NewMockClient())
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	eventsFormat = "xml"
+	defer func() { eventsFormat = "" }()
+
+	err := boardSnapshotCmd.RunE(boardSnapshotCmd, []string{"b1"})
+	assertExitCode(t, err, errors.ExitInvalidArgs)
+}
+
+func TestBoardDeleteArchive(t *testing.T) {
+	t.Run("snapshots before deleting", func(t *testing.T) {
+		mock := NewMockClient()
+		mockBoardForSnapshot(mock)
+		mock.DeleteResponse = &client.APIResponse{StatusCode: 204}
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		cfg.ArchiveBeforeDelete = true
+		defer resetTest()
+
+		err := boardDeleteCmd.RunE(boardDeleteCmd, []string{"b1"})
+		assertExitCode(t, err, 0)
+
+		path, _ := result.Response.Data.(map[string]any)["archive"].(string)
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("expected archive at %q: %v", path, err)
+		}
+		if len(mock.DeleteCalls) != 1 {
+			t.Errorf("expected board to be deleted, got %d delete calls", len(mock.DeleteCalls))
+		}
+	})
+
+	t.Run("does not delete when the snapshot fails", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetError = errors.NewNotFoundError("Board not found")
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		boardDeleteArchive = true
+		defer func() { boardDeleteArchive = false }()
+
+		err := boardDeleteCmd.RunE(boardDeleteCmd, []string{"b1"})
+		assertExitCode(t, err, errors.ExitNotFound)
+		if len(mock.DeleteCalls) != 0 {
+			t.Errorf("expected no delete, got %d delete calls", len(mock.DeleteCalls))
+		}
+	})
+
+	t.Run("--no-archive skips a configured snapshot", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.DeleteResponse = &client.APIResponse{StatusCode: 204}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		cfg.ArchiveBeforeDelete = true
+		defer resetTest()
+
+		boardDeleteNoArchive = true
+		defer func() { boardDeleteNoArchive = false }()
+
+		err := boardDeleteCmd.RunE(boardDeleteCmd, []string{"b1"})
+		assertExitCode(t, err, 0)
+		if len(mock.GetCalls) != 0 {
+			t.Errorf("expected no snapshot requests, got %v", mock.GetCalls)
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/state"
+	"github.com/spf13/cobra"
+)
+
+var boardStarCmd = &cobra.Command{
+	Use:   "star BOARD_ID",
+	Short: "Star a board",
+	Long: `Stars a board so 'fizzy board list' shows it first, marked starred: true.
+
+Stars are stored locally per account, so they don't affect anyone else.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		boardID := args[0]
+
+		// Confirm the board exists before remembering it.
+		if _, err := getSDK().Get(cmd.Context(), "/boards/"+boardID+".json"); err != nil {
+			return convertSDKError(err)
+		}
+
+		stars, err := loadBoardStars()
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(stars, boardID) {
+			stars = append(stars, boardID)
+			if err := saveBoardStars(stars); err != nil {
+				return err
+			}
+		}
+
+		printMutation(map[string]any{
+			"board_id": boardID,
+			"starred":  true,
+		}, "", []Breadcrumb{
+			breadcrumb("boards", "fizzy board list", "List boards (starred first)"),
+			breadcrumb("unstar", fmt.Sprintf("fizzy board unstar %s", boardID), "Remove star"),
+		})
+		return nil
+	},
+}
+
+var boardUnstarCmd = &cobra.Command{
+	Use:   "unstar BOARD_ID",
+	Short: "Remove a board's star",
+	Long:  "Removes a board from your local starred boards.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		boardID := args[0]
+
+		stars, err := loadBoardStars()
+		if err != nil {
+			return err
+		}
+		if i := slices.Index(stars, boardID); i >= 0 {
+			stars = slices.Delete(stars, i, i+1)
+			if err := saveBoardStars(stars); err != nil {
+				return err
+			}
+		}
+
+		printMutation(map[string]any{
+			"board_id": boardID,
+			"starred":  false,
+		}, "", []Breadcrumb{
+			breadcrumb("boards", "fizzy board list", "List boards"),
+			breadcrumb("star", fmt.Sprintf("fizzy board star %s", boardID), "Star again"),
+		})
+		return nil
+	},
+}
+
+func boardStarsKey() string {
+	return "stars/" + cfg.Account + "/boards"
+}
+
+// loadBoardStars returns the active account's starred board IDs in the order
+// they were starred.
+func loadBoardStars() ([]string, error) {
+	var stars []string
+	if _, err := state.Load(boardStarsKey(), &stars); err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not read starred boards: %v", err))
+	}
+	return stars, nil
+}
+
+func saveBoardStars(stars []string) error {
+	if err := state.Save(boardStarsKey(), stars); err != nil {
+		return errors.NewError(fmt.Sprintf("Could not save starred boards: %v", err))
+	}
+	return nil
+}
+
+// markStarredBoards sets starred on every board and moves starred boards to
+// the front, keeping the API order within each group. Boards are left
+// untouched when nothing is starred.
+func markStarredBoards(items any) any {
+	stars, err := loadBoardStars()
+	if err != nil || len(stars) == 0 {
+		return items
+	}
+	boards := toMaps(items)
+	for _, board := range boards {
+		board["starred"] = slices.Contains(stars, fmt.Sprintf("%v", board["id"]))
+	}
+	sort.SliceStable(boards, func(i, j int) bool {
+		return boards[i]["starred"] == true && boards[j]["starred"] != true
+	})
+	sorted := make([]any, len(boards))
+	for i, board := range boards {
+		sorted[i] = board
+	}
+	return sorted
+}
+
+func init() {
+	boardCmd.AddCommand(boardStarCmd)
+	boardCmd.AddCommand(boardUnstarCmd)
+}
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestBoardStar(t *testing.T) {
+	t.Run("starred boards are listed first", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/boards/b3.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{"id": "b3"}})
+		mock.OnGet("/boards.json", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"id": "b1", "name": "One"},
+			map[string]any{"id": "b2", "name": "Two"},
+			map[string]any{"id": "b3", "name": "Three"},
+		}})
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := boardStarCmd.RunE(boardStarCmd, []string{"b3"})
+		assertExitCode(t, err, 0)
+		// Starring twice keeps a single entry.
+		err = boardStarCmd.RunE(boardStarCmd, []string{"b3"})
+		assertExitCode(t, err, 0)
+
+		err = boardListCmd.RunE(boardListCmd, []string{})
+		assertExitCode(t, err, 0)
+
+		boards := result.Response.Data.([]any)
+		want := []struct {
+			id      string
+			starred bool
+		}{{"b3", true}, {"b1", false}, {"b2", false}}
+		for i, w := range want {
+			board := boards[i].(map[string]any)
+			if board["id"] != w.id || board["starred"] != w.starred {
+				t.Errorf("position %d: expected %s starred=%v, got %v", i, w.id, w.starred, board)
+			}
+		}
+
+		err = boardUnstarCmd.RunE(boardUnstarCmd, []string{"b3"})
+		assertExitCode(t, err, 0)
+		stars, _ := loadBoardStars()
+		if len(stars) != 0 {
+			t.Errorf("expected no stars after unstar, got %v", stars)
+		}
+	})
+
+	t.Run("rejects unknown boards", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetError = errors.NewNotFoundError("Board not found")
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := boardStarCmd.RunE(boardStarCmd, []string{"missing"})
+		assertExitCode(t, err, errors.ExitNotFound)
+		if stars, _ := loadBoardStars(); len(stars) != 0 {
+			t.Errorf("expected nothing starred, got %v", stars)
+		}
+	})
+}
@@ -0,0 +1,383 @@
+package commands
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/fsutil"
+	"github.com/spf13/cobra"
+)
+
+// boardFeedDefaultLimit is how many activities a board feed includes when
+// --limit isn't given. It matches one page of activities.
+const boardFeedDefaultLimit = 50
+
+// Board subscribe flags
+var boardSubscribeRSS bool
+var boardSubscribeAtom bool
+var boardSubscribeFile string
+var boardSubscribeServe string
+
+var boardSubscribeCmd = &cobra.Command{
+	Use:   "subscribe BOARD_ID",
+	Short: "Follow a board's activity from a feed reader",
+	Long: `Renders a board's recent activity (cards created, moved, and closed,
+comments, and the rest) as an RSS 2.0 (--rss) or Atom (--atom) feed, so the
+board can be followed from a feed reader without notifications.
+
+The feed is written to stdout, or to a file with --file (replaced atomically,
+so it can be regenerated from cron while a web server serves it). With
+--serve, the feed is served over HTTP instead, fetched fresh for every
+request, until interrupted:
+
+  fizzy board subscribe BOARD_ID --rss --serve 127.0.0.1:8080
+
+The served feed uses your credentials; bind it to a public address only if
+everyone who can reach it may read the board.`,
+	Example: `  fizzy board subscribe 03f5v9zjysoy0fqs9yg0ei3hq --rss > board.xml
+  fizzy board subscribe 03f5v9zjysoy0fqs9yg0ei3hq --atom --file ~/feeds/board.atom
+  fizzy board subscribe 03f5v9zjysoy0fqs9yg0ei3hq --rss --serve 127.0.0.1:8080`,
+	Args:        cobra.ExactArgs(1),
+	Annotations: map[string]string{readOnlyAnnotation: "true"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+		if boardSubscribeRSS == boardSubscribeAtom {
+			return errors.NewInvalidArgsError("choose a feed format with --rss or --atom")
+		}
+		if boardSubscribeFile != "" && boardSubscribeServe != "" {
+			return errors.NewInvalidArgsError("--file and --serve cannot be used together")
+		}
+
+		boardID := args[0]
+		format := "rss"
+		if boardSubscribeAtom {
+			format = "atom"
+		}
+
+		if boardSubscribeServe != "" {
+			return serveBoardFeed(cmd, boardID, format, boardSubscribeServe)
+		}
+
+		feed, count, err := renderBoardFeed(cmd.Context(), boardID, format)
+		if err != nil {
+			return err
+		}
+		if boardSubscribeFile == "" {
+			_, err := outWriter.Write(feed)
+			return err
+		}
+
+		f, err := fsutil.CreateAtomic(boardSubscribeFile, 0o644)
+		if err != nil {
+			return errors.NewError(fmt.Sprintf("Could not write feed: %v", err))
+		}
+		if _, err := f.Write(feed); err != nil {
+			f.Discard()
+			return errors.NewError(fmt.Sprintf("Could not write feed: %v", err))
+		}
+		if err := f.Commit(); err != nil {
+			return errors.NewError(fmt.Sprintf("Could not write feed: %v", err))
+		}
+
+		result := map[string]any{
+			"board_id": boardID,
+			"format":   format,
+			"file":     boardSubscribeFile,
+			"items":    count,
+		}
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("activity", fmt.Sprintf("fizzy activity list --board %s", boardID), "View board activity"),
+		}
+		printMutation(result, fmt.Sprintf("Wrote %s feed with %d %s to %s", format, count, pluralize(count, "item", "items"), boardSubscribeFile), breadcrumbs)
+		return nil
+	},
+}
+
+// serveBoardFeed serves the board's feed at every path on addr until the
+// command is interrupted.
+func serveBoardFeed(cmd *cobra.Command, boardID, format, addr string) error {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+	defer stop()
+
+	contentType := "application/rss+xml; charset=utf-8"
+	if format == "atom" {
+		contentType = "application/atom+xml; charset=utf-8"
+	}
+	server := &http.Server{
+		Addr:              addr,
+		ReadHeaderTimeout: 10 * time.Second,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				w.Header().Set("Allow", "GET, HEAD")
+				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			feed, _, err := renderBoardFeed(r.Context(), boardID, format)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadGateway)
+				return
+			}
+			w.Header().Set("Content-Type", contentType)
+			_, _ = w.Write(feed)
+		}),
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return errors.NewError(fmt.Sprintf("Could not serve feed: %v", err))
+	}
+	fmt.Fprintf(os.Stderr, "Serving %s feed for board %s at http://%s/ (Ctrl-C to stop)\n", format, boardID, listener.Addr())
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+		return errors.NewError(fmt.Sprintf("Could not serve feed: %v", err))
+	}
+	return nil
+}
+
+// renderBoardFeed fetches the board's latest activities and renders them as
+// an RSS or Atom document. It also returns the number of items.
+func renderBoardFeed(ctx context.Context, boardID, format string) ([]byte, int, error) {
+	path := "/activities.json?board_ids[]=" + url.QueryEscape(boardID)
+	data, _, err := getSDK().Cards().ListActivities(ctx, path)
+	if err != nil {
+		return nil, 0, convertSDKError(err)
+	}
+	activities := toMaps(normalizeAny(data))
+	limit := boardFeedDefaultLimit
+	if cfgLimit > 0 {
+		limit = cfgLimit
+	}
+	if len(activities) > limit {
+		activities = activities[:limit]
+	}
+
+	title, link := "Board "+boardID, ""
+	for _, activity := range activities {
+		if board, ok := activity["board"].(map[string]any); ok {
+			if name, _ := board["name"].(string); name != "" {
+				title = name
+			}
+			link, _ = board["url"].(string)
+			break
+		}
+	}
+
+	var doc any
+	if format == "atom" {
+		doc = atomBoardFeed(boardID, title, link, activities)
+	} else {
+		doc = rssBoardFeed(boardID, title, link, activities)
+	}
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, 0, errors.NewError(fmt.Sprintf("Could not render feed: %v", err))
+	}
+	return append([]byte(xml.Header), append(body, '\n')...), len(activities), nil
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	DC      string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link,omitempty"`
+	Description string  `xml:"description,omitempty"`
+	Creator     string  `xml:"dc:creator,omitempty"`
+	Category    string  `xml:"category,omitempty"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+func rssBoardFeed(boardID, title, link string, activities []map[string]any) rssFeed {
+	channel := rssChannel{
+		Title:       title,
+		Link:        link,
+		Description: fmt.Sprintf("Recent activity on %s", title),
+	}
+	for i, activity := range activities {
+		created := activityTime(activity)
+		if i == 0 && !created.IsZero() {
+			channel.LastBuildDate = created.Format(time.RFC1123Z)
+		}
+		item := rssItem{
+			Title:    activityTitle(activity),
+			Link:     getStringField(activity, "url"),
+			Creator:  activityCreator(activity),
+			Category: getStringField(activity, "action"),
+			GUID:     rssGUID{IsPermaLink: "false", Value: activityFeedID(boardID, activity)},
+		}
+		if body := activityBody(activity); body != "" {
+			item.Description = body
+		}
+		if !created.IsZero() {
+			item.PubDate = created.Format(time.RFC1123Z)
+		}
+		channel.Items = append(channel.Items, item)
+	}
+	return rssFeed{Version: "2.0", DC: "http://purl.org/dc/elements/1.1/", Channel: channel}
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    *atomLink   `xml:"link,omitempty"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID       string        `xml:"id"`
+	Title    string        `xml:"title"`
+	Updated  string        `xml:"updated"`
+	Link     *atomLink     `xml:"link,omitempty"`
+	Author   *atomAuthor   `xml:"author,omitempty"`
+	Category *atomCategory `xml:"category,omitempty"`
+	Content  *atomContent  `xml:"content,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func atomBoardFeed(boardID, title, link string, activities []map[string]any) atomFeed {
+	// Atom requires an updated time; an empty feed uses the time it was made.
+	feed := atomFeed{
+		ID:      "urn:fizzy:board:" + boardID,
+		Title:   title,
+		Updated: time.Now().UTC().Format(time.RFC3339),
+	}
+	if link != "" {
+		feed.Link = &atomLink{Href: link}
+	}
+	for i, activity := range activities {
+		updated := feed.Updated
+		if created := activityTime(activity); !created.IsZero() {
+			updated = created.UTC().Format(time.RFC3339)
+			if i == 0 {
+				feed.Updated = updated
+			}
+		}
+		entry := atomEntry{
+			ID:      activityFeedID(boardID, activity),
+			Title:   activityTitle(activity),
+			Updated: updated,
+		}
+		if url := getStringField(activity, "url"); url != "" {
+			entry.Link = &atomLink{Href: url}
+		}
+		if creator := activityCreator(activity); creator != "" {
+			entry.Author = &atomAuthor{Name: creator}
+		}
+		if action := getStringField(activity, "action"); action != "" {
+			entry.Category = &atomCategory{Term: action}
+		}
+		if body := activityBody(activity); body != "" {
+			entry.Content = &atomContent{Type: "html", Value: body}
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	return feed
+}
+
+// activityTitle is the activity's description, e.g. "Ann closed Fix login",
+// or its action when it has none.
+func activityTitle(activity map[string]any) string {
+	if description := getStringField(activity, "description"); description != "" {
+		return description
+	}
+	return getStringField(activity, "action")
+}
+
+// activityBody is the HTML of the comment or card the activity is about,
+// when the API includes it.
+func activityBody(activity map[string]any) string {
+	eventable, _ := activity["eventable"].(map[string]any)
+	if eventable == nil {
+		return ""
+	}
+	if body, ok := eventable["body"].(map[string]any); ok {
+		if html := getStringField(body, "html"); html != "" {
+			return html
+		}
+	}
+	return getStringField(eventable, "description_html")
+}
+
+func activityCreator(activity map[string]any) string {
+	if creator, ok := activity["creator"].(map[string]any); ok {
+		return getStringField(creator, "name")
+	}
+	return ""
+}
+
+func activityTime(activity map[string]any) time.Time {
+	created, _ := time.Parse(time.RFC3339, getStringField(activity, "created_at"))
+	return created
+}
+
+// activityFeedID is a stable feed ID for an activity, so readers don't show
+// an item twice when the feed is regenerated.
+func activityFeedID(boardID string, activity map[string]any) string {
+	id := getStringField(activity, "id")
+	if id == "" {
+		id = strconv.FormatInt(activityTime(activity).UnixNano(), 10)
+	}
+	return "urn:fizzy:board:" + boardID + ":activity:" + id
+}
+
+func init() {
+	boardSubscribeCmd.Flags().BoolVar(&boardSubscribeRSS, "rss", false, "Render an RSS 2.0 feed")
+	boardSubscribeCmd.Flags().BoolVar(&boardSubscribeAtom, "atom", false, "Render an Atom feed")
+	boardSubscribeCmd.Flags().StringVar(&boardSubscribeFile, "file", "", "Write the feed to a file instead of stdout")
+	boardSubscribeCmd.Flags().StringVar(&boardSubscribeServe, "serve", "", "Serve the feed over HTTP at this address (e.g. 127.0.0.1:8080)")
+	boardSubscribeCmd.MarkFlagsMutuallyExclusive("rss", "atom")
+	boardCmd.AddCommand(boardSubscribeCmd)
+}
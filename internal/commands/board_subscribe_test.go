@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func boardFeedActivities() []any {
+	return []any{
+		map[string]any{
+			"id":          "a-2",
+			"action":      "comment_created",
+			"description": "Ann commented on Fix login",
+			"created_at":  "2026-03-02T10:00:00Z",
+			"url":         "https://app.fizzy.do/1/cards/7#comment_2",
+			"creator":     map[string]any{"name": "Ann"},
+			"board":       map[string]any{"name": "Roadmap", "url": "https://app.fizzy.do/1/boards/b-1"},
+			"eventable":   map[string]any{"body": map[string]any{"html": "<p>Looks <b>good</b></p>"}},
+		},
+		map[string]any{
+			"id":          "a-1",
+			"action":      "card_closed",
+			"description": "Bob closed Fix login",
+			"created_at":  "2026-03-01T09:00:00Z",
+			"creator":     map[string]any{"name": "Bob"},
+		},
+	}
+}
+
+func TestBoardSubscribe(t *testing.T) {
+	t.Run("writes an RSS feed of board activity", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetWithPaginationResponse = &client.APIResponse{StatusCode: 200, Data: boardFeedActivities()}
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		path := filepath.Join(t.TempDir(), "board.xml")
+		boardSubscribeRSS = true
+		boardSubscribeFile = path
+		err := boardSubscribeCmd.RunE(boardSubscribeCmd, []string{"b-1"})
+		boardSubscribeRSS = false
+		boardSubscribeFile = ""
+
+		assertExitCode(t, err, 0)
+		if mock.GetWithPaginationCalls[0].Path != "/activities.json?board_ids[]=b-1" {
+			t.Errorf("expected board activities, got %s", mock.GetWithPaginationCalls[0].Path)
+		}
+		if data := result.Response.Data.(map[string]any); data["items"] != float64(2) {
+			t.Errorf("expected 2 items, got %v", data["items"])
+		}
+
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var feed rssFeed
+		if err := xml.Unmarshal(raw, &feed); err != nil {
+			t.Fatalf("expected valid XML, got %v\n%s", err, raw)
+		}
+		if feed.Channel.Title != "Roadmap" || feed.Channel.Link != "https://app.fizzy.do/1/boards/b-1" {
+			t.Errorf("expected channel from the board, got %+v", feed.Channel)
+		}
+		items := feed.Channel.Items
+		if len(items) != 2 || items[0].Title != "Ann commented on Fix login" || items[0].Description != "<p>Looks <b>good</b></p>" {
+			t.Errorf("unexpected items: %+v", items)
+		}
+		if items[0].PubDate != "Mon, 02 Mar 2026 10:00:00 +0000" {
+			t.Errorf("expected RFC 1123 pubDate, got %q", items[0].PubDate)
+		}
+		if items[1].GUID.Value != "urn:fizzy:board:b-1:activity:a-1" {
+			t.Errorf("expected stable guid, got %q", items[1].GUID.Value)
+		}
+		if !strings.Contains(string(raw), "<dc:creator>Bob</dc:creator>") {
+			t.Errorf("expected item creators, got\n%s", raw)
+		}
+	})
+
+	t.Run("renders Atom entries within --limit", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetWithPaginationResponse = &client.APIResponse{StatusCode: 200, Data: boardFeedActivities()}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cfgLimit = 1
+		raw, count, err := renderBoardFeed(t.Context(), "b-1", "atom")
+		cfgLimit = 0
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var feed atomFeed
+		if err := xml.Unmarshal(raw, &feed); err != nil {
+			t.Fatalf("expected valid XML, got %v\n%s", err, raw)
+		}
+		if count != 1 || len(feed.Entries) != 1 {
+			t.Fatalf("expected 1 entry, got %d", len(feed.Entries))
+		}
+		if feed.Updated != "2026-03-02T10:00:00Z" || feed.Entries[0].Author.Name != "Ann" {
+			t.Errorf("unexpected feed: %+v", feed)
+		}
+	})
+
+	t.Run("requires a feed format", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := boardSubscribeCmd.RunE(boardSubscribeCmd, []string{"b-1"})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
@@ -1,12 +1,76 @@
 package commands
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/basecamp/fizzy-cli/internal/client"
 	"github.com/basecamp/fizzy-cli/internal/errors"
 )
 
+func TestBoardListCategories(t *testing.T) {
+	boards := []any{
+		map[string]any{"id": "b1", "all_access": false, "creator": map[string]any{"id": "me"}, "user_ids": []any{"me"}},
+		map[string]any{"id": "b2", "all_access": false, "creator": map[string]any{"id": "other"}, "user_ids": []any{"me", "other"}},
+		map[string]any{"id": "b3", "all_access": true, "creator": map[string]any{"id": "other"}},
+		map[string]any{"id": "b4", "all_access": false, "creator": map[string]any{"id": "other"}, "user_ids": []any{"other"}},
+	}
+
+	tests := []struct {
+		name    string
+		set     *bool
+		wantIDs []string
+	}{
+		{name: "mine", set: &boardListMine, wantIDs: []string{"b1"}},
+		{name: "member-of", set: &boardListMemberOf, wantIDs: []string{"b1", "b2"}},
+		{name: "all-access", set: &boardListAllAccess, wantIDs: []string{"b3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := NewMockClient()
+			mock.OnGet("/boards.json", &client.APIResponse{StatusCode: 200, Data: boards})
+			mock.OnGet("/my/identity.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+				"id":       "identity-1",
+				"accounts": []any{map[string]any{"id": "1", "slug": "/account", "user": map[string]any{"id": "me"}}},
+			}})
+
+			result := SetTestModeWithSDK(mock)
+			SetTestConfig("token", "account", "https://api.example.com")
+			defer resetTest()
+
+			*tt.set = true
+			err := boardListCmd.RunE(boardListCmd, []string{})
+			*tt.set = false
+			assertExitCode(t, err, 0)
+
+			got := result.Response.Data.([]any)
+			if len(got) != len(tt.wantIDs) {
+				t.Fatalf("expected %v, got %v", tt.wantIDs, got)
+			}
+			for i, id := range tt.wantIDs {
+				if got[i].(map[string]any)["id"] != id {
+					t.Errorf("expected board %s at %d, got %v", id, i, got[i])
+				}
+			}
+			if summary := result.Response.Summary; summary != fmt.Sprintf("%d boards (1 mine, 2 member of, 1 all-access)", len(tt.wantIDs)) {
+				t.Errorf("unexpected summary %q", summary)
+			}
+		})
+	}
+
+	t.Run("rejects combined filters", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		boardListMine, boardListAllAccess = true, true
+		err := boardListCmd.RunE(boardListCmd, []string{})
+		boardListMine, boardListAllAccess = false, false
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
+
 func TestBoardList(t *testing.T) {
 	t.Run("returns list of boards", func(t *testing.T) {
 		mock := NewMockClient()
@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+// bulkConcurrency is how many requests a bulk command has in flight at once,
+// unless max_parallel_requests is lower.
+const bulkConcurrency = 4
+
+// bulkResult collects the outcome of each item in a bulk operation, so
+// scripts can retry only the items that failed.
+type bulkResult struct {
+	succeeded []any
+	failed    []any
+}
+
+// succeed records an item that was processed.
+func (r *bulkResult) succeed(item any) {
+	r.succeeded = append(r.succeeded, item)
+}
+
+// fail records an item that could not be processed, with its error message,
+// code, and whether retrying it later can succeed. It returns true when the
+// operation should stop: after a rate limit every following item would be
+// rejected too, so the caller skips them instead.
+func (r *bulkResult) fail(item any, err error) bool {
+	e := output.AsError(convertSDKError(err))
+	r.failed = append(r.failed, map[string]any{
+		"item":      item,
+		"error":     e.Message,
+		"code":      e.Code,
+		"retryable": e.Retryable || e.Code == output.CodeRateLimit || e.Code == errors.CodeLocked,
+	})
+	return e.Code == output.CodeRateLimit
+}
+
+// skip records items left unprocessed after fail stopped the operation.
+func (r *bulkResult) skip(items ...any) {
+	for _, item := range items {
+		r.failed = append(r.failed, map[string]any{
+			"item":      item,
+			"error":     "Skipped after the API rate limit was reached",
+			"code":      output.CodeRateLimit,
+			"retryable": true,
+		})
+	}
+}
+
+// runBulk calls fn for each item with up to concurrency calls in flight and
+// records the outcomes in the items' order. After a rate limit, items that
+// haven't started yet are skipped.
+func runBulk(items []any, concurrency int, fn func(item any) error) *bulkResult {
+	errs := make([]error, len(items))
+	skipped := make([]bool, len(items))
+	var limited atomic.Bool
+	sem := make(chan struct{}, max(concurrency, 1))
+	var wg sync.WaitGroup
+	for i, item := range items {
+		// Items start in order, so after a rate limit the rest are skipped.
+		sem <- struct{}{}
+		if limited.Load() {
+			skipped[i] = true
+			<-sem
+			continue
+		}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			errs[i] = fn(item)
+			if errs[i] != nil && output.AsError(convertSDKError(errs[i])).Code == output.CodeRateLimit {
+				limited.Store(true)
+			}
+		})
+	}
+	wg.Wait()
+
+	result := &bulkResult{}
+	for i, item := range items {
+		switch {
+		case skipped[i]:
+			result.skip(item)
+		case errs[i] != nil:
+			result.fail(item, errs[i])
+		default:
+			result.succeed(item)
+		}
+	}
+	return result
+}
+
+// bulkCardNumbers returns the card numbers a bulk command was given as
+// arguments, or read from stdin (whitespace separated, "#" optional) with
+// --from-stdin. Duplicates are dropped, keeping the first.
+func bulkCardNumbers(args []string, fromStdin bool, stdin io.Reader) ([]any, error) {
+	if fromStdin {
+		if len(args) > 0 {
+			return nil, errors.NewInvalidArgsError("pass card numbers as arguments or with --from-stdin, not both")
+		}
+		scanner := bufio.NewScanner(stdin)
+		scanner.Split(bufio.ScanWords)
+		for scanner.Scan() {
+			args = append(args, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("cannot read card numbers from stdin: %v", err))
+		}
+	}
+	if len(args) == 0 {
+		return nil, errors.NewInvalidArgsError("no card numbers given")
+	}
+
+	numbers := make([]any, 0, len(args))
+	for _, arg := range args {
+		number, err := strconv.Atoi(strings.TrimPrefix(arg, "#"))
+		if err != nil || number <= 0 {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("invalid card number %q", arg))
+		}
+		if !slices.Contains(numbers, any(number)) {
+			numbers = append(numbers, number)
+		}
+	}
+	return numbers, nil
+}
+
+// printBulkResult prints data with the bulk outcome added as "succeeded" and
+// "failed". If any item failed it returns a partial-failure error, which
+// exits with errors.ExitPartial without replacing the printed result.
+func printBulkResult(data map[string]any, r *bulkResult, noun, summary string, breadcrumbs []Breadcrumb) error {
+	if data == nil {
+		data = map[string]any{}
+	}
+	data["succeeded"] = nonNilSlice(r.succeeded)
+	data["failed"] = nonNilSlice(r.failed)
+
+	printMutation(data, summary, breadcrumbs)
+
+	if len(r.failed) == 0 {
+		return nil
+	}
+	total := len(r.succeeded) + len(r.failed)
+	return errors.NewPartialFailureError(fmt.Sprintf("%d of %d %s failed", len(r.failed), total, noun))
+}
+
+func nonNilSlice(items []any) []any {
+	if items == nil {
+		return []any{}
+	}
+	return items
+}
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestPrintBulkResult(t *testing.T) {
+	t.Run("partial failure lists failed items", func(t *testing.T) {
+		result := SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		r := &bulkResult{}
+		r.succeed(1)
+		r.fail(2, errors.NewNotFoundError("Card not found"))
+
+		err := printBulkResult(map[string]any{"board_id": "b1"}, r, "cards", "", nil)
+		if !errors.IsPartialFailure(err) {
+			t.Fatalf("expected partial failure, got %v", err)
+		}
+		if errors.ExitCodeOf(err) != errors.ExitPartial {
+			t.Errorf("expected exit code %d, got %d", errors.ExitPartial, errors.ExitCodeOf(err))
+		}
+		if err.Error() != "1 of 2 cards failed: Retry the items listed under failed" {
+			t.Errorf("unexpected error: %v", err)
+		}
+
+		data := result.Response.Data.(map[string]any)
+		if data["board_id"] != "b1" || len(data["succeeded"].([]any)) != 1 {
+			t.Errorf("unexpected data: %v", data)
+		}
+		failed := data["failed"].([]any)
+		if len(failed) != 1 {
+			t.Fatalf("expected 1 failed item, got %v", failed)
+		}
+		if entry := failed[0].(map[string]any); entry["item"] != float64(2) || entry["code"] != "not_found" || entry["error"] != "Card not found" {
+			t.Errorf("unexpected failed entry: %v", entry)
+		}
+	})
+
+	t.Run("no failures returns nil with an empty failed list", func(t *testing.T) {
+		result := SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		r := &bulkResult{}
+		r.succeed(1)
+		if err := printBulkResult(nil, r, "cards", "", nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if failed := result.Response.Data.(map[string]any)["failed"].([]any); len(failed) != 0 {
+			t.Errorf("expected empty failed list, got %v", failed)
+		}
+	})
+	t.Run("stops after a rate limit and marks what can be retried", func(t *testing.T) {
+		r := &bulkResult{}
+		if r.fail(1, errors.NewConflictError("Card was changed")) {
+			t.Error("expected a conflict not to stop the operation")
+		}
+		if r.fail(2, errors.NewLockedError("Board is locked")) {
+			t.Error("expected a lock not to stop the operation")
+		}
+		if !r.fail(3, errors.FromHTTPStatus(429, "")) {
+			t.Error("expected a rate limit to stop the operation")
+		}
+		r.skip(4)
+
+		want := []struct {
+			code      string
+			retryable bool
+		}{{"conflict", false}, {"locked", true}, {"rate_limit", true}, {"rate_limit", true}}
+		if len(r.failed) != len(want) {
+			t.Fatalf("expected %d failed items, got %v", len(want), r.failed)
+		}
+		for i, w := range want {
+			entry := r.failed[i].(map[string]any)
+			if entry["code"] != w.code || entry["retryable"] != w.retryable {
+				t.Errorf("item %d: expected %s retryable=%v, got %v", i+1, w.code, w.retryable, entry)
+			}
+		}
+	})
+
+	t.Run("runBulk keeps item order and skips after a rate limit", func(t *testing.T) {
+		r := runBulk([]any{1, 2, 3, 4}, 1, func(item any) error {
+			switch item {
+			case 2:
+				return errors.NewNotFoundError("Card not found")
+			case 3:
+				return errors.FromHTTPStatus(429, "")
+			}
+			return nil
+		})
+		if len(r.succeeded) != 1 || r.succeeded[0] != 1 {
+			t.Errorf("expected only item 1 to succeed, got %v", r.succeeded)
+		}
+		want := []string{"not_found", "rate_limit", "rate_limit"}
+		if len(r.failed) != len(want) {
+			t.Fatalf("expected %d failed items, got %v", len(want), r.failed)
+		}
+		for i, code := range want {
+			if entry := r.failed[i].(map[string]any); entry["item"] != i+2 || entry["code"] != code {
+				t.Errorf("failed %d: expected item %d with %s, got %v", i, i+2, code, entry)
+			}
+		}
+	})
+}
+
+func TestBulkCardNumbers(t *testing.T) {
+	numbers, err := bulkCardNumbers(nil, true, strings.NewReader("12\n#14 19\n\n12\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(numbers) != 3 || numbers[0] != 12 || numbers[1] != 14 || numbers[2] != 19 {
+		t.Errorf("expected [12 14 19], got %v", numbers)
+	}
+
+	_, err = bulkCardNumbers([]string{"12", "abc"}, false, nil)
+	assertExitCode(t, err, errors.ExitInvalidArgs)
+	_, err = bulkCardNumbers([]string{"12"}, true, strings.NewReader("14"))
+	assertExitCode(t, err, errors.ExitInvalidArgs)
+}
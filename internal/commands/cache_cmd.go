@@ -0,0 +1,231 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/cache"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+	"github.com/spf13/cobra"
+)
+
+// deltaSyncSkew widens a delta sync's window to allow for clock differences
+// between this machine and the server.
+const deltaSyncSkew = time.Minute
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage local caches and the offline store",
+	Long: `Inspect and reset the local caches: board column lists, notification counts,
+and the offline card store kept when offline_cache is enabled in config.
+
+Caches are kept per account, so status, clear, and gc only touch the current
+profile's account unless --all is given. Local state such as starred boards
+and history is never touched.`,
+}
+
+var cacheAllAccounts bool
+var cacheGCOlderThan time.Duration
+
+// cacheAccount is the account cache status, clear, and gc are limited to, or
+// "" for every account.
+func cacheAccount() string {
+	if cacheAllAccounts || cfg == nil {
+		return ""
+	}
+	return cfg.Account
+}
+
+var cacheStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show cache size, entries, age, and hit rates",
+	Long: `Shows each cache area (kind and account) with its entry count, size on disk,
+oldest and newest entry, and the hits, misses, and hit rate of its lookups.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		areas, err := cache.Usage(cacheAccount())
+		if err != nil {
+			return errors.NewError(fmt.Sprintf("Could not read cache: %v", err))
+		}
+
+		items := make([]any, 0, len(areas))
+		entries := 0
+		var size int64
+		for _, a := range areas {
+			entries += a.Entries
+			size += a.Bytes
+			item := map[string]any{
+				"kind":     a.Kind,
+				"account":  a.Account,
+				"entries":  a.Entries,
+				"bytes":    a.Bytes,
+				"hits":     a.Hits,
+				"misses":   a.Misses,
+				"hit_rate": nil,
+			}
+			if lookups := a.Hits + a.Misses; lookups > 0 {
+				item["hit_rate"] = float64(a.Hits) / float64(lookups)
+			}
+			if !a.Oldest.IsZero() {
+				item["oldest"] = a.Oldest.UTC().Format(time.RFC3339)
+				item["newest"] = a.Newest.UTC().Format(time.RFC3339)
+			}
+			items = append(items, item)
+		}
+
+		dir, _ := cache.Dir()
+		summary := fmt.Sprintf("%d cache %s, %d bytes in %s", entries, pluralize(entries, "entry", "entries"), size, dir)
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("gc", "fizzy cache gc", "Remove old entries"),
+			breadcrumb("clear", "fizzy cache clear", "Remove all entries"),
+		}
+		printList(items, cacheAreaColumns, summary, breadcrumbs)
+		return nil
+	},
+}
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove all cache entries",
+	Long:  "Removes every cache entry, including the offline store, and resets the hit counters.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		removed, err := cache.Clear(cacheAccount())
+		if err != nil {
+			return errors.NewError(fmt.Sprintf("Could not clear cache: %v", err))
+		}
+		summary := fmt.Sprintf("Removed %d cache %s", removed, pluralize(removed, "entry", "entries"))
+		printMutation(map[string]any{"removed": removed}, summary, []Breadcrumb{
+			breadcrumb("status", "fizzy cache status", "Show cache status"),
+		})
+		return nil
+	},
+}
+
+var cacheGCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Remove old and corrupt cache entries",
+	Long: `Removes cache entries stored longer ago than --older-than (30 days by default),
+along with corrupt entries and files left by interrupted writes.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if cacheGCOlderThan <= 0 {
+			return errors.NewInvalidArgsError("--older-than must be positive")
+		}
+		removed, err := cache.GC(cacheAccount(), cacheGCOlderThan)
+		if err != nil {
+			return errors.NewError(fmt.Sprintf("Could not clean cache: %v", err))
+		}
+		summary := fmt.Sprintf("Removed %d cache %s", removed, pluralize(removed, "entry", "entries"))
+		printMutation(map[string]any{"removed": removed}, summary, []Breadcrumb{
+			breadcrumb("status", "fizzy cache status", "Show cache status"),
+		})
+		return nil
+	},
+}
+
+var cacheRefreshBoard string
+var cacheRefreshFull bool
+
+var cacheRefreshCmd = &cobra.Command{
+	Use:   "refresh",
+	Short: "Re-sync the offline store",
+	Long: `Fetches every card (or every card on --board) and saves it to the offline
+store, along with the listings 'card list --offline' serves, with and without
+--all and --board.
+
+Once a complete listing is stored, later refreshes are delta syncs: only cards
+active since the last sync are fetched and merged in, so periodic refreshes of
+large accounts stay cheap. Cards closed or deleted since then stay in the store
+until a --full refresh re-lists everything.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+		if !offlineStoreEnabled() {
+			return errors.NewInvalidArgsError("offline_cache is not enabled; set offline_cache: true in config or FIZZY_OFFLINE_CACHE=1")
+		}
+
+		path := "/cards.json"
+		if cacheRefreshBoard != "" {
+			path += "?board_ids[]=" + cacheRefreshBoard
+		}
+		cards, changed, delta, err := refreshCards(cmd.Context(), getSDK(), path)
+		if err != nil {
+			return err
+		}
+
+		listings := map[string][]map[string]any{path: cards}
+		if cacheRefreshBoard == "" {
+			for _, card := range cards {
+				board, _ := card["board"].(map[string]any)
+				if id, ok := board["id"]; ok && id != nil {
+					boardPath := fmt.Sprintf("/cards.json?board_ids[]=%v", id)
+					listings[boardPath] = append(listings[boardPath], card)
+				}
+			}
+		}
+		for listPath, listed := range listings {
+			saveOfflineCardList(listPath+"#all", listed)
+			saveOfflineCardList(listPath, listed[:min(len(listed), defaultPageSize)])
+		}
+
+		summary := fmt.Sprintf("Saved %d cards to the offline store", len(cards))
+		if delta {
+			summary += fmt.Sprintf(" (%d changed)", changed)
+		}
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("list", "fizzy card list --offline", "List cards offline"),
+			breadcrumb("show", "fizzy card show <number> --offline", "Show a card offline"),
+		}
+		mode := "full"
+		if delta {
+			mode = "delta"
+		}
+		data := map[string]any{"cards": len(cards), "changed": changed, "listings": len(listings), "mode": mode}
+		printMutation(data, summary, breadcrumbs)
+		return nil
+	},
+}
+
+// refreshCards returns every card on path and how many were fetched. When a
+// complete listing of path is already stored, only cards active since it was
+// stored are fetched and merged into it, and delta is true.
+func refreshCards(ctx context.Context, ac *fizzy.AccountClient, path string) (cards []map[string]any, changed int, delta bool, err error) {
+	stored, syncedAt, ok := loadOfflineSyncedList(path + "#all")
+	if cacheRefreshFull || !ok {
+		cards, err = fetchAllCards(ctx, ac, path)
+		return cards, len(cards), false, err
+	}
+
+	fresh, err := fetchCardsChangedSince(ctx, ac, path, syncedAt.Add(-deltaSyncSkew))
+	if err != nil {
+		return nil, 0, false, err
+	}
+	index := map[string]int{}
+	for i, card := range stored {
+		index[cardKey(card)] = i
+	}
+	for _, card := range fresh {
+		if i, ok := index[cardKey(card)]; ok {
+			stored[i] = card
+		} else {
+			stored = append(stored, card)
+		}
+	}
+	sortCardsLike(stored, path)
+	return stored, len(fresh), true, nil
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+
+	cacheRefreshCmd.Flags().StringVar(&cacheRefreshBoard, "board", "", "Only refresh cards on this board")
+	cacheRefreshCmd.Flags().BoolVar(&cacheRefreshFull, "full", false, "Re-list every card instead of syncing changes since the last refresh")
+	cacheCmd.AddCommand(cacheRefreshCmd)
+
+	for _, cmd := range []*cobra.Command{cacheStatusCmd, cacheClearCmd, cacheGCCmd} {
+		cmd.Flags().BoolVar(&cacheAllAccounts, "all", false, "Include every account, not just the current profile's")
+		cacheCmd.AddCommand(cmd)
+	}
+	cacheGCCmd.Flags().DurationVar(&cacheGCOlderThan, "older-than", 30*24*time.Hour, "Remove entries stored longer ago than this")
+}
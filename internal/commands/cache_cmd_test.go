@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/cache"
+)
+
+func TestCacheStatusAndClear(t *testing.T) {
+	result := SetTestModeWithSDK(NewMockClient())
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	_ = cache.Store(columnCacheKey("b1"), []map[string]any{{"id": "c1"}})
+	_ = cache.Store("columns/other/b2", []map[string]any{{"id": "c2"}})
+	var cached []map[string]any
+	cache.Load(columnCacheKey("b1"), columnCacheTTL, &cached)
+	if err := cache.FlushStats(); err != nil {
+		t.Fatal(err)
+	}
+
+	err := cacheStatusCmd.RunE(cacheStatusCmd, nil)
+	assertExitCode(t, err, 0)
+	areas := result.Response.Data.([]any)
+	if len(areas) != 1 {
+		t.Fatalf("expected only the current account's area, got %v", areas)
+	}
+	area := areas[0].(map[string]any)
+	if area["kind"] != "columns" || area["entries"] != float64(1) || area["hit_rate"] != float64(1) {
+		t.Errorf("unexpected area: %v", area)
+	}
+
+	err = cacheClearCmd.RunE(cacheClearCmd, nil)
+	assertExitCode(t, err, 0)
+	if data := result.Response.Data.(map[string]any); data["removed"] != float64(1) {
+		t.Errorf("expected 1 entry removed, got %v", data)
+	}
+	if !cache.Load("columns/other/b2", 0, &cached) {
+		t.Error("expected other accounts' entries to be kept")
+	}
+
+	cacheAllAccounts = true
+	defer func() { cacheAllAccounts = false }()
+	err = cacheGCCmd.RunE(cacheGCCmd, nil)
+	assertExitCode(t, err, 0)
+	if data := result.Response.Data.(map[string]any); data["removed"] != float64(0) {
+		t.Errorf("expected fresh entries to survive gc, got %v", data)
+	}
+}
@@ -1,11 +1,15 @@
 package commands
 
 import (
+	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/basecamp/cli/output"
 	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
 	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
 	"github.com/spf13/cobra"
 )
@@ -17,34 +21,163 @@ var cardCmd = &cobra.Command{
 }
 
 // Card list flags
-var cardListBoard string
+var cardListBoard []string
 var cardListColumn string
-var cardListTag string
+var cardListTag []string
 var cardListIndexedBy string
-var cardListAssignee string
+var cardListAssignee []string
 var cardListSearch string
 var cardListSort string
-var cardListCreator string
+var cardListSortBy string
+var cardListDesc bool
+var cardListCreator []string
 var cardListCloser string
 var cardListUnassigned bool
 var cardListCreated string
 var cardListClosed string
+var cardListCreatedAfter string
+var cardListCreatedBefore string
+var cardListClosedAfter string
+var cardListClosedBefore string
+var cardListFilter string
+var cardListTitleMatch string
+var cardListTitleGlob string
+var cardListNotTag []string
+var cardListNotAssignee []string
+var cardListNotColumn []string
+var cardListWithClosureInfo bool
+var cardListWith []string
 var cardListPage int
 var cardListAll bool
+var cardListShare bool
+var cardListOffline bool
+var cardListFormat string
+var cardListGroupBy string
 
 var cardListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List cards",
-	Long:  "Lists cards with optional filters.",
+	Long: `Lists cards with optional filters.
+
+Use --filter for conditions the API filters can't express. The expression is
+evaluated client-side against each fetched card and supports ==, !=, >, >=, <,
+<=, contains, and matches (regex), combined with and, or, not, and parentheses:
+
+  fizzy card list --filter 'tags contains "bug" and created_at > 2025-01-01 and assignee == null'
+
+--title-match (regex) and --title-glob (* and ? wildcards) match card titles
+exactly, unlike --search which matches tokenized terms.
+
+--sort-by orders the fetched cards client-side by any field, such as title,
+number, created_at, closed_at (looked up as with --with-closure-info), or
+assignee (the first assignee's name), smallest or earliest first, or last
+with --desc. Cards without the field go last. Use it with --all to sort the
+whole listing rather than one page:
+
+  fizzy card list --board BOARD_ID --all --sort-by title
+  fizzy card list --indexed-by closed --all --sort-by closed_at --desc
+
+--created-after and --created-before take ISO dates or times, for ranges the
+--created presets can't express; after is inclusive and before exclusive.
+--closed-after and --closed-before do the same for closed cards, whose
+closure times are looked up as with --with-closure-info, so cards closed
+before the latest 10 pages of activity are left out:
+
+  fizzy card list --board BOARD_ID --all --created-after 2025-01-01 --created-before 2025-04-01
+
+--not-tag, --not-assignee, and --not-column leave out cards with any of the
+given tags (by name), assignees, or columns (by ID or name, or maybe, not-now,
+and done), client-side:
+
+  fizzy card list --board BOARD_ID --not-tag chore
+
+--share prints the web app URL for the same filters and a canonical command
+string instead of listing cards, for pasting into chat.
+
+With offline_cache enabled in config, listings are saved locally; --offline
+serves a previously fetched listing (marked with stale_as_of) without calling
+the API, and the saved copy is used automatically when the network is down.
+
+With --ndjson, --all writes each card as a JSON line as its page arrives
+rather than after the last page.
+
+--with-closure-info adds closed_at and closer to each card, which is useful with
+--indexed-by closed to see who closed what and when:
+
+  fizzy card list --indexed-by closed --with-closure-info
+
+--with steps fetches each card's steps, one request per card. Cards that
+carry steps get a steps_progress field such as "3/7" (completed/total) and a
+Steps column in tables:
+
+  fizzy card list --board BOARD_ID --with steps
+
+--format markdown prints the cards as one Markdown document, each with its
+details, description, and steps.
+
+--group-by column, assignee, or tag returns the cards as an object of group
+name to cards, with per-group counts in the summary, for a board-like
+breakdown. Column groups follow the board's order, from Maybe? through the
+board's columns to Not Now and Done. Cards with several assignees or tags
+appear in each group:
+
+  fizzy card list --board BOARD_ID --all --group-by column`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
 		}
+		document, err := documentFormat(cardListFormat)
+		if err != nil {
+			return err
+		}
 		if err := checkLimitAll(cardListAll); err != nil {
 			return err
 		}
+		filter, err := parseFilter(cardListFilter)
+		if err != nil {
+			return err
+		}
+		titleFilter, err := titlePatternFilter(cardListTitleMatch, cardListTitleGlob)
+		if err != nil {
+			return err
+		}
+		createdRange, err := dateRangeFilter("created", cardListCreatedAfter, cardListCreatedBefore)
+		if err != nil {
+			return err
+		}
+		filter = combineFilters(filter, titleFilter, createdRange, exclusionFilter(cardListNotTag, cardListNotAssignee, cardListNotColumn))
+		// Cards don't carry their closure time, so a closed range needs the
+		// closure info looked up before it can be applied.
+		closedRange, err := dateRangeFilter("closed", cardListClosedAfter, cardListClosedBefore)
+		if err != nil {
+			return err
+		}
+		withClosureInfo := cardListWithClosureInfo || closedRange != nil || cardListSortBy == "closed_at"
+		if cardListDesc && cardListSortBy == "" {
+			return errors.NewInvalidArgsError("--desc requires --sort-by")
+		}
+		if err := validateCardGroupBy(cardListGroupBy); err != nil {
+			return err
+		}
+		if cardListGroupBy != "" && document {
+			return errors.NewInvalidArgsError("--group-by cannot be combined with --format")
+		}
+		// Grouped data is keyed by group name, not a list of cards, so
+		// neither field selection nor an ID per line applies to it.
+		if cardListGroupBy != "" && (cfgFields != "" || cfgIDsOnly) {
+			return errors.NewInvalidArgsError("--group-by cannot be combined with --fields or --ids-only")
+		}
+		with, err := parseWithOptions(cardListWith, withSteps)
+		if err != nil {
+			return err
+		}
 
-		boardID := defaultBoard(cardListBoard)
+		boardIDs := defaultBoards(cardListBoard)
+		// A single board scopes column names and closure lookups.
+		boardID := ""
+		if len(boardIDs) == 1 {
+			boardID = boardIDs[0]
+		}
 		columnFilter := strings.TrimSpace(cardListColumn)
 		indexedByFilter := strings.TrimSpace(cardListIndexedBy)
 		effectiveIndexedBy := indexedByFilter
@@ -53,12 +186,14 @@ var cardListCmd = &cobra.Command{
 		path := "/cards.json"
 
 		var params []string
-		if boardID != "" {
-			params = append(params, "board_ids[]="+boardID)
+		for _, id := range boardIDs {
+			params = append(params, "board_ids[]="+id)
 		}
 
+		var listedPseudoColumn *pseudoColumn
 		if columnFilter != "" {
 			if pseudo, ok := parsePseudoColumnID(columnFilter); ok {
+				listedPseudoColumn = &pseudo
 				switch pseudo.Kind {
 				case "not_now":
 					if effectiveIndexedBy != "" && effectiveIndexedBy != "not_now" {
@@ -82,19 +217,41 @@ var cardListCmd = &cobra.Command{
 				if effectiveIndexedBy != "" {
 					return errors.NewInvalidArgsError("cannot combine --indexed-by with --column")
 				}
+				if boardID != "" {
+					if columnFilter, err = resolveColumnID(cmd.Context(), ac, boardID, columnFilter); err != nil {
+						return err
+					}
+				} else {
+					// Without a board, names are looked up across all boards. An
+					// argument that matches nothing is passed through as a column
+					// ID, as it always has been.
+					col, err := resolveAccountColumn(cmd.Context(), ac, columnFilter)
+					switch {
+					case err == nil:
+						columnFilter = col.ColumnID
+					case errors.ExitCodeOf(err) != errors.ExitNotFound:
+						return err
+					}
+				}
 				params = append(params, "column_ids[]="+columnFilter)
 			}
 		}
 
+		if closedRange != nil {
+			if effectiveIndexedBy != "" && effectiveIndexedBy != "closed" {
+				return errors.NewInvalidArgsError("--closed-after and --closed-before list closed cards and cannot be combined with --indexed-by " + effectiveIndexedBy)
+			}
+			effectiveIndexedBy = "closed"
+		}
 		if effectiveIndexedBy != "" {
 			params = append(params, "indexed_by="+effectiveIndexedBy)
 		}
 
-		if cardListTag != "" {
-			params = append(params, "tag_ids[]="+cardListTag)
+		for _, id := range cardListTag {
+			params = append(params, "tag_ids[]="+id)
 		}
-		if cardListAssignee != "" {
-			params = append(params, "assignee_ids[]="+cardListAssignee)
+		for _, id := range cardListAssignee {
+			params = append(params, "assignee_ids[]="+id)
 		}
 		if cardListSearch != "" {
 			for term := range strings.FieldsSeq(cardListSearch) {
@@ -104,8 +261,8 @@ var cardListCmd = &cobra.Command{
 		if cardListSort != "" {
 			params = append(params, "sorted_by="+cardListSort)
 		}
-		if cardListCreator != "" {
-			params = append(params, "creator_ids[]="+cardListCreator)
+		for _, id := range cardListCreator {
+			params = append(params, "creator_ids[]="+id)
 		}
 		if cardListCloser != "" {
 			params = append(params, "closer_ids[]="+cardListCloser)
@@ -119,6 +276,13 @@ var cardListCmd = &cobra.Command{
 		if cardListClosed != "" {
 			params = append(params, "closure="+cardListClosed)
 		}
+		if cardListShare {
+			if len(params) > 0 {
+				path += "?" + strings.Join(params, "&")
+			}
+			printShare(cmd, args, path, map[string]string{"board": strings.Join(boardIDs, ","), "indexed-by": indexedByFilter})
+			return nil
+		}
 		if cardListPage > 0 {
 			params = append(params, "page="+strconv.Itoa(cardListPage))
 		}
@@ -126,22 +290,94 @@ var cardListCmd = &cobra.Command{
 			path += "?" + strings.Join(params, "&")
 		}
 
+		// --ndjson --all writes cards as pages arrive instead of buffering.
+		if cfgNDJSON && cardListAll && !cardListOffline && !withClosureInfo && cardListSortBy == "" && cardListGroupBy == "" {
+			stream := &ndjsonStreamer{}
+			err := streamAllCards(cmd.Context(), ac, path, func(page []map[string]any) {
+				if stream.err != nil {
+					return
+				}
+				items := applyFilter(page, filter)
+				if listedPseudoColumn != nil {
+					items = withResolvedColumn(items, *listedPseudoColumn)
+				}
+				cards := toMaps(items)
+				if with[withSteps] {
+					if stream.err = attachCardsSteps(cmd.Context(), ac, cards); stream.err != nil {
+						return
+					}
+				}
+				addStepsProgress(cards)
+				stream.emit(cards)
+			})
+			if err == nil {
+				err = stream.err
+			}
+			captureResponse()
+			return err
+		}
+
+		listKey := path
+		if cardListAll {
+			listKey += "#all"
+		}
+
 		var items any
 		var linkNext string
+		var fetchErr error
 
-		if cardListAll {
-			pages, err := ac.GetAll(cmd.Context(), path)
+		if !cardListOffline {
+			if cardListAll {
+				cards, err := fetchAllCards(cmd.Context(), ac, path)
+				items, fetchErr = cards, err
+			} else {
+				data, resp, err := ac.Cards().List(cmd.Context(), path)
+				if err != nil {
+					fetchErr = convertSDKError(err)
+				} else {
+					items = normalizeAny(data)
+					linkNext = parseSDKLinkNext(resp)
+				}
+			}
+		}
+		switch {
+		case cardListOffline || (fetchErr != nil && offlineFallback(fetchErr)):
+			cards, err := loadOfflineCardList(listKey)
 			if err != nil {
-				return convertSDKError(err)
+				return err
 			}
-			items = jsonAnySlice(pages)
-		} else {
-			data, resp, err := ac.Cards().List(cmd.Context(), path)
+			items = cards
+		case fetchErr != nil:
+			return fetchErr
+		default:
+			saveOfflineCardList(listKey, items)
+		}
+		items = applyFilter(items, filter)
+		if listedPseudoColumn != nil {
+			items = withResolvedColumn(items, *listedPseudoColumn)
+		}
+
+		cols := cardColumns
+		if withClosureInfo {
+			enriched, err := enrichCardsWithClosureInfo(cmd.Context(), ac, items, boardID)
 			if err != nil {
-				return convertSDKError(err)
+				return err
+			}
+			items = applyFilter(enriched, closedRange)
+			cols = cardClosureColumns
+		}
+		if cardListSortBy != "" {
+			sorted := toMaps(items)
+			sortByField(sorted, cardListSortBy, cardListDesc)
+			items = sorted
+		}
+		if with[withSteps] {
+			if err := attachCardsSteps(cmd.Context(), ac, toMaps(items)); err != nil {
+				return err
 			}
-			items = normalizeAny(data)
-			linkNext = parseSDKLinkNext(resp)
+		}
+		if addStepsProgress(toMaps(items)) {
+			cols = append(slices.Clip(cols), stepsProgressColumn)
 		}
 
 		// Build summary
@@ -169,29 +405,108 @@ var cardListCmd = &cobra.Command{
 			breadcrumbs = append(breadcrumbs, breadcrumb("next", fmt.Sprintf("fizzy card list --page %d", nextPage), "Next page"))
 		}
 
-		printListPaginated(items, cardColumns, hasNext, linkNext, cardListAll, summary, breadcrumbs)
+		if document {
+			writeDocument(cardListMarkdown(summary, toMaps(items)))
+			return nil
+		}
+		if cardListGroupBy != "" {
+			groups := groupCards(toMaps(items), cardListGroupBy)
+			if cardListGroupBy == "column" && !cardListOffline {
+				orderColumnGroups(groups, boardColumnNames(cmd.Context(), ac, boardIDs))
+			}
+			printGroupedCards(groups, cols, cardGroupsSummary(count, groups), breadcrumbs)
+			return nil
+		}
+		printListPaginated(items, cols, hasNext, linkNext, cardListAll, summary, breadcrumbs)
 		return nil
 	},
 }
 
+// Card show flags
+var cardShowWith []string
+var cardShowInclude []string
+var cardShowOffline bool
+var cardShowFormat string
+
 var cardShowCmd = &cobra.Command{
 	Use:   "show CARD_NUMBER",
 	Short: "Show a card",
-	Long:  "Shows details of a specific card.",
-	Args:  cobra.ExactArgs(1),
+	Long: `Shows details of a specific card. --include (or --with) embeds related
+resources in the card, fetching them in the same call:
+
+  reactions    the card's reactions and a reaction_summary count per emoji
+  comments     the card's comments (with their reactions when reactions is
+               included too)
+  steps        the card's steps
+  attachments  the attachments in the description and comments
+
+  fizzy card show 42 --include comments,steps,reactions,attachments
+
+With offline_cache enabled in config, shown cards are saved locally; --offline
+serves the saved copy (marked with stale_as_of) without calling the API, and
+it is used automatically when the network is down.
+
+--format markdown prints the card as a Markdown document, with its
+description converted from HTML, its steps, and its comments, for pasting
+into docs or pull requests.`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
 		}
+		with, err := parseWithOptions(append(cardShowWith, cardShowInclude...), withReactions, withComments, withSteps, withAttachments)
+		if err != nil {
+			return err
+		}
+		document, err := documentFormat(cardShowFormat)
+		if err != nil {
+			return err
+		}
 
 		cardNumber := args[0]
+		ac := getSDK()
 
-		data, _, err := getSDK().Cards().Get(cmd.Context(), cardNumber)
-		if err != nil {
-			return convertSDKError(err)
+		var items any
+		offline := cardShowOffline
+		if !offline {
+			data, _, err := ac.Cards().Get(cmd.Context(), cardNumber)
+			if err != nil {
+				err = convertSDKError(err)
+				if !offlineFallback(err) {
+					return err
+				}
+				offline = true
+			} else {
+				items = normalizeAny(data)
+				saveOfflineCard(items)
+			}
+		}
+		if offline {
+			card, err := loadOfflineCard(cardNumber)
+			if err != nil {
+				return err
+			}
+			items = card
+		}
+
+		if card, ok := items.(map[string]any); ok && len(with) > 0 && !offline {
+			if err := embedCardIncludes(cmd.Context(), ac, cardNumber, card, with); err != nil {
+				return err
+			}
 		}
 
-		items := normalizeAny(data)
+		if card, ok := items.(map[string]any); ok && document {
+			var comments []any
+			if !offline {
+				pages, err := ac.GetAll(cmd.Context(), "/cards/"+cardNumber+"/comments.json")
+				if err != nil {
+					return convertSDKError(err)
+				}
+				comments = rawPagesToSlice(pages)
+			}
+			writeDocument(cardMarkdown(card, comments, 1))
+			return nil
+		}
 
 		// Build summary
 		summary := fmt.Sprintf("Card #%s", cardNumber)
@@ -219,30 +534,103 @@ var cardCreateBoard string
 var cardCreateTitle string
 var cardCreateDescription string
 var cardCreateDescriptionFile string
+var cardCreateDescriptionMD string
+var cardCreateDescriptionMDFile string
 var cardCreateAttach []string
 var cardCreateImage string
 var cardCreateCreatedAt string
+var cardCreateColumn string
+var cardCreateSteps []string
+var cardCreateStepsFile string
+var cardCreateWatch bool
+var cardCreateAssignMe bool
+var cardCreateGolden bool
+var cardCreateJSONInput string
+var cardCreateEdit bool
 
 var cardCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a card",
-	Long:  "Creates a new card in a board. Use --attach for simple end-appended inline attachments. For precise placement, upload files first and embed <action-text-attachment> tags manually in --description or --description_file.",
-	RunE: func(cmd *cobra.Command, args []string) error {
+	Long: `Creates a new card in a board. Use --attach for simple end-appended inline attachments. For precise placement, upload files first and embed <action-text-attachment> tags manually in --description or --description_file.
+
+--column places the new card in a column (ID, name, or a pseudo-column such as
+maybe or done) right after it is created. --watch, --assign-me, and --golden
+watch, self-assign, and gild it. --step (repeatable) and --steps-file add
+checklist steps, so templated cards come out fully formed; a steps file has one
+step per line, and markdown list markers and [x] checkboxes are understood.
+
+--json-input reads the request body as JSON from a file, or from stdin with -,
+so scripts can pass HTML descriptions and fields without flags (tag_names,
+assignee_ids) without shell quoting. Unknown fields are rejected, and flags
+given alongside override the JSON:
+
+  echo '{"title":"Fix login","description":"<p>Steps…</p>","tag_names":["bug"]}' |
+    fizzy card create --board BOARD_ID --json-input -
+
+--description and --description_file guess whether their input is Markdown or
+HTML. --description-md and --description-md-file always read Markdown and
+convert it to rich text the editor can display: headings become one level,
+task lists get ☐ and ☑, and tables become lines of cells.
+
+--edit opens the card in $EDITOR as Markdown, with front matter for the title,
+board, and tags, prefilled from --title, --board, and --description. The card
+is created when the editor exits; leaving the title empty cancels. If the card
+can't be created, the edit is kept and the error says where.`,
+	RunE: func(cmd *cobra.Command, args []string) (runErr error) {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
 		}
+		if cardCreateEdit && (cardCreateJSONInput != "" || cardCreateDescriptionFile != "" || cardCreateDescriptionMDFile != "") {
+			return errors.NewInvalidArgsError("--edit cannot be combined with --json-input, --description_file, or --description-md-file")
+		}
 
-		boardID, err := requireBoard(cardCreateBoard)
-		if err != nil {
-			return err
+		req := &generated.CreateCardRequest{}
+		if cardCreateJSONInput != "" {
+			if err := readJSONInput(cmd, cardCreateJSONInput, req); err != nil {
+				return err
+			}
+		}
+
+		var edited *editedCard
+		var editedFile *editFile
+		if cardCreateEdit {
+			var err error
+			if edited, editedFile, err = editCardCreate(cardCreateTitle, defaultBoard(cardCreateBoard), firstNonEmpty(cardCreateDescriptionMD, cardCreateDescription)); err != nil {
+				return err
+			}
+			// Keep the edit until the card exists, so it isn't lost when
+			// creating it fails.
+			defer func() { runErr = editedFile.Keep(runErr) }()
+			req.BoardId = edited.Board
+			req.Title = edited.Title
+			req.TagNames = edited.Tags
+		}
+
+		boardID := req.BoardId
+		if boardID == "" || (cardCreateBoard != "" && edited == nil) {
+			var err error
+			if boardID, err = requireBoard(cardCreateBoard); err != nil {
+				return err
+			}
+		}
+		if cardCreateTitle != "" && edited == nil {
+			req.Title = cardCreateTitle
 		}
-		if cardCreateTitle == "" {
+		if req.Title == "" {
 			return newRequiredFlagError("title")
 		}
 
-		description, err := resolveRichTextContent(cardCreateDescription, cardCreateDescriptionFile)
-		if err != nil {
-			return err
+		var description string
+		var err error
+		if edited != nil {
+			description = edited.Description
+		} else {
+			if description, err = resolveDescriptionInput(cardCreateDescription, cardCreateDescriptionFile, cardCreateDescriptionMD, cardCreateDescriptionMDFile); err != nil {
+				return err
+			}
+			if description == "" {
+				description = req.Description
+			}
 		}
 		description, err = appendInlineAttachmentsToContent(description, cardCreateAttach)
 		if err != nil {
@@ -251,13 +639,32 @@ var cardCreateCmd = &cobra.Command{
 
 		ac := getSDK()
 
-		req := &generated.CreateCardRequest{
-			BoardId: boardID,
-			Title:   cardCreateTitle,
+		steps := make([]checklistItem, 0, len(cardCreateSteps))
+		for _, step := range cardCreateSteps {
+			if step = strings.TrimSpace(step); step != "" {
+				steps = append(steps, checklistItem{Content: step})
+			}
 		}
-		if description != "" {
-			req.Description = description
+		if cardCreateStepsFile != "" {
+			fileSteps, err := readChecklistFile(cardCreateStepsFile)
+			if err != nil {
+				return err
+			}
+			steps = append(steps, fileSteps...)
+		}
+
+		// Resolve the column up front so a bad name fails before the card exists.
+		column := cardCreateColumn
+		if column != "" {
+			if _, ok := parsePseudoColumnID(column); !ok {
+				if column, err = resolveColumnID(cmd.Context(), ac, boardID, column); err != nil {
+					return err
+				}
+			}
 		}
+
+		req.BoardId = boardID
+		req.Description = description
 		if cardCreateImage != "" {
 			req.Image = cardCreateImage
 		}
@@ -265,28 +672,28 @@ var cardCreateCmd = &cobra.Command{
 			req.CreatedAt = cardCreateCreatedAt
 		}
 
-		data, resp, err := ac.Cards().Create(cmd.Context(), req)
+		items, cardNumber, location, err := createCard(cmd.Context(), ac, req)
 		if err != nil {
-			return convertSDKError(err)
+			return err
 		}
+		editedFile.Remove()
 
-		items := normalizeAny(data)
-		location := resp.Headers.Get("Location")
-
-		// If the API returned an empty body with a Location header (201 Created),
-		// follow the Location to fetch the created resource.
-		if items == nil && location != "" {
-			followData, _, followErr := ac.Cards().Get(cmd.Context(), locationCardNumber(location))
-			if followErr == nil {
-				items = normalizeAny(followData)
+		if len(steps) > 0 || cardCreateColumn != "" || cardCreateWatch || cardCreateAssignMe || cardCreateGolden {
+			if cardNumber == "" {
+				return errors.NewError("Card was created, but its number is unknown, so --step, --column, --watch, --assign-me, and --golden were not applied")
 			}
-		}
-
-		// Extract card number from response
-		cardNumber := ""
-		if card, ok := items.(map[string]any); ok {
-			if num, ok := card["number"].(float64); ok {
-				cardNumber = fmt.Sprintf("%d", int(num))
+			if err := applyCardFollowUps(cmd.Context(), ac, cardNumber, cardFollowUps{
+				Checklist: steps,
+				Column:    column,
+				Watch:     cardCreateWatch,
+				AssignMe:  cardCreateAssignMe,
+				Golden:    cardCreateGolden,
+			}); err != nil {
+				return err
+			}
+			// Show the card as it is after the follow-up calls.
+			if followData, _, followErr := ac.Cards().Get(cmd.Context(), cardNumber); followErr == nil {
+				items = normalizeAny(followData)
 			}
 		}
 
@@ -309,18 +716,118 @@ var cardCreateCmd = &cobra.Command{
 	},
 }
 
+// createCard creates a card and returns it with its number and Location
+// header. When the API answers with an empty body, the card is fetched from
+// its Location.
+func createCard(ctx context.Context, ac *fizzy.AccountClient, req *generated.CreateCardRequest) (any, string, string, error) {
+	data, resp, err := ac.Cards().Create(ctx, req)
+	if err != nil {
+		return nil, "", "", convertSDKError(err)
+	}
+
+	items := normalizeAny(data)
+	location := resp.Headers.Get("Location")
+
+	// If the API returned an empty body with a Location header (201 Created),
+	// follow the Location to fetch the created resource.
+	if items == nil && location != "" {
+		followData, _, followErr := ac.Cards().Get(ctx, locationCardNumber(location))
+		if followErr == nil {
+			items = normalizeAny(followData)
+		}
+	}
+
+	// Extract card number from response
+	cardNumber := ""
+	if card, ok := items.(map[string]any); ok {
+		if num, ok := card["number"].(float64); ok {
+			cardNumber = fmt.Sprintf("%d", int(num))
+		}
+	}
+	rememberRecent("card", cardNumber)
+	return items, cardNumber, location, nil
+}
+
+// cardFollowUps are the calls applied to a newly created card, by card
+// create's flags or quick's one-liner. Assignees and Column are already
+// resolved.
+type cardFollowUps struct {
+	Checklist []checklistItem
+	Tags      []string
+	Assignees []string
+	Column    string
+	Watch     bool
+	AssignMe  bool
+	Golden    bool
+}
+
+// applyCardFollowUps adds steps to, tags, assigns, places, watches, and gilds
+// a newly created card. The card already exists, so a failure says which
+// step didn't happen.
+func applyCardFollowUps(ctx context.Context, ac *fizzy.AccountClient, cardNumber string, f cardFollowUps) error {
+	steps := []struct {
+		enabled bool
+		action  string
+		run     func() error
+	}{
+		{len(f.Checklist) > 0, "add steps to", func() error {
+			for _, item := range f.Checklist {
+				req := &generated.CreateStepRequest{Content: item.Content, Completed: item.Completed}
+				if _, _, err := ac.Steps().Create(ctx, cardNumber, req); err != nil {
+					return err
+				}
+			}
+			return nil
+		}},
+		{len(f.Tags) > 0, "tag", func() error {
+			for _, tag := range f.Tags {
+				if _, err := ac.Cards().Tag(ctx, cardNumber, &generated.TagCardRequest{TagTitle: tag}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}},
+		{len(f.Assignees) > 0, "assign", func() error {
+			for _, id := range f.Assignees {
+				if _, err := ac.Cards().Assign(ctx, cardNumber, &generated.AssignCardRequest{AssigneeId: id}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}},
+		{f.Column != "", "move", func() error { return placeCard(ctx, ac, cardNumber, f.Column) }},
+		{f.Watch, "watch", func() error { _, err := ac.Cards().Watch(ctx, cardNumber); return err }},
+		{f.AssignMe, "assign", func() error { _, err := ac.Cards().SelfAssign(ctx, cardNumber); return err }},
+		{f.Golden, "gild", func() error { _, err := ac.Cards().Gold(ctx, cardNumber); return err }},
+	}
+	for _, step := range steps {
+		if !step.enabled {
+			continue
+		}
+		if err := step.run(); err != nil {
+			e := output.AsError(convertSDKError(err))
+			e.Message = fmt.Sprintf("Card #%s was created, but could not %s it: %s", cardNumber, step.action, e.Message)
+			return e
+		}
+	}
+	return nil
+}
+
 // Card update flags
 var cardUpdateTitle string
 var cardUpdateDescription string
 var cardUpdateDescriptionFile string
+var cardUpdateDescriptionMD string
+var cardUpdateDescriptionMDFile string
 var cardUpdateAttach []string
 var cardUpdateImage string
 var cardUpdateCreatedAt string
+var cardUpdateJSONInput string
 
 var cardUpdateCmd = &cobra.Command{
 	Use:   "update CARD_NUMBER",
 	Short: "Update a card",
-	Long:  "Updates an existing card. Use --attach for simple end-appended inline attachments. For precise placement, upload files first and embed <action-text-attachment> tags manually in --description or --description_file.",
+	Long:  "Updates an existing card. Use --attach for simple end-appended inline attachments. For precise placement, upload files first and embed <action-text-attachment> tags manually in --description or --description_file.\n\n--description-md and --description-md-file always read Markdown and convert it to rich text the editor can display.\n\n--json-input reads the request body as JSON from a file, or from stdin with -. Flags given alongside override its fields.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
@@ -329,11 +836,21 @@ var cardUpdateCmd = &cobra.Command{
 
 		cardNumber := args[0]
 
-		hasDescriptionInput := cardUpdateDescription != "" || cardUpdateDescriptionFile != ""
-		description, err := resolveRichTextContent(cardUpdateDescription, cardUpdateDescriptionFile)
+		req := &generated.UpdateCardRequest{}
+		if cardUpdateJSONInput != "" {
+			if err := readJSONInput(cmd, cardUpdateJSONInput, req); err != nil {
+				return err
+			}
+		}
+
+		hasDescriptionInput := cardUpdateDescription != "" || cardUpdateDescriptionFile != "" || cardUpdateDescriptionMD != "" || cardUpdateDescriptionMDFile != "" || req.Description != ""
+		description, err := resolveDescriptionInput(cardUpdateDescription, cardUpdateDescriptionFile, cardUpdateDescriptionMD, cardUpdateDescriptionMDFile)
 		if err != nil {
 			return err
 		}
+		if description == "" {
+			description = req.Description
+		}
 		if len(cardUpdateAttach) > 0 && !hasDescriptionInput {
 			currentData, _, getErr := getSDK().Cards().Get(cmd.Context(), cardNumber)
 			if getErr != nil {
@@ -357,7 +874,6 @@ var cardUpdateCmd = &cobra.Command{
 			breadcrumb("comment", fmt.Sprintf("fizzy comment create --card %s --body \"text\"", cardNumber), "Add comment"),
 		}
 
-		req := &generated.UpdateCardRequest{}
 		if cardUpdateTitle != "" {
 			req.Title = cardUpdateTitle
 		}
@@ -390,6 +906,10 @@ var cardDeleteCmd = &cobra.Command{
 			return err
 		}
 
+		if deferred, err := deferDelete(cmd, tombstone{Kind: "card", Target: args[0]}); deferred || err != nil {
+			return err
+		}
+
 		_, err := getSDK().Cards().Delete(cmd.Context(), args[0])
 		if err != nil {
 			return convertSDKError(err)
@@ -408,31 +928,90 @@ var cardDeleteCmd = &cobra.Command{
 	},
 }
 
+// Card close flags
+var cardCloseFromStdin bool
+var cardCloseApprove bool
+var cardCloseDryRun bool
+
 var cardCloseCmd = &cobra.Command{
-	Use:   "close CARD_NUMBER",
-	Short: "Close a card",
-	Long:  "Closes a card.",
-	Args:  cobra.ExactArgs(1),
+	Use:   "close CARD_NUMBER...",
+	Short: "Close cards",
+	Long: `Closes a card, or several at once.
+
+Give several card numbers, or pipe them in with --from-stdin (whitespace
+separated), to close them concurrently. Closing more than one card is a bulk
+change: in a terminal the plan is shown for confirmation, and elsewhere it
+needs --approve (use --dry-run to review the plan first). The result lists the
+cards that closed under succeeded and the ones that didn't under failed, with
+the error, and the command exits with code 9 when any failed.`,
+	Example: `  fizzy card close 42
+  fizzy card close 12 14 19
+  fizzy card list --tag leftover --ids-only | fizzy card close --from-stdin --approve`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
 		}
 
-		cardNumber := args[0]
-
-		_, err := getSDK().Cards().Close(cmd.Context(), cardNumber)
+		numbers, err := bulkCardNumbers(args, cardCloseFromStdin, cmd.InOrStdin())
 		if err != nil {
-			return convertSDKError(err)
+			return err
 		}
 
-		// Build breadcrumbs
+		action := fmt.Sprintf("close %d %s", len(numbers), pluralize(len(numbers), "card", "cards"))
+		changes := make([]planChange, len(numbers))
+		for i, number := range numbers {
+			changes[i] = planChange{Card: number, Change: "close"}
+		}
 		breadcrumbs := []Breadcrumb{
-			breadcrumb("reopen", fmt.Sprintf("fizzy card reopen %s", cardNumber), "Reopen card"),
-			breadcrumb("show", fmt.Sprintf("fizzy card show %s", cardNumber), "View card"),
+			breadcrumb("closed", "fizzy card list --indexed-by closed", "List closed cards"),
 		}
+		data := map[string]any{}
 
-		printMutation(map[string]any{}, "", breadcrumbs)
-		return nil
+		if cardCloseDryRun {
+			progressf("%s", renderPlan(action, changes))
+			data["dry_run"] = true
+			data["plan"] = changes
+			printMutation(data, "Would "+action, breadcrumbs)
+			return nil
+		}
+
+		// A single card named on the command line isn't a bulk change.
+		if len(args) == 1 && !cardCloseFromStdin {
+			cardNumber := fmt.Sprint(numbers[0])
+
+			_, err := getSDK().Cards().Close(cmd.Context(), cardNumber)
+			if err != nil {
+				return convertSDKError(err)
+			}
+
+			// Build breadcrumbs
+			breadcrumbs := []Breadcrumb{
+				breadcrumb("reopen", fmt.Sprintf("fizzy card reopen %s", cardNumber), "Reopen card"),
+				breadcrumb("show", fmt.Sprintf("fizzy card show %s", cardNumber), "View card"),
+			}
+
+			printMutation(map[string]any{}, "", breadcrumbs)
+			return nil
+		}
+
+		approved, err := approvePlan(action, changes, cardCloseApprove)
+		if err != nil {
+			return err
+		}
+		if !approved {
+			data["cancelled"] = true
+			printMutation(data, "Close cancelled; no cards changed", breadcrumbs)
+			return nil
+		}
+
+		ac := getSDK()
+		result := runBulk(numbers, maxParallel(bulkConcurrency), func(number any) error {
+			_, err := ac.Cards().Close(cmd.Context(), fmt.Sprint(number))
+			return err
+		})
+
+		summary := fmt.Sprintf("Closed %d of %d %s", len(result.succeeded), len(numbers), pluralize(len(numbers), "card", "cards"))
+		return printBulkResult(data, result, "cards", summary, breadcrumbs)
 	},
 }
 
@@ -542,11 +1121,12 @@ var cardMoveCmd = &cobra.Command{
 
 // Card column flags
 var cardColumnColumn string
+var cardColumnBoard string
 
 var cardColumnCmd = &cobra.Command{
 	Use:   "column CARD_NUMBER",
 	Short: "Move card to column",
-	Long:  "Moves a card to a specific column.",
+	Long:  "Moves a card to a specific column. --column accepts a column ID, a column name, or a pseudo-column (not-now, maybe, done). Names are resolved and IDs validated against the column list of the card's own board, or of --board when given, which is cached locally for a few minutes.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
@@ -566,38 +1146,8 @@ var cardColumnCmd = &cobra.Command{
 			breadcrumb("close", fmt.Sprintf("fizzy card close %s", cardNumber), "Close card"),
 		}
 
-		ac := getSDK()
-		if pseudo, ok := parsePseudoColumnID(cardColumnColumn); ok {
-			switch pseudo.Kind {
-			case "triage":
-				_, err := ac.Cards().UnTriage(cmd.Context(), cardNumber)
-				if err != nil {
-					return convertSDKError(err)
-				}
-				printMutation(map[string]any{}, "", breadcrumbs)
-				return nil
-			case "not_now":
-				_, err := ac.Cards().Postpone(cmd.Context(), cardNumber)
-				if err != nil {
-					return convertSDKError(err)
-				}
-				printMutation(map[string]any{}, "", breadcrumbs)
-				return nil
-			case "closed":
-				_, err := ac.Cards().Close(cmd.Context(), cardNumber)
-				if err != nil {
-					return convertSDKError(err)
-				}
-				printMutation(map[string]any{}, "", breadcrumbs)
-				return nil
-			}
-		}
-
-		_, err := ac.Cards().Triage(cmd.Context(), cardNumber, &generated.TriageCardRequest{
-			ColumnId: cardColumnColumn,
-		})
-		if err != nil {
-			return convertSDKError(err)
+		if err := moveCardToColumn(cmd.Context(), getSDK(), cardNumber, cardColumnBoard, cardColumnColumn); err != nil {
+			return err
 		}
 
 		printMutation(map[string]any{}, "", breadcrumbs)
@@ -605,6 +1155,62 @@ var cardColumnCmd = &cobra.Command{
 	},
 }
 
+// moveCardToColumn places a card in column, which may be a column ID, a
+// column name, or a pseudo-column. Names are resolved and IDs validated
+// against boardID's columns, or the card's own board when boardID is empty.
+func moveCardToColumn(ctx context.Context, ac *fizzy.AccountClient, cardNumber, boardID, column string) error {
+	if _, ok := parsePseudoColumnID(column); !ok {
+		if boardID == "" {
+			var err error
+			if boardID, err = cardBoardID(ctx, ac, cardNumber); err != nil {
+				return err
+			}
+		}
+		if boardID != "" {
+			var err error
+			if column, err = resolveColumnID(ctx, ac, boardID, column); err != nil {
+				return err
+			}
+		}
+	}
+	return placeCard(ctx, ac, cardNumber, column)
+}
+
+// placeCard places a card in a column already resolved to an ID or a
+// pseudo-column.
+func placeCard(ctx context.Context, ac *fizzy.AccountClient, cardNumber, columnID string) error {
+	var err error
+	if pseudo, ok := parsePseudoColumnID(columnID); ok {
+		switch pseudo.Kind {
+		case "triage":
+			_, err = ac.Cards().UnTriage(ctx, cardNumber)
+		case "not_now":
+			_, err = ac.Cards().Postpone(ctx, cardNumber)
+		case "closed":
+			_, err = ac.Cards().Close(ctx, cardNumber)
+		}
+	} else {
+		_, err = ac.Cards().Triage(ctx, cardNumber, &generated.TriageCardRequest{
+			ColumnId: columnID,
+		})
+	}
+	if err != nil {
+		return convertSDKError(err)
+	}
+	return nil
+}
+
+// cardBoardID returns the ID of the board a card is on.
+func cardBoardID(ctx context.Context, ac *fizzy.AccountClient, cardNumber string) (string, error) {
+	data, _, err := ac.Cards().Get(ctx, cardNumber)
+	if err != nil {
+		return "", convertSDKError(err)
+	}
+	card, _ := normalizeAny(data).(map[string]any)
+	board, _ := card["board"].(map[string]any)
+	return getStringField(board, "id"), nil
+}
+
 var cardUntriageCmd = &cobra.Command{
 	Use:   "untriage CARD_NUMBER",
 	Short: "Send card back to triage",
@@ -1035,25 +1641,47 @@ func init() {
 	rootCmd.AddCommand(cardCmd)
 
 	// List
-	cardListCmd.Flags().StringVar(&cardListBoard, "board", "", "Filter by board ID")
-	cardListCmd.Flags().StringVar(&cardListColumn, "column", "", "Filter by column ID or pseudo column (not-now, maybe, done)")
-	cardListCmd.Flags().StringVar(&cardListTag, "tag", "", "Filter by tag ID")
+	cardListCmd.Flags().StringSliceVar(&cardListBoard, "board", nil, "Filter by board ID (repeatable or comma-separated)")
+	cardListCmd.Flags().StringVar(&cardListColumn, "column", "", "Filter by column ID, name, board/column, or pseudo column (not-now, maybe, done)")
+	cardListCmd.Flags().StringSliceVar(&cardListTag, "tag", nil, "Filter by tag ID (repeatable or comma-separated)")
 	cardListCmd.Flags().StringVar(&cardListIndexedBy, "indexed-by", "", "Filter by lane/index (all, closed, maybe, not_now, stalled, postponing_soon, golden)")
 	cardListCmd.Flags().StringVar(&cardListIndexedBy, "status", "", "Alias for --indexed-by")
 	_ = cardListCmd.Flags().MarkDeprecated("status", "use --indexed-by")
-	cardListCmd.Flags().StringVar(&cardListAssignee, "assignee", "", "Filter by assignee ID")
+	cardListCmd.Flags().StringSliceVar(&cardListAssignee, "assignee", nil, "Filter by assignee ID (repeatable or comma-separated)")
 	cardListCmd.Flags().StringVar(&cardListSearch, "search", "", "Search terms (space-separated for multiple)")
 	cardListCmd.Flags().StringVar(&cardListSort, "sort", "", "Sort order: newest, oldest, or latest (default)")
-	cardListCmd.Flags().StringVar(&cardListCreator, "creator", "", "Filter by creator user ID")
+	cardListCmd.Flags().StringVar(&cardListSortBy, "sort-by", "", "Sort the fetched cards by a field, e.g. title, number, created_at, closed_at, assignee (client-side)")
+	cardListCmd.Flags().BoolVar(&cardListDesc, "desc", false, "With --sort-by, sort largest or latest first")
+	cardListCmd.Flags().StringSliceVar(&cardListCreator, "creator", nil, "Filter by creator user ID (repeatable or comma-separated)")
 	cardListCmd.Flags().StringVar(&cardListCloser, "closer", "", "Filter by closer user ID")
 	cardListCmd.Flags().BoolVar(&cardListUnassigned, "unassigned", false, "Only show unassigned cards")
 	cardListCmd.Flags().StringVar(&cardListCreated, "created", "", "Filter by creation time (today, yesterday, thisweek, lastweek, thismonth, lastmonth)")
 	cardListCmd.Flags().StringVar(&cardListClosed, "closed", "", "Filter by closure time (today, yesterday, thisweek, lastweek, thismonth, lastmonth)")
+	cardListCmd.Flags().StringVar(&cardListCreatedAfter, "created-after", "", "Only cards created on or after this ISO date or time (client-side)")
+	cardListCmd.Flags().StringVar(&cardListCreatedBefore, "created-before", "", "Only cards created before this ISO date or time (client-side)")
+	cardListCmd.Flags().StringVar(&cardListClosedAfter, "closed-after", "", "Only cards closed on or after this ISO date or time (client-side; implies --with-closure-info)")
+	cardListCmd.Flags().StringVar(&cardListClosedBefore, "closed-before", "", "Only cards closed before this ISO date or time (client-side; implies --with-closure-info)")
+	cardListCmd.Flags().StringVar(&cardListFilter, "filter", "", "Filter fetched cards client-side with an expression (e.g. 'tags contains \"bug\" and assignee == null')")
+	cardListCmd.Flags().StringVar(&cardListTitleMatch, "title-match", "", "Only show cards whose title matches a regular expression (client-side)")
+	cardListCmd.Flags().StringVar(&cardListTitleGlob, "title-glob", "", "Only show cards whose title matches a glob such as 'Release *' (client-side, case-insensitive)")
+	cardListCmd.Flags().StringSliceVar(&cardListNotTag, "not-tag", nil, "Leave out cards with this tag name (client-side; repeatable or comma-separated)")
+	cardListCmd.Flags().StringSliceVar(&cardListNotAssignee, "not-assignee", nil, "Leave out cards assigned to this user ID or name (client-side; repeatable or comma-separated)")
+	cardListCmd.Flags().StringSliceVar(&cardListNotColumn, "not-column", nil, "Leave out cards in this column ID or name (client-side; repeatable or comma-separated)")
+	cardListCmd.Flags().BoolVar(&cardListWithClosureInfo, "with-closure-info", false, "Add closed_at and closer to each card (looked up from recent activity)")
+	cardListCmd.Flags().StringSliceVar(&cardListWith, "with", nil, "Include related data: steps (one request per card)")
 	cardListCmd.Flags().IntVar(&cardListPage, "page", 0, "Page number")
 	cardListCmd.Flags().BoolVar(&cardListAll, "all", false, "Fetch all pages")
+	cardListCmd.Flags().BoolVar(&cardListShare, "share", false, shareFlagUsage)
+	cardListCmd.Flags().BoolVar(&cardListOffline, "offline", false, "Serve the listing from the offline store instead of the API")
+	cardListCmd.Flags().StringVar(&cardListFormat, "format", "", "Print a document instead: markdown")
+	cardListCmd.Flags().StringVar(&cardListGroupBy, "group-by", "", "Group the cards by column, assignee, or tag")
 	cardCmd.AddCommand(cardListCmd)
 
 	// Show
+	cardShowCmd.Flags().StringSliceVar(&cardShowWith, "with", nil, "Include related data: reactions, comments, steps, attachments")
+	cardShowCmd.Flags().StringSliceVar(&cardShowInclude, "include", nil, "Same as --with")
+	cardShowCmd.Flags().BoolVar(&cardShowOffline, "offline", false, "Serve the card from the offline store instead of the API")
+	cardShowCmd.Flags().StringVar(&cardShowFormat, "format", "", "Print a document instead: markdown")
 	cardCmd.AddCommand(cardShowCmd)
 
 	// Create
@@ -1061,24 +1689,41 @@ func init() {
 	cardCreateCmd.Flags().StringVar(&cardCreateTitle, "title", "", "Card title (required)")
 	cardCreateCmd.Flags().StringVar(&cardCreateDescription, "description", "", "Card description (markdown or HTML)")
 	cardCreateCmd.Flags().StringVar(&cardCreateDescriptionFile, "description_file", "", "Read description from file (markdown or HTML)")
+	cardCreateCmd.Flags().StringVar(&cardCreateDescriptionMD, "description-md", "", "Card description in Markdown, converted to rich text")
+	cardCreateCmd.Flags().StringVar(&cardCreateDescriptionMDFile, "description-md-file", "", "Read the description from a Markdown file, converted to rich text")
 	cardCreateCmd.Flags().StringArrayVar(&cardCreateAttach, "attach", nil, "Upload and append inline attachment at the end of the description. Repeatable.")
 	cardCreateCmd.Flags().StringVar(&cardCreateImage, "image", "", "Header image signed ID")
 	cardCreateCmd.Flags().StringVar(&cardCreateCreatedAt, "created-at", "", "Custom created_at timestamp")
+	cardCreateCmd.Flags().StringArrayVar(&cardCreateSteps, "step", nil, "Add a step to the new card. Repeatable.")
+	cardCreateCmd.Flags().StringVar(&cardCreateStepsFile, "steps-file", "", "Add steps from a file, one per line (markdown checklists supported)")
+	cardCreateCmd.Flags().StringVar(&cardCreateColumn, "column", "", "Column to place the card in (ID, name, or maybe/not-now/done)")
+	cardCreateCmd.Flags().BoolVar(&cardCreateWatch, "watch", false, "Watch the card after creating it")
+	cardCreateCmd.Flags().BoolVar(&cardCreateAssignMe, "assign-me", false, "Assign the card to yourself after creating it")
+	cardCreateCmd.Flags().BoolVar(&cardCreateGolden, "golden", false, "Mark the card golden after creating it")
+	cardCreateCmd.Flags().StringVar(&cardCreateJSONInput, "json-input", "", jsonInputUsage)
+	cardCreateCmd.Flags().BoolVar(&cardCreateEdit, "edit", false, "Write the card in $EDITOR as Markdown with front matter for title, board, and tags")
 	cardCmd.AddCommand(cardCreateCmd)
 
 	// Update
 	cardUpdateCmd.Flags().StringVar(&cardUpdateTitle, "title", "", "Card title")
 	cardUpdateCmd.Flags().StringVar(&cardUpdateDescription, "description", "", "Card description (markdown or HTML)")
 	cardUpdateCmd.Flags().StringVar(&cardUpdateDescriptionFile, "description_file", "", "Read description from file (markdown or HTML)")
+	cardUpdateCmd.Flags().StringVar(&cardUpdateDescriptionMD, "description-md", "", "Card description in Markdown, converted to rich text")
+	cardUpdateCmd.Flags().StringVar(&cardUpdateDescriptionMDFile, "description-md-file", "", "Read the description from a Markdown file, converted to rich text")
 	cardUpdateCmd.Flags().StringArrayVar(&cardUpdateAttach, "attach", nil, "Upload and append inline attachment at the end of the description. Repeatable.")
 	cardUpdateCmd.Flags().StringVar(&cardUpdateImage, "image", "", "Header image signed ID")
 	cardUpdateCmd.Flags().StringVar(&cardUpdateCreatedAt, "created-at", "", "Custom created_at timestamp")
+	cardUpdateCmd.Flags().StringVar(&cardUpdateJSONInput, "json-input", "", jsonInputUsage)
 	cardCmd.AddCommand(cardUpdateCmd)
 
 	// Delete
+	addDeleteNowFlag(cardDeleteCmd)
 	cardCmd.AddCommand(cardDeleteCmd)
 
 	// Actions
+	cardCloseCmd.Flags().BoolVar(&cardCloseFromStdin, "from-stdin", false, "Read card numbers from stdin")
+	cardCloseCmd.Flags().BoolVar(&cardCloseApprove, "approve", false, "Close several cards without the confirmation prompt")
+	cardCloseCmd.Flags().BoolVar(&cardCloseDryRun, "dry-run", false, "Print the plan without closing anything")
 	cardCmd.AddCommand(cardCloseCmd)
 	cardCmd.AddCommand(cardReopenCmd)
 	cardCmd.AddCommand(cardPostponeCmd)
@@ -1088,7 +1733,8 @@ func init() {
 	cardCmd.AddCommand(cardMoveCmd)
 
 	// Column
-	cardColumnCmd.Flags().StringVar(&cardColumnColumn, "column", "", "Column ID (required)")
+	cardColumnCmd.Flags().StringVar(&cardColumnColumn, "column", "", "Column ID or name (required)")
+	cardColumnCmd.Flags().StringVar(&cardColumnBoard, "board", "", "Board ID used to resolve and validate --column (defaults to the card's board)")
 	cardCmd.AddCommand(cardColumnCmd)
 
 	// Untriage
@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
+	"github.com/spf13/cobra"
+)
+
+var cardAssigneesCmd = &cobra.Command{
+	Use:   "assignees",
+	Short: "Manage card assignees",
+	Long:  "Commands for managing who is assigned to a card.",
+}
+
+// Card assignees set flags
+var cardAssigneesSetUsers []string
+
+var cardAssigneesSetCmd = &cobra.Command{
+	Use:   "set CARD_NUMBER",
+	Short: "Replace a card's assignees",
+	Long: `Makes a card's assignees exactly the given users. Users already assigned
+are left alone, missing ones are assigned, and anyone else is unassigned.
+
+Users can be given by ID, email address, or name, as with 'fizzy user find'.
+Pass --users "" to unassign everyone.`,
+	Example: `  fizzy card assignees set 42 --users alice@example.com,bob@example.com
+  fizzy card assignees set 42 --users ""`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		if !cmd.Flags().Changed("users") {
+			return newRequiredFlagError("users")
+		}
+
+		cardNumber := args[0]
+		ac := getSDK()
+
+		data, _, err := ac.Cards().Get(cmd.Context(), cardNumber)
+		if err != nil {
+			return convertSDKError(err)
+		}
+		card := toMap(data)
+
+		var current []string
+		for _, assignee := range toMaps(card["assignees"]) {
+			current = append(current, fmt.Sprintf("%v", assignee["id"]))
+		}
+
+		// Resolve users to IDs. Current assignee IDs need no lookup; anything
+		// else is matched against the account's users, fetched once.
+		var users []map[string]any
+		var desired []string
+		for _, value := range cardAssigneesSetUsers {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				continue
+			}
+			id := value
+			if !slices.Contains(current, value) {
+				if users == nil {
+					pages, err := ac.GetAll(cmd.Context(), "/users.json")
+					if err != nil {
+						return convertSDKError(err)
+					}
+					users = toMaps(jsonAnySlice(pages))
+				}
+				user, err := matchUser(users, value)
+				if err != nil {
+					return err
+				}
+				id = fmt.Sprintf("%v", user["id"])
+			}
+			if !slices.Contains(desired, id) {
+				desired = append(desired, id)
+			}
+		}
+
+		// Assigning toggles, so only touch users whose state has to change.
+		added, removed := []string{}, []string{}
+		for _, id := range desired {
+			if !slices.Contains(current, id) {
+				added = append(added, id)
+			}
+		}
+		for _, id := range current {
+			if !slices.Contains(desired, id) {
+				removed = append(removed, id)
+			}
+		}
+		for _, id := range slices.Concat(added, removed) {
+			if _, err := ac.Cards().Assign(cmd.Context(), cardNumber, &generated.AssignCardRequest{AssigneeId: id}); err != nil {
+				return convertSDKError(err)
+			}
+		}
+
+		if desired == nil {
+			desired = []string{}
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("show", fmt.Sprintf("fizzy card show %s", cardNumber), "View card"),
+			breadcrumb("people", "fizzy user list", "List users"),
+		}
+
+		printMutation(map[string]any{
+			"number":    cardNumber,
+			"assignees": desired,
+			"added":     added,
+			"removed":   removed,
+		}, fmt.Sprintf("Card #%s: %d assigned, %d unassigned", cardNumber, len(added), len(removed)), breadcrumbs)
+		return nil
+	},
+}
+
+func init() {
+	cardCmd.AddCommand(cardAssigneesCmd)
+
+	cardAssigneesSetCmd.Flags().StringSliceVar(&cardAssigneesSetUsers, "users", nil, "Users to assign, by ID, email, or name (comma-separated)")
+	cardAssigneesCmd.AddCommand(cardAssigneesSetCmd)
+}
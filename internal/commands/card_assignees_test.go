@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestCardAssigneesSet(t *testing.T) {
+	card := &client.APIResponse{StatusCode: 200, Data: map[string]any{
+		"number": float64(42),
+		"assignees": []any{
+			map[string]any{"id": "u1", "name": "Ann"},
+			map[string]any{"id": "u2", "name": "Ben"},
+		},
+	}}
+	users := &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"id": "u1", "name": "Ann", "email_address": "ann@example.com"},
+		map[string]any{"id": "u2", "name": "Ben", "email_address": "ben@example.com"},
+		map[string]any{"id": "u3", "name": "Cat", "email_address": "cat@example.com"},
+	}}
+
+	t.Run("toggles only the difference", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards/42", card)
+		mock.OnGet("/users.json", users)
+		mock.PostResponse = &client.APIResponse{StatusCode: 204}
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardAssigneesSetCmd.Flags().Set("users", "u1,cat@example.com")
+		defer func() {
+			cardAssigneesSetUsers = nil
+			cardAssigneesSetCmd.Flags().Lookup("users").Changed = false
+		}()
+
+		err := cardAssigneesSetCmd.RunE(cardAssigneesSetCmd, []string{"42"})
+		assertExitCode(t, err, 0)
+
+		if len(mock.PostCalls) != 2 {
+			t.Fatalf("expected 2 toggle calls, got %d", len(mock.PostCalls))
+		}
+		for i, want := range []string{"u3", "u2"} {
+			call := mock.PostCalls[i]
+			if call.Path != "/cards/42/assignments.json" {
+				t.Errorf("expected assignments path, got %s", call.Path)
+			}
+			if body := call.Body.(map[string]any); body["assignee_id"] != want {
+				t.Errorf("call %d: expected assignee_id %s, got %v", i, want, body["assignee_id"])
+			}
+		}
+
+		data := result.Response.Data.(map[string]any)
+		added := data["added"].([]any)
+		removed := data["removed"].([]any)
+		if len(added) != 1 || added[0] != "u3" || len(removed) != 1 || removed[0] != "u2" {
+			t.Errorf("unexpected diff: added %v, removed %v", added, removed)
+		}
+	})
+
+	t.Run("empty list unassigns everyone", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards/42", card)
+		mock.PostResponse = &client.APIResponse{StatusCode: 204}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardAssigneesSetCmd.Flags().Set("users", "")
+		defer func() {
+			cardAssigneesSetUsers = nil
+			cardAssigneesSetCmd.Flags().Lookup("users").Changed = false
+		}()
+
+		err := cardAssigneesSetCmd.RunE(cardAssigneesSetCmd, []string{"42"})
+		assertExitCode(t, err, 0)
+		if len(mock.PostCalls) != 2 {
+			t.Errorf("expected 2 toggle calls, got %d", len(mock.PostCalls))
+		}
+	})
+
+	t.Run("requires users flag", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := cardAssigneesSetCmd.RunE(cardAssigneesSetCmd, []string{"42"})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
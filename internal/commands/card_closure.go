@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+)
+
+// closureActivityPageLimit bounds how many activity pages --with-closure-info
+// walks looking for card_closed events before giving up on older closures.
+const closureActivityPageLimit = 10
+
+// enrichCardsWithClosureInfo adds closed_at and closer ({id, name}) to each
+// card. The card payload doesn't carry closure metadata, so it is recovered
+// from the most recent card_closed activity. Open cards, and closed cards whose
+// closure falls outside the scanned window, get null values so every card has
+// the same shape.
+func enrichCardsWithClosureInfo(ctx context.Context, ac *fizzy.AccountClient, items any, boardID string) (any, error) {
+	cards := toMaps(items)
+	if len(cards) == 0 {
+		return items, nil
+	}
+
+	pending := map[string]map[string]any{}
+	for _, card := range cards {
+		closedAt, _ := card["closed_at"].(string)
+		if closedAt == "" {
+			card["closed_at"] = nil
+		}
+		if _, ok := card["closer"].(map[string]any); !ok {
+			card["closer"] = nil
+		}
+		if closed, _ := card["closed"].(bool); closed && (closedAt == "" || card["closer"] == nil) {
+			pending[fmt.Sprintf("%v", card["number"])] = card
+		}
+	}
+
+	path := "/activities.json"
+	if boardID != "" {
+		path += "?board_ids[]=" + boardID
+	}
+
+	for page := 0; page < closureActivityPageLimit && len(pending) > 0 && path != ""; page++ {
+		data, resp, err := ac.Cards().ListActivities(ctx, path)
+		if err != nil {
+			return nil, convertSDKError(err)
+		}
+
+		// Activities arrive newest first, so the first card_closed seen for a
+		// card is its latest closure (a card may be reopened and closed again).
+		for _, activity := range toMaps(normalizeAny(data)) {
+			if activity["action"] != "card_closed" {
+				continue
+			}
+			eventable, _ := activity["eventable"].(map[string]any)
+			number := fmt.Sprintf("%v", eventable["number"])
+			card, ok := pending[number]
+			if !ok {
+				continue
+			}
+			if card["closed_at"] == nil {
+				card["closed_at"] = activity["created_at"]
+			}
+			if card["closer"] == nil {
+				if creator, ok := activity["creator"].(map[string]any); ok {
+					card["closer"] = map[string]any{
+						"id":   creator["id"],
+						"name": creator["name"],
+					}
+				}
+			}
+			delete(pending, number)
+		}
+
+		path = parseSDKLinkNext(resp)
+	}
+
+	return cards, nil
+}
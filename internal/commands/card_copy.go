@@ -0,0 +1,158 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+// Card copy flags
+var cardCopyToBoard string
+var cardCopyIncludeSteps bool
+var cardCopyIncludeComments bool
+
+var cardCopyCmd = &cobra.Command{
+	Use:   "copy CARD_NUMBER",
+	Short: "Copy a card",
+	Long: `Creates a new card from an existing one, on the same board or on --to-board.
+The copy gets the original's title, description, and tags. It lands in the
+original's column, or on another board in the column of the same name when
+there is one (otherwise in Maybe?).
+--include-steps copies the steps with their completion, and --include-comments
+copies the comments.
+
+The copy starts open, whatever the original's state, which makes a card a
+reusable template:
+
+  fizzy card copy 12 --include-steps`,
+	Example: `  fizzy card copy 12
+  fizzy card copy 12 --to-board 03f5v9zjysoy0fqs9yg0ei3hq --include-steps --include-comments`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		cardNumber := strings.TrimPrefix(args[0], "#")
+		c := getClient()
+		resp, err := c.Get("/cards/" + cardNumber + ".json")
+		if err != nil {
+			return err
+		}
+		source, ok := resp.Data.(map[string]any)
+		if !ok {
+			return errors.NewError("Invalid card response")
+		}
+
+		sourceBoardID := ""
+		if board, ok := source["board"].(map[string]any); ok {
+			sourceBoardID = getStringField(board, "id")
+		}
+		boardID := cardCopyToBoard
+		if boardID == "" {
+			boardID = sourceBoardID
+		}
+		if boardID == "" {
+			return errors.NewError("Could not tell which board the card is on; pass --to-board")
+		}
+
+		cardParams := map[string]any{"title": getStringField(source, "title")}
+		if description := getStringField(source, "description_html"); description != "" {
+			cardParams["description"] = description
+		} else if description := getStringField(source, "description"); description != "" {
+			cardParams["description"] = description
+		}
+		newCardNum, err := createCardOnBoard(c, boardID, cardParams)
+		if err != nil {
+			return err
+		}
+		newCardNumStr := strconv.Itoa(newCardNum)
+
+		// The copy exists now, so anything that fails from here on is a
+		// warning rather than an error.
+		tags := 0
+		if sourceTags, ok := source["tags"].([]any); ok {
+			for _, tag := range sourceTags {
+				tagName, ok := tag.(string)
+				if !ok {
+					continue
+				}
+				if err := applyTag(c, newCardNumStr, tagName); err != nil {
+					warnf("Warning: Failed to apply tag '%s': %v\n", tagName, err)
+					continue
+				}
+				tags++
+			}
+		}
+
+		if columnID := copyColumnID(c, source, sourceBoardID, boardID); columnID != "" {
+			if err := moveToColumn(c, newCardNumStr, columnID); err != nil {
+				warnf("Warning: Failed to move the copy to its column: %v\n", err)
+			}
+		}
+
+		steps, comments := 0, 0
+		if cardCopyIncludeSteps {
+			if steps, err = migrateSteps(c, c, source, newCardNumStr); err != nil {
+				warnf("Warning: Failed to copy steps: %v\n", err)
+			}
+		}
+		if cardCopyIncludeComments {
+			if comments, err = migrateComments(c, c, cardNumber, newCardNumStr); err != nil {
+				warnf("Warning: Failed to copy comments: %v\n", err)
+			}
+		}
+
+		result := map[string]any{
+			"number":   newCardNum,
+			"source":   getIntField(source, "number"),
+			"board_id": boardID,
+			"tags":     tags,
+			"steps":    steps,
+			"comments": comments,
+		}
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("show", fmt.Sprintf("fizzy card show %d", newCardNum), "View the copy"),
+			breadcrumb("update", fmt.Sprintf("fizzy card update %d --title \"...\"", newCardNum), "Retitle the copy"),
+		}
+		printMutation(result, fmt.Sprintf("Copied card #%s to #%d", cardNumber, newCardNum), breadcrumbs)
+		return nil
+	},
+}
+
+// copyColumnID returns the column on boardID a copy of source belongs in:
+// the same column on the same board, or the column with the same name on
+// another board. It returns "" when there is none.
+func copyColumnID(c client.API, source map[string]any, sourceBoardID, boardID string) string {
+	columnID := getCardColumnID(source)
+	if columnID == "" || boardID == sourceBoardID {
+		return columnID
+	}
+	column, _ := source["column"].(map[string]any)
+	name := getStringField(column, "name")
+	if name == "" {
+		return ""
+	}
+	columns, err := getColumns(c, boardID)
+	if err != nil {
+		warnf("Warning: Failed to fetch the target board's columns: %v\n", err)
+		return ""
+	}
+	for _, col := range toMaps(columns) {
+		if strings.EqualFold(getStringField(col, "name"), name) {
+			return getStringField(col, "id")
+		}
+	}
+	return ""
+}
+
+func init() {
+	cardCopyCmd.Flags().StringVar(&cardCopyToBoard, "to-board", "", "Board to copy the card to (defaults to the card's board)")
+	cardCopyCmd.Flags().BoolVar(&cardCopyIncludeSteps, "include-steps", false, "Copy the card's steps")
+	cardCopyCmd.Flags().BoolVar(&cardCopyIncludeComments, "include-comments", false, "Copy the card's comments")
+	cardCmd.AddCommand(cardCopyCmd)
+}
@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+)
+
+func TestCardCopy(t *testing.T) {
+	source := map[string]any{
+		"number":           float64(12),
+		"title":            "Release checklist",
+		"description_html": "<p>Ship it</p>",
+		"tags":             []any{"release"},
+		"board":            map[string]any{"id": "board-1"},
+		"column":           map[string]any{"id": "col-1", "name": "Doing"},
+		"steps": []any{
+			map[string]any{"content": "Tag", "completed": true},
+			map[string]any{"content": "Announce", "completed": false},
+		},
+	}
+
+	t.Run("copies to another board's column of the same name", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards/12.json", &client.APIResponse{StatusCode: 200, Data: source})
+		mock.OnGet("/boards/board-2/columns.json", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"id": "col-9", "name": "doing"},
+		}})
+		mock.PostResponse = &client.APIResponse{StatusCode: 201, Data: map[string]any{"number": float64(40)}}
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardCopyToBoard = "board-2"
+		cardCopyIncludeSteps = true
+		err := cardCopyCmd.RunE(cardCopyCmd, []string{"12"})
+		cardCopyToBoard = ""
+		cardCopyIncludeSteps = false
+		assertExitCode(t, err, 0)
+
+		var paths []string
+		for _, call := range mock.PostCalls {
+			paths = append(paths, call.Path)
+		}
+		want := []string{"/cards.json", "/cards/40/taggings.json", "/cards/40/triage.json", "/cards/40/steps.json", "/cards/40/steps.json"}
+		if len(paths) != len(want) {
+			t.Fatalf("expected posts %v, got %v", want, paths)
+		}
+		for i := range want {
+			if paths[i] != want[i] {
+				t.Errorf("expected post %d to %s, got %s", i, want[i], paths[i])
+			}
+		}
+
+		create := mock.PostCalls[0].Body.(map[string]any)
+		card := create["card"].(map[string]any)
+		if create["board_id"] != "board-2" || card["title"] != "Release checklist" || card["description"] != "<p>Ship it</p>" || card["created_at"] != nil {
+			t.Errorf("unexpected create body %v", create)
+		}
+		if body := mock.PostCalls[2].Body.(map[string]any); body["column_id"] != "col-9" {
+			t.Errorf("expected the copy in col-9, got %v", body)
+		}
+
+		data := result.Response.Data.(map[string]any)
+		if data["number"] != float64(40) || data["steps"] != float64(2) || data["tags"] != float64(1) {
+			t.Errorf("unexpected result %v", data)
+		}
+	})
+
+	t.Run("copies into the same column by default", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards/12.json", &client.APIResponse{StatusCode: 200, Data: source})
+		mock.PostResponse = &client.APIResponse{StatusCode: 201, Data: map[string]any{"number": float64(41)}}
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := cardCopyCmd.RunE(cardCopyCmd, []string{"#12"})
+		assertExitCode(t, err, 0)
+
+		if len(mock.PostCalls) != 3 {
+			t.Fatalf("expected create, tag, and move without steps, got %d posts", len(mock.PostCalls))
+		}
+		if body := mock.PostCalls[0].Body.(map[string]any); body["board_id"] != "board-1" {
+			t.Errorf("expected the copy on board-1, got %v", body)
+		}
+		if body := mock.PostCalls[2].Body.(map[string]any); body["column_id"] != "col-1" {
+			t.Errorf("expected the copy in col-1, got %v", body)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// cardFrontMatter is the YAML front matter of a card written in the editor.
+type cardFrontMatter struct {
+	Title string   `yaml:"title"`
+	Board string   `yaml:"board"`
+	Tags  []string `yaml:"tags"`
+}
+
+// editedCard is a card written in the editor, with its body as HTML.
+type editedCard struct {
+	cardFrontMatter
+	Description string
+}
+
+// editCardCreate opens a new card in the user's editor as Markdown with
+// front matter for the title, board, and tags, prefilled from the flags. An
+// empty title aborts, as with an empty commit message. The edited file is
+// returned too, for the caller to keep until the card is created.
+func editCardCreate(title, board, description string) (*editedCard, *editFile, error) {
+	front, err := yaml.Marshal(cardFrontMatter{Title: title, Board: board, Tags: []string{}})
+	if err != nil {
+		return nil, nil, errors.NewError(fmt.Sprintf("Could not prepare the card: %v", err))
+	}
+	initial := "---\n" + string(front) + "---\n\n" + description
+	if description == "" {
+		initial += "<!-- Write the description in Markdown below the front matter. Leave the title empty to cancel. -->\n"
+	}
+
+	var card *editedCard
+	_, file, err := editText(initial, "fizzy-card-*.md", func(text string) error {
+		var err error
+		card, err = parseCardMarkdown(text)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if card.Title == "" {
+		file.Remove()
+		return nil, nil, errors.NewInvalidArgsError("the card has no title; nothing was created")
+	}
+	return card, file, nil
+}
+
+// parseCardMarkdown splits a card written in the editor into its front
+// matter and its body, converting the body to HTML. HTML comments in the
+// body are dropped.
+func parseCardMarkdown(text string) (*editedCard, error) {
+	// Editors on Windows save CRLF line endings.
+	text = strings.ReplaceAll(strings.TrimPrefix(text, "\ufeff"), "\r\n", "\n")
+	card := &editedCard{}
+	body := text
+	if rest, ok := strings.CutPrefix(text, "---\n"); ok {
+		front, after, found := strings.Cut(rest, "\n---")
+		if !found {
+			return nil, errors.NewInvalidArgsError("the card's front matter has no closing ---")
+		}
+		decoder := yaml.NewDecoder(bytes.NewReader([]byte(front)))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&card.cardFrontMatter); err != nil && err != io.EOF {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("invalid front matter: %v", err))
+		}
+		_, body, _ = strings.Cut(after, "\n")
+	}
+
+	card.Title = strings.TrimSpace(card.Title)
+	card.Board = strings.TrimSpace(card.Board)
+	tags := card.Tags[:0]
+	for _, tag := range card.Tags {
+		if tag = strings.TrimSpace(strings.TrimPrefix(tag, "#")); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	card.Tags = tags
+
+	body = stripHTMLComments(body)
+	if strings.TrimSpace(body) != "" {
+		card.Description = markdownToTrixHTML(strings.TrimSpace(body))
+	}
+	return card, nil
+}
+
+// stripHTMLComments removes <!-- ... --> comments, such as editor hints.
+func stripHTMLComments(s string) string {
+	for {
+		start := strings.Index(s, "<!--")
+		if start < 0 {
+			return s
+		}
+		end := strings.Index(s[start:], "-->")
+		if end < 0 {
+			return s[:start]
+		}
+		s = s[:start] + s[start+end+len("-->"):]
+	}
+}
@@ -0,0 +1,208 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+	"github.com/spf13/cobra"
+)
+
+// cardEventsPageLimit bounds how many activity pages 'card events' walks
+// without --all.
+const cardEventsPageLimit = 10
+
+// Card events flags
+var cardEventsAll bool
+
+var cardEventsCmd = &cobra.Command{
+	Use:   "events CARD_NUMBER",
+	Short: "Show a card's history",
+	Long: `Shows a card's timeline, oldest first: when it was published, moved between
+columns, assigned, retitled, closed, reopened, and commented on, and by whom.
+
+There is no per-card history in the API, so the timeline is collected from
+the activity of the card's board. Only the latest 10 pages of board activity
+are scanned unless --all is given. When the scan doesn't reach the card's
+creation, or the card is closed without a closure in the scanned activity,
+those events are filled in from the card itself and marked with source
+"card"; a filled-in closure is dated by the card's last activity.`,
+	Example: `  fizzy card events 42
+  fizzy card events 42 --all --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		cardNumber := strings.TrimPrefix(args[0], "#")
+		ac := getSDK()
+		data, _, err := ac.Cards().Get(cmd.Context(), cardNumber)
+		if err != nil {
+			return convertSDKError(err)
+		}
+		card, _ := normalizeAny(data).(map[string]any)
+
+		cardID := getStringField(card, "id")
+		boardID := ""
+		if board, ok := card["board"].(map[string]any); ok {
+			boardID = getStringField(board, "id")
+		}
+		events, complete, err := cardActivityEvents(cmd.Context(), ac, cardNumber, cardID, boardID, cardEventsAll)
+		if err != nil {
+			return err
+		}
+		events = fillCardEvents(events, card)
+
+		summary := fmt.Sprintf("%d %s for card #%s", len(events), pluralize(len(events), "event", "events"), cardNumber)
+		if !complete {
+			summary += " (older activity not scanned; use --all)"
+		}
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("show", fmt.Sprintf("fizzy card show %s", cardNumber), "View card"),
+			breadcrumb("comments", fmt.Sprintf("fizzy comment list --card %s", cardNumber), "View comments"),
+		}
+		if !complete {
+			breadcrumbs = append(breadcrumbs, breadcrumb("all", fmt.Sprintf("fizzy card events %s --all", cardNumber), "Scan all activity"))
+		}
+
+		printList(events, cardEventColumns, summary, breadcrumbs)
+		return nil
+	},
+}
+
+// cardActivityEvents walks the board's activity, newest first, and returns
+// the events about the card, oldest first. complete is false when pages were
+// left unscanned.
+func cardActivityEvents(ctx context.Context, ac *fizzy.AccountClient, cardNumber, cardID, boardID string, all bool) ([]map[string]any, bool, error) {
+	path := "/activities.json"
+	if boardID != "" {
+		path += "?board_ids[]=" + boardID
+	}
+
+	var events []map[string]any
+	for page := 0; path != ""; page++ {
+		if !all && page == cardEventsPageLimit {
+			return sortCardEvents(events), false, nil
+		}
+		data, resp, err := ac.Cards().ListActivities(ctx, path)
+		if err != nil {
+			return nil, false, convertSDKError(err)
+		}
+		for _, activity := range toMaps(normalizeAny(data)) {
+			if !activityAboutCard(activity, cardNumber, cardID) {
+				continue
+			}
+			events = append(events, cardEvent(activity))
+		}
+		path = parseSDKLinkNext(resp)
+	}
+	return sortCardEvents(events), true, nil
+}
+
+// activityAboutCard reports whether an activity is about the card: the card
+// itself, or a comment on it, whose eventable refers to the card by id.
+func activityAboutCard(activity map[string]any, cardNumber, cardID string) bool {
+	if eventCardNumber(activity) == cardNumber {
+		return true
+	}
+	eventable, _ := activity["eventable"].(map[string]any)
+	parent, _ := eventable["card"].(map[string]any)
+	return cardID != "" && getStringField(parent, "id") == cardID
+}
+
+// cardEvent flattens an activity into a timeline entry.
+func cardEvent(activity map[string]any) map[string]any {
+	creator := ""
+	if user, ok := activity["creator"].(map[string]any); ok {
+		creator = getStringField(user, "name")
+	}
+	return map[string]any{
+		"at":          activity["created_at"],
+		"action":      activity["action"],
+		"by":          creator,
+		"description": activity["description"],
+		"detail":      cardEventDetail(activity),
+		"source":      "activity",
+	}
+}
+
+// cardEventDetail describes what changed, from the activity's particulars.
+func cardEventDetail(activity map[string]any) string {
+	particulars, _ := activity["particulars"].(map[string]any)
+	if particulars == nil {
+		return ""
+	}
+	var parts []string
+	if column := getStringField(particulars, "column"); column != "" {
+		parts = append(parts, "column: "+column)
+	}
+	if title := getStringField(particulars, "new_title"); title != "" {
+		parts = append(parts, fmt.Sprintf("title: %q → %q", getStringField(particulars, "old_title"), title))
+	}
+	if board := getStringField(particulars, "new_board"); board != "" {
+		parts = append(parts, fmt.Sprintf("board: %s → %s", getStringField(particulars, "old_board"), board))
+	}
+	if ids, ok := particulars["assignee_ids"].([]any); ok && len(ids) > 0 {
+		names := make([]string, len(ids))
+		for i, id := range ids {
+			names[i] = fmt.Sprintf("%v", id)
+		}
+		parts = append(parts, "assignees: "+strings.Join(names, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// fillCardEvents adds the creation and closure of the card when the
+// activity didn't include them.
+func fillCardEvents(events []map[string]any, card map[string]any) []map[string]any {
+	seen := map[string]bool{}
+	for _, event := range events {
+		action, _ := event["action"].(string)
+		seen[action] = true
+	}
+
+	creator := ""
+	if user, ok := card["creator"].(map[string]any); ok {
+		creator = getStringField(user, "name")
+	}
+	if createdAt := getStringField(card, "created_at"); createdAt != "" && !seen["card_published"] {
+		events = append(events, map[string]any{
+			"at":          createdAt,
+			"action":      "card_published",
+			"by":          creator,
+			"description": "Created the card",
+			"detail":      "",
+			"source":      "card",
+		})
+	}
+	if closed, _ := card["closed"].(bool); closed && !seen["card_closed"] {
+		events = append(events, map[string]any{
+			"at":          getStringField(card, "last_active_at"),
+			"action":      "card_closed",
+			"by":          "",
+			"description": "Closed the card",
+			"detail":      "time approximate",
+			"source":      "card",
+		})
+	}
+	return sortCardEvents(events)
+}
+
+// sortCardEvents orders events oldest first.
+func sortCardEvents(events []map[string]any) []map[string]any {
+	sort.SliceStable(events, func(i, j int) bool {
+		return getStringField(events[i], "at") < getStringField(events[j], "at")
+	})
+	if events == nil {
+		events = []map[string]any{}
+	}
+	return events
+}
+
+func init() {
+	cardEventsCmd.Flags().BoolVar(&cardEventsAll, "all", false, "Scan all of the board's activity")
+	cardCmd.AddCommand(cardEventsCmd)
+}
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+)
+
+func TestCardEvents(t *testing.T) {
+	t.Run("collects the card's activity oldest first", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards/42", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+			"id":         "card-42",
+			"number":     float64(42),
+			"board":      map[string]any{"id": "board-1"},
+			"created_at": "2025-04-01T09:00:00Z",
+			"closed":     false,
+		}})
+		mock.OnGet("/activities.json", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"action": "card_triaged", "created_at": "2025-04-03T10:00:00Z", "creator": map[string]any{"name": "Bo"}, "eventable": map[string]any{"number": float64(42)}, "particulars": map[string]any{"column": "Doing"}},
+			map[string]any{"action": "comment_created", "created_at": "2025-04-02T10:00:00Z", "eventable": map[string]any{"id": "comment-1", "card": map[string]any{"id": "card-42"}}},
+			map[string]any{"action": "card_published", "created_at": "2025-04-02T09:00:00Z", "eventable": map[string]any{"number": float64(7)}},
+		}})
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := cardEventsCmd.RunE(cardEventsCmd, []string{"42"})
+		assertExitCode(t, err, 0)
+
+		if path := mock.GetWithPaginationCalls[1].Path; path != "/activities.json?board_ids[]=board-1" {
+			t.Errorf("expected board-scoped activities path, got %q", path)
+		}
+		events := toMaps(result.Response.Data)
+		if len(events) != 3 {
+			t.Fatalf("expected 3 events, got %d: %v", len(events), events)
+		}
+		want := []string{"card_published", "comment_created", "card_triaged"}
+		for i, action := range want {
+			if events[i]["action"] != action {
+				t.Errorf("event %d: expected %s, got %v", i, action, events[i]["action"])
+			}
+		}
+		if events[0]["source"] != "card" {
+			t.Errorf("expected creation filled in from the card, got %v", events[0]["source"])
+		}
+		if events[2]["by"] != "Bo" || events[2]["detail"] != "column: Doing" {
+			t.Errorf("expected creator and column detail, got %v", events[2])
+		}
+	})
+
+	t.Run("fills in a closure missing from the activity", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards/42", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+			"number":         float64(42),
+			"board":          map[string]any{"id": "board-1"},
+			"created_at":     "2025-04-01T09:00:00Z",
+			"last_active_at": "2025-04-09T09:00:00Z",
+			"closed":         true,
+		}})
+		mock.OnGet("/activities.json", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"action": "card_published", "created_at": "2025-04-01T09:00:00Z", "eventable": map[string]any{"number": float64(42)}},
+		}})
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := cardEventsCmd.RunE(cardEventsCmd, []string{"42"})
+		assertExitCode(t, err, 0)
+
+		events := toMaps(result.Response.Data)
+		if len(events) != 2 {
+			t.Fatalf("expected 2 events, got %d: %v", len(events), events)
+		}
+		if events[0]["source"] != "activity" {
+			t.Errorf("expected the published activity, got %v", events[0])
+		}
+		if events[1]["action"] != "card_closed" || events[1]["at"] != "2025-04-09T09:00:00Z" || events[1]["source"] != "card" {
+			t.Errorf("expected approximate closure, got %v", events[1])
+		}
+	})
+}
@@ -0,0 +1,165 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+// Card for-change flags
+var cardForChangeTrailers []string
+var cardForChangeRepo string
+
+// cardURLPattern matches the card number in a card URL such as
+// https://app.fizzy.do/123/cards/42.
+var cardURLPattern = regexp.MustCompile(`/cards/(\d+)`)
+
+var cardForChangeCmd = &cobra.Command{
+	Use:   "for-change RANGE",
+	Short: "Summarize the cards referenced by a range of commits",
+	Long: `Collects card references from the trailers of the commits in a git range,
+fetches each card, and prints a change summary: card, title, and where the
+card sits now (its column, or Maybe?, Not Now, or Done).
+
+By default the "Fizzy" and "Card" trailers are read; --trailer replaces them.
+A trailer can hold several references, as numbers, #numbers, or card URLs:
+
+  Fix login redirect
+
+  Fizzy: #42, https://app.fizzy.do/123/cards/57
+
+Cards that can't be fetched are listed with status "not found".`,
+	Example: `  fizzy card for-change v1.2.0..v1.3.0
+  fizzy card for-change main..HEAD --trailer Fixes --trailer Refs`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		log, err := gitCommitMessages(cardForChangeRepo, args[0])
+		if err != nil {
+			return err
+		}
+		refs := cardRefsFromCommits(log, cardForChangeTrailers)
+
+		ac := getSDK()
+		items := make([]any, 0, len(refs))
+		for _, ref := range refs {
+			item := map[string]any{"number": ref.Number, "commits": ref.Commits}
+			data, _, err := ac.Cards().Get(cmd.Context(), strconv.Itoa(ref.Number))
+			if err != nil {
+				err = convertSDKError(err)
+				if output.AsError(err).Code != output.CodeNotFound {
+					return err
+				}
+				item["status"] = "not found"
+				items = append(items, item)
+				continue
+			}
+			card, _ := normalizeAny(data).(map[string]any)
+			item["title"] = card["title"]
+			item["status"] = cardPlacement(card)
+			item["url"] = card["url"]
+			items = append(items, item)
+		}
+
+		summary := fmt.Sprintf("%d cards referenced in %s", len(items), args[0])
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("show", "fizzy card show <number>", "View a card"),
+		}
+		printList(items, cardForChangeColumns, summary, breadcrumbs)
+		return nil
+	},
+}
+
+// cardRef is a card referenced by one or more commits.
+type cardRef struct {
+	Number  int
+	Commits []string
+}
+
+// gitCommitMessages returns the hash and message of each commit in
+// revRange, separated by NUL and record-separator bytes.
+func gitCommitMessages(repo, revRange string) (string, error) {
+	if strings.HasPrefix(revRange, "-") {
+		return "", errors.NewInvalidArgsError(fmt.Sprintf("invalid git range %q", revRange))
+	}
+	gitArgs := []string{"log", "--format=%h%x00%B%x1e", revRange, "--"}
+	if repo != "" {
+		gitArgs = append([]string{"-C", repo}, gitArgs...)
+	}
+	var stderr bytes.Buffer
+	git := exec.Command("git", gitArgs...) //nolint:gosec // G204: fixed git subcommand; the range can't be an option
+	git.Stderr = &stderr
+	out, err := git.Output()
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+		return "", errors.NewInvalidArgsError(fmt.Sprintf("git log %s failed: %s", revRange, message))
+	}
+	return string(out), nil
+}
+
+// cardRefsFromCommits extracts the cards named in the given trailers of each
+// commit, in order of first reference.
+func cardRefsFromCommits(log string, trailers []string) []cardRef {
+	var refs []cardRef
+	index := map[int]int{}
+	for record := range strings.SplitSeq(log, "\x1e") {
+		hash, message, ok := strings.Cut(strings.TrimLeft(record, "\n"), "\x00")
+		if !ok {
+			continue
+		}
+		for line := range strings.Lines(message) {
+			name, value, ok := strings.Cut(line, ":")
+			if !ok || !slices.ContainsFunc(trailers, func(t string) bool { return strings.EqualFold(strings.TrimSpace(name), t) }) {
+				continue
+			}
+			for _, number := range cardRefNumbers(value) {
+				i, seen := index[number]
+				if !seen {
+					i = len(refs)
+					index[number] = i
+					refs = append(refs, cardRef{Number: number})
+				}
+				if !slices.Contains(refs[i].Commits, hash) {
+					refs[i].Commits = append(refs[i].Commits, hash)
+				}
+			}
+		}
+	}
+	return refs
+}
+
+// cardRefNumbers returns the card numbers in a trailer value: numbers,
+// #numbers, and card URLs, separated by commas or spaces.
+func cardRefNumbers(value string) []int {
+	var numbers []int
+	for _, token := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || unicode.IsSpace(r) }) {
+		if match := cardURLPattern.FindStringSubmatch(token); match != nil {
+			token = match[1]
+		}
+		if number, err := strconv.Atoi(strings.TrimPrefix(token, "#")); err == nil && number > 0 {
+			numbers = append(numbers, number)
+		}
+	}
+	return numbers
+}
+
+func init() {
+	cardForChangeCmd.Flags().StringSliceVar(&cardForChangeTrailers, "trailer", []string{"Fizzy", "Card"}, "Commit trailers that reference cards")
+	cardForChangeCmd.Flags().StringVar(&cardForChangeRepo, "repo", "", "Git repository to read (defaults to the current directory)")
+	cardCmd.AddCommand(cardForChangeCmd)
+}
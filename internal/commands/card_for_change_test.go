@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestCardRefsFromCommits(t *testing.T) {
+	log := "abc1234\x00Fix login\n\nFizzy: #42, https://app.fizzy.do/123/cards/57\n\x1e\n" +
+		"def5678\x00Tidy up\n\nSee #9 for context\ncard: 42\nCo-authored-by: someone\n\x1e\n"
+
+	refs := cardRefsFromCommits(log, []string{"Fizzy", "Card"})
+	if len(refs) != 2 {
+		t.Fatalf("expected 2 cards, got %+v", refs)
+	}
+	if refs[0].Number != 42 || len(refs[0].Commits) != 2 || refs[0].Commits[1] != "def5678" {
+		t.Errorf("expected card 42 from both commits, got %+v", refs[0])
+	}
+	if refs[1].Number != 57 || refs[1].Commits[0] != "abc1234" {
+		t.Errorf("expected card 57 from its URL, got %+v", refs[1])
+	}
+}
+
+func TestCardForChange(t *testing.T) {
+	repo := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", repo, "-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	git("init", "-q")
+	git("commit", "-q", "--allow-empty", "-m", "Start")
+	git("tag", "v1")
+	git("commit", "-q", "--allow-empty", "-m", "Fix login\n\nFizzy: 42")
+	git("commit", "-q", "--allow-empty", "-m", "Remove old flag\n\nFizzy: 7")
+
+	mock := NewMockClient()
+	mock.OnGet("/cards/42", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+		"number": 42, "title": "Login broken", "column": map[string]any{"id": "c1", "name": "Review"},
+	}})
+	mock.OnGet("/cards/7", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+		"number": 7, "title": "Old flag", "closed": true,
+	}})
+
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	cardForChangeRepo = repo
+	defer func() { cardForChangeRepo = "" }()
+
+	err := cardForChangeCmd.RunE(cardForChangeCmd, []string{"v1..HEAD"})
+	assertExitCode(t, err, 0)
+
+	items := result.Response.Data.([]any)
+	if len(items) != 2 {
+		t.Fatalf("expected 2 cards, got %v", items)
+	}
+	status := map[float64]any{}
+	for _, item := range items {
+		card := item.(map[string]any)
+		status[card["number"].(float64)] = card["status"]
+	}
+	if status[42] != "Review" || status[7] != "Done" {
+		t.Errorf("unexpected statuses: %v", status)
+	}
+
+	err = cardForChangeCmd.RunE(cardForChangeCmd, []string{"--output=x"})
+	assertExitCode(t, err, errors.ExitInvalidArgs)
+}
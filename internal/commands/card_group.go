@@ -0,0 +1,187 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/render"
+	"github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+)
+
+// cardGroupings are the values --group-by accepts, with the name of the group
+// for cards that have none.
+var cardGroupings = map[string]string{
+	"column":   "",
+	"assignee": "Unassigned",
+	"tag":      "Untagged",
+}
+
+// cardGroup is a named group of cards.
+type cardGroup struct {
+	Name  string
+	Cards []map[string]any
+}
+
+func validateCardGroupBy(by string) error {
+	if _, ok := cardGroupings[by]; by != "" && !ok {
+		return errors.NewInvalidArgsError(fmt.Sprintf("invalid --group-by %q: use column, assignee, or tag", by))
+	}
+	return nil
+}
+
+// groupCards splits cards into groups by column, assignee, or tag. A card
+// with several assignees or tags is in each of their groups. Groups are
+// sorted by name, with the group for cards that have none last; see
+// orderColumnGroups for board order.
+func groupCards(cards []map[string]any, by string) []cardGroup {
+	none := cardGroupings[by]
+	index := map[string]int{}
+	var groups []cardGroup
+	for _, card := range cards {
+		for _, name := range cardGroupNames(card, by, none) {
+			i, ok := index[name]
+			if !ok {
+				i = len(groups)
+				index[name] = i
+				groups = append(groups, cardGroup{Name: name})
+			}
+			groups[i].Cards = append(groups[i].Cards, card)
+		}
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if (groups[i].Name == none) != (groups[j].Name == none) {
+			return groups[j].Name == none
+		}
+		return strings.ToLower(groups[i].Name) < strings.ToLower(groups[j].Name)
+	})
+	return groups
+}
+
+// orderColumnGroups puts column groups in the order of a kanban board:
+// Maybe? first, then the columns in the board's order, then Not Now and
+// Done. Columns missing from columnNames follow the board's, by name.
+func orderColumnGroups(groups []cardGroup, columnNames []string) {
+	rank := map[string]int{pseudoColumnMaybe.Name: 0}
+	for _, name := range columnNames {
+		if _, ok := rank[name]; !ok {
+			rank[name] = len(rank)
+		}
+	}
+	unlisted := len(rank)
+	rank[pseudoColumnNotNow.Name] = unlisted + 1
+	rank[pseudoColumnDone.Name] = unlisted + 2
+	position := func(name string) int {
+		if r, ok := rank[name]; ok {
+			return r
+		}
+		return unlisted
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return position(groups[i].Name) < position(groups[j].Name)
+	})
+}
+
+// boardColumnNames returns the names of the boards' columns in board order,
+// or of every board's when boardIDs is empty. The order is only cosmetic,
+// so a failed lookup returns none rather than failing the listing.
+func boardColumnNames(ctx context.Context, ac *fizzy.AccountClient, boardIDs []string) []string {
+	var names []string
+	if len(boardIDs) == 1 {
+		columns, _, err := fetchBoardColumns(ctx, ac, boardIDs[0], true)
+		if err != nil {
+			return nil
+		}
+		for _, column := range columns {
+			names = append(names, getStringField(column, "name"))
+		}
+		return names
+	}
+	columns, _, err := fetchAccountColumns(ctx, ac, true)
+	if err != nil {
+		return nil
+	}
+	for _, column := range columns {
+		if len(boardIDs) == 0 || slices.Contains(boardIDs, column.BoardID) {
+			names = append(names, column.ColumnName)
+		}
+	}
+	return names
+}
+
+// cardGroupNames returns the groups a card belongs in.
+func cardGroupNames(card map[string]any, by, none string) []string {
+	var names []string
+	switch by {
+	case "column":
+		return []string{cardPlacement(card)}
+	case "assignee":
+		for _, assignee := range toMaps(card["assignees"]) {
+			if name := getStringField(assignee, "name"); name != "" {
+				names = append(names, name)
+			}
+		}
+	case "tag":
+		tags, _ := card["tags"].([]any)
+		for _, tag := range tags {
+			if name, ok := tag.(string); ok && name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return []string{none}
+	}
+	return names
+}
+
+// cardGroupsSummary describes the groups, e.g. "12 cards in 3 groups: Doing 5, Done 4, Maybe? 3".
+func cardGroupsSummary(count int, groups []cardGroup) string {
+	parts := make([]string, len(groups))
+	for i, group := range groups {
+		parts[i] = fmt.Sprintf("%s %d", group.Name, len(group.Cards))
+	}
+	summary := fmt.Sprintf("%d cards in %d %s", count, len(groups), pluralize(len(groups), "group", "groups"))
+	if len(parts) > 0 {
+		summary += ": " + strings.Join(parts, ", ")
+	}
+	return summary
+}
+
+// printGroupedCards renders grouped cards. Machine output is an object of
+// group name to cards; styled and markdown output is a table per group.
+func printGroupedCards(groups []cardGroup, cols render.Columns, summary string, breadcrumbs []Breadcrumb) {
+	data := make(map[string]any, len(groups))
+	for _, group := range groups {
+		data[group.Name] = group.Cards
+	}
+	historySummary = summary
+	hookData = data
+
+	switch out.EffectiveFormat() {
+	case output.FormatStyled, output.FormatMarkdown:
+		markdown := out.EffectiveFormat() == output.FormatMarkdown
+		sections := []string{summary + "\n"}
+		for _, group := range groups {
+			heading := fmt.Sprintf("%s (%d)", group.Name, len(group.Cards))
+			if markdown {
+				sections = append(sections, render.MarkdownList(group.Cards, cols, "### "+heading))
+			} else {
+				sections = append(sections, render.StyledList(group.Cards, cols, heading))
+			}
+		}
+		writeOutputString(appendHumanSections(strings.Join(sections, "\n"), "", "", breadcrumbs, markdown))
+		captureResponse()
+	default:
+		opts := []output.ResponseOption{output.WithBreadcrumbs(breadcrumbs...)}
+		if summary != "" {
+			opts = append(opts, output.WithSummary(summary))
+		}
+		recordOutputError(okEnvelope(data, opts...))
+		captureResponse()
+	}
+}
@@ -0,0 +1,125 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+)
+
+// --with values card show embeds besides reactions.
+const (
+	withComments    = "comments"
+	withSteps       = "steps"
+	withAttachments = "attachments"
+)
+
+// embedCardIncludes fetches what --with/--include asked for and embeds it
+// in card: its comments (with their reactions when reactions were asked for
+// too), its steps, and the attachments of its description and comments.
+func embedCardIncludes(ctx context.Context, ac *fizzy.AccountClient, cardNumber string, card map[string]any, with map[string]bool) error {
+	if with[withReactions] {
+		if err := attachCardReactions(ctx, ac, cardNumber, card); err != nil {
+			return err
+		}
+	}
+
+	var comments []any
+	if with[withComments] || with[withAttachments] {
+		pages, err := ac.GetAll(ctx, "/cards/"+cardNumber+"/comments.json")
+		if err != nil {
+			return convertSDKError(err)
+		}
+		comments = rawPagesToSlice(pages)
+	}
+	if with[withComments] {
+		if with[withReactions] {
+			if err := attachCommentReactions(ctx, ac, cardNumber, comments); err != nil {
+				return err
+			}
+		}
+		card["comments"] = comments
+	}
+
+	if with[withSteps] {
+		if err := attachCardSteps(ctx, ac, cardNumber, card); err != nil {
+			return err
+		}
+	}
+
+	if with[withAttachments] {
+		descriptionHTML, _ := card["description_html"].(string)
+		attachments := []any{}
+		for _, a := range parseAttachments(descriptionHTML) {
+			attachments = append(attachments, a)
+		}
+		for _, ca := range extractCommentAttachments(comments) {
+			ca.Index = len(attachments) + 1
+			attachments = append(attachments, ca)
+		}
+		card["attachments"] = normalizeAny(attachments)
+	}
+	return nil
+}
+
+// attachCardSteps embeds the card's steps unless the card already carries
+// them.
+func attachCardSteps(ctx context.Context, ac *fizzy.AccountClient, cardNumber string, card map[string]any) error {
+	if _, ok := card["steps"]; ok {
+		return nil
+	}
+	data, _, err := ac.Steps().List(ctx, cardNumber)
+	if err != nil {
+		return convertSDKError(err)
+	}
+	steps := normalizeAny(data)
+	if steps == nil {
+		steps = []any{}
+	}
+	card["steps"] = steps
+	return nil
+}
+
+// attachCardsSteps embeds each card's steps, fetching them for several
+// cards at once.
+func attachCardsSteps(ctx context.Context, ac *fizzy.AccountClient, cards []map[string]any) error {
+	errs := make([]error, len(cards))
+	sem := make(chan struct{}, maxParallel(bulkConcurrency))
+	var wg sync.WaitGroup
+	for i, card := range cards {
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			errs[i] = attachCardSteps(ctx, ac, fmt.Sprintf("%v", card["number"]), card)
+		})
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addStepsProgress sets steps_progress, completed over total steps such as
+// "3/7", on each card that carries steps, and reports whether any did.
+func addStepsProgress(cards []map[string]any) bool {
+	added := false
+	for _, card := range cards {
+		steps := toMaps(card["steps"])
+		if len(steps) == 0 {
+			continue
+		}
+		done := 0
+		for _, step := range steps {
+			if completed, _ := step["completed"].(bool); completed {
+				done++
+			}
+		}
+		card["steps_progress"] = fmt.Sprintf("%d/%d", done, len(steps))
+		added = true
+	}
+	return added
+}
@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+// Card reopen-bulk flags
+var cardReopenBulkBoard string
+var cardReopenBulkClosed string
+var cardReopenBulkCloser string
+var cardReopenBulkTag string
+var cardReopenBulkFilter string
+var cardReopenBulkApprove bool
+var cardReopenBulkDryRun bool
+
+var cardReopenBulkCmd = &cobra.Command{
+	Use:   "reopen-bulk",
+	Short: "Reopen closed cards matching filters",
+	Long: `Reopens every closed card that matches the filters, concurrently: for when a
+batch of cards was closed by mistake or a release was rolled back.
+
+--closed picks when the cards were closed (today, yesterday, thisweek,
+lastweek, thismonth, lastmonth), --closer who closed them, and --tag and
+--board narrow them further. --filter applies a client-side expression, as
+with 'card list --filter'. At least one of --closed, --closer, --tag, or
+--filter is required, so a typo can't reopen every card ever closed.
+
+Reopening is a bulk change: in a terminal the plan is shown for confirmation,
+and elsewhere it needs --approve (use --dry-run to review the plan first). The
+result lists the cards that reopened under succeeded and the ones that didn't
+under failed, and the command exits with code 9 when any failed.`,
+	Example: `  fizzy card reopen-bulk --closed lastweek --board BOARD_ID --dry-run
+  fizzy card reopen-bulk --closed today --closer USER_ID --filter 'tags contains "release"' --approve`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+		if cardReopenBulkClosed == "" && cardReopenBulkCloser == "" && cardReopenBulkTag == "" && cardReopenBulkFilter == "" {
+			return errors.NewInvalidArgsError("pass --closed, --closer, --tag, or --filter to choose the cards to reopen")
+		}
+		if cardReopenBulkClosed != "" && !slices.Contains(timeWindows, cardReopenBulkClosed) {
+			return errors.NewInvalidArgsError(fmt.Sprintf("invalid --closed %q: use %s", cardReopenBulkClosed, strings.Join(timeWindows, ", ")))
+		}
+		filter, err := parseFilter(cardReopenBulkFilter)
+		if err != nil {
+			return err
+		}
+
+		params := []string{"indexed_by=closed"}
+		boardID := defaultBoard(cardReopenBulkBoard)
+		if boardID != "" {
+			params = append(params, "board_ids[]="+boardID)
+		}
+		if cardReopenBulkClosed != "" {
+			params = append(params, "closure="+cardReopenBulkClosed)
+		}
+		if cardReopenBulkCloser != "" {
+			params = append(params, "closer_ids[]="+cardReopenBulkCloser)
+		}
+		if cardReopenBulkTag != "" {
+			params = append(params, "tag_ids[]="+cardReopenBulkTag)
+		}
+
+		ac := getSDK()
+		cards, err := fetchAllCards(cmd.Context(), ac, "/cards.json?"+strings.Join(params, "&"))
+		if err != nil {
+			return err
+		}
+		cards = toMaps(applyFilter(cards, filter))
+
+		numbers := make([]any, 0, len(cards))
+		changes := make([]planChange, 0, len(cards))
+		for _, card := range cards {
+			number := getIntField(card, "number")
+			numbers = append(numbers, number)
+			changes = append(changes, planChange{Card: number, Title: getStringField(card, "title"), Change: "reopen"})
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("closed", "fizzy card list --indexed-by closed", "List closed cards"),
+		}
+		data := map[string]any{}
+		if len(numbers) == 0 {
+			data["succeeded"] = []any{}
+			data["failed"] = []any{}
+			printMutation(data, "No closed cards match; nothing to reopen", breadcrumbs)
+			return nil
+		}
+
+		action := fmt.Sprintf("reopen %d %s", len(numbers), pluralize(len(numbers), "card", "cards"))
+		if cardReopenBulkDryRun {
+			progressf("%s", renderPlan(action, changes))
+			data["dry_run"] = true
+			data["plan"] = changes
+			printMutation(data, "Would "+action, breadcrumbs)
+			return nil
+		}
+
+		approved, err := approvePlan(action, changes, cardReopenBulkApprove)
+		if err != nil {
+			return err
+		}
+		if !approved {
+			data["cancelled"] = true
+			printMutation(data, "Reopen cancelled; no cards changed", breadcrumbs)
+			return nil
+		}
+
+		result := runBulk(numbers, maxParallel(bulkConcurrency), func(number any) error {
+			_, err := ac.Cards().Reopen(cmd.Context(), fmt.Sprint(number))
+			return err
+		})
+
+		summary := fmt.Sprintf("Reopened %d of %d %s", len(result.succeeded), len(numbers), pluralize(len(numbers), "card", "cards"))
+		return printBulkResult(data, result, "cards", summary, breadcrumbs)
+	},
+}
+
+func init() {
+	cardReopenBulkCmd.Flags().StringVar(&cardReopenBulkBoard, "board", "", "Only reopen cards on this board")
+	cardReopenBulkCmd.Flags().StringVar(&cardReopenBulkClosed, "closed", "", "Closed when: today, yesterday, thisweek, lastweek, thismonth, lastmonth")
+	cardReopenBulkCmd.Flags().StringVar(&cardReopenBulkCloser, "closer", "", "Closed by this user ID")
+	cardReopenBulkCmd.Flags().StringVar(&cardReopenBulkTag, "tag", "", "Only reopen cards with this tag ID")
+	cardReopenBulkCmd.Flags().StringVar(&cardReopenBulkFilter, "filter", "", "Only reopen cards matching an expression (client-side, as in card list)")
+	cardReopenBulkCmd.Flags().BoolVar(&cardReopenBulkApprove, "approve", false, "Reopen without the confirmation prompt")
+	cardReopenBulkCmd.Flags().BoolVar(&cardReopenBulkDryRun, "dry-run", false, "Print the plan without reopening anything")
+	cardCmd.AddCommand(cardReopenBulkCmd)
+}
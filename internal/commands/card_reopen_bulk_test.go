@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestCardReopenBulk(t *testing.T) {
+	closed := &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"number": float64(12), "title": "Ship it", "tags": []any{"release"}},
+		map[string]any{"number": float64(14), "title": "Docs", "tags": []any{}},
+		map[string]any{"number": float64(19), "title": "Announce", "tags": []any{"release"}},
+	}}
+
+	t.Run("reopens the filtered closed cards", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards.json", closed)
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardReopenBulkBoard, cardReopenBulkClosed = "123", "lastweek"
+		cardReopenBulkFilter = `tags contains "release"`
+		cardReopenBulkApprove = true
+		err := cardReopenBulkCmd.RunE(cardReopenBulkCmd, []string{})
+		cardReopenBulkBoard, cardReopenBulkClosed, cardReopenBulkFilter = "", "", ""
+		cardReopenBulkApprove = false
+		assertExitCode(t, err, 0)
+
+		if path := mock.GetWithPaginationCalls[0].Path; path != "/cards.json?indexed_by=closed&board_ids[]=123&closure=lastweek" {
+			t.Errorf("unexpected listing path %q", path)
+		}
+		paths := []string{}
+		for _, call := range mock.DeleteCalls {
+			paths = append(paths, call.Path)
+		}
+		sort.Strings(paths)
+		if strings.Join(paths, " ") != "/cards/12/closure.json /cards/19/closure.json" {
+			t.Errorf("unexpected reopens: %v", paths)
+		}
+		if result.Response.Summary != "Reopened 2 of 2 cards" {
+			t.Errorf("unexpected summary %q", result.Response.Summary)
+		}
+	})
+
+	t.Run("dry run lists the plan without reopening", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards.json", closed)
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardReopenBulkClosed, cardReopenBulkDryRun = "today", true
+		err := cardReopenBulkCmd.RunE(cardReopenBulkCmd, []string{})
+		cardReopenBulkClosed, cardReopenBulkDryRun = "", false
+		assertExitCode(t, err, 0)
+
+		if len(mock.DeleteCalls) != 0 {
+			t.Errorf("expected no reopens, got %v", mock.DeleteCalls)
+		}
+		if plan := result.Response.Data.(map[string]any)["plan"].([]any); len(plan) != 3 {
+			t.Errorf("expected 3 planned reopens, got %v", plan)
+		}
+	})
+
+	t.Run("requires a filter and approval", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards.json", closed)
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := cardReopenBulkCmd.RunE(cardReopenBulkCmd, []string{})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+
+		cardReopenBulkClosed = "today"
+		err = cardReopenBulkCmd.RunE(cardReopenBulkCmd, []string{})
+		cardReopenBulkClosed = ""
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+		if len(mock.DeleteCalls) != 0 {
+			t.Errorf("expected no reopens without approval, got %v", mock.DeleteCalls)
+		}
+	})
+
+	t.Run("rejects an unknown --closed preset before fetching", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardReopenBulkClosed = "last-week"
+		err := cardReopenBulkCmd.RunE(cardReopenBulkCmd, []string{})
+		cardReopenBulkClosed = ""
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+		if len(mock.GetCalls) != 0 {
+			t.Errorf("expected no requests, got %v", mock.GetCalls)
+		}
+	})
+}
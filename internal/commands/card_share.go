@@ -0,0 +1,447 @@
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/fsutil"
+	"github.com/charmbracelet/huh"
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+)
+
+// shareBundleVersion is the version of the share bundle format, recorded in
+// every bundle so 'card share open' can refuse ones it doesn't understand.
+const shareBundleVersion = 1
+
+// shareBundleIterations is the PBKDF2-SHA256 work factor for deriving the
+// bundle key from the password.
+const shareBundleIterations = 600_000
+
+// Bundles are untrusted input, so their work factor is bounded: too few
+// iterations would make a weak bundle, and too many would hang 'card share
+// open' deriving the key.
+const (
+	shareBundleMinIterations = 100_000
+	shareBundleMaxIterations = 10_000_000
+)
+
+// cardShareHTML is the page an HTML bundle is rendered into. It decrypts the
+// bundle in the browser with WebCrypto, so it works offline and without a
+// Fizzy account.
+//
+//go:embed card_share.html
+var cardShareHTML string
+
+var cardShareTemplate = template.Must(template.New("card_share").Parse(cardShareHTML))
+
+// shareBundleScript finds the bundle embedded in an HTML share page.
+var shareBundleScript = regexp.MustCompile(`(?s)<script type="application/json" id="fizzy-share">(.*?)</script>`)
+
+// shareBundle is an encrypted card, as written to a .json bundle or embedded
+// in an .html one. Byte fields are base64 encoded.
+type shareBundle struct {
+	Version    int    `json:"fizzy_share"`
+	Cipher     string `json:"cipher"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// sharePayload is what a bundle decrypts to.
+type sharePayload struct {
+	Card        map[string]any    `json:"card"`
+	Comments    []any             `json:"comments"`
+	Attachments []shareAttachment `json:"attachments"`
+	SharedAt    string            `json:"shared_at"`
+}
+
+type shareAttachment struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	Data        []byte `json:"data"`
+}
+
+// Card share flags
+var cardShareEncrypt bool
+var cardSharePassword string
+var cardShareFormat string
+var cardShareFile string
+
+var cardShareCmd = &cobra.Command{
+	Use:   "share CARD_NUMBER",
+	Short: "Export a card as an encrypted bundle",
+	Long: `Exports a card with its description, comments, and attachments as a
+password-protected bundle that can be sent to people without a Fizzy account.
+
+The default HTML bundle is a single page that asks for the password and
+decrypts the card in the browser, offline. A JSON bundle (--format json) is
+for 'fizzy card share open'. Both are encrypted with AES-256-GCM using a key
+derived from the password (PBKDF2-SHA256); send the password separately.
+
+The password comes from --password (- reads it from stdin), the
+FIZZY_SHARE_PASSWORD environment variable, or a prompt.`,
+	Example: `  fizzy card share 42 --encrypt
+  fizzy card share 42 --encrypt --password - --file /tmp/card-42.html < pass.txt
+  fizzy card share 42 --encrypt --format json --file card-42.json
+  fizzy card share open card-42.html --dir ./card-42`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+		if !cardShareEncrypt {
+			return errors.NewInvalidArgsError("share bundles are always encrypted; pass --encrypt")
+		}
+		if cardShareFormat != "html" && cardShareFormat != "json" {
+			return errors.NewInvalidArgsError(fmt.Sprintf("unsupported --format %q (use html or json)", cardShareFormat))
+		}
+		password, err := sharePassword(cmd, cardSharePassword)
+		if err != nil {
+			return err
+		}
+
+		cardNumber := args[0]
+		payload, err := fetchSharePayload(cmd.Context(), cardNumber)
+		if err != nil {
+			return err
+		}
+		bundle, err := sealShareBundle(payload, password)
+		if err != nil {
+			return err
+		}
+
+		var content []byte
+		if cardShareFormat == "json" {
+			content, err = json.MarshalIndent(bundle, "", "  ")
+			content = append(content, '\n')
+		} else {
+			content, err = renderShareHTML(bundle)
+		}
+		if err != nil {
+			return errors.NewError(fmt.Sprintf("Could not render share bundle: %v", err))
+		}
+
+		path := cardShareFile
+		if path == "" {
+			path = fmt.Sprintf("card-%s.%s", fsutil.SafeFilename(cardNumber), cardShareFormat)
+		}
+		if err := writeShareFile(path, content); err != nil {
+			return err
+		}
+
+		result := map[string]any{
+			"card_number": cardNumber,
+			"file":        path,
+			"format":      cardShareFormat,
+			"comments":    len(payload.Comments),
+			"attachments": len(payload.Attachments),
+		}
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("open", fmt.Sprintf("fizzy card share open %s", path), "Decrypt the bundle"),
+		}
+		printMutation(result, fmt.Sprintf("Card #%s shared to %s", cardNumber, path), breadcrumbs)
+		return nil
+	},
+}
+
+// Card share open flags
+var cardShareOpenPassword string
+var cardShareOpenDir string
+
+var cardShareOpenCmd = &cobra.Command{
+	Use:   "open BUNDLE",
+	Short: "Decrypt a card share bundle",
+	Long: `Decrypts a bundle made by 'fizzy card share' (HTML or JSON) and shows the
+card with its comments. With --dir, the attachments are saved there too.
+
+Opening a bundle doesn't need a Fizzy account or network access.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		raw, err := os.ReadFile(args[0])
+		if err != nil {
+			return errors.NewInvalidArgsError(fmt.Sprintf("cannot read bundle: %v", err))
+		}
+		bundle, err := parseShareBundle(raw)
+		if err != nil {
+			return err
+		}
+		password, err := sharePassword(cmd, cardShareOpenPassword)
+		if err != nil {
+			return err
+		}
+		payload, err := openShareBundle(bundle, password)
+		if err != nil {
+			return err
+		}
+
+		files := make([]any, 0, len(payload.Attachments))
+		saved := map[string]bool{}
+		for i, attachment := range payload.Attachments {
+			file := map[string]any{
+				"filename":     attachment.Filename,
+				"content_type": attachment.ContentType,
+				"filesize":     len(attachment.Data),
+			}
+			if cardShareOpenDir != "" {
+				name := fsutil.SafeFilename(attachment.Filename)
+				if saved[name] {
+					name = fmt.Sprintf("%d_%s", i+1, name)
+				}
+				saved[name] = true
+				path := filepath.Join(cardShareOpenDir, name)
+				if err := os.MkdirAll(cardShareOpenDir, 0o755); err != nil {
+					return errors.NewError(fmt.Sprintf("Could not save attachment: %v", err))
+				}
+				if err := os.WriteFile(path, attachment.Data, 0o644); err != nil {
+					return errors.NewError(fmt.Sprintf("Could not save attachment: %v", err))
+				}
+				file["saved_to"] = path
+			}
+			files = append(files, file)
+		}
+
+		card := payload.Card
+		card["comments"] = payload.Comments
+		card["attachments"] = files
+		card["shared_at"] = payload.SharedAt
+
+		summary := "Shared card"
+		if number := card["number"]; number != nil {
+			summary = fmt.Sprintf("Shared card #%v", number)
+		}
+		if title, _ := card["title"].(string); title != "" {
+			summary += ": " + title
+		}
+		printDetail(card, summary, nil)
+		return nil
+	},
+}
+
+// sharePassword returns the bundle password from the flag ("-" reads a line
+// from stdin), FIZZY_SHARE_PASSWORD, or a masked prompt on a terminal.
+func sharePassword(cmd *cobra.Command, flag string) (string, error) {
+	password := flag
+	if password == "-" {
+		scanner := bufio.NewScanner(cmd.InOrStdin())
+		password = ""
+		if scanner.Scan() {
+			password = strings.TrimRight(scanner.Text(), "\r")
+		}
+	} else if password == "" {
+		password = os.Getenv("FIZZY_SHARE_PASSWORD")
+	}
+	if password == "" && (isatty.IsTerminal(os.Stdin.Fd()) || isatty.IsCygwinTerminal(os.Stdin.Fd())) {
+		err := huh.NewInput().
+			Title("Bundle password").
+			EchoMode(huh.EchoModePassword).
+			Value(&password).
+			Run()
+		if err != nil {
+			return "", err
+		}
+	}
+	if password == "" {
+		return "", newRequiredFlagError("password")
+	}
+	return password, nil
+}
+
+// fetchSharePayload collects the card, its comments, and the attachments in
+// its description and comments.
+func fetchSharePayload(ctx context.Context, cardNumber string) (*sharePayload, error) {
+	ac := getSDK()
+	cardData, _, err := ac.Cards().Get(ctx, cardNumber)
+	if err != nil {
+		return nil, convertSDKError(err)
+	}
+	card := toMap(normalizeAny(cardData))
+	if card == nil {
+		return nil, errors.NewError("Invalid card response")
+	}
+	pages, err := ac.GetAll(ctx, "/cards/"+cardNumber+"/comments.json")
+	if err != nil {
+		return nil, convertSDKError(err)
+	}
+	comments := rawPagesToSlice(pages)
+
+	descriptionHTML, _ := card["description_html"].(string)
+	attachments := parseAttachments(descriptionHTML)
+	for _, ca := range extractCommentAttachments(comments) {
+		attachments = append(attachments, ca.Attachment)
+	}
+
+	payload := &sharePayload{
+		Card:        card,
+		Comments:    comments,
+		Attachments: []shareAttachment{},
+		SharedAt:    time.Now().UTC().Format(time.RFC3339),
+	}
+	if len(attachments) == 0 {
+		return payload, nil
+	}
+
+	dir, err := os.MkdirTemp("", "fizzy-share-")
+	if err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not download attachments: %v", err))
+	}
+	defer os.RemoveAll(dir)
+	client := getClient()
+	for i, attachment := range attachments {
+		path := filepath.Join(dir, fmt.Sprintf("%d", i))
+		if err := client.DownloadFile(attachment.DownloadURL, path); err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.NewError(fmt.Sprintf("Could not download %s: %v", attachment.Filename, err))
+		}
+		payload.Attachments = append(payload.Attachments, shareAttachment{
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+			Data:        data,
+		})
+	}
+	return payload, nil
+}
+
+// shareKey derives the AES-256 key for a bundle from its password and salt.
+func shareKey(password string, salt []byte, iterations int) ([]byte, error) {
+	return pbkdf2.Key(sha256.New, password, salt, iterations, 32)
+}
+
+// sealShareBundle encrypts payload with a key derived from password.
+func sealShareBundle(payload *sharePayload, password string) (*shareBundle, error) {
+	plaintext, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not encode card: %v", err))
+	}
+	bundle := &shareBundle{
+		Version:    shareBundleVersion,
+		Cipher:     "AES-256-GCM",
+		KDF:        "PBKDF2-SHA256",
+		Iterations: shareBundleIterations,
+		Salt:       make([]byte, 16),
+		Nonce:      make([]byte, 12),
+	}
+	_, _ = rand.Read(bundle.Salt)
+	_, _ = rand.Read(bundle.Nonce)
+
+	gcm, err := shareCipher(password, bundle)
+	if err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not encrypt card: %v", err))
+	}
+	bundle.Ciphertext = gcm.Seal(nil, bundle.Nonce, plaintext, nil)
+	return bundle, nil
+}
+
+// openShareBundle decrypts bundle with password.
+func openShareBundle(bundle *shareBundle, password string) (*sharePayload, error) {
+	if bundle.Version != shareBundleVersion || bundle.Cipher != "AES-256-GCM" || bundle.KDF != "PBKDF2-SHA256" {
+		return nil, errors.NewInvalidArgsError(fmt.Sprintf("unsupported share bundle (version %d, %s, %s)", bundle.Version, bundle.Cipher, bundle.KDF))
+	}
+	if bundle.Iterations < shareBundleMinIterations || bundle.Iterations > shareBundleMaxIterations {
+		return nil, errors.NewInvalidArgsError(fmt.Sprintf("invalid share bundle: %d key derivation iterations is outside %d to %d", bundle.Iterations, shareBundleMinIterations, shareBundleMaxIterations))
+	}
+	gcm, err := shareCipher(password, bundle)
+	if err != nil {
+		return nil, errors.NewInvalidArgsError(fmt.Sprintf("invalid share bundle: %v", err))
+	}
+	if len(bundle.Nonce) != gcm.NonceSize() {
+		return nil, errors.NewInvalidArgsError("invalid share bundle: bad nonce")
+	}
+	plaintext, err := gcm.Open(nil, bundle.Nonce, bundle.Ciphertext, nil)
+	if err != nil {
+		return nil, errors.NewInvalidArgsError("wrong password, or the bundle is corrupted")
+	}
+	var payload sharePayload
+	if err := json.Unmarshal(plaintext, &payload); err != nil || payload.Card == nil {
+		return nil, errors.NewInvalidArgsError("invalid share bundle: no card inside")
+	}
+	return &payload, nil
+}
+
+func shareCipher(password string, bundle *shareBundle) (cipher.AEAD, error) {
+	key, err := shareKey(password, bundle.Salt, bundle.Iterations)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// parseShareBundle reads a JSON bundle, or the bundle embedded in an HTML
+// share page.
+func parseShareBundle(raw []byte) (*shareBundle, error) {
+	if match := shareBundleScript.FindSubmatch(raw); match != nil {
+		raw = match[1]
+	}
+	var bundle shareBundle
+	if err := json.Unmarshal(bytes.TrimSpace(raw), &bundle); err != nil || bundle.Version == 0 {
+		return nil, errors.NewInvalidArgsError("not a fizzy share bundle")
+	}
+	return &bundle, nil
+}
+
+// renderShareHTML embeds bundle in the self-decrypting share page.
+func renderShareHTML(bundle *shareBundle) ([]byte, error) {
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	// The JSON is base64 and fixed keys only, so it can't close the script tag.
+	err = cardShareTemplate.Execute(&buf, map[string]any{"Bundle": template.JS(data)})
+	return buf.Bytes(), err
+}
+
+// writeShareFile writes a bundle readable only by the current user; it is
+// encrypted, but there is no reason to widen access.
+func writeShareFile(path string, content []byte) error {
+	f, err := fsutil.CreateAtomic(path, 0o600)
+	if err != nil {
+		return errors.NewError(fmt.Sprintf("Could not write share bundle: %v", err))
+	}
+	if _, err := f.Write(content); err != nil {
+		f.Discard()
+		return errors.NewError(fmt.Sprintf("Could not write share bundle: %v", err))
+	}
+	if err := f.Commit(); err != nil {
+		return errors.NewError(fmt.Sprintf("Could not write share bundle: %v", err))
+	}
+	return nil
+}
+
+func init() {
+	cardShareCmd.Flags().BoolVar(&cardShareEncrypt, "encrypt", false, "Encrypt the bundle with a password (required)")
+	cardShareCmd.Flags().StringVar(&cardSharePassword, "password", "", "Bundle password, or - to read it from stdin (default: FIZZY_SHARE_PASSWORD or a prompt)")
+	cardShareCmd.Flags().StringVar(&cardShareFormat, "format", "html", "Bundle format: html (opens in a browser) or json")
+	cardShareCmd.Flags().StringVar(&cardShareFile, "file", "", "Bundle path (default: card-NUMBER.html or .json)")
+
+	cardShareOpenCmd.Flags().StringVar(&cardShareOpenPassword, "password", "", "Bundle password, or - to read it from stdin (default: FIZZY_SHARE_PASSWORD or a prompt)")
+	cardShareOpenCmd.Flags().StringVar(&cardShareOpenDir, "dir", "", "Save the attachments to this directory")
+
+	cardShareCmd.AddCommand(cardShareOpenCmd)
+	cardCmd.AddCommand(cardShareCmd)
+}
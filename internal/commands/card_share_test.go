@@ -0,0 +1,137 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestCardShare(t *testing.T) {
+	t.Run("writes an HTML bundle that card share open decrypts", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards/42", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+			"number": float64(42), "title": "Fix login", "description_html": "<p>Steps</p>",
+		}})
+		mock.OnGet("/cards/42/comments.json", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"id": "c1", "body": map[string]any{"html": "<p>On it</p>"}},
+		}})
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		path := filepath.Join(t.TempDir(), "card.html")
+		cardShareEncrypt = true
+		cardShareFormat = "html"
+		cardSharePassword = "correct horse"
+		cardShareFile = path
+		err := cardShareCmd.RunE(cardShareCmd, []string{"42"})
+		cardShareEncrypt = false
+		cardSharePassword = ""
+		cardShareFile = ""
+
+		assertExitCode(t, err, 0)
+		if data := result.Response.Data.(map[string]any); data["comments"] != float64(1) {
+			t.Errorf("expected 1 comment shared, got %v", data)
+		}
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(raw), "Fix login") || strings.Contains(string(raw), "On it") {
+			t.Error("expected the card encrypted, found it in plain text")
+		}
+		if info, _ := os.Stat(path); info.Mode().Perm() != 0o600 {
+			t.Errorf("expected a private bundle, got %v", info.Mode().Perm())
+		}
+
+		cardShareOpenCmd.SetIn(strings.NewReader("correct horse\n"))
+		defer cardShareOpenCmd.SetIn(nil)
+		cardShareOpenPassword = "-"
+		err = cardShareOpenCmd.RunE(cardShareOpenCmd, []string{path})
+		cardShareOpenPassword = ""
+
+		assertExitCode(t, err, 0)
+		card := result.Response.Data.(map[string]any)
+		if card["title"] != "Fix login" || len(card["comments"].([]any)) != 1 {
+			t.Errorf("expected the card and its comment back, got %v", card)
+		}
+	})
+
+	t.Run("rejects the wrong password", func(t *testing.T) {
+		bundle, err := sealShareBundle(&sharePayload{Card: map[string]any{"title": "Secret"}}, "right")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := openShareBundle(bundle, "wrong"); err == nil {
+			t.Fatal("expected the wrong password to fail")
+		} else {
+			assertExitCode(t, err, errors.ExitInvalidArgs)
+		}
+	})
+
+	t.Run("rejects bundles with an out-of-range work factor", func(t *testing.T) {
+		bundle, err := sealShareBundle(&sharePayload{Card: map[string]any{"title": "Secret"}}, "right")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, iterations := range []int{1_000, 1_000_000_000} {
+			bundle.Iterations = iterations
+			_, err := openShareBundle(bundle, "right")
+			assertExitCode(t, err, errors.ExitInvalidArgs)
+		}
+	})
+
+	t.Run("saves attachments with --dir", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		bundle, err := sealShareBundle(&sharePayload{
+			Card: map[string]any{"title": "With files"},
+			Attachments: []shareAttachment{
+				{Filename: "notes.txt", ContentType: "text/plain", Data: []byte("first")},
+				{Filename: "notes.txt", ContentType: "text/plain", Data: []byte("second")},
+			},
+		}, "pw")
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := renderShareHTML(bundle)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dir := t.TempDir()
+		path := filepath.Join(dir, "card.html")
+		if err := os.WriteFile(path, content, 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		cardShareOpenPassword = "pw"
+		cardShareOpenDir = filepath.Join(dir, "files")
+		err = cardShareOpenCmd.RunE(cardShareOpenCmd, []string{path})
+		cardShareOpenPassword = ""
+		cardShareOpenDir = ""
+
+		assertExitCode(t, err, 0)
+		for name, want := range map[string]string{"notes.txt": "first", "2_notes.txt": "second"} {
+			got, err := os.ReadFile(filepath.Join(dir, "files", name))
+			if err != nil || string(got) != want {
+				t.Errorf("expected %s to contain %q, got %q (%v)", name, want, got, err)
+			}
+		}
+	})
+
+	t.Run("requires --encrypt", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := cardShareCmd.RunE(cardShareCmd, []string{"42"})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
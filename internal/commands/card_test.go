@@ -1,6 +1,9 @@
 package commands
 
 import (
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
 
@@ -51,10 +54,10 @@ func TestCardList(t *testing.T) {
 		SetTestConfig("token", "account", "https://api.example.com")
 		defer resetTest()
 
-		cardListBoard = "123"
+		cardListBoard = []string{"123"}
 		cardListIndexedBy = "closed"
 		err := cardListCmd.RunE(cardListCmd, []string{})
-		cardListBoard = ""
+		cardListBoard = nil
 		cardListIndexedBy = ""
 
 		assertExitCode(t, err, 0)
@@ -126,6 +129,37 @@ func TestCardList(t *testing.T) {
 		})
 	})
 
+	t.Run("injects resolved_column for pseudo-column listings", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetWithPaginationResponse = &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"number": float64(1), "title": "Done card", "closed": true},
+			},
+		}
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardListColumn = "done"
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		cardListColumn = ""
+
+		assertExitCode(t, err, 0)
+		items := toMaps(result.Response.Data)
+		if len(items) != 1 {
+			t.Fatalf("expected 1 card, got %d", len(items))
+		}
+		resolved, ok := items[0]["resolved_column"].(map[string]any)
+		if !ok {
+			t.Fatalf("expected resolved_column object, got %v", items[0]["resolved_column"])
+		}
+		if resolved["id"] != "done" || resolved["name"] != "Done" || resolved["kind"] != "closed" {
+			t.Errorf("unexpected resolved_column: %v", resolved)
+		}
+	})
+
 	t.Run("filters by real column server-side without client-side filtering", func(t *testing.T) {
 		mock := NewMockClient()
 		mock.GetWithPaginationResponse = &client.APIResponse{
@@ -140,13 +174,17 @@ func TestCardList(t *testing.T) {
 		SetTestConfig("token", "account", "https://api.example.com")
 		defer resetTest()
 
+		mock.OnGet("/boards.json", &client.APIResponse{StatusCode: 200, Data: []any{}})
+		mock.OnGet("/cards.json", mock.GetWithPaginationResponse)
+
 		cardListColumn = "col-1"
 		err := cardListCmd.RunE(cardListCmd, []string{})
 		cardListColumn = ""
 
 		assertExitCode(t, err, 0)
-		if mock.GetWithPaginationCalls[0].Path != "/cards.json?column_ids[]=col-1" {
-			t.Errorf("expected server-side column_ids filter, got '%s'", mock.GetWithPaginationCalls[0].Path)
+		last := mock.GetWithPaginationCalls[len(mock.GetWithPaginationCalls)-1]
+		if last.Path != "/cards.json?column_ids[]=col-1" {
+			t.Errorf("expected server-side column_ids filter, got '%s'", last.Path)
 		}
 
 		arr, ok := result.Response.Data.([]any)
@@ -275,9 +313,9 @@ func TestCardList(t *testing.T) {
 		SetTestConfig("token", "account", "https://api.example.com")
 		defer resetTest()
 
-		cardListCreator = "user-123"
+		cardListCreator = []string{"user-123"}
 		err := cardListCmd.RunE(cardListCmd, []string{})
-		cardListCreator = ""
+		cardListCreator = nil
 
 		assertExitCode(t, err, 0)
 		path := mock.GetWithPaginationCalls[0].Path
@@ -363,12 +401,12 @@ func TestCardList(t *testing.T) {
 		SetTestConfig("token", "account", "https://api.example.com")
 		defer resetTest()
 
-		cardListBoard = "123"
+		cardListBoard = []string{"123"}
 		cardListSearch = "bug"
 		cardListSort = "newest"
 		cardListUnassigned = true
 		err := cardListCmd.RunE(cardListCmd, []string{})
-		cardListBoard = ""
+		cardListBoard = nil
 		cardListSearch = ""
 		cardListSort = ""
 		cardListUnassigned = false
@@ -383,43 +421,69 @@ func TestCardList(t *testing.T) {
 
 	t.Run("combines column with other filters without changing command shape", func(t *testing.T) {
 		mock := NewMockClient()
-		mock.GetWithPaginationResponse = &client.APIResponse{
+		mock.OnGet("/boards/123/columns.json", &client.APIResponse{
+			StatusCode: 200,
+			Data:       []any{map[string]any{"id": "col-1", "name": "Doing"}},
+		})
+		mock.OnGet("/cards.json", &client.APIResponse{
 			StatusCode: 200,
 			Data:       []any{},
-		}
+		})
 
 		SetTestModeWithSDK(mock)
 		SetTestConfig("token", "account", "https://api.example.com")
 		defer resetTest()
 
-		cardListBoard = "123"
+		cardListBoard = []string{"123"}
 		cardListColumn = "col-1"
-		cardListTag = "tag-1"
-		cardListAssignee = "user-1"
+		cardListTag = []string{"tag-1"}
+		cardListAssignee = []string{"user-1"}
 		err := cardListCmd.RunE(cardListCmd, []string{})
-		cardListBoard = ""
+		cardListBoard = nil
 		cardListColumn = ""
-		cardListTag = ""
-		cardListAssignee = ""
+		cardListTag = nil
+		cardListAssignee = nil
 
 		assertExitCode(t, err, 0)
-		path := mock.GetWithPaginationCalls[0].Path
+		path := mock.GetWithPaginationCalls[len(mock.GetWithPaginationCalls)-1].Path
 		expected := "/cards.json?board_ids[]=123&column_ids[]=col-1&tag_ids[]=tag-1&assignee_ids[]=user-1"
 		if path != expected {
 			t.Errorf("expected path '%s', got '%s'", expected, path)
 		}
 	})
-}
+	t.Run("sends every value of multi-value filters", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards.json", &client.APIResponse{StatusCode: 200, Data: []any{}})
 
-func TestCardShow(t *testing.T) {
-	t.Run("shows card by number", func(t *testing.T) {
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardListBoard = []string{"b1", "b2"}
+		cardListTag = []string{"t1", "t2"}
+		cardListAssignee = []string{"u1", "u2"}
+		cardListCreator = []string{"u3"}
+		defer func() {
+			cardListBoard, cardListTag, cardListAssignee, cardListCreator = nil, nil, nil, nil
+		}()
+
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		assertExitCode(t, err, 0)
+
+		path := mock.GetWithPaginationCalls[0].Path
+		expected := "/cards.json?board_ids[]=b1&board_ids[]=b2&tag_ids[]=t1&tag_ids[]=t2&assignee_ids[]=u1&assignee_ids[]=u2&creator_ids[]=u3"
+		if path != expected {
+			t.Errorf("expected path '%s', got '%s'", expected, path)
+		}
+	})
+	t.Run("applies --filter client-side", func(t *testing.T) {
 		mock := NewMockClient()
-		mock.GetResponse = &client.APIResponse{
+		mock.GetWithPaginationResponse = &client.APIResponse{
 			StatusCode: 200,
-			Data: map[string]any{
-				"id":     "123",
-				"number": 42,
-				"title":  "Test Card",
+			Data: []any{
+				map[string]any{"number": float64(1), "title": "Crash", "tags": []any{"bug"}, "assignees": []any{}},
+				map[string]any{"number": float64(2), "title": "Assigned crash", "tags": []any{"bug"}, "assignees": []any{map[string]any{"id": "u1"}}},
+				map[string]any{"number": float64(3), "title": "Feature", "tags": []any{"feature"}, "assignees": []any{}},
 			},
 		}
 
@@ -427,216 +491,820 @@ func TestCardShow(t *testing.T) {
 		SetTestConfig("token", "account", "https://api.example.com")
 		defer resetTest()
 
-		err := cardShowCmd.RunE(cardShowCmd, []string{"42"})
-		assertExitCode(t, err, 0)
+		cardListFilter = `tags contains "bug" and assignee == null`
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		cardListFilter = ""
 
-		if err != nil {
-			t.Fatalf("unexpected error: %v", err)
-		}
-		if !result.Response.OK {
-			t.Error("expected success response")
+		assertExitCode(t, err, 0)
+		if path := mock.GetWithPaginationCalls[0].Path; path != "/cards.json" {
+			t.Errorf("expected filter not to change request path, got '%s'", path)
 		}
-		if mock.GetCalls[0].Path != "/cards/42" {
-			t.Errorf("expected path '/cards/42', got '%s'", mock.GetCalls[0].Path)
+		items := toMaps(result.Response.Data)
+		if len(items) != 1 || items[0]["number"] != float64(1) {
+			t.Errorf("expected only card #1 to match, got %v", items)
 		}
 	})
 
-	t.Run("handles not found", func(t *testing.T) {
+	t.Run("adds steps progress with --with steps", func(t *testing.T) {
 		mock := NewMockClient()
-		mock.GetError = errors.NewNotFoundError("Card not found")
+		mock.OnGet("/cards.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"number": float64(7), "title": "Checklist"},
+				map[string]any{"number": float64(8), "title": "Carries steps", "steps": []any{
+					map[string]any{"content": "a", "completed": true},
+				}},
+			},
+		})
+		mock.OnGet("/cards/7/steps.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"content": "one", "completed": true},
+				map[string]any{"content": "two", "completed": false},
+				map[string]any{"content": "three", "completed": true},
+			},
+		})
 
-		SetTestModeWithSDK(mock)
+		result := SetTestModeWithSDK(mock)
 		SetTestConfig("token", "account", "https://api.example.com")
 		defer resetTest()
 
-		err := cardShowCmd.RunE(cardShowCmd, []string{"999"})
-		assertExitCode(t, err, errors.ExitNotFound)
+		cardListBoard = []string{"123"}
+		cardListWith = []string{"steps"}
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		cardListBoard = nil
+		cardListWith = nil
+		assertExitCode(t, err, 0)
+
+		items := toMaps(result.Response.Data)
+		if items[0]["steps_progress"] != "2/3" || items[1]["steps_progress"] != "1/1" {
+			t.Errorf("expected 2/3 and 1/1, got %v and %v", items[0]["steps_progress"], items[1]["steps_progress"])
+		}
+		for _, call := range mock.GetWithPaginationCalls {
+			if call.Path == "/cards/8/steps.json" {
+				t.Errorf("expected no steps request for a card that carries them")
+			}
+		}
 	})
-}
 
-func TestCardCreate(t *testing.T) {
-	t.Run("creates card with required fields", func(t *testing.T) {
+	t.Run("rejects unknown --with values", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardListWith = []string{"comments"}
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		cardListWith = nil
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+
+	t.Run("filters by closure date range", func(t *testing.T) {
 		mock := NewMockClient()
-		mock.PostResponse = &client.APIResponse{
-			StatusCode: 201,
-			Location:   "/cards/42",
-			Data: map[string]any{
-				"id":     "abc",
-				"number": 42,
-				"title":  "New Card",
+		mock.OnGet("/cards.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"number": float64(7), "title": "Recent", "closed": true},
+				map[string]any{"number": float64(8), "title": "Older", "closed": true},
 			},
-		}
+		})
+		mock.OnGet("/activities.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"action": "card_closed", "created_at": "2025-05-02T10:00:00Z", "eventable": map[string]any{"number": float64(7)}},
+				map[string]any{"action": "card_closed", "created_at": "2025-03-01T10:00:00Z", "eventable": map[string]any{"number": float64(8)}},
+			},
+		})
 
-		SetTestModeWithSDK(mock)
+		result := SetTestModeWithSDK(mock)
 		SetTestConfig("token", "account", "https://api.example.com")
 		defer resetTest()
 
-		cardCreateBoard = "123"
-		cardCreateTitle = "New Card"
-		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
-		cardCreateBoard = ""
-		cardCreateTitle = ""
-
+		cardListBoard = []string{"123"}
+		cardListClosedAfter = "2025-05-01"
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		cardListBoard = nil
+		cardListClosedAfter = ""
 		assertExitCode(t, err, 0)
-		if mock.PostCalls[0].Path != "/cards.json" {
-			t.Errorf("expected path '/cards.json', got '%s'", mock.PostCalls[0].Path)
-		}
 
-		body := mock.PostCalls[0].Body.(map[string]any)
-		if body["board_id"] != "123" {
-			t.Errorf("expected board_id '123', got '%v'", body["board_id"])
+		if path := mock.GetWithPaginationCalls[0].Path; !strings.Contains(path, "indexed_by=closed") {
+			t.Errorf("expected closed cards to be listed, got %q", path)
 		}
-		if body["title"] != "New Card" {
-			t.Errorf("expected title 'New Card', got '%v'", body["title"])
+		items := toMaps(result.Response.Data)
+		if len(items) != 1 || items[0]["title"] != "Recent" {
+			t.Errorf("expected only the card closed after May 1, got %v", items)
 		}
 	})
 
-	t.Run("requires board flag", func(t *testing.T) {
-		mock := NewMockClient()
-		SetTestModeWithSDK(mock)
+	t.Run("rejects a closure range with another index", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
 		SetTestConfig("token", "account", "https://api.example.com")
 		defer resetTest()
 
-		cardCreateBoard = ""
-		cardCreateTitle = "Test"
-		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
-		cardCreateTitle = ""
-
+		cardListClosedBefore, cardListIndexedBy = "2025-05-01", "not_now"
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		cardListClosedBefore, cardListIndexedBy = "", ""
 		assertExitCode(t, err, errors.ExitInvalidArgs)
 	})
 
-	t.Run("uses configured board when flag omitted", func(t *testing.T) {
+	t.Run("sorts by a field with --sort-by", func(t *testing.T) {
 		mock := NewMockClient()
-		mock.PostResponse = &client.APIResponse{
-			StatusCode: 201,
-			Data:       map[string]any{"id": "abc", "number": 42},
-		}
+		mock.OnGet("/cards.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"number": float64(7), "title": "Banana"},
+				map[string]any{"number": float64(8), "title": "apple"},
+				map[string]any{"number": float64(9), "title": "Cherry"},
+			},
+		})
 
-		SetTestModeWithSDK(mock)
+		result := SetTestModeWithSDK(mock)
 		SetTestConfig("token", "account", "https://api.example.com")
-		cfg.Board = "555"
 		defer resetTest()
 
-		cardCreateBoard = ""
-		cardCreateTitle = "New Card"
-		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
-		cardCreateTitle = ""
-
+		cardListBoard = []string{"123"}
+		cardListSortBy, cardListDesc = "title", true
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		cardListBoard = nil
+		cardListSortBy, cardListDesc = "", false
 		assertExitCode(t, err, 0)
-		body := mock.PostCalls[0].Body.(map[string]any)
-		if body["board_id"] != "555" {
-			t.Errorf("expected board_id '555', got '%v'", body["board_id"])
+
+		items := toMaps(result.Response.Data)
+		if len(items) != 3 || items[0]["title"] != "Cherry" || items[2]["title"] != "apple" {
+			t.Errorf("expected titles in descending order, got %v", items)
 		}
 	})
 
-	t.Run("requires title flag", func(t *testing.T) {
-		mock := NewMockClient()
-		SetTestModeWithSDK(mock)
+	t.Run("rejects --desc without --sort-by", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
 		SetTestConfig("token", "account", "https://api.example.com")
 		defer resetTest()
 
-		cardCreateBoard = "123"
-		cardCreateTitle = ""
-		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
-		cardCreateBoard = ""
-
+		cardListDesc = true
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		cardListDesc = false
 		assertExitCode(t, err, errors.ExitInvalidArgs)
 	})
 
-	t.Run("includes optional fields", func(t *testing.T) {
+	t.Run("enriches closed cards with closure info from activity", func(t *testing.T) {
 		mock := NewMockClient()
-		mock.PostResponse = &client.APIResponse{
-			StatusCode: 201,
-			Data:       map[string]any{"id": "abc", "number": 42},
-		}
+		mock.OnGet("/cards.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"number": float64(7), "title": "Shipped", "closed": true},
+				map[string]any{"number": float64(8), "title": "Old", "closed": true},
+			},
+		})
+		mock.OnGet("/activities.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"action": "card_closed", "created_at": "2025-05-02T10:00:00Z", "creator": map[string]any{"id": "u2", "name": "Bo", "email_address": "bo@example.com"}, "eventable": map[string]any{"number": float64(7)}},
+				map[string]any{"action": "card_closed", "created_at": "2025-05-01T10:00:00Z", "creator": map[string]any{"id": "u1", "name": "Al"}, "eventable": map[string]any{"number": float64(7)}},
+				map[string]any{"action": "card_published", "created_at": "2025-04-01T10:00:00Z", "eventable": map[string]any{"number": float64(8)}},
+			},
+		})
 
-		SetTestModeWithSDK(mock)
+		result := SetTestModeWithSDK(mock)
 		SetTestConfig("token", "account", "https://api.example.com")
 		defer resetTest()
 
-		cardCreateBoard = "123"
-		cardCreateTitle = "Test"
-		cardCreateDescription = "<p>Description</p>"
-		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
-		cardCreateBoard = ""
-		cardCreateTitle = ""
-		cardCreateDescription = ""
+		cardListBoard = []string{"123"}
+		cardListIndexedBy = "closed"
+		cardListWithClosureInfo = true
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		cardListBoard = nil
+		cardListIndexedBy = ""
+		cardListWithClosureInfo = false
 
 		assertExitCode(t, err, 0)
+		if len(mock.GetWithPaginationCalls) != 2 {
+			t.Fatalf("expected 2 GET calls, got %d", len(mock.GetWithPaginationCalls))
+		}
+		if path := mock.GetWithPaginationCalls[1].Path; path != "/activities.json?board_ids[]=123" {
+			t.Errorf("expected board-scoped activities path, got '%s'", path)
+		}
 
-		body := mock.PostCalls[0].Body.(map[string]any)
-		if body["description"] != "<p>Description</p>" {
-			t.Errorf("expected description '<p>Description</p>', got '%v'", body["description"])
+		items := toMaps(result.Response.Data)
+		if len(items) != 2 {
+			t.Fatalf("expected 2 cards, got %d", len(items))
+		}
+		if items[0]["closed_at"] != "2025-05-02T10:00:00Z" {
+			t.Errorf("expected latest closure time, got %v", items[0]["closed_at"])
+		}
+		closer, _ := items[0]["closer"].(map[string]any)
+		if closer["id"] != "u2" || closer["name"] != "Bo" || len(closer) != 2 {
+			t.Errorf("expected normalized closer {id, name}, got %v", items[0]["closer"])
+		}
+		if items[1]["closed_at"] != nil || items[1]["closer"] != nil {
+			t.Errorf("expected null closure info when no activity found, got %v / %v", items[1]["closed_at"], items[1]["closer"])
 		}
 	})
 
-	t.Run("uploads and appends single inline attachment", func(t *testing.T) {
-		tempDir := t.TempDir()
-		attachPath := writeTestAttachmentFile(t, tempDir, "single.txt", "single")
-
+	t.Run("rejects invalid --filter before fetching", func(t *testing.T) {
 		mock := NewMockClient()
-		mock.PostResponse = &client.APIResponse{
-			StatusCode: 201,
-			Data:       map[string]any{"id": "abc", "number": 42},
-		}
-		mock.UploadFileResponse = &client.APIResponse{
-			StatusCode: 200,
-			Data:       map[string]any{"attachable_sgid": "sgid-single"},
-		}
 
 		SetTestModeWithSDK(mock)
 		SetTestConfig("token", "account", "https://api.example.com")
 		defer resetTest()
 
-		cardCreateBoard = "123"
-		cardCreateTitle = "Test"
-		cardCreateDescription = "See attached"
-		cardCreateAttach = []string{attachPath}
-		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
-		cardCreateBoard = ""
-		cardCreateTitle = ""
-		cardCreateDescription = ""
-		cardCreateAttach = nil
-
-		assertExitCode(t, err, 0)
-
-		if len(mock.UploadFileCalls) != 1 || mock.UploadFileCalls[0] != attachPath {
-			t.Fatalf("unexpected upload calls: %#v", mock.UploadFileCalls)
-		}
+		cardListFilter = "tags ~ bug"
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		cardListFilter = ""
 
-		body := mock.PostCalls[0].Body.(map[string]any)
-		expected := strings.Join([]string{
-			"See attached",
-			`<action-text-attachment sgid="sgid-single"></action-text-attachment>`,
-		}, "\n")
-		if body["description"] != expected {
-			t.Errorf("expected description %q, got %v", expected, body["description"])
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+		if len(mock.GetWithPaginationCalls) != 0 {
+			t.Errorf("expected no API calls, got %d", len(mock.GetWithPaginationCalls))
 		}
 	})
 
-	t.Run("uploads and appends multiple inline attachments in order", func(t *testing.T) {
-		tempDir := t.TempDir()
-		attachPath1 := writeTestAttachmentFile(t, tempDir, "first.txt", "first")
-		attachPath2 := writeTestAttachmentFile(t, tempDir, "second.txt", "second")
-
+	t.Run("groups cards by column, assignee, and tag", func(t *testing.T) {
 		mock := NewMockClient()
-		mock.PostResponse = &client.APIResponse{
-			StatusCode: 201,
-			Data:       map[string]any{"id": "abc", "number": 42},
-		}
-		mock.UploadFileResponses = []*client.APIResponse{
-			{StatusCode: 200, Data: map[string]any{"attachable_sgid": "sgid-1"}},
-			{StatusCode: 200, Data: map[string]any{"attachable_sgid": "sgid-2"}},
+		mock.GetWithPaginationResponse = &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"number": 1, "title": "A", "column": map[string]any{"name": "Doing"}, "tags": []any{"bug"},
+					"assignees": []any{map[string]any{"name": "Ann"}, map[string]any{"name": "Bo"}}},
+				map[string]any{"number": 2, "title": "B", "column": map[string]any{"name": "Doing"}, "tags": []any{"bug", "ui"}},
+				map[string]any{"number": 3, "title": "C", "closed": true},
+			},
 		}
 
-		SetTestModeWithSDK(mock)
+		result := SetTestModeWithSDK(mock)
 		SetTestConfig("token", "account", "https://api.example.com")
 		defer resetTest()
 
-		cardCreateBoard = "123"
-		cardCreateTitle = "Test"
-		cardCreateAttach = []string{attachPath1, attachPath2}
-		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		tests := []struct {
+			by      string
+			counts  map[string]int
+			summary string
+		}{
+			{"column", map[string]int{"Doing": 2, "Done": 1}, "3 cards in 2 groups: Doing 2, Done 1"},
+			{"assignee", map[string]int{"Ann": 1, "Bo": 1, "Unassigned": 2}, "3 cards in 3 groups: Ann 1, Bo 1, Unassigned 2"},
+			{"tag", map[string]int{"bug": 2, "ui": 1, "Untagged": 1}, "3 cards in 3 groups: bug 2, ui 1, Untagged 1"},
+		}
+		for _, tt := range tests {
+			cardListGroupBy = tt.by
+			err := cardListCmd.RunE(cardListCmd, []string{})
+			cardListGroupBy = ""
+			assertExitCode(t, err, 0)
+
+			groups, ok := result.Response.Data.(map[string]any)
+			if !ok {
+				t.Fatalf("%s: expected an object of groups, got %T", tt.by, result.Response.Data)
+			}
+			if len(groups) != len(tt.counts) {
+				t.Errorf("%s: expected %d groups, got %v", tt.by, len(tt.counts), groups)
+			}
+			for name, want := range tt.counts {
+				cards, _ := groups[name].([]any)
+				if len(cards) != want {
+					t.Errorf("%s: expected %d cards in %q, got %d", tt.by, want, name, len(cards))
+				}
+			}
+			if result.Response.Summary != tt.summary {
+				t.Errorf("%s: expected summary %q, got %q", tt.by, tt.summary, result.Response.Summary)
+			}
+		}
+	})
+
+	t.Run("orders column groups like the board", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"number": 1, "title": "A", "closed": true},
+				map[string]any{"number": 2, "title": "B", "column": map[string]any{"name": "Review"}},
+				map[string]any{"number": 3, "title": "C", "column": map[string]any{"name": "Doing"}},
+				map[string]any{"number": 4, "title": "D"},
+				map[string]any{"number": 5, "title": "E", "postponed": true},
+			},
+		})
+		mock.OnGet("/boards/123/columns.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"id": "c1", "name": "Doing"},
+				map[string]any{"id": "c2", "name": "Review"},
+			},
+		})
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardListBoard, cardListGroupBy = []string{"123"}, "column"
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		cardListBoard, cardListGroupBy = nil, ""
+		assertExitCode(t, err, 0)
+
+		want := "5 cards in 5 groups: Maybe? 1, Doing 1, Review 1, Not Now 1, Done 1"
+		if result.Response.Summary != want {
+			t.Errorf("expected summary %q, got %q", want, result.Response.Summary)
+		}
+	})
+
+	t.Run("rejects an unknown grouping", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardListGroupBy = "board"
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		cardListGroupBy = ""
+
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+
+	t.Run("rejects --fields and --ids-only", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardListGroupBy, cfgFields = "column", "number,title"
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+
+		cfgFields, cfgIDsOnly = "", true
+		err = cardListCmd.RunE(cardListCmd, []string{})
+		cardListGroupBy, cfgIDsOnly = "", false
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+
+		if len(mock.GetCalls)+len(mock.GetWithPaginationCalls) != 0 {
+			t.Errorf("expected no requests, got %v", mock.GetCalls)
+		}
+	})
+}
+
+func TestCardShow(t *testing.T) {
+	t.Run("shows card by number", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetResponse = &client.APIResponse{
+			StatusCode: 200,
+			Data: map[string]any{
+				"id":     "123",
+				"number": 42,
+				"title":  "Test Card",
+			},
+		}
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := cardShowCmd.RunE(cardShowCmd, []string{"42"})
+		assertExitCode(t, err, 0)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !result.Response.OK {
+			t.Error("expected success response")
+		}
+		if mock.GetCalls[0].Path != "/cards/42" {
+			t.Errorf("expected path '/cards/42', got '%s'", mock.GetCalls[0].Path)
+		}
+	})
+
+	t.Run("handles not found", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetError = errors.NewNotFoundError("Card not found")
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := cardShowCmd.RunE(cardShowCmd, []string{"999"})
+		assertExitCode(t, err, errors.ExitNotFound)
+	})
+
+	t.Run("with reactions adds reaction summary", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards/42", &client.APIResponse{
+			StatusCode: 200,
+			Data:       map[string]any{"id": "123", "number": 42, "title": "Test Card"},
+		})
+		mock.OnGet("/cards/42/reactions.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"id": "r1", "content": "👍"},
+				map[string]any{"id": "r2", "content": "🎉"},
+				map[string]any{"id": "r3", "content": "👍"},
+			},
+		})
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardShowWith = []string{"reactions"}
+		err := cardShowCmd.RunE(cardShowCmd, []string{"42"})
+		cardShowWith = nil
+		assertExitCode(t, err, 0)
+
+		card := result.Response.Data.(map[string]any)
+		summary, ok := card["reaction_summary"].(map[string]any)
+		if !ok {
+			t.Fatalf("expected reaction_summary, got %#v", card["reaction_summary"])
+		}
+		if summary["👍"] != float64(2) || summary["🎉"] != float64(1) || len(summary) != 2 {
+			t.Errorf("unexpected reaction_summary: %v", summary)
+		}
+		if reactions, _ := card["reactions"].([]any); len(reactions) != 3 {
+			t.Errorf("expected 3 reactions, got %v", card["reactions"])
+		}
+	})
+
+	t.Run("rejects unknown with value", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardShowWith = []string{"watchers"}
+		err := cardShowCmd.RunE(cardShowCmd, []string{"42"})
+		cardShowWith = nil
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+		if len(mock.GetCalls) != 0 {
+			t.Errorf("expected no API calls, got %d", len(mock.GetCalls))
+		}
+	})
+
+	t.Run("include embeds comments, steps, and attachments", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards/42", &client.APIResponse{
+			StatusCode: 200,
+			Data: map[string]any{"id": "123", "number": 42, "title": "Test Card",
+				"description_html": `<action-text-attachment sgid="s1" filename="spec.pdf" content-type="application/pdf" filesize="10" url="https://example.com/spec.pdf"></action-text-attachment>`},
+		})
+		mock.OnGet("/cards/42/comments.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{map[string]any{"id": "c1", "body": map[string]any{
+				"html": `<action-text-attachment sgid="s2" filename="log.txt" content-type="text/plain" filesize="5" url="https://example.com/log.txt"></action-text-attachment>`}}},
+		})
+		mock.OnGet("/cards/42/steps.json", &client.APIResponse{
+			StatusCode: 200,
+			Data:       []any{map[string]any{"id": "s1", "content": "Write tests"}},
+		})
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardShowInclude = []string{"comments", "steps", "attachments"}
+		err := cardShowCmd.RunE(cardShowCmd, []string{"42"})
+		cardShowInclude = nil
+		assertExitCode(t, err, 0)
+
+		card := result.Response.Data.(map[string]any)
+		if comments, _ := card["comments"].([]any); len(comments) != 1 {
+			t.Errorf("expected 1 comment, got %v", card["comments"])
+		}
+		if steps, _ := card["steps"].([]any); len(steps) != 1 {
+			t.Errorf("expected 1 step, got %v", card["steps"])
+		}
+		attachments, _ := card["attachments"].([]any)
+		if len(attachments) != 2 {
+			t.Fatalf("expected 2 attachments, got %v", card["attachments"])
+		}
+		if second := attachments[1].(map[string]any); second["comment_id"] != "c1" || second["index"] != float64(2) {
+			t.Errorf("expected the comment attachment second, got %v", second)
+		}
+		if _, ok := card["reactions"]; ok {
+			t.Error("expected no reactions unless included")
+		}
+	})
+}
+
+func TestCardCreate(t *testing.T) {
+	t.Run("creates card with required fields", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{
+			StatusCode: 201,
+			Location:   "/cards/42",
+			Data: map[string]any{
+				"id":     "abc",
+				"number": 42,
+				"title":  "New Card",
+			},
+		}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardCreateBoard = "123"
+		cardCreateTitle = "New Card"
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateBoard = ""
+		cardCreateTitle = ""
+
+		assertExitCode(t, err, 0)
+		if mock.PostCalls[0].Path != "/cards.json" {
+			t.Errorf("expected path '/cards.json', got '%s'", mock.PostCalls[0].Path)
+		}
+
+		body := mock.PostCalls[0].Body.(map[string]any)
+		if body["board_id"] != "123" {
+			t.Errorf("expected board_id '123', got '%v'", body["board_id"])
+		}
+		if body["title"] != "New Card" {
+			t.Errorf("expected title 'New Card', got '%v'", body["title"])
+		}
+	})
+
+	t.Run("watches, self-assigns, and gilds the new card", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{
+			StatusCode: 201,
+			Data:       map[string]any{"id": "abc", "number": 42, "title": "New Card"},
+		}
+		mock.OnGet("/cards/42", &client.APIResponse{
+			StatusCode: 200,
+			Data:       map[string]any{"id": "abc", "number": 42, "title": "New Card", "golden": true},
+		})
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardCreateBoard = "123"
+		cardCreateTitle = "New Card"
+		cardCreateWatch, cardCreateAssignMe, cardCreateGolden = true, true, true
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateBoard = ""
+		cardCreateTitle = ""
+		cardCreateWatch, cardCreateAssignMe, cardCreateGolden = false, false, false
+
+		assertExitCode(t, err, 0)
+		want := []string{"/cards.json", "/cards/42/watch.json", "/cards/42/self_assignment.json", "/cards/42/goldness.json"}
+		if len(mock.PostCalls) != len(want) {
+			t.Fatalf("expected %d POST calls, got %d", len(want), len(mock.PostCalls))
+		}
+		for i, path := range want {
+			if mock.PostCalls[i].Path != path {
+				t.Errorf("call %d: expected %s, got %s", i, path, mock.PostCalls[i].Path)
+			}
+		}
+		if data := result.Response.Data.(map[string]any); data["golden"] != true {
+			t.Errorf("expected refreshed card in output, got %v", data)
+		}
+	})
+
+	t.Run("places the new card in a column by name", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{
+			StatusCode: 201,
+			Data:       map[string]any{"id": "abc", "number": 42},
+		}
+		mock.OnGet("/boards/123/columns.json", &client.APIResponse{
+			StatusCode: 200,
+			Data:       []any{map[string]any{"id": "col-1", "name": "Doing"}},
+		})
+		mock.OnGet("/cards/42", &client.APIResponse{
+			StatusCode: 200,
+			Data:       map[string]any{"id": "abc", "number": 42},
+		})
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardCreateBoard = "123"
+		cardCreateTitle = "New Card"
+		cardCreateColumn = "doing"
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateBoard = ""
+		cardCreateTitle = ""
+		cardCreateColumn = ""
+
+		assertExitCode(t, err, 0)
+		if len(mock.PostCalls) != 2 || mock.PostCalls[1].Path != "/cards/42/triage.json" {
+			t.Fatalf("expected triage call after create, got %v", mock.PostCalls)
+		}
+		if body := mock.PostCalls[1].Body.(map[string]any); body["column_id"] != "col-1" {
+			t.Errorf("expected column_id 'col-1', got %v", body["column_id"])
+		}
+	})
+
+	t.Run("adds steps from flags and a file", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{
+			StatusCode: 201,
+			Data:       map[string]any{"id": "abc", "number": 42},
+		}
+		mock.OnGet("/cards/42", &client.APIResponse{
+			StatusCode: 200,
+			Data:       map[string]any{"id": "abc", "number": 42},
+		})
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		stepsFile := filepath.Join(t.TempDir(), "checklist.md")
+		if err := os.WriteFile(stepsFile, []byte("- [ ] Tag\n- [x] Changelog\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		cardCreateBoard = "123"
+		cardCreateTitle = "Release"
+		cardCreateSteps = []string{"Plan"}
+		cardCreateStepsFile = stepsFile
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateBoard = ""
+		cardCreateTitle = ""
+		cardCreateSteps = nil
+		cardCreateStepsFile = ""
+
+		assertExitCode(t, err, 0)
+		if len(mock.PostCalls) != 4 {
+			t.Fatalf("expected card and 3 step calls, got %d", len(mock.PostCalls))
+		}
+		for i, want := range []string{"Plan", "Tag", "Changelog"} {
+			call := mock.PostCalls[i+1]
+			if call.Path != "/cards/42/steps.json" {
+				t.Errorf("expected steps path, got %s", call.Path)
+			}
+			if body := call.Body.(map[string]any); body["content"] != want {
+				t.Errorf("step %d: expected %q, got %v", i, want, body["content"])
+			}
+		}
+		if body := mock.PostCalls[3].Body.(map[string]any); body["completed"] != true {
+			t.Errorf("expected checked step to be completed, got %v", body)
+		}
+	})
+
+	t.Run("rejects an unknown column before creating the card", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/boards/123/columns.json", &client.APIResponse{
+			StatusCode: 200,
+			Data:       []any{map[string]any{"id": "col-1", "name": "Doing"}},
+		})
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardCreateBoard = "123"
+		cardCreateTitle = "New Card"
+		cardCreateColumn = "Nope"
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateBoard = ""
+		cardCreateTitle = ""
+		cardCreateColumn = ""
+
+		assertExitCode(t, err, errors.ExitNotFound)
+		if len(mock.PostCalls) != 0 {
+			t.Errorf("expected no card to be created, got %v", mock.PostCalls)
+		}
+	})
+
+	t.Run("requires board flag", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardCreateBoard = ""
+		cardCreateTitle = "Test"
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateTitle = ""
+
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+
+	t.Run("uses configured board when flag omitted", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{
+			StatusCode: 201,
+			Data:       map[string]any{"id": "abc", "number": 42},
+		}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		cfg.Board = "555"
+		defer resetTest()
+
+		cardCreateBoard = ""
+		cardCreateTitle = "New Card"
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateTitle = ""
+
+		assertExitCode(t, err, 0)
+		body := mock.PostCalls[0].Body.(map[string]any)
+		if body["board_id"] != "555" {
+			t.Errorf("expected board_id '555', got '%v'", body["board_id"])
+		}
+	})
+
+	t.Run("requires title flag", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardCreateBoard = "123"
+		cardCreateTitle = ""
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateBoard = ""
+
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+
+	t.Run("includes optional fields", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{
+			StatusCode: 201,
+			Data:       map[string]any{"id": "abc", "number": 42},
+		}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardCreateBoard = "123"
+		cardCreateTitle = "Test"
+		cardCreateDescription = "<p>Description</p>"
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateBoard = ""
+		cardCreateTitle = ""
+		cardCreateDescription = ""
+
+		assertExitCode(t, err, 0)
+
+		body := mock.PostCalls[0].Body.(map[string]any)
+		if body["description"] != "<p>Description</p>" {
+			t.Errorf("expected description '<p>Description</p>', got '%v'", body["description"])
+		}
+	})
+
+	t.Run("uploads and appends single inline attachment", func(t *testing.T) {
+		tempDir := t.TempDir()
+		attachPath := writeTestAttachmentFile(t, tempDir, "single.txt", "single")
+
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{
+			StatusCode: 201,
+			Data:       map[string]any{"id": "abc", "number": 42},
+		}
+		mock.UploadFileResponse = &client.APIResponse{
+			StatusCode: 200,
+			Data:       map[string]any{"attachable_sgid": "sgid-single"},
+		}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardCreateBoard = "123"
+		cardCreateTitle = "Test"
+		cardCreateDescription = "See attached"
+		cardCreateAttach = []string{attachPath}
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateBoard = ""
+		cardCreateTitle = ""
+		cardCreateDescription = ""
+		cardCreateAttach = nil
+
+		assertExitCode(t, err, 0)
+
+		if len(mock.UploadFileCalls) != 1 || mock.UploadFileCalls[0] != attachPath {
+			t.Fatalf("unexpected upload calls: %#v", mock.UploadFileCalls)
+		}
+
+		body := mock.PostCalls[0].Body.(map[string]any)
+		expected := strings.Join([]string{
+			"See attached",
+			`<action-text-attachment sgid="sgid-single"></action-text-attachment>`,
+		}, "\n")
+		if body["description"] != expected {
+			t.Errorf("expected description %q, got %v", expected, body["description"])
+		}
+	})
+
+	t.Run("uploads and appends multiple inline attachments in order", func(t *testing.T) {
+		tempDir := t.TempDir()
+		attachPath1 := writeTestAttachmentFile(t, tempDir, "first.txt", "first")
+		attachPath2 := writeTestAttachmentFile(t, tempDir, "second.txt", "second")
+
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{
+			StatusCode: 201,
+			Data:       map[string]any{"id": "abc", "number": 42},
+		}
+		mock.UploadFileResponses = []*client.APIResponse{
+			{StatusCode: 200, Data: map[string]any{"attachable_sgid": "sgid-1"}},
+			{StatusCode: 200, Data: map[string]any{"attachable_sgid": "sgid-2"}},
+		}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardCreateBoard = "123"
+		cardCreateTitle = "Test"
+		cardCreateAttach = []string{attachPath1, attachPath2}
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
 		cardCreateBoard = ""
 		cardCreateTitle = ""
 		cardCreateAttach = nil
@@ -652,6 +1320,166 @@ func TestCardCreate(t *testing.T) {
 			t.Errorf("expected description %q, got %v", expected, body["description"])
 		}
 	})
+
+	t.Run("creates the card written in the editor", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{
+			StatusCode: 201,
+			Data:       map[string]any{"id": "abc", "number": 42, "title": "Fix login"},
+		}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		defer func(orig func(string) error) { runEditor = orig }(runEditor)
+		var initial, editedPath string
+		runEditor = func(path string) error {
+			editedPath = path
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			initial = string(content)
+			edited := "---\ntitle: Fix login\nboard: 456\ntags: [bug, \"#urgent\"]\n---\n\nIt **fails** on Safari.\n"
+			return os.WriteFile(path, []byte(edited), 0o600)
+		}
+
+		cardCreateBoard = "123"
+		cardCreateTitle = "Draft"
+		cardCreateEdit = true
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateBoard = ""
+		cardCreateTitle = ""
+		cardCreateEdit = false
+
+		assertExitCode(t, err, 0)
+		if _, statErr := os.Stat(editedPath); !os.IsNotExist(statErr) {
+			t.Errorf("expected the edited file removed once the card exists, got %v", statErr)
+		}
+		if !strings.Contains(initial, "title: Draft") || !strings.Contains(initial, "board: \"123\"") {
+			t.Errorf("expected the template to be prefilled from the flags, got %q", initial)
+		}
+
+		body := mock.PostCalls[0].Body.(map[string]any)
+		if body["board_id"] != "456" {
+			t.Errorf("expected board_id '456' from the front matter, got '%v'", body["board_id"])
+		}
+		if body["title"] != "Fix login" {
+			t.Errorf("expected title 'Fix login', got '%v'", body["title"])
+		}
+		tags, _ := body["tag_names"].([]any)
+		if len(tags) != 2 || tags[0] != "bug" || tags[1] != "urgent" {
+			t.Errorf("expected tag_names [bug urgent], got %v", body["tag_names"])
+		}
+		if desc, _ := body["description"].(string); !strings.Contains(desc, "<strong>fails</strong>") {
+			t.Errorf("expected the body converted to HTML, got %q", desc)
+		}
+	})
+
+	t.Run("creates nothing when the editor leaves the title empty", func(t *testing.T) {
+		mock := NewMockClient()
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		defer func(orig func(string) error) { runEditor = orig }(runEditor)
+		runEditor = func(path string) error {
+			return os.WriteFile(path, []byte("---\ntitle: \"\"\nboard: \"123\"\n---\n\nSome notes\n"), 0o600)
+		}
+
+		cardCreateEdit = true
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateEdit = false
+
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+		if len(mock.PostCalls) != 0 {
+			t.Errorf("expected no card to be created, got %d POST calls", len(mock.PostCalls))
+		}
+	})
+
+	t.Run("reads front matter saved with CRLF line endings", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{StatusCode: 201, Data: map[string]any{"id": "abc", "number": 42}}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		defer func(orig func(string) error) { runEditor = orig }(runEditor)
+		runEditor = func(path string) error {
+			return os.WriteFile(path, []byte("---\r\ntitle: Fix login\r\nboard: \"123\"\r\n---\r\n\r\nOn Windows\r\n"), 0o600)
+		}
+
+		cardCreateEdit = true
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateEdit = false
+
+		assertExitCode(t, err, 0)
+		if body := mock.PostCalls[0].Body.(map[string]any); body["title"] != "Fix login" || body["board_id"] != "123" {
+			t.Errorf("expected the front matter read, got %v", body)
+		}
+	})
+
+	t.Run("keeps the edit when the front matter is invalid", func(t *testing.T) {
+		mock := NewMockClient()
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		defer func(orig func(string) error) { runEditor = orig }(runEditor)
+		var edited string
+		runEditor = func(path string) error {
+			edited = path
+			return os.WriteFile(path, []byte("---\ntitle: Fix login\ncolour: red\n---\n\nLong notes\n"), 0o600)
+		}
+
+		cardCreateEdit = true
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateEdit = false
+		defer os.Remove(edited)
+
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+		if e, ok := err.(*errors.CLIError); !ok || !strings.Contains(e.Hint, edited) {
+			t.Errorf("expected the hint to give the kept file, got %v", err)
+		}
+		if _, statErr := os.Stat(edited); statErr != nil {
+			t.Errorf("expected the edited file to be kept: %v", statErr)
+		}
+	})
+
+	t.Run("keeps the edit when the card can't be created", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostError = errors.NewForbiddenError("Not allowed on this board")
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		defer func(orig func(string) error) { runEditor = orig }(runEditor)
+		var edited string
+		runEditor = func(path string) error {
+			edited = path
+			return os.WriteFile(path, []byte("---\ntitle: Fix login\nboard: \"123\"\n---\n\nLong notes\n"), 0o600)
+		}
+
+		cardCreateEdit = true
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateEdit = false
+		defer os.Remove(edited)
+
+		if err == nil {
+			t.Fatal("expected the create to fail")
+		}
+		if e, ok := err.(*errors.CLIError); !ok || !strings.Contains(e.Hint, edited) {
+			t.Errorf("expected the hint to give the kept file, got %v", err)
+		}
+		if _, statErr := os.Stat(edited); statErr != nil {
+			t.Errorf("expected the edited file to be kept: %v", statErr)
+		}
+	})
 }
 
 func TestCardUpdate(t *testing.T) {
@@ -679,6 +1507,51 @@ func TestCardUpdate(t *testing.T) {
 		}
 	})
 
+	t.Run("converts a Markdown description", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PatchResponse = &client.APIResponse{
+			StatusCode: 200,
+			Data:       map[string]any{"id": "abc", "number": 42},
+		}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		path := filepath.Join(t.TempDir(), "notes.md")
+		if err := os.WriteFile(path, []byte("Plain words\n\n- [ ] check"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		cardUpdateDescriptionMDFile = path
+		err := cardUpdateCmd.RunE(cardUpdateCmd, []string{"42"})
+		cardUpdateDescriptionMDFile = ""
+
+		assertExitCode(t, err, 0)
+		body := mock.PatchCalls[0].Body.(map[string]any)
+		desc, _ := body["description"].(string)
+		if !strings.Contains(desc, "<p>Plain words</p>") || !strings.Contains(desc, "☐ check") {
+			t.Errorf("expected converted Markdown, got %q", desc)
+		}
+	})
+
+	t.Run("rejects --description-md with --description", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardUpdateDescription = "<p>html</p>"
+		cardUpdateDescriptionMD = "**md**"
+		err := cardUpdateCmd.RunE(cardUpdateCmd, []string{"42"})
+		cardUpdateDescription = ""
+		cardUpdateDescriptionMD = ""
+
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+		if len(mock.PatchCalls) != 0 {
+			t.Errorf("expected no update, got %d PATCH calls", len(mock.PatchCalls))
+		}
+	})
+
 	t.Run("uploads and appends inline attachments", func(t *testing.T) {
 		tempDir := t.TempDir()
 		attachPath := writeTestAttachmentFile(t, tempDir, "update.txt", "update")
@@ -786,6 +1659,93 @@ func TestCardClose(t *testing.T) {
 			t.Errorf("expected path '/cards/42/closure.json', got '%s'", mock.PostCalls[0].Path)
 		}
 	})
+
+	t.Run("closes several cards concurrently", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{StatusCode: 200, Data: map[string]any{}}
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardCloseApprove = true
+		defer func() { cardCloseApprove = false }()
+
+		err := cardCloseCmd.RunE(cardCloseCmd, []string{"12", "14", "19"})
+		assertExitCode(t, err, 0)
+
+		paths := []string{}
+		for _, call := range mock.PostCalls {
+			paths = append(paths, call.Path)
+		}
+		sort.Strings(paths)
+		if strings.Join(paths, " ") != "/cards/12/closure.json /cards/14/closure.json /cards/19/closure.json" {
+			t.Errorf("unexpected closures: %v", paths)
+		}
+		data := result.Response.Data.(map[string]any)
+		if succeeded := data["succeeded"].([]any); len(succeeded) != 3 || succeeded[0] != float64(12) {
+			t.Errorf("expected the cards in order under succeeded, got %v", succeeded)
+		}
+		if result.Response.Summary != "Closed 3 of 3 cards" {
+			t.Errorf("unexpected summary %q", result.Response.Summary)
+		}
+	})
+
+	t.Run("reads card numbers from stdin and reports failures", func(t *testing.T) {
+		mock := NewMockClient().WithValidationError("Card is already closed")
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardCloseFromStdin, cardCloseApprove = true, true
+		defer func() { cardCloseFromStdin, cardCloseApprove = false, false }()
+		cardCloseCmd.SetIn(strings.NewReader("12\n14\n"))
+		defer cardCloseCmd.SetIn(nil)
+
+		err := cardCloseCmd.RunE(cardCloseCmd, []string{})
+		if errors.ExitCodeOf(err) != errors.ExitPartial {
+			t.Fatalf("expected exit code %d, got %v", errors.ExitPartial, err)
+		}
+		if failed := result.Response.Data.(map[string]any)["failed"].([]any); len(failed) != 2 {
+			t.Errorf("expected both cards under failed, got %v", failed)
+		}
+	})
+
+	t.Run("several cards need approval without a terminal", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := cardCloseCmd.RunE(cardCloseCmd, []string{"12", "14"})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+
+		cardCloseDryRun = true
+		defer func() { cardCloseDryRun = false }()
+		err = cardCloseCmd.RunE(cardCloseCmd, []string{"12", "14"})
+		assertExitCode(t, err, 0)
+		if len(mock.PostCalls) != 0 {
+			t.Errorf("expected no closures, got %v", mock.PostCalls)
+		}
+	})
+
+	t.Run("dry run with one card closes nothing", func(t *testing.T) {
+		mock := NewMockClient()
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardCloseDryRun = true
+		err := cardCloseCmd.RunE(cardCloseCmd, []string{"42"})
+		cardCloseDryRun = false
+		assertExitCode(t, err, 0)
+
+		if len(mock.PostCalls)+len(mock.GetCalls)+len(mock.GetWithPaginationCalls) != 0 {
+			t.Errorf("expected no API calls, got %v %v", mock.PostCalls, mock.GetCalls)
+		}
+		if result.Response.Summary != "Would close 1 card" {
+			t.Errorf("unexpected summary %q", result.Response.Summary)
+		}
+	})
 }
 
 func TestCardReopen(t *testing.T) {
@@ -833,6 +1793,7 @@ func TestCardPostpone(t *testing.T) {
 func TestCardColumn(t *testing.T) {
 	t.Run("moves card to column", func(t *testing.T) {
 		mock := NewMockClient()
+		mock.OnGet("/cards/42", &client.APIResponse{StatusCode: 200, Data: map[string]any{"number": float64(42)}})
 		mock.PostResponse = &client.APIResponse{
 			StatusCode: 200,
 			Data:       map[string]any{},
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+// checklistItem is one step parsed from a plain-text or markdown checklist.
+type checklistItem struct {
+	Content   string
+	Completed bool
+}
+
+// checklistMarker matches markdown list and task markers at the start of a
+// line: "- ", "* ", "+ ", "1. ", "- [ ] ", and "- [x] ".
+var checklistMarker = regexp.MustCompile(`^(?:[-*+]|\d+[.)])(?:\s+|$)(?:\[([ xX])\](?:\s+|$))?|^\[([ xX])\](?:\s+|$)`)
+
+// parseChecklist reads one step per non-blank line. List markers are
+// stripped, and "[x]" task markers mark the step completed. Lines starting
+// with '#' are headings or comments and are skipped.
+func parseChecklist(text string) []checklistItem {
+	var items []checklistItem
+	for line := range strings.Lines(text) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		item := checklistItem{}
+		if m := checklistMarker.FindStringSubmatch(line); m != nil {
+			mark := m[1] + m[2]
+			item.Completed = mark == "x" || mark == "X"
+			line = strings.TrimSpace(line[len(m[0]):])
+		}
+		if line == "" {
+			continue
+		}
+		item.Content = line
+		items = append(items, item)
+	}
+	return items
+}
+
+// taskListMarker matches a markdown task list item: "- [ ] ", "* [x] ",
+// "1. [X] ", and so on, possibly indented.
+var taskListMarker = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s+\[([ xX])\](?:\s+|$)`)
+
+// parseTaskList reads only the task list items of a markdown document, so
+// checklists can be taken from docs and PR templates with other content
+// around them.
+func parseTaskList(text string) []checklistItem {
+	var items []checklistItem
+	for line := range strings.Lines(text) {
+		m := taskListMarker.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		content := strings.TrimSpace(line[len(m[0]):])
+		if content == "" {
+			continue
+		}
+		items = append(items, checklistItem{Content: content, Completed: m[1] != " "})
+	}
+	return items
+}
+
+// readChecklistFile parses a checklist file with parseChecklist.
+func readChecklistFile(path string) ([]checklistItem, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.NewInvalidArgsError(fmt.Sprintf("could not read steps file: %v", err))
+	}
+	return parseChecklist(string(data)), nil
+}
@@ -0,0 +1,60 @@
+package commands
+
+import "testing"
+
+func TestParseChecklist(t *testing.T) {
+	text := `# Release checklist
+
+- [ ] Tag the release
+- [x] Write changelog
+* Update docs
+1. Announce
+Plain step
+-
+`
+	want := []checklistItem{
+		{Content: "Tag the release"},
+		{Content: "Write changelog", Completed: true},
+		{Content: "Update docs"},
+		{Content: "Announce"},
+		{Content: "Plain step"},
+	}
+
+	got := parseChecklist(text)
+	if len(got) != len(want) {
+		t.Fatalf("expected %d items, got %d: %v", len(want), len(got), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("item %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestParseTaskList(t *testing.T) {
+	text := `## Checklist
+
+Describe the change.
+
+- [ ] Tests pass
+  - [x] Docs updated
+* Not a task
+1. [X] Changelog
+- [ ]
+`
+	want := []checklistItem{
+		{Content: "Tests pass"},
+		{Content: "Docs updated", Completed: true},
+		{Content: "Changelog", Completed: true},
+	}
+
+	got := parseTaskList(text)
+	if len(got) != len(want) {
+		t.Fatalf("expected %d items, got %d: %v", len(want), len(got), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("item %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"html"
 
 	"github.com/basecamp/fizzy-cli/internal/errors"
 	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
@@ -38,6 +39,7 @@ var columnListCmd = &cobra.Command{
 		}
 
 		items := normalizeAny(data)
+		cacheBoardColumns(boardID, toMaps(items))
 
 		dataSlice := toSliceAny(items)
 		if dataSlice == nil {
@@ -113,11 +115,12 @@ var columnShowCmd = &cobra.Command{
 var columnCreateBoard string
 var columnCreateName string
 var columnCreateColor string
+var columnCreateJSONInput string
 
 var columnCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a column",
-	Long:  "Creates a new column in a board.",
+	Long:  "Creates a new column in a board.\n\n--json-input reads the request body (e.g. {\"name\": \"Review\", \"color\": \"var(--color-card-4)\"}) as JSON from a file, or from stdin with -. Flags given alongside override its fields.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
@@ -127,20 +130,29 @@ var columnCreateCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		if columnCreateName == "" {
+
+		req := &generated.CreateColumnRequest{}
+		if columnCreateJSONInput != "" {
+			if err := readJSONInput(cmd, columnCreateJSONInput, req); err != nil {
+				return err
+			}
+		}
+		if columnCreateName != "" {
+			req.Name = columnCreateName
+		}
+		if req.Name == "" {
 			return newRequiredFlagError("name")
 		}
-
-		ac := getSDK()
-		req := &generated.CreateColumnRequest{Name: columnCreateName}
 		if columnCreateColor != "" {
 			req.Color = columnCreateColor
 		}
 
+		ac := getSDK()
 		data, resp, err := ac.Columns().Create(cmd.Context(), boardID, req)
 		if err != nil {
 			return convertSDKError(err)
 		}
+		invalidateColumnCache(boardID)
 
 		items := normalizeAny(data)
 		if items == nil {
@@ -177,11 +189,12 @@ var columnCreateCmd = &cobra.Command{
 var columnUpdateBoard string
 var columnUpdateName string
 var columnUpdateColor string
+var columnUpdateJSONInput string
 
 var columnUpdateCmd = &cobra.Command{
 	Use:   "update COLUMN_ID",
 	Short: "Update a column",
-	Long:  "Updates an existing column.",
+	Long:  "Updates an existing column.\n\n--json-input reads the request body as JSON from a file, or from stdin with -. Flags given alongside override its fields.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
@@ -200,6 +213,11 @@ var columnUpdateCmd = &cobra.Command{
 		columnID := args[0]
 
 		req := &generated.UpdateColumnRequest{}
+		if columnUpdateJSONInput != "" {
+			if err := readJSONInput(cmd, columnUpdateJSONInput, req); err != nil {
+				return err
+			}
+		}
 		if columnUpdateName != "" {
 			req.Name = columnUpdateName
 		}
@@ -211,6 +229,7 @@ var columnUpdateCmd = &cobra.Command{
 		if err != nil {
 			return convertSDKError(err)
 		}
+		invalidateColumnCache(boardID)
 
 		// Build breadcrumbs
 		breadcrumbs := []Breadcrumb{
@@ -227,6 +246,99 @@ var columnUpdateCmd = &cobra.Command{
 	},
 }
 
+// Column rename flags
+var columnRenameBoard string
+var columnRenameName string
+var columnRenameCascade bool
+
+var columnRenameCmd = &cobra.Command{
+	Use:   "rename COLUMN",
+	Short: "Rename a column",
+	Long: `Renames a column and reports how many cards sit in it.
+
+COLUMN may be a column ID or its current name. With --cascade, a comment noting
+the rename is posted on every card in the column so the change is visible in
+each card's history.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		if _, ok := parsePseudoColumnID(args[0]); ok {
+			return errors.NewInvalidArgsError("cannot rename pseudo columns (Not Yet, Maybe?, Done)")
+		}
+
+		boardID, err := requireBoard(columnRenameBoard)
+		if err != nil {
+			return err
+		}
+		if columnRenameName == "" {
+			return newRequiredFlagError("name")
+		}
+
+		ac := getSDK()
+		columnID, err := resolveColumnID(cmd.Context(), ac, boardID, args[0])
+		if err != nil {
+			return err
+		}
+
+		current, _, err := ac.Columns().Get(cmd.Context(), boardID, columnID)
+		if err != nil {
+			return convertSDKError(err)
+		}
+		previousName := current.Name
+
+		data, _, err := ac.Columns().Update(cmd.Context(), boardID, columnID, &generated.UpdateColumnRequest{Name: columnRenameName})
+		if err != nil {
+			return convertSDKError(err)
+		}
+		invalidateColumnCache(boardID)
+
+		pages, err := ac.GetAll(cmd.Context(), fmt.Sprintf("/boards/%s/columns/%s/cards.json", boardID, columnID))
+		if err != nil {
+			return convertSDKError(err)
+		}
+		cards := toMaps(jsonAnySlice(pages))
+
+		result := map[string]any{
+			"column":         normalizeAny(data),
+			"previous_name":  previousName,
+			"name":           columnRenameName,
+			"affected_cards": len(cards),
+		}
+
+		if columnRenameCascade {
+			body := fmt.Sprintf("<p>Column renamed from <strong>%s</strong> to <strong>%s</strong>.</p>",
+				html.EscapeString(previousName), html.EscapeString(columnRenameName))
+			annotated := 0
+			var failed []string
+			for _, card := range cards {
+				number := fmt.Sprintf("%v", card["number"])
+				if _, _, err := ac.Comments().Create(cmd.Context(), number, &generated.CreateCommentRequest{Body: body}); err != nil {
+					failed = append(failed, number)
+					continue
+				}
+				annotated++
+			}
+			result["annotated_cards"] = annotated
+			if len(failed) > 0 {
+				result["failed_cards"] = failed
+			}
+		}
+
+		summary := fmt.Sprintf("Renamed column %q to %q (%d cards)", previousName, columnRenameName, len(cards))
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("columns", fmt.Sprintf("fizzy column list --board %s", boardID), "List columns"),
+			breadcrumb("cards", fmt.Sprintf("fizzy card list --board %s --column %s", boardID, columnID), "List cards in column"),
+		}
+
+		printMutation(result, summary, breadcrumbs)
+		return nil
+	},
+}
+
 // Column delete flags
 var columnDeleteBoard string
 
@@ -249,10 +361,15 @@ var columnDeleteCmd = &cobra.Command{
 			return err
 		}
 
+		if deferred, err := deferDelete(cmd, tombstone{Kind: "column", Target: args[0], Parent: boardID}); deferred || err != nil {
+			return err
+		}
+
 		_, err = getSDK().Columns().Delete(cmd.Context(), boardID, args[0])
 		if err != nil {
 			return convertSDKError(err)
 		}
+		invalidateColumnCache(boardID)
 
 		// Build breadcrumbs
 		breadcrumbs := []Breadcrumb{
@@ -344,16 +461,25 @@ func init() {
 	columnCreateCmd.Flags().StringVar(&columnCreateBoard, "board", "", "Board ID (required)")
 	columnCreateCmd.Flags().StringVar(&columnCreateName, "name", "", "Column name (required)")
 	columnCreateCmd.Flags().StringVar(&columnCreateColor, "color", "", "Column color")
+	columnCreateCmd.Flags().StringVar(&columnCreateJSONInput, "json-input", "", jsonInputUsage)
 	columnCmd.AddCommand(columnCreateCmd)
 
 	// Update
 	columnUpdateCmd.Flags().StringVar(&columnUpdateBoard, "board", "", "Board ID (required)")
 	columnUpdateCmd.Flags().StringVar(&columnUpdateName, "name", "", "Column name")
 	columnUpdateCmd.Flags().StringVar(&columnUpdateColor, "color", "", "Column color")
+	columnUpdateCmd.Flags().StringVar(&columnUpdateJSONInput, "json-input", "", jsonInputUsage)
 	columnCmd.AddCommand(columnUpdateCmd)
 
+	// Rename
+	columnRenameCmd.Flags().StringVar(&columnRenameBoard, "board", "", "Board ID (required)")
+	columnRenameCmd.Flags().StringVar(&columnRenameName, "name", "", "New column name (required)")
+	columnRenameCmd.Flags().BoolVar(&columnRenameCascade, "cascade", false, "Comment on each card in the column noting the rename")
+	columnCmd.AddCommand(columnRenameCmd)
+
 	// Delete
 	columnDeleteCmd.Flags().StringVar(&columnDeleteBoard, "board", "", "Board ID (required)")
+	addDeleteNowFlag(columnDeleteCmd)
 	columnCmd.AddCommand(columnDeleteCmd)
 
 	// Move
@@ -0,0 +1,243 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/cache"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+)
+
+// columnCacheTTL is how long a board's column list is trusted before it is
+// refetched. Column create/update/delete invalidate it immediately.
+const columnCacheTTL = 5 * time.Minute
+
+func columnCacheKey(boardID string) string {
+	account := ""
+	if cfg != nil {
+		account = cfg.Account
+	}
+	return "columns/" + account + "/" + boardID
+}
+
+// cacheBoardColumns records a freshly fetched column list for boardID.
+func cacheBoardColumns(boardID string, columns []map[string]any) {
+	_ = cache.Store(columnCacheKey(boardID), columns)
+}
+
+// invalidateColumnCache drops the cached column list for boardID, and the
+// account-wide index built from it.
+func invalidateColumnCache(boardID string) {
+	_ = cache.Invalidate(columnCacheKey(boardID))
+	_ = cache.Invalidate(accountColumnsCacheKey())
+}
+
+// fetchBoardColumns returns the real (non-pseudo) columns of a board, served from
+// the local cache when fresh. The second return reports a cache hit.
+func fetchBoardColumns(ctx context.Context, ac *fizzy.AccountClient, boardID string, allowCached bool) ([]map[string]any, bool, error) {
+	if allowCached {
+		var cached []map[string]any
+		if cache.Load(columnCacheKey(boardID), columnCacheTTL, &cached) {
+			return cached, true, nil
+		}
+	}
+
+	data, _, err := ac.Columns().List(ctx, boardID)
+	if err != nil {
+		return nil, false, convertSDKError(err)
+	}
+	columns := toMaps(normalizeAny(data))
+	cacheBoardColumns(boardID, columns)
+	return columns, false, nil
+}
+
+// resolveColumnID maps a --column argument (column ID or name) to a column ID
+// on boardID. Names match case-insensitively. A miss against cached data
+// triggers one refetch in case the column was created elsewhere.
+func resolveColumnID(ctx context.Context, ac *fizzy.AccountClient, boardID, arg string) (string, error) {
+	columns, cached, err := fetchBoardColumns(ctx, ac, boardID, true)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := matchColumn(columns, boardID, arg)
+	if err != nil && cached {
+		if columns, _, err = fetchBoardColumns(ctx, ac, boardID, false); err != nil {
+			return "", err
+		}
+		id, err = matchColumn(columns, boardID, arg)
+	}
+	return id, err
+}
+
+func matchColumn(columns []map[string]any, boardID, arg string) (string, error) {
+	for _, col := range columns {
+		if id := fmt.Sprintf("%v", col["id"]); id == arg {
+			return id, nil
+		}
+	}
+
+	var matches []string
+	for _, col := range columns {
+		if name, _ := col["name"].(string); strings.EqualFold(strings.TrimSpace(name), strings.TrimSpace(arg)) {
+			matches = append(matches, fmt.Sprintf("%v", col["id"]))
+		}
+	}
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		e := errors.NewNotFoundError(fmt.Sprintf("column %q not found on board %s", arg, boardID))
+		e.Hint = fmt.Sprintf("Run 'fizzy column list --board %s' to see available columns", boardID)
+		return "", e
+	default:
+		return "", errors.NewAmbiguousError("column "+arg, matches)
+	}
+}
+
+// boardColumn is a column qualified by its board, for resolving column names
+// when no board is given.
+type boardColumn struct {
+	BoardID    string `json:"board_id"`
+	BoardName  string `json:"board_name"`
+	ColumnID   string `json:"column_id"`
+	ColumnName string `json:"column_name"`
+}
+
+// Qualified returns the column as "board/column".
+func (c boardColumn) Qualified() string {
+	return c.BoardName + "/" + c.ColumnName
+}
+
+func accountColumnsCacheKey() string {
+	return columnCacheKey("all-boards")
+}
+
+// fetchAccountColumns returns the columns of every board in the account,
+// served from the local cache when fresh. The second return reports a cache
+// hit. Each board's columns come from its own column cache when possible.
+func fetchAccountColumns(ctx context.Context, ac *fizzy.AccountClient, allowCached bool) ([]boardColumn, bool, error) {
+	if allowCached {
+		var cached []boardColumn
+		if cache.Load(accountColumnsCacheKey(), columnCacheTTL, &cached) {
+			return cached, true, nil
+		}
+	}
+
+	pages, err := ac.GetAll(ctx, "/boards.json")
+	if err != nil {
+		return nil, false, convertSDKError(err)
+	}
+	boards := toMaps(jsonAnySlice(pages))
+	perBoard := make([][]map[string]any, len(boards))
+	errs := make([]error, len(boards))
+	sem := make(chan struct{}, maxParallel(bulkConcurrency))
+	var wg sync.WaitGroup
+	for i, board := range boards {
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			perBoard[i], _, errs[i] = fetchBoardColumns(ctx, ac, fmt.Sprintf("%v", board["id"]), allowCached)
+		})
+	}
+	wg.Wait()
+
+	var columns []boardColumn
+	for i, board := range boards {
+		if errs[i] != nil {
+			return nil, false, errs[i]
+		}
+		for _, col := range perBoard[i] {
+			columns = append(columns, boardColumn{
+				BoardID:    fmt.Sprintf("%v", board["id"]),
+				BoardName:  getStringField(board, "name"),
+				ColumnID:   fmt.Sprintf("%v", col["id"]),
+				ColumnName: getStringField(col, "name"),
+			})
+		}
+	}
+	_ = cache.Store(accountColumnsCacheKey(), columns)
+	return columns, false, nil
+}
+
+// fizzyIDPattern matches the shape of Fizzy's IDs: 25 lowercase base-36
+// characters.
+var fizzyIDPattern = regexp.MustCompile(`^[0-9a-z]{25}$`)
+
+// resolveAccountColumn maps a --column argument to a column when no board
+// narrows the search: a column ID, a name that only one board uses, or a
+// name qualified as "board/column" with the board's ID or name. A name used
+// on several boards is ambiguous, and the error lists the qualified names to
+// choose from. An argument shaped like an ID is taken as one without
+// fetching every board's columns. A miss against cached data triggers one
+// refetch.
+func resolveAccountColumn(ctx context.Context, ac *fizzy.AccountClient, arg string) (boardColumn, error) {
+	if id := strings.TrimSpace(arg); fizzyIDPattern.MatchString(id) {
+		return boardColumn{ColumnID: id}, nil
+	}
+
+	columns, cached, err := fetchAccountColumns(ctx, ac, true)
+	if err != nil {
+		return boardColumn{}, err
+	}
+
+	match, err := matchAccountColumn(columns, arg)
+	if err != nil && cached {
+		if columns, _, err = fetchAccountColumns(ctx, ac, false); err != nil {
+			return boardColumn{}, err
+		}
+		match, err = matchAccountColumn(columns, arg)
+	}
+	return match, err
+}
+
+func matchAccountColumn(columns []boardColumn, arg string) (boardColumn, error) {
+	arg = strings.TrimSpace(arg)
+	for _, col := range columns {
+		if col.ColumnID == arg {
+			return col, nil
+		}
+	}
+
+	sameName := func(a, b string) bool {
+		return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+	}
+	var matches []boardColumn
+	for _, col := range columns {
+		if sameName(col.ColumnName, arg) {
+			matches = append(matches, col)
+		}
+	}
+	// Column names may contain "/" themselves, so the qualified form is only
+	// tried when the whole argument isn't a column name.
+	if board, name, ok := strings.Cut(arg, "/"); ok && len(matches) == 0 {
+		for _, col := range columns {
+			if (col.BoardID == strings.TrimSpace(board) || sameName(col.BoardName, board)) && (col.ColumnID == strings.TrimSpace(name) || sameName(col.ColumnName, name)) {
+				matches = append(matches, col)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		e := errors.NewNotFoundError(fmt.Sprintf("column %q not found on any board", arg))
+		e.Hint = "Run 'fizzy column list --board ID' to see a board's columns, or pass --board"
+		return boardColumn{}, e
+	default:
+		qualified := make([]string, len(matches))
+		for i, col := range matches {
+			qualified[i] = fmt.Sprintf("%q", col.Qualified())
+		}
+		e := errors.NewAmbiguousError("column "+arg, qualified)
+		e.Message = fmt.Sprintf("column %q is on %d boards", arg, len(matches))
+		e.Hint = "Qualify it as board/column, one of " + strings.Join(qualified, ", ") + ", or pass --board"
+		return boardColumn{}, e
+	}
+}
@@ -0,0 +1,241 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func countGets(mock *MockClient, path string) int {
+	n := 0
+	for _, call := range mock.GetWithPaginationCalls {
+		if call.Path == path {
+			n++
+		}
+	}
+	return n
+}
+
+func TestColumnCache(t *testing.T) {
+	columns := &client.APIResponse{
+		StatusCode: 200,
+		Data: []any{
+			map[string]any{"id": "col-1", "name": "Doing"},
+			map[string]any{"id": "col-2", "name": "Review"},
+			map[string]any{"id": "col-3", "name": "review"},
+		},
+	}
+
+	t.Run("card list resolves column names and reuses the cached list", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/boards/123/columns.json", columns)
+		mock.OnGet("/cards.json", &client.APIResponse{StatusCode: 200, Data: []any{}})
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardListBoard = []string{"123"}
+		cardListColumn = "doing"
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		assertExitCode(t, err, 0)
+		err = cardListCmd.RunE(cardListCmd, []string{})
+		cardListBoard = nil
+		cardListColumn = ""
+		assertExitCode(t, err, 0)
+
+		if n := countGets(mock, "/boards/123/columns.json"); n != 1 {
+			t.Errorf("expected columns fetched once, got %d", n)
+		}
+		if n := countGets(mock, "/cards.json?board_ids[]=123&column_ids[]=col-1"); n != 2 {
+			t.Errorf("expected resolved column ID in both card requests, got %d", n)
+		}
+	})
+
+	t.Run("column create invalidates the cached list", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/boards/123/columns.json", columns)
+		mock.OnGet("/cards.json", &client.APIResponse{StatusCode: 200, Data: []any{}})
+		mock.PostResponse = &client.APIResponse{StatusCode: 201, Data: map[string]any{"id": "col-4"}}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardListBoard = []string{"123"}
+		cardListColumn = "col-1"
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		assertExitCode(t, err, 0)
+
+		columnCreateBoard = "123"
+		columnCreateName = "QA"
+		err = columnCreateCmd.RunE(columnCreateCmd, []string{})
+		columnCreateBoard = ""
+		columnCreateName = ""
+		assertExitCode(t, err, 0)
+
+		err = cardListCmd.RunE(cardListCmd, []string{})
+		cardListBoard = nil
+		cardListColumn = ""
+		assertExitCode(t, err, 0)
+
+		if n := countGets(mock, "/boards/123/columns.json"); n != 2 {
+			t.Errorf("expected columns refetched after create, got %d fetches", n)
+		}
+	})
+
+	t.Run("unknown column is a not-found error", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/boards/123/columns.json", columns)
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardListBoard = []string{"123"}
+		cardListColumn = "Shipped"
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		cardListBoard = nil
+		cardListColumn = ""
+
+		assertExitCode(t, err, errors.ExitNotFound)
+		if n := countGets(mock, "/cards.json?board_ids[]=123&column_ids[]=Shipped"); n != 0 {
+			t.Error("expected no card request for an unknown column")
+		}
+	})
+
+	t.Run("name matching several columns is ambiguous", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/boards/123/columns.json", columns)
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardColumnBoard = "123"
+		cardColumnColumn = "REVIEW"
+		err := cardColumnCmd.RunE(cardColumnCmd, []string{"42"})
+		cardColumnBoard = ""
+		cardColumnColumn = ""
+
+		assertExitCode(t, err, errors.ExitAmbiguous)
+		if len(mock.PostCalls) != 0 {
+			t.Errorf("expected no triage call, got %d", len(mock.PostCalls))
+		}
+	})
+
+	t.Run("card column resolves names with --board", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/boards/123/columns.json", columns)
+		mock.PostResponse = &client.APIResponse{StatusCode: 200, Data: map[string]any{}}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardColumnBoard = "123"
+		cardColumnColumn = "Doing"
+		err := cardColumnCmd.RunE(cardColumnCmd, []string{"42"})
+		cardColumnBoard = ""
+		cardColumnColumn = ""
+
+		assertExitCode(t, err, 0)
+		body := mock.PostCalls[0].Body.(map[string]any)
+		if body["column_id"] != "col-1" {
+			t.Errorf("expected column_id 'col-1', got '%v'", body["column_id"])
+		}
+	})
+
+	t.Run("card column resolves names against the card's board", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards/42", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+			"number": float64(42),
+			"board":  map[string]any{"id": "123", "name": "Platform"},
+		}})
+		mock.OnGet("/boards/123/columns.json", columns)
+		mock.PostResponse = &client.APIResponse{StatusCode: 200, Data: map[string]any{}}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		cfg.Board = "999"
+		defer resetTest()
+
+		cardColumnColumn = "Doing"
+		err := cardColumnCmd.RunE(cardColumnCmd, []string{"42"})
+		cardColumnColumn = ""
+
+		assertExitCode(t, err, 0)
+		if countGets(mock, "/boards/999/columns.json") != 0 {
+			t.Error("expected the configured board to be ignored")
+		}
+		body := mock.PostCalls[0].Body.(map[string]any)
+		if body["column_id"] != "col-1" {
+			t.Errorf("expected column_id 'col-1', got '%v'", body["column_id"])
+		}
+	})
+
+	t.Run("card list without a board takes an ID-shaped column as is", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards.json", &client.APIResponse{StatusCode: 200, Data: []any{}})
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardListColumn = "03f5vaqbz3yccogh0r8o3ou2x"
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		cardListColumn = ""
+
+		assertExitCode(t, err, 0)
+		if n := countGets(mock, "/boards.json"); n != 0 {
+			t.Errorf("expected no board lookup, got %d", n)
+		}
+		if n := countGets(mock, "/cards.json?column_ids[]=03f5vaqbz3yccogh0r8o3ou2x"); n != 1 {
+			t.Errorf("expected the column ID passed through, got %v", mock.GetWithPaginationCalls)
+		}
+	})
+
+	t.Run("card list without a board qualifies names as board/column", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/boards.json", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"id": "123", "name": "Platform"},
+			map[string]any{"id": "456", "name": "Mobile"},
+		}})
+		mock.OnGet("/boards/123/columns.json", columns)
+		mock.OnGet("/boards/456/columns.json", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"id": "col-9", "name": "Doing"},
+		}})
+		mock.OnGet("/cards.json", &client.APIResponse{StatusCode: 200, Data: []any{}})
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardListColumn = "doing"
+		err := cardListCmd.RunE(cardListCmd, []string{})
+		assertExitCode(t, err, errors.ExitAmbiguous)
+		if e, ok := err.(*errors.CLIError); !ok || !strings.Contains(e.Hint, `"Platform/Doing"`) || !strings.Contains(e.Hint, `"Mobile/Doing"`) {
+			t.Errorf("expected qualified names in the hint, got %v", err)
+		}
+
+		cardListColumn = "mobile/doing"
+		err = cardListCmd.RunE(cardListCmd, []string{})
+		assertExitCode(t, err, 0)
+		cardListColumn = "123/Doing"
+		err = cardListCmd.RunE(cardListCmd, []string{})
+		cardListColumn = ""
+		assertExitCode(t, err, 0)
+
+		if n := countGets(mock, "/cards.json?column_ids[]=col-9"); n != 1 {
+			t.Errorf("expected Mobile/Doing to resolve to col-9, got %d requests", n)
+		}
+		if n := countGets(mock, "/cards.json?column_ids[]=col-1"); n != 1 {
+			t.Errorf("expected 123/Doing to resolve to col-1, got %d requests", n)
+		}
+		if n := countGets(mock, "/boards.json"); n != 1 {
+			t.Errorf("expected boards fetched once, got %d", n)
+		}
+	})
+}
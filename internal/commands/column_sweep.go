@@ -0,0 +1,177 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+// Column sweep flags
+var columnSweepBoard string
+var columnSweepClose bool
+var columnSweepPostpone bool
+var columnSweepTo string
+var columnSweepApprove bool
+var columnSweepDryRun bool
+
+var columnSweepCmd = &cobra.Command{
+	Use:   "sweep COLUMN",
+	Short: "Close, postpone, or move every card in a column",
+	Long: `Applies one action to every card currently in a column, for flushing a
+column such as "Ready to close":
+
+  --close       close each card
+  --postpone    move each card to Not Now
+  --to COLUMN   move each card to another column (ID, name, or maybe/not-now/done)
+
+COLUMN may be a column ID or name. The plan, each card and the change it
+gets, is shown for confirmation first; --approve applies it without the
+prompt, and is required when not running in a terminal. --dry-run prints the
+plan without changing anything.
+
+Cards that fail are reported under "failed" and the command exits with code
+9, so a sweep can be re-run for what is left.`,
+	Example: `  fizzy column sweep "Ready to close" --board 123 --close
+  fizzy column sweep col-1 --board 123 --to Archive --approve
+  fizzy column sweep Waiting --board 123 --postpone --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		if _, ok := parsePseudoColumnID(args[0]); ok {
+			return errors.NewInvalidArgsError("cannot sweep pseudo columns (Not Yet, Maybe?, Done)")
+		}
+		boardID, err := requireBoard(columnSweepBoard)
+		if err != nil {
+			return err
+		}
+
+		actions := 0
+		for _, set := range []bool{columnSweepClose, columnSweepPostpone, columnSweepTo != ""} {
+			if set {
+				actions++
+			}
+		}
+		if actions != 1 {
+			return errors.NewInvalidArgsError("specify exactly one of --close, --postpone, or --to")
+		}
+		verb, target := "close", pseudoColumnDone.ID
+		switch {
+		case columnSweepPostpone:
+			verb, target = "postpone", pseudoColumnNotNow.ID
+		case columnSweepTo != "":
+			verb, target = "move", columnSweepTo
+		}
+
+		ctx := cmd.Context()
+		ac := getSDK()
+		columnID, err := resolveColumnID(ctx, ac, boardID, args[0])
+		if err != nil {
+			return err
+		}
+		targetName := ""
+		if pseudo, ok := parsePseudoColumnID(target); ok {
+			targetName = pseudo.Name
+		} else {
+			if target, err = resolveColumnID(ctx, ac, boardID, target); err != nil {
+				return err
+			}
+			if target == columnID {
+				return errors.NewInvalidArgsError("--to is the column being swept")
+			}
+			targetName = columnSweepTo
+			if columns, _, err := fetchBoardColumns(ctx, ac, boardID, true); err == nil {
+				for _, c := range columns {
+					if getStringField(c, "id") == target {
+						targetName = getStringField(c, "name")
+					}
+				}
+			}
+		}
+		column, _, err := ac.Columns().Get(ctx, boardID, columnID)
+		if err != nil {
+			return convertSDKError(err)
+		}
+		pages, err := ac.GetAll(ctx, fmt.Sprintf("/boards/%s/columns/%s/cards.json", boardID, columnID))
+		if err != nil {
+			return convertSDKError(err)
+		}
+		cards := toMaps(jsonAnySlice(pages))
+
+		numbers := make([]any, len(cards))
+		changes := make([]planChange, len(cards))
+		for i, card := range cards {
+			numbers[i] = card["number"]
+			changes[i] = planChange{Card: card["number"], Title: getStringField(card, "title"), Change: column.Name + " → " + targetName}
+		}
+		data := map[string]any{
+			"board_id":  boardID,
+			"column_id": columnID,
+			"column":    column.Name,
+			"action":    verb,
+			"cards":     numbers,
+		}
+		action := fmt.Sprintf("%s %d %s in %q", verb, len(cards), pluralize(len(cards), "card", "cards"), column.Name)
+		if verb == "move" {
+			data["to"] = columnSweepTo
+			action += " to " + columnSweepTo
+		}
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("cards", fmt.Sprintf("fizzy card list --board %s --column %s", boardID, columnID), "List cards in column"),
+			breadcrumb("print", fmt.Sprintf("fizzy board print %s --styled", boardID), "Print board"),
+		}
+
+		if len(cards) == 0 || columnSweepDryRun {
+			summary := fmt.Sprintf("No cards in %q", column.Name)
+			if len(cards) > 0 {
+				summary = "Would " + action
+				progressf("%s", renderPlan(action, changes))
+			}
+			data["dry_run"] = columnSweepDryRun
+			data["plan"] = changes
+			printMutation(data, summary, breadcrumbs)
+			return nil
+		}
+
+		approved, err := approvePlan(action, changes, columnSweepApprove)
+		if err != nil {
+			return err
+		}
+		if !approved {
+			data["cancelled"] = true
+			printMutation(data, "Sweep cancelled; no cards changed", breadcrumbs)
+			return nil
+		}
+
+		result := &bulkResult{}
+		for i, card := range cards {
+			number := fmt.Sprint(card["number"])
+			if err := moveCardToColumn(ctx, ac, number, boardID, target); err != nil {
+				if result.fail(card["number"], err) {
+					result.skip(numbers[i+1:]...)
+					break
+				}
+				continue
+			}
+			result.succeed(card["number"])
+		}
+
+		summary := fmt.Sprintf("Swept %q: %d of %d %s %sd", column.Name, len(result.succeeded), len(cards), pluralize(len(cards), "card", "cards"), verb)
+		return printBulkResult(data, result, "cards", summary, breadcrumbs)
+	},
+}
+
+func init() {
+	columnSweepCmd.Flags().StringVar(&columnSweepBoard, "board", "", "Board ID (required)")
+	columnSweepCmd.Flags().BoolVar(&columnSweepClose, "close", false, "Close every card in the column")
+	columnSweepCmd.Flags().BoolVar(&columnSweepPostpone, "postpone", false, "Move every card in the column to Not Now")
+	columnSweepCmd.Flags().StringVar(&columnSweepTo, "to", "", "Move every card to this column (ID, name, or maybe/not-now/done)")
+	columnSweepCmd.Flags().BoolVar(&columnSweepApprove, "approve", false, "Apply the plan without the confirmation prompt")
+	columnSweepCmd.Flags().BoolVar(&columnSweepApprove, "yes", false, "Alias for --approve")
+	_ = columnSweepCmd.Flags().MarkDeprecated("yes", "use --approve")
+	columnSweepCmd.Flags().BoolVar(&columnSweepDryRun, "dry-run", false, "Print the plan without changing anything")
+	columnCmd.AddCommand(columnSweepCmd)
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/basecamp/fizzy-cli/internal/client"
@@ -296,6 +297,104 @@ func TestColumnUpdate(t *testing.T) {
 	})
 }
 
+func TestColumnRename(t *testing.T) {
+	newMock := func() *MockClient {
+		mock := NewMockClient()
+		mock.OnGet("/boards/123/columns.json", &client.APIResponse{
+			StatusCode: 200,
+			Data:       []any{map[string]any{"id": "col-1", "name": "Doing"}},
+		})
+		mock.OnGet("/boards/123/columns/col-1", &client.APIResponse{
+			StatusCode: 200,
+			Data:       map[string]any{"id": "col-1", "name": "Doing"},
+		})
+		mock.OnGet("/boards/123/columns/col-1/cards.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"id": "c1", "number": float64(1)},
+				map[string]any{"id": "c2", "number": float64(2)},
+			},
+		})
+		mock.PatchResponse = &client.APIResponse{
+			StatusCode: 200,
+			Data:       map[string]any{"id": "col-1", "name": "In Progress"},
+		}
+		return mock
+	}
+
+	t.Run("renames by name and reports affected cards", func(t *testing.T) {
+		mock := newMock()
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		columnRenameBoard = "123"
+		columnRenameName = "In Progress"
+		err := columnRenameCmd.RunE(columnRenameCmd, []string{"doing"})
+		columnRenameBoard = ""
+		columnRenameName = ""
+
+		assertExitCode(t, err, 0)
+		if len(mock.PatchCalls) != 1 || mock.PatchCalls[0].Path != "/boards/123/columns/col-1" {
+			t.Fatalf("expected rename PATCH, got %+v", mock.PatchCalls)
+		}
+		if len(mock.PostCalls) != 0 {
+			t.Errorf("expected no comments without --cascade, got %d", len(mock.PostCalls))
+		}
+		data := result.Response.Data.(map[string]any)
+		if data["affected_cards"] != float64(2) || data["previous_name"] != "Doing" {
+			t.Errorf("unexpected result: %v", data)
+		}
+	})
+
+	t.Run("cascade comments on each card", func(t *testing.T) {
+		mock := newMock()
+		mock.PostResponse = &client.APIResponse{StatusCode: 201, Data: map[string]any{"id": "comment-1"}}
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		columnRenameBoard = "123"
+		columnRenameName = "In <Progress>"
+		columnRenameCascade = true
+		err := columnRenameCmd.RunE(columnRenameCmd, []string{"col-1"})
+		columnRenameBoard = ""
+		columnRenameName = ""
+		columnRenameCascade = false
+
+		assertExitCode(t, err, 0)
+		if len(mock.PostCalls) != 2 {
+			t.Fatalf("expected 2 comment posts, got %d", len(mock.PostCalls))
+		}
+		if mock.PostCalls[0].Path != "/cards/1/comments.json" || mock.PostCalls[1].Path != "/cards/2/comments.json" {
+			t.Errorf("unexpected comment paths: %s, %s", mock.PostCalls[0].Path, mock.PostCalls[1].Path)
+		}
+		body := mock.PostCalls[0].Body.(map[string]any)
+		if !strings.Contains(body["body"].(string), "In &lt;Progress&gt;") {
+			t.Errorf("expected escaped new name in comment body, got %v", body["body"])
+		}
+		data := result.Response.Data.(map[string]any)
+		if data["annotated_cards"] != float64(2) {
+			t.Errorf("expected 2 annotated cards, got %v", data["annotated_cards"])
+		}
+	})
+
+	t.Run("rejects pseudo columns", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		columnRenameBoard = "123"
+		columnRenameName = "Shipped"
+		err := columnRenameCmd.RunE(columnRenameCmd, []string{"done"})
+		columnRenameBoard = ""
+		columnRenameName = ""
+
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
+
 func TestColumnDelete(t *testing.T) {
 	t.Run("deletes column", func(t *testing.T) {
 		mock := NewMockClient()
@@ -385,3 +484,119 @@ func TestColumnMoveRight(t *testing.T) {
 		assertExitCode(t, err, errors.ExitInvalidArgs)
 	})
 }
+
+func TestColumnSweep(t *testing.T) {
+	newMock := func() *MockClient {
+		mock := NewMockClient()
+		mock.OnGet("/boards/123/columns.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"id": "col-1", "name": "Ready to close"},
+				map[string]any{"id": "col-2", "name": "Archive"},
+			},
+		})
+		mock.OnGet("/boards/123/columns/col-1", &client.APIResponse{
+			StatusCode: 200,
+			Data:       map[string]any{"id": "col-1", "name": "Ready to close"},
+		})
+		mock.OnGet("/boards/123/columns/col-1/cards.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"id": "c1", "number": float64(1)},
+				map[string]any{"id": "c2", "number": float64(2)},
+			},
+		})
+		return mock
+	}
+	reset := func() {
+		columnSweepBoard, columnSweepTo = "", ""
+		columnSweepClose, columnSweepPostpone, columnSweepApprove, columnSweepDryRun = false, false, false, false
+	}
+
+	t.Run("closes every card with --approve", func(t *testing.T) {
+		mock := newMock()
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+		defer reset()
+
+		columnSweepBoard, columnSweepClose, columnSweepApprove = "123", true, true
+		err := columnSweepCmd.RunE(columnSweepCmd, []string{"ready to close"})
+		assertExitCode(t, err, 0)
+
+		if len(mock.PostCalls) != 2 || mock.PostCalls[0].Path != "/cards/1/closure.json" || mock.PostCalls[1].Path != "/cards/2/closure.json" {
+			t.Fatalf("expected a closure per card, got %+v", mock.PostCalls)
+		}
+		data := result.Response.Data.(map[string]any)
+		if len(data["succeeded"].([]any)) != 2 || data["action"] != "close" {
+			t.Errorf("unexpected result: %v", data)
+		}
+		if result.Response.Summary != `Swept "Ready to close": 2 of 2 cards closed` {
+			t.Errorf("unexpected summary %q", result.Response.Summary)
+		}
+	})
+
+	t.Run("moves to a named column", func(t *testing.T) {
+		mock := newMock()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+		defer reset()
+
+		columnSweepBoard, columnSweepTo, columnSweepApprove = "123", "archive", true
+		err := columnSweepCmd.RunE(columnSweepCmd, []string{"col-1"})
+		assertExitCode(t, err, 0)
+
+		if len(mock.PostCalls) != 2 || mock.PostCalls[0].Path != "/cards/1/triage.json" {
+			t.Fatalf("expected a triage per card, got %+v", mock.PostCalls)
+		}
+	})
+
+	t.Run("requires approval when not interactive", func(t *testing.T) {
+		mock := newMock()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+		defer reset()
+
+		columnSweepBoard, columnSweepPostpone = "123", true
+		err := columnSweepCmd.RunE(columnSweepCmd, []string{"col-1"})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+		if len(mock.PostCalls) != 0 {
+			t.Errorf("expected no changes, got %+v", mock.PostCalls)
+		}
+	})
+
+	t.Run("dry run prints the plan", func(t *testing.T) {
+		mock := newMock()
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+		defer reset()
+
+		columnSweepBoard, columnSweepClose, columnSweepDryRun = "123", true, true
+		err := columnSweepCmd.RunE(columnSweepCmd, []string{"col-1"})
+		assertExitCode(t, err, 0)
+		if len(mock.PostCalls) != 0 {
+			t.Errorf("expected no changes, got %+v", mock.PostCalls)
+		}
+		if result.Response.Summary != `Would close 2 cards in "Ready to close"` {
+			t.Errorf("unexpected summary %q", result.Response.Summary)
+		}
+		plan := result.Response.Data.(map[string]any)["plan"].([]any)
+		if len(plan) != 2 || plan[0].(map[string]any)["change"] != "Ready to close → Done" {
+			t.Errorf("unexpected plan: %v", plan)
+		}
+	})
+
+	t.Run("requires exactly one action", func(t *testing.T) {
+		SetTestModeWithSDK(newMock())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+		defer reset()
+
+		columnSweepBoard, columnSweepClose, columnSweepPostpone = "123", true, true
+		err := columnSweepCmd.RunE(columnSweepCmd, []string{"col-1"})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
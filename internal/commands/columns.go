@@ -14,6 +14,17 @@ var (
 		{Header: "Title", Field: "title"},
 	}
 
+	// stepsProgressColumn is appended to card tables when the cards carry
+	// steps.
+	stepsProgressColumn = render.Column{Header: "Steps", Field: "steps_progress"}
+
+	cardClosureColumns = render.Columns{
+		{Header: "#", Field: "number"},
+		{Header: "Title", Field: "title"},
+		{Header: "Closed By", Field: "closer.name"},
+		{Header: "Closed At", Field: "closed_at"},
+	}
+
 	columnColumns = render.Columns{
 		{Header: "ID", Field: "id"},
 		{Header: "Name", Field: "name"},
@@ -29,6 +40,68 @@ var (
 		{Header: "ID", Field: "id"},
 	}
 
+	statsCLIColumns = render.Columns{
+		{Header: "Command", Field: "command"},
+		{Header: "Runs", Field: "runs"},
+		{Header: "Errors", Field: "errors"},
+		{Header: "Avg ms", Field: "avg_ms"},
+		{Header: "p95 ms", Field: "p95_ms"},
+		{Header: "Max ms", Field: "max_ms"},
+	}
+
+	stepImportColumns = render.Columns{
+		{Header: "Action", Field: "action"},
+		{Header: "Content", Field: "content"},
+		{Header: "Done", Field: "completed"},
+	}
+
+	purgeColumns = render.Columns{
+		{Header: "ID", Field: "id"},
+		{Header: "Pending delete", Field: "description"},
+		{Header: "Purge after", Field: "purge_after"},
+	}
+
+	slaWatchColumns = render.Columns{
+		{Header: "Card", Field: "card"},
+		{Header: "Rule", Field: "rule"},
+		{Header: "Column", Field: "column"},
+		{Header: "Age", Field: "age"},
+		{Header: "New", Field: "new"},
+	}
+
+	rulesProcessColumns = render.Columns{
+		{Header: "Event", Field: "event"},
+		{Header: "Action", Field: "action"},
+		{Header: "Rule", Field: "rule"},
+		{Header: "Card", Field: "card"},
+		{Header: "Status", Field: "status"},
+	}
+
+	cardEventColumns = render.Columns{
+		{Header: "When", Field: "at"},
+		{Header: "Action", Field: "action"},
+		{Header: "By", Field: "by"},
+		{Header: "Description", Field: "description"},
+		{Header: "Detail", Field: "detail"},
+	}
+
+	daemonColumns = render.Columns{
+		{Header: "PID", Field: "pid"},
+		{Header: "Command", Field: "command"},
+		{Header: "Status", Field: "status"},
+		{Header: "Uptime (s)", Field: "uptime_seconds"},
+		{Header: "Last API success", Field: "last_api_success"},
+		{Header: "Queue", Field: "queue_depth"},
+		{Header: "Last error", Field: "last_error"},
+	}
+
+	commentDraftColumns = render.Columns{
+		{Header: "ID", Field: "id"},
+		{Header: "Card", Field: "card"},
+		{Header: "Preview", Field: "preview"},
+		{Header: "Updated", Field: "updated_at"},
+	}
+
 	tagColumns = render.Columns{
 		{Header: "ID", Field: "id"},
 		{Header: "Title", Field: "title"},
@@ -46,6 +119,68 @@ var (
 		{Header: "Base URL", Field: "base_url"},
 	}
 
+	accountColumns = render.Columns{
+		{Header: "Slug", Field: "slug"},
+		{Header: "Name", Field: "name"},
+		{Header: "Active", Field: "active"},
+	}
+
+	lintLinksColumns = render.Columns{
+		{Header: "Card", Field: "card"},
+		{Header: "Source", Field: "source"},
+		{Header: "Status", Field: "status"},
+		{Header: "Code", Field: "status_code"},
+		{Header: "URL", Field: "url"},
+	}
+
+	cardForChangeColumns = render.Columns{
+		{Header: "#", Field: "number"},
+		{Header: "Title", Field: "title"},
+		{Header: "Status", Field: "status"},
+	}
+
+	lintBoardColumns = render.Columns{
+		{Header: "Severity", Field: "severity"},
+		{Header: "Rule", Field: "rule"},
+		{Header: "Card", Field: "card"},
+		{Header: "Column", Field: "column"},
+		{Header: "Message", Field: "message"},
+	}
+
+	migrateVerifyColumns = render.Columns{
+		{Header: "Check", Field: "check"},
+		{Header: "Card", Field: "card"},
+		{Header: "Target", Field: "target_card"},
+		{Header: "Source value", Field: "source"},
+		{Header: "Target value", Field: "target"},
+	}
+
+	boardAccessDiffColumns = render.Columns{
+		{Header: "Change", Field: "change"},
+		{Header: "User", Field: "name"},
+		{Header: "Email", Field: "email_address"},
+		{Header: "Before", Field: "before"},
+		{Header: "After", Field: "after"},
+	}
+
+	devCoverageColumns = render.Columns{
+		{Header: "Status", Field: "status"},
+		{Header: "Method", Field: "method"},
+		{Header: "Path", Field: "path"},
+	}
+
+	historyColumns = render.Columns{
+		{Header: "ID", Field: "id"},
+		{Header: "Command", Field: "command"},
+		{Header: "Result", Field: "result"},
+		{Header: "When", Field: "at"},
+	}
+
+	authHeaderColumns = render.Columns{
+		{Header: "Name", Field: "name"},
+		{Header: "Value", Field: "value"},
+	}
+
 	notificationColumns = render.Columns{
 		{Header: "ID", Field: "id"},
 		{Header: "Message", Field: "message"},
@@ -64,6 +199,25 @@ var (
 
 	searchColumns = cardColumns
 
+	recentSearchColumns = render.Columns{
+		{Header: "Query", Field: "query"},
+	}
+
+	cacheAreaColumns = render.Columns{
+		{Header: "Kind", Field: "kind"},
+		{Header: "Account", Field: "account"},
+		{Header: "Entries", Field: "entries"},
+		{Header: "Bytes", Field: "bytes"},
+		{Header: "Hit Rate", Field: "hit_rate"},
+		{Header: "Oldest", Field: "oldest"},
+	}
+
+	searchAccountsColumns = render.Columns{
+		{Header: "Account", Field: "account"},
+		{Header: "#", Field: "number"},
+		{Header: "Title", Field: "title"},
+	}
+
 	activityColumns = render.Columns{
 		{Header: "ID", Field: "id"},
 		{Header: "Action", Field: "action"},
@@ -71,6 +225,14 @@ var (
 		{Header: "Created", Field: "created_at"},
 	}
 
+	agendaColumns = render.Columns{
+		{Header: "Section", Field: "section"},
+		{Header: "#", Field: "number"},
+		{Header: "Title", Field: "title"},
+		{Header: "Board", Field: "board"},
+		{Header: "Detail", Field: "detail"},
+	}
+
 	attachmentColumns = render.Columns{
 		{Header: "#", Field: "index"},
 		{Header: "Filename", Field: "filename"},
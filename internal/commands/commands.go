@@ -31,10 +31,10 @@ var commandCatalogTitles = map[string]string{
 }
 
 var commandCatalogGroups = map[string][]string{
-	"core":          {"activity", "board", "card", "column", "comment", "search", "step"},
+	"core":          {"activity", "agenda", "board", "card", "column", "comment", "quick", "search", "step"},
 	"collaboration": {"notification", "pin", "reaction", "tag", "user"},
 	"admin":         {"auth", "account", "identity", "token", "webhook", "upload", "migrate"},
-	"utilities":     {"setup", "signup", "completion", "doctor", "config", "skill", "commands", "version"},
+	"utilities":     {"setup", "signup", "completion", "doctor", "lint", "config", "cache", "skill", "commands", "dev", "version"},
 }
 
 var commandCatalogCategory = func() map[string]string {
@@ -46,7 +46,7 @@ func TestCommandsFilterRendersMatchingHumanCatalog(t *testing.T) {
 	if strings.Contains(raw, "board") {
 		t.Fatalf("expected filtered catalog to omit non-matching board command, got:\n%s", raw)
 	}
-	if !strings.Contains(raw, "list, login, logout, status, switch") {
+	if !strings.Contains(raw, "list, login, logout, rotate, status, switch") {
 		t.Fatalf("expected filtered catalog to include action list, got:\n%s", raw)
 	}
 }
@@ -2,8 +2,12 @@ package commands
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/basecamp/fizzy-cli/internal/errors"
 	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
 	"github.com/spf13/cobra"
 )
@@ -18,11 +22,24 @@ var commentCmd = &cobra.Command{
 var commentListCard string
 var commentListPage int
 var commentListAll bool
+var commentListWith []string
+var commentListSince string
+var commentListLast int
+var commentListOrder string
+var commentListAuthor string
 
 var commentListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List comments for a card",
-	Long:  "Lists all comments for a specific card.",
+	Long: `Lists all comments for a specific card.
+
+Use --since to show only comments created at or after a timestamp (or within a
+duration such as 48h), --last to show only the N most recent comments, and
+--author to show only comments by one user (ID, email, or part of their name).
+These fetch every page and filter client-side, so they can't be combined with
+--page. Use --order newest to list the latest comments first. Use --with
+reactions to include each comment's reactions and a reaction_summary count per
+emoji.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
@@ -30,10 +47,43 @@ var commentListCmd = &cobra.Command{
 		if err := checkLimitAll(commentListAll); err != nil {
 			return err
 		}
+		with, err := parseWithOptions(commentListWith, withReactions)
+		if err != nil {
+			return err
+		}
 
 		if commentListCard == "" {
 			return newRequiredFlagError("card")
 		}
+		if commentListLast < 0 {
+			return errors.NewInvalidArgsError("--last must be a positive number")
+		}
+		var since time.Time
+		if commentListSince != "" {
+			since, err = parseCommentSince(commentListSince, time.Now())
+			if err != nil {
+				return err
+			}
+		}
+		var newestFirst bool
+		switch commentListOrder {
+		case "", "oldest":
+		case "newest":
+			newestFirst = true
+		default:
+			return errors.NewInvalidArgsError(fmt.Sprintf("invalid --order %q (use newest or oldest)", commentListOrder))
+		}
+		sel := commentSelection{
+			since:       since,
+			last:        commentListLast,
+			author:      strings.TrimSpace(commentListAuthor),
+			newestFirst: newestFirst,
+		}
+		filtered := !sel.since.IsZero() || sel.last > 0 || sel.author != ""
+		if filtered && commentListPage > 0 {
+			return errors.NewInvalidArgsError("--page cannot be combined with --since, --last, or --author")
+		}
+		fetchAll := commentListAll || filtered
 
 		ac := getSDK()
 		var items any
@@ -44,7 +94,7 @@ var commentListCmd = &cobra.Command{
 			path += "?page=" + strconv.Itoa(commentListPage)
 		}
 
-		if commentListAll {
+		if fetchAll {
 			pages, err := ac.GetAll(cmd.Context(), path)
 			if err != nil {
 				return convertSDKError(err)
@@ -63,9 +113,28 @@ var commentListCmd = &cobra.Command{
 			linkNext = parseSDKLinkNext(resp)
 		}
 
+		if filtered || commentListOrder != "" {
+			items = selectComments(items, sel)
+		}
+
+		if with[withReactions] {
+			if err := attachCommentReactions(cmd.Context(), ac, commentListCard, items); err != nil {
+				return err
+			}
+		}
+
 		// Build summary
 		count := dataCount(items)
 		summary := fmt.Sprintf("%d comments on card #%s", count, commentListCard)
+		if sel.author != "" {
+			summary += fmt.Sprintf(" by %s", sel.author)
+		}
+		if commentListLast > 0 {
+			summary += fmt.Sprintf(" (last %d)", commentListLast)
+		}
+		if !since.IsZero() {
+			summary += fmt.Sprintf(" (since %s)", since.Format(time.RFC3339))
+		}
 		if commentListAll {
 			summary += " (all)"
 		} else if commentListPage > 0 {
@@ -80,11 +149,85 @@ var commentListCmd = &cobra.Command{
 		}
 
 		hasNext := linkNext != ""
-		printListPaginated(items, commentColumns, hasNext, linkNext, commentListAll, summary, breadcrumbs)
+		printListPaginated(items, commentColumns, hasNext, linkNext, fetchAll, summary, breadcrumbs)
 		return nil
 	},
 }
 
+// parseCommentSince accepts an absolute timestamp (RFC 3339 or YYYY-MM-DD) or
+// a duration such as "48h" measured back from now.
+func parseCommentSince(value string, now time.Time) (time.Time, error) {
+	if t, ok := parseFilterTime(value); ok {
+		return t, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d > 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, errors.NewInvalidArgsError(fmt.Sprintf("invalid --since value %q (use a timestamp like 2026-01-02T15:04:05Z, a date, or a duration like 48h)", value))
+}
+
+// commentSelection narrows and orders a card's comments client-side.
+type commentSelection struct {
+	since       time.Time
+	last        int
+	author      string
+	newestFirst bool
+}
+
+// selectComments sorts comments oldest first, keeps those by author and
+// created at or after since (when set), trims to the last N (when last > 0),
+// and finally reverses the result for newest-first output.
+func selectComments(items any, sel commentSelection) any {
+	comments := toMaps(items)
+	sort.SliceStable(comments, func(i, j int) bool {
+		a, _ := comments[i]["created_at"].(string)
+		b, _ := comments[j]["created_at"].(string)
+		at, aok := parseFilterTime(a)
+		bt, bok := parseFilterTime(b)
+		return aok && bok && at.Before(bt)
+	})
+	kept := make([]any, 0, len(comments))
+	for _, comment := range comments {
+		if sel.author != "" && !commentByAuthor(comment, sel.author) {
+			continue
+		}
+		if !sel.since.IsZero() {
+			createdAt, _ := comment["created_at"].(string)
+			t, ok := parseFilterTime(createdAt)
+			if !ok || t.Before(sel.since) {
+				continue
+			}
+		}
+		kept = append(kept, comment)
+	}
+	if sel.last > 0 && len(kept) > sel.last {
+		kept = kept[len(kept)-sel.last:]
+	}
+	if sel.newestFirst {
+		for i, j := 0, len(kept)-1; i < j; i, j = i+1, j-1 {
+			kept[i], kept[j] = kept[j], kept[i]
+		}
+	}
+	return kept
+}
+
+// commentByAuthor matches the comment creator by ID or email address, or by a
+// case-insensitive substring of their name.
+func commentByAuthor(comment map[string]any, author string) bool {
+	creator, _ := comment["creator"].(map[string]any)
+	if creator == nil {
+		return false
+	}
+	if id := fmt.Sprintf("%v", creator["id"]); id == author {
+		return true
+	}
+	if email, _ := creator["email_address"].(string); email != "" && strings.EqualFold(email, author) {
+		return true
+	}
+	name, _ := creator["name"].(string)
+	return name != "" && strings.Contains(strings.ToLower(name), strings.ToLower(author))
+}
+
 // Comment show flags
 var commentShowCard string
 
@@ -128,11 +271,12 @@ var commentCreateBody string
 var commentCreateBodyFile string
 var commentCreateAttach []string
 var commentCreateCreatedAt string
+var commentCreateJSONInput string
 
 var commentCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a comment",
-	Long:  "Creates a new comment on a card. Use --attach for simple end-appended inline attachments. For precise placement, upload files first and embed <action-text-attachment> tags manually in --body or --body_file.",
+	Long:  "Creates a new comment on a card. Use --attach for simple end-appended inline attachments. For precise placement, upload files first and embed <action-text-attachment> tags manually in --body or --body_file.\n\n--json-input reads the request body (e.g. {\"body\": \"<p>…</p>\"}) as JSON from a file, or from stdin with -. Flags given alongside override its fields.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
@@ -142,10 +286,20 @@ var commentCreateCmd = &cobra.Command{
 			return newRequiredFlagError("card")
 		}
 
+		req := &generated.CreateCommentRequest{}
+		if commentCreateJSONInput != "" {
+			if err := readJSONInput(cmd, commentCreateJSONInput, req); err != nil {
+				return err
+			}
+		}
+
 		body, err := resolveRichTextContent(commentCreateBody, commentCreateBodyFile)
 		if err != nil {
 			return err
 		}
+		if body == "" {
+			body = req.Body
+		}
 		body, err = appendInlineAttachmentsToContent(body, commentCreateAttach)
 		if err != nil {
 			return err
@@ -163,7 +317,7 @@ var commentCreateCmd = &cobra.Command{
 			breadcrumb("show", fmt.Sprintf("fizzy card show %s", cardNumber), "View card"),
 		}
 
-		req := &generated.CreateCommentRequest{Body: body}
+		req.Body = body
 		if commentCreateCreatedAt != "" {
 			req.CreatedAt = commentCreateCreatedAt
 		}
@@ -187,11 +341,12 @@ var commentUpdateCard string
 var commentUpdateBody string
 var commentUpdateBodyFile string
 var commentUpdateAttach []string
+var commentUpdateJSONInput string
 
 var commentUpdateCmd = &cobra.Command{
 	Use:   "update COMMENT_ID",
 	Short: "Update a comment",
-	Long:  "Updates an existing comment. Use --attach for simple end-appended inline attachments. For precise placement, upload files first and embed <action-text-attachment> tags manually in --body or --body_file.",
+	Long:  "Updates an existing comment. Use --attach for simple end-appended inline attachments. For precise placement, upload files first and embed <action-text-attachment> tags manually in --body or --body_file.\n\n--json-input reads the request body as JSON from a file, or from stdin with -. Flags given alongside override its fields.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
@@ -205,11 +360,21 @@ var commentUpdateCmd = &cobra.Command{
 		commentID := args[0]
 		cardNumber := commentUpdateCard
 
-		hasBodyInput := commentUpdateBody != "" || commentUpdateBodyFile != ""
+		req := &generated.UpdateCommentRequest{}
+		if commentUpdateJSONInput != "" {
+			if err := readJSONInput(cmd, commentUpdateJSONInput, req); err != nil {
+				return err
+			}
+		}
+
+		hasBodyInput := commentUpdateBody != "" || commentUpdateBodyFile != "" || req.Body != ""
 		body, err := resolveRichTextContent(commentUpdateBody, commentUpdateBodyFile)
 		if err != nil {
 			return err
 		}
+		if body == "" {
+			body = req.Body
+		}
 		if len(commentUpdateAttach) > 0 && !hasBodyInput {
 			currentData, _, getErr := getSDK().Comments().Get(cmd.Context(), cardNumber, commentID)
 			if getErr != nil {
@@ -228,7 +393,6 @@ var commentUpdateCmd = &cobra.Command{
 			return err
 		}
 
-		req := &generated.UpdateCommentRequest{}
 		if body != "" {
 			req.Body = body
 		}
@@ -267,6 +431,10 @@ var commentDeleteCmd = &cobra.Command{
 
 		cardNumber := commentDeleteCard
 
+		if deferred, err := deferDelete(cmd, tombstone{Kind: "comment", Target: args[0], Parent: cardNumber}); deferred || err != nil {
+			return err
+		}
+
 		_, err := getSDK().Comments().Delete(cmd.Context(), cardNumber, args[0])
 		if err != nil {
 			return convertSDKError(err)
@@ -292,6 +460,11 @@ func init() {
 	commentListCmd.Flags().StringVar(&commentListCard, "card", "", "Card number (required)")
 	commentListCmd.Flags().IntVar(&commentListPage, "page", 0, "Page number")
 	commentListCmd.Flags().BoolVar(&commentListAll, "all", false, "Fetch all pages")
+	commentListCmd.Flags().StringVar(&commentListSince, "since", "", "Only comments created at or after TIMESTAMP (RFC 3339, YYYY-MM-DD, or a duration like 48h)")
+	commentListCmd.Flags().IntVar(&commentListLast, "last", 0, "Only the N most recent comments")
+	commentListCmd.Flags().StringVar(&commentListOrder, "order", "", "Sort order: oldest (default) or newest")
+	commentListCmd.Flags().StringVar(&commentListAuthor, "author", "", "Only comments by USER (ID, email, or part of their name)")
+	commentListCmd.Flags().StringSliceVar(&commentListWith, "with", nil, "Include related data: reactions")
 	commentCmd.AddCommand(commentListCmd)
 
 	// Show
@@ -304,6 +477,7 @@ func init() {
 	commentCreateCmd.Flags().StringVar(&commentCreateBodyFile, "body_file", "", "Read body from file (markdown or HTML)")
 	commentCreateCmd.Flags().StringArrayVar(&commentCreateAttach, "attach", nil, "Upload and append inline attachment at the end of the body. Repeatable.")
 	commentCreateCmd.Flags().StringVar(&commentCreateCreatedAt, "created-at", "", "Custom created_at timestamp")
+	commentCreateCmd.Flags().StringVar(&commentCreateJSONInput, "json-input", "", jsonInputUsage)
 	commentCmd.AddCommand(commentCreateCmd)
 
 	// Update
@@ -311,9 +485,11 @@ func init() {
 	commentUpdateCmd.Flags().StringVar(&commentUpdateBody, "body", "", "Comment body (markdown or HTML)")
 	commentUpdateCmd.Flags().StringVar(&commentUpdateBodyFile, "body_file", "", "Read body from file (markdown or HTML)")
 	commentUpdateCmd.Flags().StringArrayVar(&commentUpdateAttach, "attach", nil, "Upload and append inline attachment at the end of the body. Repeatable.")
+	commentUpdateCmd.Flags().StringVar(&commentUpdateJSONInput, "json-input", "", jsonInputUsage)
 	commentCmd.AddCommand(commentUpdateCmd)
 
 	// Delete
 	commentDeleteCmd.Flags().StringVar(&commentDeleteCard, "card", "", "Card number (required)")
+	addDeleteNowFlag(commentDeleteCmd)
 	commentCmd.AddCommand(commentDeleteCmd)
 }
@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
+	"github.com/spf13/cobra"
+)
+
+// Comment ack flags
+var commentAckCard string
+var commentAckAll bool
+var commentAckContent string
+
+var commentAckCmd = &cobra.Command{
+	Use:   "ack",
+	Short: "Mark comments as seen with a reaction",
+	Long: `Reacts to the latest comment on a card (👀 by default) as a quick "seen".
+
+With --all, reacts to every comment since the last one you reacted to, so a
+busy thread can be acknowledged in one go. Your own comments and comments you
+have already reacted to are skipped.`,
+	Example: `  fizzy comment ack --card 42
+  fizzy comment ack --card 42 --all
+  fizzy comment ack --card 42 --all --content "👍"`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+		if commentAckCard == "" {
+			return newRequiredFlagError("card")
+		}
+		if commentAckContent == "" {
+			return newRequiredFlagError("content")
+		}
+
+		me, err := currentUserID(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		ac := getSDK()
+		pages, err := ac.GetAll(cmd.Context(), "/cards/"+commentAckCard+"/comments.json")
+		if err != nil {
+			return convertSDKError(err)
+		}
+		comments := toMaps(selectComments(jsonAnySlice(pages), commentSelection{}))
+
+		// Walk back from the newest comment to the last one already reacted
+		// to, collecting the comments to acknowledge.
+		var pending []map[string]any
+		for i := len(comments) - 1; i >= 0; i-- {
+			comment := comments[i]
+			if creator, _ := comment["creator"].(map[string]any); fmt.Sprint(creator["id"]) == me {
+				continue
+			}
+			commentID := fmt.Sprint(comment["id"])
+			data, _, err := ac.Reactions().ListComment(cmd.Context(), commentAckCard, commentID)
+			if err != nil {
+				return convertSDKError(err)
+			}
+			if reactedBy(normalizeAny(data), me) {
+				break
+			}
+			pending = append(pending, comment)
+			if !commentAckAll {
+				break
+			}
+		}
+
+		var result bulkResult
+		for i := len(pending) - 1; i >= 0; i-- {
+			commentID := fmt.Sprint(pending[i]["id"])
+			req := &generated.CreateCommentReactionRequest{Content: commentAckContent}
+			if _, _, err := ac.Reactions().CreateComment(cmd.Context(), commentAckCard, commentID, req); err != nil {
+				if result.fail(commentID, err) {
+					for j := i - 1; j >= 0; j-- {
+						result.skip(fmt.Sprint(pending[j]["id"]))
+					}
+					break
+				}
+				continue
+			}
+			result.succeed(commentID)
+		}
+
+		summary := fmt.Sprintf("Acknowledged %d %s on card #%s", len(result.succeeded), pluralize(len(result.succeeded), "comment", "comments"), commentAckCard)
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("comments", fmt.Sprintf("fizzy comment list --card %s --with reactions", commentAckCard), "View comments"),
+			breadcrumb("show", fmt.Sprintf("fizzy card show %s", commentAckCard), "View card"),
+		}
+		data := map[string]any{"card": commentAckCard, "content": commentAckContent}
+		return printBulkResult(data, &result, "comments", summary, breadcrumbs)
+	},
+}
+
+// reactedBy reports whether any of reactions was made by userID.
+func reactedBy(reactions any, userID string) bool {
+	for _, reaction := range toMaps(reactions) {
+		if reacter, _ := reaction["reacter"].(map[string]any); fmt.Sprint(reacter["id"]) == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	commentAckCmd.Flags().StringVar(&commentAckCard, "card", "", "Card number (required)")
+	commentAckCmd.Flags().BoolVar(&commentAckAll, "all", false, "Acknowledge every comment since your last reaction")
+	commentAckCmd.Flags().StringVar(&commentAckContent, "content", "👀", "Reaction to add")
+	commentCmd.AddCommand(commentAckCmd)
+}
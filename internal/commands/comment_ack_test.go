@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+)
+
+func TestCommentAck(t *testing.T) {
+	comment := func(id, creator, createdAt string) map[string]any {
+		return map[string]any{"id": id, "created_at": createdAt, "creator": map[string]any{"id": creator}}
+	}
+	mine := []any{map[string]any{"content": "👀", "reacter": map[string]any{"id": "me"}}}
+
+	setup := func(t *testing.T) *MockClient {
+		t.Helper()
+		mock := NewMockClient()
+		mock.OnGet("/my/identity.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+			"accounts": []any{map[string]any{"id": "1", "slug": "/account", "user": map[string]any{"id": "me"}}},
+		}})
+		mock.OnGet("/cards/42/comments.json", &client.APIResponse{StatusCode: 200, Data: []any{
+			comment("c4", "bob", "2026-01-04T00:00:00Z"),
+			comment("c1", "bob", "2026-01-01T00:00:00Z"),
+			comment("c2", "bob", "2026-01-02T00:00:00Z"),
+			comment("c3", "me", "2026-01-03T00:00:00Z"),
+			comment("c5", "ann", "2026-01-05T00:00:00Z"),
+		}})
+		mock.OnGet("/cards/42/comments/c1/reactions.json", &client.APIResponse{StatusCode: 200, Data: mine})
+		for _, id := range []string{"c2", "c4", "c5"} {
+			mock.OnGet("/cards/42/comments/"+id+"/reactions.json", &client.APIResponse{StatusCode: 200, Data: []any{}})
+		}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		t.Cleanup(resetTest)
+
+		commentAckCard = "42"
+		t.Cleanup(func() { commentAckCard, commentAckAll = "", false })
+		return mock
+	}
+	reacted := func(mock *MockClient) []string {
+		var paths []string
+		for _, call := range mock.PostCalls {
+			paths = append(paths, call.Path)
+		}
+		return paths
+	}
+
+	t.Run("acknowledges the latest comment", func(t *testing.T) {
+		mock := setup(t)
+		err := commentAckCmd.RunE(commentAckCmd, nil)
+		assertExitCode(t, err, 0)
+		if got := reacted(mock); len(got) != 1 || got[0] != "/cards/42/comments/c5/reactions.json" {
+			t.Errorf("expected a reaction on c5 only, got %v", got)
+		}
+	})
+
+	t.Run("--all acknowledges everything since the last reaction", func(t *testing.T) {
+		mock := setup(t)
+		commentAckAll = true
+		err := commentAckCmd.RunE(commentAckCmd, nil)
+		assertExitCode(t, err, 0)
+		want := []string{"/cards/42/comments/c2/reactions.json", "/cards/42/comments/c4/reactions.json", "/cards/42/comments/c5/reactions.json"}
+		got := reacted(mock)
+		if len(got) != len(want) {
+			t.Fatalf("expected reactions on %v, got %v", want, got)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("reaction %d: expected %s, got %s", i, want[i], got[i])
+			}
+		}
+		if body := mock.PostCalls[0].Body.(map[string]any); body["content"] != "👀" {
+			t.Errorf("expected the default 👀 reaction, got %v", body)
+		}
+	})
+}
@@ -74,7 +74,8 @@ When downloading a single attachment, -o sets the exact output filename.
 When downloading multiple attachments, -o sets a prefix (e.g. -o test produces test_1.png, test_2.png).
 
 Use 'fizzy comment attachments show --card CARD_NUMBER' to see available attachments and their indices.`,
-	Args: cobra.MaximumNArgs(1),
+	Args:        cobra.MaximumNArgs(1),
+	Annotations: map[string]string{downloadOutputAnnotation: "true"},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
@@ -185,6 +186,6 @@ func init() {
 
 	// Download
 	commentAttachmentsDownloadCmd.Flags().StringVar(&commentAttachmentsDownloadCard, "card", "", "Card number (required)")
-	commentAttachmentsDownloadCmd.Flags().StringVarP(&commentAttachmentsDownloadOutput, "output", "o", "", "Output filename (single file) or prefix (multiple files, e.g. -o test produces test_1.png)")
+	commentAttachmentsDownloadCmd.Flags().StringVar(&commentAttachmentsDownloadOutput, "output", "", "Output filename (single file) or prefix (multiple files, e.g. -o test produces test_1.png)")
 	commentAttachmentsCmd.AddCommand(commentAttachmentsDownloadCmd)
 }
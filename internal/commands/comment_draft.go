@@ -0,0 +1,341 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/state"
+	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
+	"github.com/spf13/cobra"
+)
+
+var commentDraftCmd = &cobra.Command{
+	Use:   "draft",
+	Short: "Write comments offline and post them later",
+	Long: `Keeps comment drafts on this machine so long comments can be written over
+time and posted when they're ready. Drafts are Markdown, stored per account
+with the rest of fizzy's local state, so they survive reboots and network
+outages; nothing is sent until 'fizzy comment draft post'.
+
+  fizzy comment draft new --card 42          # opens $EDITOR
+  fizzy comment draft edit 1
+  fizzy comment draft post 1`,
+}
+
+// commentDraft is a locally saved comment waiting to be posted.
+type commentDraft struct {
+	ID        int    `json:"id"`
+	Card      string `json:"card"`
+	Body      string `json:"body"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// commentDrafts is the draft store of one account.
+type commentDrafts struct {
+	NextID int            `json:"next_id"`
+	Drafts []commentDraft `json:"drafts"`
+}
+
+// Comment draft new flags
+var commentDraftNewCard string
+var commentDraftNewBody string
+var commentDraftNewBodyFile string
+
+var commentDraftNewCmd = &cobra.Command{
+	Use:   "new",
+	Short: "Start a comment draft",
+	Long:  "Saves a new comment draft for a card. Without --body or --body_file, the draft is written in $VISUAL or $EDITOR.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+		if commentDraftNewCard == "" {
+			return newRequiredFlagError("card")
+		}
+
+		body, err := draftBody(commentDraftNewBody, commentDraftNewBodyFile, "")
+		if err != nil {
+			return err
+		}
+
+		drafts, err := loadCommentDrafts()
+		if err != nil {
+			return err
+		}
+		drafts.NextID++
+		now := time.Now().UTC().Format(time.RFC3339)
+		draft := commentDraft{ID: drafts.NextID, Card: commentDraftNewCard, Body: body, CreatedAt: now, UpdatedAt: now}
+		drafts.Drafts = append(drafts.Drafts, draft)
+		if err := saveCommentDrafts(drafts); err != nil {
+			return err
+		}
+
+		printMutation(draftData(draft), fmt.Sprintf("Draft %d saved for card #%s", draft.ID, draft.Card), draftBreadcrumbs(draft))
+		return nil
+	},
+}
+
+// Comment draft edit flags
+var commentDraftEditBody string
+var commentDraftEditBodyFile string
+
+var commentDraftEditCmd = &cobra.Command{
+	Use:   "edit DRAFT_ID",
+	Short: "Edit a comment draft",
+	Long:  "Opens a comment draft in $VISUAL or $EDITOR, or replaces its body with --body or --body_file.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		drafts, err := loadCommentDrafts()
+		if err != nil {
+			return err
+		}
+		i, err := findCommentDraft(drafts, args[0])
+		if err != nil {
+			return err
+		}
+
+		body, err := draftBody(commentDraftEditBody, commentDraftEditBodyFile, drafts.Drafts[i].Body)
+		if err != nil {
+			return err
+		}
+
+		// Other drafts may have been saved or sent while the editor was
+		// open, so only this draft is updated in the current list.
+		if drafts, err = loadCommentDrafts(); err != nil {
+			return err
+		}
+		if i, err = findCommentDraft(drafts, args[0]); err != nil {
+			return err
+		}
+		drafts.Drafts[i].Body = body
+		drafts.Drafts[i].UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+		if err := saveCommentDrafts(drafts); err != nil {
+			return err
+		}
+
+		draft := drafts.Drafts[i]
+		printMutation(draftData(draft), fmt.Sprintf("Draft %d saved for card #%s", draft.ID, draft.Card), draftBreadcrumbs(draft))
+		return nil
+	},
+}
+
+// Comment draft list flags
+var commentDraftListCard string
+
+var commentDraftListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List comment drafts",
+	Long:  "Lists the comment drafts saved on this machine for the active account, oldest first.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		drafts, err := loadCommentDrafts()
+		if err != nil {
+			return err
+		}
+		items := []any{}
+		for _, draft := range drafts.Drafts {
+			if commentDraftListCard == "" || draft.Card == commentDraftListCard {
+				items = append(items, draftData(draft))
+			}
+		}
+
+		summary := fmt.Sprintf("%d %s", len(items), pluralize(len(items), "draft", "drafts"))
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("new", "fizzy comment draft new --card <number>", "Start a draft"),
+		}
+		printList(items, commentDraftColumns, summary, breadcrumbs)
+		return nil
+	},
+}
+
+var commentDraftPostCmd = &cobra.Command{
+	Use:   "post DRAFT_ID",
+	Short: "Post a comment draft",
+	Long:  "Posts a comment draft to its card and removes it from the local drafts. If posting fails, the draft is kept so it can be posted again.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		drafts, err := loadCommentDrafts()
+		if err != nil {
+			return err
+		}
+		i, err := findCommentDraft(drafts, args[0])
+		if err != nil {
+			return err
+		}
+		draft := drafts.Drafts[i]
+
+		req := &generated.CreateCommentRequest{Body: markdownToHTML(draft.Body)}
+		data, resp, err := getSDK().Comments().Create(cmd.Context(), draft.Card, req)
+		if err != nil {
+			return convertSDKError(err)
+		}
+
+		drafts.Drafts = slices.Delete(drafts.Drafts, i, i+1)
+		if err := saveCommentDrafts(drafts); err != nil {
+			warnf("Warning: Comment posted, but draft %d could not be removed: %v\n", draft.ID, err)
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("comments", fmt.Sprintf("fizzy comment list --card %s", draft.Card), "List comments"),
+			breadcrumb("show", fmt.Sprintf("fizzy card show %s", draft.Card), "View card"),
+		}
+		items := normalizeAny(data)
+		if location := resp.Headers.Get("Location"); location != "" {
+			printMutationWithLocation(items, location, breadcrumbs)
+		} else {
+			printMutation(items, "", breadcrumbs)
+		}
+		return nil
+	},
+}
+
+var commentDraftDeleteCmd = &cobra.Command{
+	Use:   "delete DRAFT_ID",
+	Short: "Discard a comment draft",
+	Long:  "Discards a comment draft without posting it.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		drafts, err := loadCommentDrafts()
+		if err != nil {
+			return err
+		}
+		i, err := findCommentDraft(drafts, args[0])
+		if err != nil {
+			return err
+		}
+		draft := drafts.Drafts[i]
+		drafts.Drafts = slices.Delete(drafts.Drafts, i, i+1)
+		if err := saveCommentDrafts(drafts); err != nil {
+			return err
+		}
+
+		printMutation(map[string]any{"id": draft.ID, "deleted": true}, fmt.Sprintf("Draft %d discarded", draft.ID), []Breadcrumb{
+			breadcrumb("drafts", "fizzy comment draft list", "List drafts"),
+		})
+		return nil
+	},
+}
+
+func commentDraftsKey() string {
+	return "drafts/" + cfg.Account + "/comments"
+}
+
+func loadCommentDrafts() (*commentDrafts, error) {
+	drafts := &commentDrafts{}
+	if _, err := state.Load(commentDraftsKey(), drafts); err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not read comment drafts: %v", err))
+	}
+	return drafts, nil
+}
+
+func saveCommentDrafts(drafts *commentDrafts) error {
+	if err := state.Save(commentDraftsKey(), drafts); err != nil {
+		return errors.NewError(fmt.Sprintf("Could not save comment drafts: %v", err))
+	}
+	return nil
+}
+
+// findCommentDraft returns the index of the draft with the given ID.
+func findCommentDraft(drafts *commentDrafts, id string) (int, error) {
+	n, err := strconv.Atoi(id)
+	if err == nil {
+		for i, draft := range drafts.Drafts {
+			if draft.ID == n {
+				return i, nil
+			}
+		}
+	}
+	return 0, errors.NewNotFoundError(fmt.Sprintf("Comment draft %s not found; see 'fizzy comment draft list'", id))
+}
+
+// draftBody returns a draft's new body from --body, --body_file, or the
+// editor opened on current. An empty body is refused so a draft is never
+// lost to an accidentally cleared editor.
+func draftBody(body, bodyFile, current string) (string, error) {
+	switch {
+	case body != "":
+	case bodyFile != "":
+		content, err := os.ReadFile(bodyFile)
+		if err != nil {
+			return "", errors.NewInvalidArgsError(fmt.Sprintf("cannot read --body_file: %v", err))
+		}
+		body = string(content)
+	default:
+		edited, file, err := editText(current, "fizzy-draft-*.md", nil)
+		if err != nil {
+			return "", err
+		}
+		file.Remove()
+		body = edited
+	}
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", errors.NewInvalidArgsError("the draft is empty; nothing was saved")
+	}
+	return body, nil
+}
+
+// draftData is a draft as command output, with a one-line preview.
+func draftData(draft commentDraft) map[string]any {
+	preview, _, _ := strings.Cut(draft.Body, "\n")
+	if len([]rune(preview)) > 60 {
+		preview = string([]rune(preview)[:59]) + "…"
+	}
+	return map[string]any{
+		"id":         draft.ID,
+		"card":       draft.Card,
+		"body":       draft.Body,
+		"preview":    preview,
+		"created_at": draft.CreatedAt,
+		"updated_at": draft.UpdatedAt,
+	}
+}
+
+func draftBreadcrumbs(draft commentDraft) []Breadcrumb {
+	return []Breadcrumb{
+		breadcrumb("edit", fmt.Sprintf("fizzy comment draft edit %d", draft.ID), "Keep writing"),
+		breadcrumb("post", fmt.Sprintf("fizzy comment draft post %d", draft.ID), "Post the comment"),
+	}
+}
+
+func init() {
+	commentCmd.AddCommand(commentDraftCmd)
+
+	commentDraftNewCmd.Flags().StringVar(&commentDraftNewCard, "card", "", "Card number (required)")
+	commentDraftNewCmd.Flags().StringVar(&commentDraftNewBody, "body", "", "Draft body (markdown)")
+	commentDraftNewCmd.Flags().StringVar(&commentDraftNewBodyFile, "body_file", "", "Read the draft body from a file")
+	commentDraftCmd.AddCommand(commentDraftNewCmd)
+
+	commentDraftEditCmd.Flags().StringVar(&commentDraftEditBody, "body", "", "Replace the draft body (markdown)")
+	commentDraftEditCmd.Flags().StringVar(&commentDraftEditBodyFile, "body_file", "", "Replace the draft body from a file")
+	commentDraftCmd.AddCommand(commentDraftEditCmd)
+
+	commentDraftListCmd.Flags().StringVar(&commentDraftListCard, "card", "", "Only drafts for this card number")
+	commentDraftCmd.AddCommand(commentDraftListCmd)
+
+	commentDraftCmd.AddCommand(commentDraftPostCmd)
+	commentDraftCmd.AddCommand(commentDraftDeleteCmd)
+}
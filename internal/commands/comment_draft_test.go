@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestCommentDrafts(t *testing.T) {
+	mock := NewMockClient()
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	// Start a draft from a flag, then finish it in the editor.
+	commentDraftNewCard = "42"
+	commentDraftNewBody = "First thoughts"
+	err := commentDraftNewCmd.RunE(commentDraftNewCmd, []string{})
+	commentDraftNewCard = ""
+	commentDraftNewBody = ""
+	assertExitCode(t, err, 0)
+
+	defer func(orig func(string) error) { runEditor = orig }(runEditor)
+	runEditor = func(path string) error {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(path, append(content, "\n\nMore **detail**"...), 0o600)
+	}
+	err = commentDraftEditCmd.RunE(commentDraftEditCmd, []string{"1"})
+	assertExitCode(t, err, 0)
+
+	err = commentDraftListCmd.RunE(commentDraftListCmd, []string{})
+	assertExitCode(t, err, 0)
+	drafts := toMaps(result.Response.Data)
+	if len(drafts) != 1 || drafts[0]["body"] != "First thoughts\n\nMore **detail**" || drafts[0]["preview"] != "First thoughts" {
+		t.Fatalf("unexpected drafts: %v", drafts)
+	}
+
+	t.Run("a failed post keeps the draft", func(t *testing.T) {
+		mock.PostError = errors.NewNetworkError("connection refused")
+		defer func() { mock.PostError = nil }()
+
+		err := commentDraftPostCmd.RunE(commentDraftPostCmd, []string{"1"})
+		if err == nil {
+			t.Fatal("expected the post to fail")
+		}
+		stored, _ := loadCommentDrafts()
+		if len(stored.Drafts) != 1 {
+			t.Errorf("expected the draft to be kept, got %v", stored.Drafts)
+		}
+	})
+
+	t.Run("post sends markdown as HTML and removes the draft", func(t *testing.T) {
+		mock.PostResponse = &client.APIResponse{StatusCode: 201, Data: map[string]any{"id": "comment-1"}}
+
+		err := commentDraftPostCmd.RunE(commentDraftPostCmd, []string{"1"})
+		assertExitCode(t, err, 0)
+
+		call := mock.PostCalls[len(mock.PostCalls)-1]
+		if call.Path != "/cards/42/comments.json" {
+			t.Errorf("expected path '/cards/42/comments.json', got '%s'", call.Path)
+		}
+		body := call.Body.(map[string]any)
+		if body["body"] != markdownToHTML("First thoughts\n\nMore **detail**") {
+			t.Errorf("unexpected body %v", body["body"])
+		}
+		stored, _ := loadCommentDrafts()
+		if len(stored.Drafts) != 0 {
+			t.Errorf("expected the draft to be removed, got %v", stored.Drafts)
+		}
+	})
+
+	t.Run("rejects an empty draft and unknown IDs", func(t *testing.T) {
+		runEditor = func(path string) error { return os.WriteFile(path, []byte("  \n"), 0o600) }
+		commentDraftNewCard = "42"
+		err := commentDraftNewCmd.RunE(commentDraftNewCmd, []string{})
+		commentDraftNewCard = ""
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+
+		err = commentDraftPostCmd.RunE(commentDraftPostCmd, []string{"7"})
+		assertExitCode(t, err, errors.ExitNotFound)
+	})
+
+	t.Run("edit keeps drafts saved while the editor was open", func(t *testing.T) {
+		commentDraftNewCard, commentDraftNewBody = "42", "Second"
+		err := commentDraftNewCmd.RunE(commentDraftNewCmd, []string{})
+		commentDraftNewCard, commentDraftNewBody = "", ""
+		assertExitCode(t, err, 0)
+		id := fmt.Sprint(result.Response.Data.(map[string]any)["id"])
+
+		runEditor = func(path string) error {
+			commentDraftNewCard, commentDraftNewBody = "7", "Written meanwhile"
+			defer func() { commentDraftNewCard, commentDraftNewBody = "", "" }()
+			if err := commentDraftNewCmd.RunE(commentDraftNewCmd, []string{}); err != nil {
+				return err
+			}
+			return os.WriteFile(path, []byte("Second, edited"), 0o600)
+		}
+		err = commentDraftEditCmd.RunE(commentDraftEditCmd, []string{id})
+		assertExitCode(t, err, 0)
+
+		stored, _ := loadCommentDrafts()
+		if len(stored.Drafts) != 2 || stored.Drafts[0].Body != "Second, edited" || stored.Drafts[1].Body != "Written meanwhile" {
+			t.Errorf("expected the edit and the new draft both kept, got %+v", stored.Drafts)
+		}
+	})
+}
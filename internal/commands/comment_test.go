@@ -3,6 +3,7 @@ package commands
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/basecamp/fizzy-cli/internal/client"
 	"github.com/basecamp/fizzy-cli/internal/errors"
@@ -86,6 +87,187 @@ func TestCommentList(t *testing.T) {
 		}
 	})
 
+	t.Run("with reactions adds reaction summary per comment", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards/42/comments.json", &client.APIResponse{
+			StatusCode: 200,
+			Data:       []any{map[string]any{"id": "1"}, map[string]any{"id": "2"}},
+		})
+		mock.OnGet("/cards/42/comments/1/reactions.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"id": "r1", "content": "👍"},
+				map[string]any{"id": "r2", "content": "👍"},
+			},
+		})
+		mock.OnGet("/cards/42/comments/2/reactions.json", &client.APIResponse{
+			StatusCode: 200,
+			Data:       []any{map[string]any{"id": "r9", "content": "🎉"}},
+		})
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		commentListCard = "42"
+		commentListWith = []string{"reactions"}
+		err := commentListCmd.RunE(commentListCmd, []string{})
+		commentListCard = ""
+		commentListWith = nil
+
+		assertExitCode(t, err, 0)
+		comments := result.Response.Data.([]any)
+		first := comments[0].(map[string]any)["reaction_summary"].(map[string]any)
+		if first["👍"] != float64(2) {
+			t.Errorf("expected 2 thumbs up on comment 1, got %v", first)
+		}
+		second := comments[1].(map[string]any)["reaction_summary"].(map[string]any)
+		if second["🎉"] != float64(1) {
+			t.Errorf("expected 1 tada on comment 2, got %v", second)
+		}
+	})
+
+	t.Run("last keeps the most recent comments across all pages", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetWithPaginationResponse = &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"id": "1", "created_at": "2026-01-01T10:00:00Z"},
+				map[string]any{"id": "3", "created_at": "2026-01-03T10:00:00Z"},
+				map[string]any{"id": "2", "created_at": "2026-01-02T10:00:00Z"},
+			},
+		}
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		commentListCard = "42"
+		commentListLast = 2
+		err := commentListCmd.RunE(commentListCmd, []string{})
+		commentListCard = ""
+		commentListLast = 0
+
+		assertExitCode(t, err, 0)
+		if len(mock.GetWithPaginationCalls) != 1 || mock.GetWithPaginationCalls[0].Path != "/cards/42/comments.json" {
+			t.Fatalf("expected all pages to be fetched, got %+v", mock.GetWithPaginationCalls)
+		}
+		comments := result.Response.Data.([]any)
+		if len(comments) != 2 {
+			t.Fatalf("expected 2 comments, got %d", len(comments))
+		}
+		if comments[0].(map[string]any)["id"] != "2" || comments[1].(map[string]any)["id"] != "3" {
+			t.Errorf("expected comments 2 and 3 oldest first, got %v", comments)
+		}
+	})
+
+	t.Run("since filters by created_at", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetWithPaginationResponse = &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"id": "1", "created_at": "2026-01-01T10:00:00Z"},
+				map[string]any{"id": "2", "created_at": "2026-01-02T10:00:00Z"},
+				map[string]any{"id": "3", "created_at": "2026-01-03T10:00:00Z"},
+			},
+		}
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		commentListCard = "42"
+		commentListSince = "2026-01-02"
+		err := commentListCmd.RunE(commentListCmd, []string{})
+		commentListCard = ""
+		commentListSince = ""
+
+		assertExitCode(t, err, 0)
+		if comments := result.Response.Data.([]any); len(comments) != 2 {
+			t.Errorf("expected 2 comments since 2026-01-02, got %d", len(comments))
+		}
+	})
+
+	t.Run("author and newest order", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetWithPaginationResponse = &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"id": "1", "created_at": "2026-01-01T10:00:00Z", "creator": map[string]any{"id": "u1", "name": "Ada Lovelace"}},
+				map[string]any{"id": "2", "created_at": "2026-01-02T10:00:00Z", "creator": map[string]any{"id": "u2", "name": "Grace Hopper"}},
+				map[string]any{"id": "3", "created_at": "2026-01-03T10:00:00Z", "creator": map[string]any{"id": "u1", "name": "Ada Lovelace"}},
+			},
+		}
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		commentListCard = "42"
+		commentListAuthor = "ada"
+		commentListOrder = "newest"
+		err := commentListCmd.RunE(commentListCmd, []string{})
+		commentListCard = ""
+		commentListAuthor = ""
+		commentListOrder = ""
+
+		assertExitCode(t, err, 0)
+		comments := result.Response.Data.([]any)
+		if len(comments) != 2 {
+			t.Fatalf("expected 2 comments by Ada, got %d", len(comments))
+		}
+		if comments[0].(map[string]any)["id"] != "3" || comments[1].(map[string]any)["id"] != "1" {
+			t.Errorf("expected comments 3 then 1, got %v", comments)
+		}
+	})
+
+	t.Run("rejects invalid order", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		commentListCard = "42"
+		commentListOrder = "sideways"
+		err := commentListCmd.RunE(commentListCmd, []string{})
+		commentListCard = ""
+		commentListOrder = ""
+
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+
+	t.Run("rejects since combined with page", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		commentListCard = "42"
+		commentListSince = "48h"
+		commentListPage = 2
+		err := commentListCmd.RunE(commentListCmd, []string{})
+		commentListCard = ""
+		commentListSince = ""
+		commentListPage = 0
+
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+
+	t.Run("rejects invalid since", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		commentListCard = "42"
+		commentListSince = "last tuesday"
+		err := commentListCmd.RunE(commentListCmd, []string{})
+		commentListCard = ""
+		commentListSince = ""
+
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+
 	t.Run("requires card flag for list", func(t *testing.T) {
 		mock := NewMockClient()
 		SetTestModeWithSDK(mock)
@@ -98,6 +280,22 @@ func TestCommentList(t *testing.T) {
 	})
 }
 
+func TestParseCommentSince(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	got, err := parseCommentSince("48h", now)
+	if err != nil || !got.Equal(now.Add(-48*time.Hour)) {
+		t.Errorf("expected 48h before now, got %v (%v)", got, err)
+	}
+	got, err = parseCommentSince("2026-03-01T09:30:00Z", now)
+	if err != nil || !got.Equal(time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)) {
+		t.Errorf("expected absolute timestamp, got %v (%v)", got, err)
+	}
+	if _, err := parseCommentSince("-5h", now); err == nil {
+		t.Error("expected negative duration to be rejected")
+	}
+}
+
 func TestCommentShow(t *testing.T) {
 	t.Run("shows comment by ID", func(t *testing.T) {
 		mock := NewMockClient()
@@ -1,8 +1,10 @@
 package commands
 
 import (
+	"bytes"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/basecamp/cli/output"
 	"github.com/basecamp/fizzy-cli/internal/errors"
@@ -67,3 +69,82 @@ PowerShell:
 func init() {
 	rootCmd.AddCommand(completionCmd)
 }
+
+// detectShell returns the user's login shell if it is one we can install
+// completions for, or "" otherwise.
+func detectShell() string {
+	switch shell := filepath.Base(os.Getenv("SHELL")); shell {
+	case "bash", "zsh", "fish":
+		return shell
+	}
+	return ""
+}
+
+// completionInstallPath returns a per-user location the shell loads
+// completions from without extra configuration (zsh needs the directory on
+// its fpath).
+func completionInstallPath(shell string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	dataHome := os.Getenv("XDG_DATA_HOME")
+	if dataHome == "" {
+		dataHome = filepath.Join(home, ".local", "share")
+	}
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" {
+		configHome = filepath.Join(home, ".config")
+	}
+
+	switch shell {
+	case "bash":
+		return filepath.Join(dataHome, "bash-completion", "completions", "fizzy"), nil
+	case "zsh":
+		return filepath.Join(home, ".zsh", "completions", "_fizzy"), nil
+	case "fish":
+		return filepath.Join(configHome, "fish", "completions", "fizzy.fish"), nil
+	}
+	return "", fmt.Errorf("unsupported shell %q", shell)
+}
+
+// installCompletion writes the completion script for shell to its per-user
+// location and returns the path written.
+func installCompletion(root *cobra.Command, shell string) (string, error) {
+	path, err := completionInstallPath(shell)
+	if err != nil {
+		return "", err
+	}
+	script, err := generateCompletion(root, shell)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { // #nosec G301 -- completion scripts are not secrets //nolint:gosec
+		return "", err
+	}
+	if err := os.WriteFile(path, script, 0o644); err != nil { // #nosec G306 -- completion scripts are not secrets //nolint:gosec
+		return "", err
+	}
+	return path, nil
+}
+
+// generateCompletion renders the completion script for shell.
+func generateCompletion(root *cobra.Command, shell string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch shell {
+	case "bash":
+		err = root.GenBashCompletion(&buf)
+	case "zsh":
+		err = root.GenZshCompletion(&buf)
+	case "fish":
+		err = root.GenFishCompletion(&buf, true)
+	default:
+		err = fmt.Errorf("unsupported shell %q", shell)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDetectShell(t *testing.T) {
+	tests := map[string]string{
+		"/bin/bash":          "bash",
+		"/usr/local/bin/zsh": "zsh",
+		"/usr/bin/fish":      "fish",
+		"/bin/tcsh":          "",
+		"":                   "",
+	}
+	for shellPath, want := range tests {
+		t.Setenv("SHELL", shellPath)
+		if got := detectShell(); got != want {
+			t.Errorf("detectShell() with SHELL=%q = %q, want %q", shellPath, got, want)
+		}
+	}
+}
+
+func TestInstallCompletion(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_DATA_HOME", "")
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	tests := map[string]string{
+		"bash": filepath.Join(home, ".local", "share", "bash-completion", "completions", "fizzy"),
+		"zsh":  filepath.Join(home, ".zsh", "completions", "_fizzy"),
+		"fish": filepath.Join(home, ".config", "fish", "completions", "fizzy.fish"),
+	}
+	for shell, want := range tests {
+		t.Run(shell, func(t *testing.T) {
+			path, err := installCompletion(rootCmd, shell)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if path != want {
+				t.Errorf("expected %s, got %s", want, path)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("reading completion: %v", err)
+			}
+			if !strings.Contains(string(data), "fizzy") {
+				t.Error("expected completion script to mention fizzy")
+			}
+		})
+	}
+
+	if _, err := installCompletion(rootCmd, "tcsh"); err == nil {
+		t.Error("expected error for unsupported shell")
+	}
+}
@@ -83,7 +83,7 @@ from flags, environment variables, profile settings, local config, and global co
 			captureResponse()
 			return nil
 		default:
-			recordOutputError(out.OK(data,
+			recordOutputError(okEnvelope(data,
 				output.WithSummary("Configuration precedence"),
 				output.WithBreadcrumbs(breadcrumbs...),
 			))
@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+// currentUserID returns the ID of the authenticated user within the active
+// account. Users are per account, so it is read from the matching account
+// entry of the identity endpoint rather than from the identity's own ID.
+func currentUserID(ctx context.Context) (string, error) {
+	if err := requireSDK(); err != nil {
+		return "", err
+	}
+	_, resp, err := getSDKClient().Identity().GetMyIdentity(ctx)
+	if err != nil {
+		return "", convertSDKError(err)
+	}
+
+	var identity struct {
+		Accounts []struct {
+			ID   any    `json:"id"`
+			Slug string `json:"slug"`
+			User struct {
+				ID any `json:"id"`
+			} `json:"user"`
+		} `json:"accounts"`
+	}
+	if err := json.Unmarshal(resp.Data, &identity); err != nil {
+		return "", errors.NewError(fmt.Sprintf("Could not read identity: %v", err))
+	}
+
+	active := strings.TrimPrefix(cfg.Account, "/")
+	for _, a := range identity.Accounts {
+		if strings.TrimPrefix(a.Slug, "/") != active && fmt.Sprintf("%v", a.ID) != active {
+			continue
+		}
+		if a.User.ID == nil {
+			break
+		}
+		return fmt.Sprintf("%v", a.User.ID), nil
+	}
+	return "", errors.NewError(fmt.Sprintf("Could not determine your user in account %s", active))
+}
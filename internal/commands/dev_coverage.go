@@ -0,0 +1,199 @@
+package commands
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+// apiEndpointsTxt lists the endpoints the commands call, one "METHOD /path"
+// per line. It is generated from the sources by 'make api-endpoints'.
+//
+//go:embed api_endpoints.txt
+var apiEndpointsTxt string
+
+// apiDocEndpointPattern matches an endpoint in API docs, e.g.
+// "GET /:account_slug/cards/:card_number".
+var apiDocEndpointPattern = regexp.MustCompile("\\b(GET|POST|PUT|PATCH|DELETE)\\s+(/[^\\s`\"')]*)")
+
+var devCmd = &cobra.Command{
+	Use:   "dev",
+	Short: "Tools for developing the CLI",
+	Long:  "Commands for maintaining the CLI itself against the upstream API.",
+}
+
+// Dev coverage flags
+var devCoverageAPIDoc string
+var devCoverageAll bool
+
+var devCoverageCmd = &cobra.Command{
+	Use:   "coverage",
+	Short: "Compare the upstream API docs with the endpoints the CLI calls",
+	Long: `Reads the upstream API docs (API.md, or a directory of markdown sections)
+and compares the endpoints they document with the endpoints this build of the
+CLI calls, listing each gap:
+
+  missing        documented, but no command calls it
+  undocumented   called by the CLI, but not in the docs
+
+With --all, covered endpoints are listed too. Paths are compared without the
+account prefix, .json suffix, or query string, and with any parameter
+(:card_number, {cardNumber}) matching any other. Use - to read the docs from
+stdin.`,
+	Example: `  fizzy dev coverage --api-doc ../fizzy/docs/API.md
+  fizzy dev coverage --api-doc ../fizzy/docs/api/sections
+  curl -s https://raw.githubusercontent.com/basecamp/fizzy/main/docs/API.md | fizzy dev coverage --api-doc -`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if devCoverageAPIDoc == "" {
+			return newRequiredFlagError("api-doc")
+		}
+		doc, err := readAPIDoc(cmd, devCoverageAPIDoc)
+		if err != nil {
+			return err
+		}
+
+		documented := apiEndpointSet(apiDocEndpoints(doc))
+		called := apiEndpointSet(strings.Split(strings.TrimSpace(apiEndpointsTxt), "\n"))
+
+		items := []any{}
+		covered, missing, undocumented := 0, 0, 0
+		add := func(endpoint, status string) {
+			if status == "covered" && !devCoverageAll {
+				return
+			}
+			method, path, _ := strings.Cut(endpoint, " ")
+			items = append(items, map[string]any{"method": method, "path": path, "status": status})
+		}
+		for _, key := range slices.Sorted(maps.Keys(documented)) {
+			if _, ok := called[key]; ok {
+				covered++
+				add(documented[key], "covered")
+			} else {
+				missing++
+				add(documented[key], "missing")
+			}
+		}
+		for _, key := range slices.Sorted(maps.Keys(called)) {
+			if _, ok := documented[key]; !ok {
+				undocumented++
+				add(called[key], "undocumented")
+			}
+		}
+
+		percent := 0
+		if len(documented) > 0 {
+			percent = covered * 100 / len(documented)
+		}
+		summary := fmt.Sprintf("%d of %d documented endpoints covered (%d%%), %d missing, %d undocumented", covered, len(documented), percent, missing, undocumented)
+		printList(items, devCoverageColumns, summary, nil)
+		return nil
+	},
+}
+
+// readAPIDoc reads the API docs from a markdown file, every markdown file
+// under a directory, or stdin for "-".
+func readAPIDoc(cmd *cobra.Command, source string) (string, error) {
+	if source == "-" {
+		data, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return "", errors.NewError(fmt.Sprintf("cannot read API docs from stdin: %v", err))
+		}
+		return string(data), nil
+	}
+	info, err := os.Stat(source)
+	if err != nil {
+		return "", errors.NewInvalidArgsError(fmt.Sprintf("cannot read API docs: %v", err))
+	}
+	if !info.IsDir() {
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return "", errors.NewInvalidArgsError(fmt.Sprintf("cannot read API docs: %v", err))
+		}
+		return string(data), nil
+	}
+	var sb strings.Builder
+	err = filepath.WalkDir(source, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.EqualFold(filepath.Ext(path), ".md") {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		sb.Write(data)
+		sb.WriteString("\n")
+		return nil
+	})
+	if err != nil {
+		return "", errors.NewInvalidArgsError(fmt.Sprintf("cannot read API docs: %v", err))
+	}
+	return sb.String(), nil
+}
+
+// apiDocEndpoints returns every "METHOD /path" mentioned in doc.
+func apiDocEndpoints(doc string) []string {
+	var endpoints []string
+	for _, match := range apiDocEndpointPattern.FindAllStringSubmatch(doc, -1) {
+		endpoints = append(endpoints, match[1]+" "+match[2])
+	}
+	return endpoints
+}
+
+// apiEndpointSet maps each endpoint's comparison key to the endpoint as
+// first written.
+func apiEndpointSet(endpoints []string) map[string]string {
+	set := map[string]string{}
+	for _, endpoint := range endpoints {
+		method, path, ok := strings.Cut(strings.TrimSpace(endpoint), " ")
+		if !ok {
+			continue
+		}
+		key := method + " " + normalizeAPIPath(path)
+		if _, seen := set[key]; !seen {
+			set[key] = endpoint
+		}
+	}
+	return set
+}
+
+// normalizeAPIPath reduces a documented or called path to a form both
+// share: no query string, .json suffix, or account prefix, and "{}" for
+// every parameter.
+func normalizeAPIPath(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	path = strings.TrimSuffix(strings.TrimSuffix(path, "/"), ".json")
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(segments) > 0 && isAPIPathParam(segments[0]) && strings.Contains(strings.ToLower(segments[0]), "account") {
+		segments = segments[1:]
+	}
+	for i, segment := range segments {
+		if isAPIPathParam(segment) {
+			segments[i] = "{}"
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func isAPIPathParam(segment string) bool {
+	if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "{") {
+		return true
+	}
+	return segment != "" && strings.Trim(segment, "0123456789") == ""
+}
+
+func init() {
+	devCoverageCmd.Flags().StringVar(&devCoverageAPIDoc, "api-doc", "", "Upstream API docs: a markdown file, a directory of them, or - for stdin (required)")
+	devCoverageCmd.Flags().BoolVar(&devCoverageAll, "all", false, "List covered endpoints too")
+	devCmd.AddCommand(devCoverageCmd)
+	rootCmd.AddCommand(devCmd)
+}
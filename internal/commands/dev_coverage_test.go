@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeAPIPath(t *testing.T) {
+	tests := map[string]string{
+		"/:account_slug/cards/:card_number":                "/cards/{}",
+		"/{accountId}/cards/{cardNumber}/comments":         "/cards/{}/comments",
+		"/cards/42/comments.json?page=2":                   "/cards/{}/comments",
+		"/:account_slug/boards/:board_id/columns.json":     "/boards/{}/columns",
+		"/my/identity.json":                                "/my/identity",
+		"/:account_slug/boards/03f5v9zjysoy0fqs9yg0ei3hq/": "/boards/03f5v9zjysoy0fqs9yg0ei3hq",
+	}
+	for path, want := range tests {
+		if got := normalizeAPIPath(path); got != want {
+			t.Errorf("normalizeAPIPath(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestDevCoverage(t *testing.T) {
+	doc := "# API\n\n" +
+		"### `GET /:account_slug/cards/:card_number`\n\n" +
+		"```bash\ncurl -X POST /:account_slug/cards/:card_number/closure.json\n```\n\n" +
+		"### `POST /:account_slug/cards/:card_number/future_thing`\n"
+	path := filepath.Join(t.TempDir(), "API.md")
+	if err := os.WriteFile(path, []byte(doc), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	result := SetTestModeWithSDK(NewMockClient())
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	devCoverageAPIDoc = path
+	err := devCoverageCmd.RunE(devCoverageCmd, []string{})
+	devCoverageAPIDoc = ""
+
+	assertExitCode(t, err, 0)
+	items := result.Response.Data.([]any)
+	var missing []string
+	undocumented := 0
+	for _, item := range items {
+		m := item.(map[string]any)
+		switch m["status"] {
+		case "missing":
+			missing = append(missing, m["method"].(string)+" "+m["path"].(string))
+		case "undocumented":
+			undocumented++
+		case "covered":
+			t.Errorf("expected covered endpoints hidden without --all, got %v", m)
+		}
+	}
+	if len(missing) != 1 || missing[0] != "POST /:account_slug/cards/:card_number/future_thing" {
+		t.Errorf("expected the undocumented-by-CLI endpoint reported missing, got %v", missing)
+	}
+	if undocumented == 0 {
+		t.Error("expected endpoints the docs don't mention reported as undocumented")
+	}
+	if !strings.HasPrefix(result.Response.Summary, "2 of 3 documented endpoints covered") {
+		t.Errorf("unexpected summary %q", result.Response.Summary)
+	}
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/basecamp/cli/output"
 	"github.com/basecamp/cli/profile"
+	"github.com/basecamp/fizzy-cli/internal/client"
 	"github.com/basecamp/fizzy-cli/internal/config"
 	"github.com/basecamp/fizzy-cli/internal/errors"
 	"github.com/basecamp/fizzy-cli/internal/harness"
@@ -46,6 +47,7 @@ type DoctorProfileResult struct {
 
 // DoctorResult holds the complete diagnostic results.
 type DoctorResult struct {
+	Fixes    []DoctorFix           `json:"fixes,omitempty"`
 	Checks   []DoctorCheck         `json:"checks"`
 	Profiles []DoctorProfileResult `json:"profiles,omitempty"`
 	Passed   int                   `json:"passed"`
@@ -55,6 +57,24 @@ type DoctorResult struct {
 }
 
 func (r *DoctorResult) Summary() string {
+	summary := r.checksSummary()
+	if fixed := r.fixedCount(); fixed > 0 {
+		summary = fmt.Sprintf("%d %s applied; %s", fixed, pluralize(fixed, "fix", "fixes"), summary)
+	}
+	return summary
+}
+
+func (r *DoctorResult) fixedCount() int {
+	n := 0
+	for _, f := range r.Fixes {
+		if f.Status == "fixed" {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *DoctorResult) checksSummary() string {
 	if r.Failed == 0 && r.Warned == 0 && r.Passed > 0 {
 		if r.Skipped > 0 {
 			return fmt.Sprintf("All %d checks passed, %d skipped", r.Passed, r.Skipped)
@@ -95,6 +115,7 @@ var doctorVersionChecker = fetchLatestDoctorVersion
 func NewDoctorCmd() *cobra.Command {
 	var verbose bool
 	var allProfiles bool
+	var fix bool
 
 	cmd := &cobra.Command{
 		Use:   "doctor",
@@ -105,11 +126,17 @@ board access, shell integration, and coding-agent setup.
 Use --profile NAME to check a specific saved profile, or --all-profiles to sweep every saved
 profile in addition to the global install checks.
 
+Use --fix to apply safe repairs before checking: move a legacy ~/.fizzy/config.yaml to
+~/.config/fizzy/config.yaml, restrict config file permissions to 0600, remove corrupt cache
+entries, and regenerate an out-of-date completion script installed by fizzy. Each repair is
+reported under "fixes" in JSON output.
+
 Examples:
   fizzy doctor
   fizzy doctor --profile acme
   fizzy doctor --all-profiles
   fizzy doctor --verbose
+  fizzy doctor --fix
   fizzy doctor --json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if allProfiles && cfgProfile != "" {
@@ -122,7 +149,12 @@ Examples:
 				return errors.NewInvalidArgsError("--all-profiles cannot be used with --api-url")
 			}
 
+			var fixes []DoctorFix
+			if fix {
+				fixes = runDoctorFixes()
+			}
 			result := runDoctor(cmd.Context(), verbose, allProfiles)
+			result.Fixes = fixes
 			breadcrumbs := buildDoctorBreadcrumbs(flattenDoctorChecks(result))
 
 			switch out.EffectiveFormat() {
@@ -139,7 +171,7 @@ Examples:
 				if len(breadcrumbs) > 0 {
 					opts = append(opts, output.WithBreadcrumbs(breadcrumbs...))
 				}
-				recordOutputError(out.OK(result, opts...))
+				recordOutputError(okEnvelope(result, opts...))
 				captureResponse()
 				return nil
 			}
@@ -148,6 +180,7 @@ Examples:
 
 	cmd.Flags().BoolVar(&verbose, "verbose", false, "Show additional diagnostic detail")
 	cmd.Flags().BoolVar(&allProfiles, "all-profiles", false, "Run profile health checks for every saved profile")
+	cmd.Flags().BoolVar(&fix, "fix", false, "Apply safe automatic fixes before running checks")
 	return cmd
 }
 
@@ -223,6 +256,9 @@ func runDoctorTargetChecks(ctx context.Context, eff doctorEffectiveConfig, verbo
 		checkDoctorLegacyState(eff),
 		checkDoctorAPIURL(eff, verbose),
 	)
+	if !tlsOptions().IsZero() {
+		checks = append(checks, checkDoctorTLS())
+	}
 
 	reachabilityCheck := checkDoctorAPIReachability(ctx, eff, verbose)
 	checks = append(checks, reachabilityCheck)
@@ -659,6 +695,34 @@ func checkDoctorAPIURL(eff doctorEffectiveConfig, _ bool) DoctorCheck {
 	return DoctorCheck{Name: "API URL", Status: "pass", Message: eff.APIURL}
 }
 
+func checkDoctorTLS() DoctorCheck {
+	opts := tlsOptions()
+	if _, err := client.NewTransport(opts); err != nil {
+		return DoctorCheck{
+			Name:    "TLS",
+			Status:  "fail",
+			Message: "Invalid TLS configuration",
+			Hint:    fmt.Sprintf("%v. Fix --ca-cert, --client-cert, --client-key or the matching config keys", err),
+		}
+	}
+	if opts.InsecureSkipVerify {
+		return DoctorCheck{
+			Name:    "TLS",
+			Status:  "warn",
+			Message: "Certificate verification is disabled",
+			Hint:    "Trust your CA with --ca-cert (or ca_cert in config) instead of --insecure-skip-verify",
+		}
+	}
+	var parts []string
+	if opts.CACert != "" {
+		parts = append(parts, "custom CA "+opts.CACert)
+	}
+	if opts.ClientCert != "" {
+		parts = append(parts, "client certificate "+opts.ClientCert)
+	}
+	return DoctorCheck{Name: "TLS", Status: "pass", Message: "Using " + strings.Join(parts, ", ")}
+}
+
 func checkDoctorAPIReachability(ctx context.Context, eff doctorEffectiveConfig, verbose bool) DoctorCheck {
 	if eff.APIURL == "" {
 		return DoctorCheck{Name: "API Reachability", Status: "fail", Message: "No API URL configured", Hint: "Run: fizzy setup"}
@@ -667,8 +731,16 @@ func checkDoctorAPIReachability(ctx context.Context, eff doctorEffectiveConfig,
 	if err != nil {
 		return DoctorCheck{Name: "API Reachability", Status: "fail", Message: "Cannot build API request", Hint: err.Error()}
 	}
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	transport, err := httpTransport()
+	if err != nil {
+		return DoctorCheck{Name: "API Reachability", Status: "fail", Message: "Invalid TLS configuration", Hint: err.Error()}
+	}
+	if transport != nil {
+		httpClient.Transport = transport
+	}
 	start := time.Now()
-	resp, err := (&http.Client{Timeout: 5 * time.Second}).Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return DoctorCheck{Name: "API Reachability", Status: "fail", Message: "Cannot reach API host", Hint: err.Error()}
 	}
@@ -880,7 +952,13 @@ func buildDoctorBreadcrumbs(checks []DoctorCheck) []Breadcrumb {
 			continue
 		}
 		switch c.Name {
-		case "Global Config", "Local Config", "API URL", "Filesystem", "Effective Config":
+		case "Filesystem":
+			if c.Status == "warn" {
+				breadcrumbs = append(breadcrumbs, breadcrumb("fix", "fizzy doctor --fix", "Tighten config file permissions"))
+			} else {
+				breadcrumbs = append(breadcrumbs, breadcrumb("setup", "fizzy setup", "Review and repair configuration"))
+			}
+		case "Global Config", "Local Config", "API URL", "Effective Config":
 			breadcrumbs = append(breadcrumbs, breadcrumb("setup", "fizzy setup", "Review and repair configuration"))
 		case "Profile Store":
 			breadcrumbs = append(breadcrumbs,
@@ -947,6 +1025,17 @@ func renderDoctorStyled(w io.Writer, result *DoctorResult, breadcrumbs []Breadcr
 	fmt.Fprintln(w)
 	fmt.Fprintln(w, title.Render("Fizzy CLI Doctor"))
 	fmt.Fprintln(w)
+	if len(result.Fixes) > 0 {
+		fmt.Fprintln(w, title.Render("Fixes"))
+		for _, f := range result.Fixes {
+			style, icon := passStyle, "✓"
+			if f.Status != "fixed" {
+				style, icon = failStyle, "✗"
+			}
+			fmt.Fprintf(w, "  %s %s %s\n", style.Render(icon), title.Render(f.Name), style.Render(f.Message))
+		}
+		fmt.Fprintln(w)
+	}
 	renderChecks("  ", result.Checks)
 	if len(result.Profiles) > 0 {
 		fmt.Fprintln(w)
@@ -995,6 +1084,17 @@ func renderDoctorMarkdown(w io.Writer, result *DoctorResult, breadcrumbs []Bread
 	}
 	fmt.Fprintln(w, "# Fizzy CLI Doctor")
 	fmt.Fprintln(w)
+	if len(result.Fixes) > 0 {
+		fmt.Fprintln(w, "## Fixes")
+		for _, f := range result.Fixes {
+			icon := "✅"
+			if f.Status != "fixed" {
+				icon = "❌"
+			}
+			fmt.Fprintf(w, "- %s **%s:** %s\n", icon, f.Name, f.Message)
+		}
+		fmt.Fprintln(w)
+	}
 	for _, check := range result.Checks {
 		icon := map[string]string{"pass": "✅", "fail": "❌", "warn": "⚠️", "skip": "➖"}[check.Status]
 		fmt.Fprintf(w, "- %s **%s:** %s\n", icon, check.Name, check.Message)
@@ -1323,8 +1423,13 @@ func newDoctorClients(eff doctorEffectiveConfig) (client *fizzy.Client, accountC
 			accountClient = nil
 		}
 	}()
+	opts, err := transportClientOptions()
+	if err != nil {
+		return nil, nil, err
+	}
+	opts = append(opts, fizzy.WithUserAgent("fizzy-cli/"+currentVersion()))
 	sdkCfg := &fizzy.Config{BaseURL: eff.APIURL}
-	client = fizzy.NewClient(sdkCfg, &fizzy.StaticTokenProvider{Token: eff.Token}, fizzy.WithUserAgent("fizzy-cli/"+currentVersion()))
+	client = fizzy.NewClient(sdkCfg, &fizzy.StaticTokenProvider{Token: eff.Token}, opts...)
 	accountClient = client.ForAccount(eff.ProfileName)
 	return client, accountClient, nil
 }
@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/cache"
+	"github.com/basecamp/fizzy-cli/internal/config"
+)
+
+// DoctorFix records one remediation applied (or attempted) by doctor --fix.
+type DoctorFix struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"` // fixed, failed
+	Message string `json:"message"`
+	Path    string `json:"path,omitempty"`
+}
+
+// runDoctorFixes applies the safe automatic fixes. Each fix only acts when
+// it finds something to repair, so a healthy install yields no entries.
+func runDoctorFixes() []DoctorFix {
+	var fixes []DoctorFix
+	fixes = append(fixes, fixDoctorLegacyConfig()...)
+	fixes = append(fixes, fixDoctorConfigPermissions()...)
+	fixes = append(fixes, fixDoctorCache()...)
+	fixes = append(fixes, fixDoctorShellCompletion()...)
+	return fixes
+}
+
+func fixDoctorLegacyConfig() []DoctorFix {
+	from, to, err := config.MigrateLegacyConfig()
+	if err != nil {
+		return []DoctorFix{{
+			Name:    "Legacy Config",
+			Status:  "failed",
+			Message: fmt.Sprintf("Could not migrate legacy config: %v", err),
+		}}
+	}
+	if from == "" {
+		return nil
+	}
+	return []DoctorFix{{
+		Name:    "Legacy Config",
+		Status:  "fixed",
+		Message: fmt.Sprintf("Moved %s to %s", from, to),
+		Path:    to,
+	}}
+}
+
+func fixDoctorConfigPermissions() []DoctorFix {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	var fixes []DoctorFix
+	for _, path := range []string{globalConfigPathForDoctor(), config.LocalConfigPath()} {
+		if strings.TrimSpace(path) == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() || info.Mode().Perm()&0o077 == 0 {
+			continue
+		}
+		if err := os.Chmod(path, 0o600); err != nil {
+			fixes = append(fixes, DoctorFix{
+				Name:    "Config Permissions",
+				Status:  "failed",
+				Message: fmt.Sprintf("Could not chmod 600: %v", err),
+				Path:    path,
+			})
+			continue
+		}
+		fixes = append(fixes, DoctorFix{
+			Name:    "Config Permissions",
+			Status:  "fixed",
+			Message: fmt.Sprintf("Changed permissions from %04o to 0600", info.Mode().Perm()),
+			Path:    path,
+		})
+	}
+	return fixes
+}
+
+func fixDoctorCache() []DoctorFix {
+	removed, err := cache.RemoveCorrupt()
+	if err != nil {
+		return []DoctorFix{{
+			Name:    "Cache",
+			Status:  "failed",
+			Message: fmt.Sprintf("Could not scan cache: %v", err),
+		}}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	dir, _ := cache.Dir()
+	return []DoctorFix{{
+		Name:    "Cache",
+		Status:  "fixed",
+		Message: fmt.Sprintf("Removed %d corrupt cache %s", len(removed), pluralize(len(removed), "entry", "entries")),
+		Path:    dir,
+	}}
+}
+
+// fixDoctorShellCompletion rewrites a completion script previously installed
+// by fizzy when it no longer matches the current command tree. Scripts
+// installed elsewhere (e.g. by a package manager) are left alone.
+func fixDoctorShellCompletion() []DoctorFix {
+	shell := detectShell()
+	if shell == "" {
+		return nil
+	}
+	path, err := completionInstallPath(shell)
+	if err != nil {
+		return nil
+	}
+	current, err := os.ReadFile(path) //nolint:gosec // per-user completion path built from home/XDG dirs
+	if err != nil {
+		return nil
+	}
+	script, err := generateCompletion(rootCmd, shell)
+	if err == nil && bytes.Equal(current, script) {
+		return nil
+	}
+	if err == nil {
+		_, err = installCompletion(rootCmd, shell)
+	}
+	if err != nil {
+		return []DoctorFix{{
+			Name:    "Shell Completion",
+			Status:  "failed",
+			Message: fmt.Sprintf("Could not regenerate %s completion: %v", shell, err),
+			Path:    path,
+		}}
+	}
+	return []DoctorFix{{
+		Name:    "Shell Completion",
+		Status:  "fixed",
+		Message: fmt.Sprintf("Regenerated %s completion script", shell),
+		Path:    path,
+	}}
+}
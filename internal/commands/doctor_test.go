@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -545,3 +546,94 @@ func credsSaveProfileTokenForTest(store *credstore.Store, profileName, token str
 	}
 	return store.Save("profile:"+profileName, data)
 }
+
+func TestDoctorFixReportsRepairs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permission repair is not applicable on Windows")
+	}
+	configDir := t.TempDir()
+	config.SetTestConfigDir(configDir)
+	defer config.ResetTestConfigDir()
+	t.Setenv("SHELL", "")
+
+	configPath := filepath.Join(configDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte("board: board-1\n"), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	mock := NewMockClient()
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("", "", testHTTPServer.URL)
+	defer resetTest()
+
+	corrupt := filepath.Join(testCacheDir, "columns", "broken.json")
+	if err := os.MkdirAll(filepath.Dir(corrupt), 0o700); err != nil {
+		t.Fatalf("mkdir cache: %v", err)
+	}
+	if err := os.WriteFile(corrupt, []byte("{"), 0o600); err != nil {
+		t.Fatalf("write corrupt cache: %v", err)
+	}
+
+	cmd, _, err := rootCmd.Find([]string{"doctor"})
+	if err != nil {
+		t.Fatalf("find doctor command: %v", err)
+	}
+	if err := cmd.Flags().Set("fix", "true"); err != nil {
+		t.Fatalf("set fix flag: %v", err)
+	}
+	defer cmd.Flags().Set("fix", "false")
+
+	err = cmd.RunE(cmd, []string{})
+	assertExitCode(t, err, 0)
+
+	data := result.Response.Data.(map[string]any)
+	fixes, ok := data["fixes"].([]any)
+	if !ok {
+		t.Fatalf("expected fixes array, got %#v", data["fixes"])
+	}
+	fixed := map[string]string{}
+	for _, item := range fixes {
+		f := item.(map[string]any)
+		fixed[f["name"].(string)] = f["status"].(string)
+	}
+	if fixed["Config Permissions"] != "fixed" || fixed["Cache"] != "fixed" {
+		t.Fatalf("expected permission and cache fixes, got %#v", fixes)
+	}
+	if !strings.HasPrefix(result.Response.Summary, "2 fixes applied;") {
+		t.Errorf("expected summary to count fixes, got %q", result.Response.Summary)
+	}
+
+	info, err := os.Stat(configPath)
+	if err != nil {
+		t.Fatalf("stat config: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("expected config permissions 0600, got %04o", perm)
+	}
+	if _, err := os.Stat(corrupt); !os.IsNotExist(err) {
+		t.Errorf("expected corrupt cache entry to be removed")
+	}
+}
+
+func TestDoctorWithoutFixOmitsFixes(t *testing.T) {
+	configDir := t.TempDir()
+	config.SetTestConfigDir(configDir)
+	defer config.ResetTestConfigDir()
+
+	mock := NewMockClient()
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("", "", testHTTPServer.URL)
+	defer resetTest()
+
+	cmd, _, err := rootCmd.Find([]string{"doctor"})
+	if err != nil {
+		t.Fatalf("find doctor command: %v", err)
+	}
+	err = cmd.RunE(cmd, []string{})
+	assertExitCode(t, err, 0)
+
+	data := result.Response.Data.(map[string]any)
+	if _, ok := data["fixes"]; ok {
+		t.Fatalf("expected no fixes key without --fix, got %#v", data["fixes"])
+	}
+}
@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+// editorCommand returns the user's editor: $VISUAL, then $EDITOR, then a
+// platform default.
+func editorCommand() string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(name)); editor != "" {
+			return editor
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "vi"
+}
+
+// runEditor opens path in the user's editor and waits for it to exit. Tests
+// replace it to edit the file without a terminal.
+var runEditor = func(path string) error {
+	args := strings.Fields(editorCommand())
+	cmd := exec.Command(args[0], append(args[1:], path)...) //nolint:gosec // the editor is the user's own choice
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// editFile is the temporary file a text was edited in. It is kept until the
+// caller has used the text, so a failure afterwards doesn't lose the edit.
+type editFile struct {
+	path    string
+	removed bool
+}
+
+// Remove deletes the file once the text has been used.
+func (f *editFile) Remove() {
+	if f != nil && !f.removed {
+		os.Remove(f.path)
+		f.removed = true
+	}
+}
+
+// Keep removes the file when err is nil. Otherwise it leaves the file in
+// place and sets err's hint to its path.
+func (f *editFile) Keep(err error) error {
+	if f == nil || f.removed {
+		return err
+	}
+	if err == nil {
+		f.Remove()
+		return nil
+	}
+	e := output.AsError(err)
+	e.Hint = "Your edit was kept in " + f.path
+	return e
+}
+
+// editText opens initial in the user's editor in a temporary file named
+// after pattern (e.g. "draft-*.md") and returns what was saved, along with
+// the file, which the caller removes once the text has been used. When the
+// editor fails, or check rejects the text, the file is kept and the error's
+// hint gives its path, so the edit isn't lost.
+func editText(initial, pattern string, check func(string) error) (string, *editFile, error) {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", nil, errors.NewError(fmt.Sprintf("Could not create a file to edit: %v", err))
+	}
+	file := &editFile{path: f.Name()}
+	if _, err := f.WriteString(initial); err != nil {
+		f.Close()
+		file.Remove()
+		return "", nil, errors.NewError(fmt.Sprintf("Could not create a file to edit: %v", err))
+	}
+	if err := f.Close(); err != nil {
+		file.Remove()
+		return "", nil, errors.NewError(fmt.Sprintf("Could not create a file to edit: %v", err))
+	}
+
+	if err := runEditor(file.path); err != nil {
+		return "", nil, file.Keep(errors.NewError(fmt.Sprintf("Editor %q failed: %v", editorCommand(), err)))
+	}
+	edited, err := os.ReadFile(file.path)
+	if err != nil {
+		file.Remove()
+		return "", nil, errors.NewError(fmt.Sprintf("Could not read the edited file: %v", err))
+	}
+	if check != nil {
+		if err := check(string(edited)); err != nil {
+			return "", nil, file.Keep(err)
+		}
+	}
+	return string(edited), file, nil
+}
@@ -0,0 +1,164 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/basecamp/cli/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// schemaVersion is the version of the JSON envelope and the data shapes in
+// it, reported as meta.schema_version. It is bumped when a field is removed
+// or changes type; additions don't bump it. --compat asks for an older
+// version's shapes, so consumers survive upgrades until they migrate.
+const schemaVersion = 1
+
+// minCompatVersion is the oldest schema version --compat still supports.
+const minCompatVersion = 1
+
+// cfgCompat is the --compat flag, e.g. "v1".
+var cfgCompat string
+
+// compatVersion is the schema version output follows: --compat, or the
+// current version.
+var compatVersion = schemaVersion
+
+// deprecation is a deprecated flag, variable, or field the command used,
+// reported in meta.deprecations.
+type deprecation struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// deprecations collects the deprecations the current command ran into.
+var deprecations []deprecation
+
+// noteDeprecation records a deprecation for meta.deprecations. Human output
+// keeps getting the warning on stderr from wherever it is printed.
+func noteDeprecation(name, message string) {
+	for _, d := range deprecations {
+		if d.Name == name {
+			return
+		}
+	}
+	deprecations = append(deprecations, deprecation{Name: name, Message: message})
+}
+
+// parseCompat validates --compat and returns the schema version it asks for.
+func parseCompat(value string) (int, error) {
+	if value == "" {
+		return schemaVersion, nil
+	}
+	version, err := strconv.Atoi(strings.TrimPrefix(strings.ToLower(value), "v"))
+	if err != nil || version < minCompatVersion || version > schemaVersion {
+		return 0, &output.Error{
+			Code:    output.CodeUsage,
+			Message: fmt.Sprintf("unsupported --compat %q", value),
+			Hint:    fmt.Sprintf("This version of fizzy supports schema versions v%d through v%d", minCompatVersion, schemaVersion),
+		}
+	}
+	return version, nil
+}
+
+// noteDeprecatedFlags records every deprecated flag set on cmd.
+func noteDeprecatedFlags(cmd *cobra.Command) {
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if f.Deprecated != "" {
+			noteDeprecation("--"+f.Name, fmt.Sprintf("--%s is deprecated, %s", f.Name, f.Deprecated))
+		}
+	})
+}
+
+// envelopeMeta returns the meta every JSON envelope carries: the schema
+// version, the --compat version when one was asked for, and deprecations.
+func envelopeMeta() map[string]any {
+	meta := map[string]any{"schema_version": compatVersion}
+	if cfgCompat != "" {
+		meta["compat"] = fmt.Sprintf("v%d", compatVersion)
+	}
+	if len(deprecations) > 0 {
+		meta["deprecations"] = deprecations
+	}
+	return meta
+}
+
+// okEnvelope writes a success envelope with the standard meta, with the
+// data pruned to --fields. With --ids-only cards are listed by number. With
+// --ndjson it writes just the data, a line per item, with --template the
+// envelope rendered through it, and with --gha workflow commands and
+// step outputs.
+func okEnvelope(data any, opts ...output.ResponseOption) error {
+	if cfgFields != "" {
+		data = selectFields(data, cfgFields)
+	}
+	if cfgNDJSON {
+		return writeNDJSON(data)
+	}
+	if out.EffectiveFormat() == output.FormatIDs {
+		data = cardNumberIDs(data)
+	}
+	for key, value := range envelopeMeta() {
+		opts = append(opts, output.WithMeta(key, value))
+	}
+	if outputTemplate != nil || ghaMode() {
+		resp := &output.Response{OK: true, Data: data}
+		for _, opt := range opts {
+			opt(resp)
+		}
+		if ghaMode() {
+			return writeGHAResult(resp)
+		}
+		return writeTemplate(outputTemplate, resp)
+	}
+	return out.OK(data, opts...)
+}
+
+// cardNumberIDs swaps the ID of each card in data for its number, the
+// identifier card commands take, so --ids-only output can be piped into them.
+// Anything without a number, such as boards, columns, and tags, keeps its ID.
+func cardNumberIDs(data any) any {
+	swap := func(item map[string]any) map[string]any {
+		number, ok := item["number"]
+		if !ok {
+			return item
+		}
+		return map[string]any{"id": number}
+	}
+	switch d := output.NormalizeData(data).(type) {
+	case []map[string]any:
+		items := make([]map[string]any, len(d))
+		for i, item := range d {
+			items[i] = swap(item)
+		}
+		return items
+	case map[string]any:
+		return swap(d)
+	}
+	return data
+}
+
+// errEnvelope writes an error envelope with the standard meta, and with
+// --explain the explanation as meta.explain.
+func errEnvelope(err error, explanation *errorExplanation) error {
+	return out.Err(err, func(r *output.ErrorResponse) {
+		if r.Meta == nil {
+			r.Meta = map[string]any{}
+		}
+		for key, value := range envelopeMeta() {
+			r.Meta[key] = value
+		}
+		if explanation != nil {
+			r.Meta["explain"] = explanation
+		}
+	})
+}
+
+// warnDeprecated prints a deprecation warning on stderr and records it.
+func warnDeprecated(name, message string) {
+	fmt.Fprintln(os.Stderr, "Warning: "+message)
+	noteDeprecation(name, message)
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/spf13/cobra"
+)
+
+func TestEnvelopeMeta(t *testing.T) {
+	t.Run("reports the schema version", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/boards.json", &client.APIResponse{StatusCode: 200, Data: []any{}})
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		raw, err := runCobraWithArgs("board", "list", "--json", "--compat", "v1")
+		cfgCompat = ""
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var resp struct {
+			Meta map[string]any `json:"meta"`
+		}
+		if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+			t.Fatalf("expected JSON envelope, got %v\n%s", err, raw)
+		}
+		if resp.Meta["schema_version"] != float64(schemaVersion) {
+			t.Errorf("expected schema_version %d, got %v", schemaVersion, resp.Meta["schema_version"])
+		}
+		if resp.Meta["compat"] != "v1" {
+			t.Errorf("expected compat v1, got %v", resp.Meta["compat"])
+		}
+	})
+
+	t.Run("rejects unsupported compat versions", func(t *testing.T) {
+		for _, value := range []string{"v0", "v99", "latest"} {
+			if _, err := parseCompat(value); err == nil {
+				t.Errorf("expected --compat %s to be rejected", value)
+			}
+		}
+		if version, err := parseCompat("V1"); err != nil || version != 1 {
+			t.Errorf("expected --compat V1 to be accepted, got %d, %v", version, err)
+		}
+	})
+
+	t.Run("lists deprecated flags that were used", func(t *testing.T) {
+		defer func() { deprecations = nil }()
+		cmd := &cobra.Command{Use: "list"}
+		cmd.Flags().String("status", "", "")
+		cmd.Flags().String("indexed-by", "", "")
+		_ = cmd.Flags().MarkDeprecated("status", "use --indexed-by")
+		if err := cmd.Flags().Parse([]string{"--status", "closed"}); err != nil {
+			t.Fatal(err)
+		}
+
+		noteDeprecatedFlags(cmd)
+		noteDeprecatedFlags(cmd)
+
+		meta := envelopeMeta()
+		got, _ := meta["deprecations"].([]deprecation)
+		if len(got) != 1 || got[0].Name != "--status" || got[0].Message != "--status is deprecated, use --indexed-by" {
+			t.Errorf("expected one --status deprecation, got %v", meta["deprecations"])
+		}
+	})
+}
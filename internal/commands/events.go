@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+// Event flags, shared by the compound commands that emit progress events.
+var eventsFormat string
+var eventsFile string
+
+// eventsOut receives progress events while a compound command runs. It is
+// nil when events are off.
+var eventsOut io.Writer
+
+// addEventFlags registers --events and --events-file on a compound command.
+func addEventFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&eventsFormat, "events", "", "Emit one structured progress event per step (ndjson)")
+	cmd.Flags().StringVar(&eventsFile, "events-file", "", "Write --events to a file instead of stderr")
+}
+
+// startEvents opens the event stream requested by --events. The returned
+// function closes it and must be called when the command finishes.
+func startEvents() (func(), error) {
+	stop := func() { eventsOut = nil }
+	switch eventsFormat {
+	case "":
+		if eventsFile != "" {
+			return stop, errors.NewInvalidArgsError("--events-file requires --events ndjson")
+		}
+		return stop, nil
+	case "ndjson":
+	default:
+		return stop, errors.NewInvalidArgsError(fmt.Sprintf("unsupported --events format %q (use ndjson)", eventsFormat))
+	}
+
+	if eventsFile == "" {
+		eventsOut = os.Stderr
+		return stop, nil
+	}
+	f, err := os.OpenFile(eventsFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return stop, errors.NewError(fmt.Sprintf("Could not open events file: %v", err))
+	}
+	eventsOut = f
+	return func() {
+		eventsOut = nil
+		f.Close()
+	}, nil
+}
+
+// emitEvent writes one event as a JSON line, e.g.
+// {"event":"card_created","at":"...","source_number":3,"number":12}.
+func emitEvent(event string, fields map[string]any) {
+	if eventsOut == nil {
+		return
+	}
+	line := map[string]any{"event": event, "at": time.Now().UTC().Format(time.RFC3339Nano)}
+	for k, v := range fields {
+		line[k] = v
+	}
+	data, err := json.Marshal(line)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(eventsOut, "%s\n", data)
+}
+
+// progressf prints human-readable progress to stderr, unless events are
+// being written there instead.
+func progressf(format string, args ...any) {
+	if eventsOut == os.Stderr {
+		return
+	}
+	fmt.Fprintf(os.Stderr, format, args...)
+}
+
+// warnf reports a non-fatal problem as a "warning" event and as progress.
+func warnf(format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	emitEvent("warning", map[string]any{"message": strings.TrimPrefix(strings.TrimSpace(message), "Warning: ")})
+	progressf("%s", message)
+}
@@ -0,0 +1,163 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+// cfgExplain is the --explain flag.
+var cfgExplain bool
+
+// errorExplanation is what --explain adds to a failed command's error, as
+// meta.explain in JSON: likely causes, the API request that failed, and
+// commands to try next.
+type errorExplanation struct {
+	Causes      []string          `json:"causes"`
+	Request     *attemptedRequest `json:"request,omitempty"`
+	Suggestions []Breadcrumb      `json:"suggestions"`
+}
+
+// attemptedRequest is the API request behind an error.
+type attemptedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Status int    `json:"status,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// attempted returns the invocation's failed API request, or its last one
+// when none failed, without forgetting the traces.
+func (h *traceHooks) attempted() *attemptedRequest {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.traces) == 0 {
+		return nil
+	}
+	trace := h.traces[len(h.traces)-1]
+	for i := len(h.traces) - 1; i >= 0; i-- {
+		if h.traces[i].Error != "" || h.traces[i].Status >= 400 {
+			trace = h.traces[i]
+			break
+		}
+	}
+	return &attemptedRequest{Method: trace.Method, URL: trace.URL, Status: trace.Status, Error: trace.Error}
+}
+
+// explainError works out the likely causes of e and the commands that
+// would help, from its code and HTTP status.
+func explainError(cmd *cobra.Command, e *output.Error, request *attemptedRequest) *errorExplanation {
+	if cmd == nil {
+		cmd = rootCmd
+	}
+	x := &errorExplanation{Request: request, Causes: []string{}, Suggestions: []Breadcrumb{}}
+	suggest := func(action, command, description string) {
+		x.Suggestions = append(x.Suggestions, breadcrumb(action, command, description))
+	}
+
+	switch e.Code {
+	case output.CodeUsage:
+		x.Causes = append(x.Causes,
+			"A required argument or flag is missing, or a value is malformed",
+			"Two flags that can't be combined were given together")
+		suggest("help", usageHelpCommand(cmd), "Show the command's arguments and flags")
+	case output.CodeNotFound:
+		x.Causes = append(x.Causes,
+			"The number or ID doesn't exist, or was deleted",
+			"It belongs to another account than the one selected",
+			"A card was referred to by ID where its number is expected")
+		if list := listCommandFor(cmd); list != "" {
+			suggest("list", list, "Find the right number or ID")
+		}
+		suggest("account", "fizzy account show", "Check the selected account")
+	case output.CodeAuth:
+		x.Causes = append(x.Causes,
+			"No token is configured, or it expired or was revoked",
+			"The token belongs to another Fizzy instance than --api-url")
+		suggest("status", "fizzy auth status", "Check the configured credentials")
+		suggest("login", "fizzy auth login TOKEN", "Log in with a new token")
+	case output.CodeForbidden:
+		x.Causes = append(x.Causes,
+			"The token's user has no access to the board or resource",
+			"The action needs an administrator or the owner",
+			"The token is read-only")
+		suggest("status", "fizzy auth status", "Check who the token belongs to")
+		suggest("account", "fizzy account show", "Check the selected account")
+	case output.CodeRateLimit:
+		x.Causes = append(x.Causes, "Too many requests were made in a short time")
+		suggest("retry", cmd.CommandPath(), "Run the command again in a minute")
+	case output.CodeNetwork:
+		x.Causes = append(x.Causes,
+			"The network is down or the API URL is wrong",
+			"A proxy or TLS setting blocks the connection")
+		suggest("doctor", "fizzy doctor", "Check configuration and connectivity")
+	case output.CodeAmbiguous:
+		x.Causes = append(x.Causes, "The name matches more than one resource")
+		if list := listCommandFor(cmd); list != "" {
+			suggest("list", list, "Find the ID to use instead of the name")
+		}
+	case errors.CodeConflict:
+		x.Causes = append(x.Causes,
+			"The resource changed since it was read",
+			"The resource already exists")
+		if list := listCommandFor(cmd); list != "" {
+			suggest("list", list, "Fetch the current state before retrying")
+		}
+	case errors.CodeLocked:
+		x.Causes = append(x.Causes, "Another operation holds a lock on the resource")
+		suggest("retry", cmd.CommandPath(), "Run the command again in a moment")
+	default:
+		if e.HTTPStatus == 422 {
+			x.Causes = append(x.Causes, "The API rejected a field value, such as a name that's blank or too long")
+			suggest("help", usageHelpCommand(cmd), "Check the accepted values")
+		} else {
+			x.Causes = append(x.Causes, "The API failed to handle the request")
+			suggest("doctor", "fizzy doctor", "Check configuration and connectivity")
+		}
+	}
+	return x
+}
+
+// listCommandFor returns the list command next to cmd, e.g. "fizzy card
+// list" for "fizzy card show", or "" when there is none.
+func listCommandFor(cmd *cobra.Command) string {
+	if cmd == nil || !cmd.HasParent() {
+		return ""
+	}
+	for _, sibling := range cmd.Parent().Commands() {
+		if sibling.Name() == "list" {
+			return sibling.CommandPath()
+		}
+	}
+	return ""
+}
+
+// printExplanation writes an explanation for human output.
+func printExplanation(w io.Writer, x *errorExplanation) {
+	if x == nil {
+		return
+	}
+	fmt.Fprintln(w, "\nLikely causes:")
+	for _, cause := range x.Causes {
+		fmt.Fprintf(w, "  - %s\n", cause)
+	}
+	if r := x.Request; r != nil {
+		fmt.Fprintf(w, "\nRequest: %s %s", r.Method, r.URL)
+		switch {
+		case r.Error != "":
+			fmt.Fprintf(w, " (%s)", r.Error)
+		case r.Status != 0:
+			fmt.Fprintf(w, " (HTTP %d)", r.Status)
+		}
+		fmt.Fprintln(w)
+	}
+	if len(x.Suggestions) > 0 {
+		fmt.Fprintln(w, "\nTry:")
+		for _, s := range x.Suggestions {
+			fmt.Fprintf(w, "  %s  # %s\n", s.Cmd, s.Description)
+		}
+	}
+}
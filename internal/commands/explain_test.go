@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestExplainError(t *testing.T) {
+	t.Run("suggests listing when a card isn't found", func(t *testing.T) {
+		request := &attemptedRequest{Method: "GET", URL: "https://api.example.com/account/cards/999", Status: 404}
+		x := explainError(cardShowCmd, errors.NewNotFoundError("Card not found"), request)
+
+		if len(x.Causes) == 0 || x.Request != request {
+			t.Fatalf("expected causes and the request, got %+v", x)
+		}
+		if len(x.Suggestions) == 0 || x.Suggestions[0].Cmd != "fizzy card list" {
+			t.Errorf("expected card list suggested first, got %+v", x.Suggestions)
+		}
+	})
+
+	t.Run("suggests help for usage errors", func(t *testing.T) {
+		x := explainError(cardShowCmd, errors.NewInvalidArgsError("missing card"), nil)
+		if len(x.Suggestions) != 1 || x.Suggestions[0].Cmd != "fizzy card show --help" {
+			t.Errorf("expected the command's help, got %+v", x.Suggestions)
+		}
+	})
+
+	t.Run("picks the failed request", func(t *testing.T) {
+		h := &traceHooks{traces: []RequestTrace{
+			{Method: "GET", URL: "/boards.json", Status: 200},
+			{Method: "GET", URL: "/cards/9", Status: 404},
+			{Method: "GET", URL: "/users.json", Status: 200},
+		}}
+		if r := h.attempted(); r == nil || r.URL != "/cards/9" || r.Status != 404 {
+			t.Errorf("expected the 404 request, got %+v", r)
+		}
+		if len(h.traces) != 3 {
+			t.Errorf("expected the traces to be kept for recording")
+		}
+	})
+
+	t.Run("adds meta.explain to the error envelope", func(t *testing.T) {
+		saved := out
+		defer func() { out = saved }()
+		var buf bytes.Buffer
+		out = output.New(output.Options{Format: output.FormatJSON, Writer: &buf})
+
+		e := errors.NewForbiddenError("Forbidden")
+		_ = errEnvelope(e, explainError(cardShowCmd, e, nil))
+
+		var resp struct {
+			Meta struct {
+				Explain struct {
+					Causes      []string     `json:"causes"`
+					Suggestions []Breadcrumb `json:"suggestions"`
+				} `json:"explain"`
+			} `json:"meta"`
+		}
+		if err := json.Unmarshal(buf.Bytes(), &resp); err != nil {
+			t.Fatalf("expected JSON envelope, got %v\n%s", err, buf.String())
+		}
+		if len(resp.Meta.Explain.Causes) == 0 || len(resp.Meta.Explain.Suggestions) == 0 {
+			t.Errorf("expected causes and suggestions, got %s", buf.String())
+		}
+	})
+
+	t.Run("prints the explanation for people", func(t *testing.T) {
+		var buf bytes.Buffer
+		printExplanation(&buf, &errorExplanation{
+			Causes:      []string{"The network is down"},
+			Request:     &attemptedRequest{Method: "GET", URL: "https://api.example.com/boards.json", Error: "connection refused"},
+			Suggestions: []Breadcrumb{breadcrumb("doctor", "fizzy doctor", "Check connectivity")},
+		})
+		for _, want := range []string{"Likely causes:", "GET https://api.example.com/boards.json (connection refused)", "fizzy doctor  # Check connectivity"} {
+			if !strings.Contains(buf.String(), want) {
+				t.Errorf("expected %q in:\n%s", want, buf.String())
+			}
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// cfgFields is the --fields flag, e.g. "number,title,column.name".
+var cfgFields string
+
+// selectFields prunes data to the comma-separated dotted paths in fields:
+// each object keeps only those paths, nested objects keep their nesting, and
+// lists are pruned item by item. Paths missing from an object are left out.
+// Data that isn't an object or a list of objects is returned as is.
+func selectFields(data any, fields string) any {
+	var paths [][]string
+	for _, field := range strings.Split(fields, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			paths = append(paths, strings.Split(field, "."))
+		}
+	}
+	if len(paths) == 0 || data == nil {
+		return data
+	}
+
+	switch data.(type) {
+	case map[string]any, []any, []map[string]any:
+	default:
+		// Typed results (structs, typed slices) are pruned in their JSON form.
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return data
+		}
+		var generic any
+		if json.Unmarshal(raw, &generic) != nil {
+			return data
+		}
+		data = generic
+	}
+	return prunePaths(data, paths)
+}
+
+func prunePaths(data any, paths [][]string) any {
+	switch d := data.(type) {
+	case []any:
+		pruned := make([]any, len(d))
+		for i, item := range d {
+			pruned[i] = prunePaths(item, paths)
+		}
+		return pruned
+	case []map[string]any:
+		pruned := make([]any, len(d))
+		for i, item := range d {
+			pruned[i] = prunePaths(item, paths)
+		}
+		return pruned
+	case map[string]any:
+		pruned := map[string]any{}
+		for _, path := range paths {
+			copyPath(d, pruned, path)
+		}
+		return pruned
+	default:
+		return data
+	}
+}
+
+// copyPath copies the value at path in src to the same path in dst. A path
+// through a list applies to every item in it.
+func copyPath(src, dst map[string]any, path []string) {
+	value, ok := src[path[0]]
+	if !ok {
+		return
+	}
+	if len(path) == 1 {
+		dst[path[0]] = value
+		return
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		child, _ := dst[path[0]].(map[string]any)
+		if child == nil {
+			child = map[string]any{}
+			dst[path[0]] = child
+		}
+		copyPath(v, child, path[1:])
+	case []any:
+		items, _ := dst[path[0]].([]any)
+		if items == nil {
+			items = make([]any, len(v))
+			for i := range items {
+				items[i] = map[string]any{}
+			}
+			dst[path[0]] = items
+		}
+		for i, item := range v {
+			m, isMap := item.(map[string]any)
+			child, isChild := items[i].(map[string]any)
+			if isMap && isChild {
+				copyPath(m, child, path[1:])
+			}
+		}
+	}
+}
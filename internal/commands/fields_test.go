@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+)
+
+func TestSelectFields(t *testing.T) {
+	card := map[string]any{
+		"number":    float64(42),
+		"title":     "Fix login",
+		"status":    "open",
+		"column":    map[string]any{"id": "col-1", "name": "Doing"},
+		"assignees": []any{map[string]any{"id": "u1", "name": "Ann"}, map[string]any{"id": "u2", "name": "Bo"}},
+	}
+
+	tests := []struct {
+		name   string
+		data   any
+		fields string
+		want   any
+	}{
+		{
+			name:   "top-level and nested fields",
+			data:   card,
+			fields: "number, title,column.name",
+			want:   map[string]any{"number": float64(42), "title": "Fix login", "column": map[string]any{"name": "Doing"}},
+		},
+		{
+			name:   "path through a list",
+			data:   card,
+			fields: "assignees.name",
+			want:   map[string]any{"assignees": []any{map[string]any{"name": "Ann"}, map[string]any{"name": "Bo"}}},
+		},
+		{
+			name:   "each list item",
+			data:   []any{card, map[string]any{"number": float64(7)}},
+			fields: "number,title",
+			want:   []any{map[string]any{"number": float64(42), "title": "Fix login"}, map[string]any{"number": float64(7)}},
+		},
+		{
+			name:   "typed data in its JSON form",
+			data:   []Attachment{{Index: 1, Filename: "a.png", SGID: "s"}},
+			fields: "filename",
+			want:   []any{map[string]any{"filename": "a.png"}},
+		},
+		{
+			name:   "scalars untouched",
+			data:   "ok",
+			fields: "title",
+			want:   "ok",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := selectFields(tt.data, tt.fields); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("selectFields() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldsFlagPrunesOutput(t *testing.T) {
+	mock := NewMockClient()
+	mock.OnGet("/boards/b1.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{"id": "b1", "name": "Roadmap", "all_access": true}})
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	cfgFields = "name"
+	err := boardShowCmd.RunE(boardShowCmd, []string{"b1"})
+	assertExitCode(t, err, 0)
+
+	if data := result.Response.Data; !reflect.DeepEqual(data, map[string]any{"name": "Roadmap"}) {
+		t.Errorf("expected pruned data, got %v", data)
+	}
+}
@@ -0,0 +1,565 @@
+package commands
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+// filterExpr is a parsed --filter expression evaluated client-side against
+// each fetched item. The grammar is intentionally small:
+//
+//	expr       := term ("or" term)*
+//	term       := factor ("and" factor)*
+//	factor     := "not" factor | "(" expr ")" | field op value
+//	op         := == | != | > | >= | < | <= | contains | matches
+//	value      := "quoted string" | null | true | false | bareword
+//
+// Fields are dotted paths into the item (e.g. board.name). A singular field
+// name falls back to its plural key, so `assignee == null` matches cards with
+// no assignees.
+type filterExpr interface {
+	match(item map[string]any) bool
+}
+
+type filterAnd struct{ left, right filterExpr }
+type filterOr struct{ left, right filterExpr }
+type filterNot struct{ inner filterExpr }
+
+type filterCompare struct {
+	field string
+	op    string
+	value filterValue
+	re    *regexp.Regexp
+}
+
+type filterValue struct {
+	null bool
+	text string
+}
+
+func (e filterAnd) match(item map[string]any) bool {
+	return e.left.match(item) && e.right.match(item)
+}
+
+func (e filterOr) match(item map[string]any) bool {
+	return e.left.match(item) || e.right.match(item)
+}
+
+func (e filterNot) match(item map[string]any) bool {
+	return !e.inner.match(item)
+}
+
+// filterPlacement matches cards sitting in a column, given by ID or name or
+// as a pseudo-column alias. Closed, postponed, and untriaged cards sit in
+// Done, Not Now, and Maybe?, whatever their column field says.
+type filterPlacement struct{ column string }
+
+func (e filterPlacement) match(item map[string]any) bool {
+	placement := cardPlacement(item)
+	if pseudo, ok := parsePseudoColumnID(e.column); ok {
+		return placement == pseudo.Name
+	}
+	if strings.EqualFold(placement, e.column) {
+		return true
+	}
+	column, _ := item["column"].(map[string]any)
+	return placement == getStringField(column, "name") && getStringField(column, "id") == e.column
+}
+
+// parseFilter parses a --filter expression. An empty expression yields nil.
+func parseFilter(input string) (filterExpr, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, nil
+	}
+	tokens, err := tokenizeFilter(input)
+	if err != nil {
+		return nil, errors.NewInvalidArgsError("invalid --filter: " + err.Error())
+	}
+	p := &filterParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+	if err != nil {
+		return nil, errors.NewInvalidArgsError("invalid --filter: " + err.Error())
+	}
+	return expr, nil
+}
+
+// applyFilter returns the items matching expr. A nil expr returns items unchanged.
+func applyFilter(items any, expr filterExpr) any {
+	if expr == nil {
+		return items
+	}
+	matched := make([]map[string]any, 0)
+	for _, item := range toSliceAny(items) {
+		if m, ok := item.(map[string]any); ok && expr.match(m) {
+			matched = append(matched, m)
+		}
+	}
+	return matched
+}
+
+// titlePatternFilter builds a filter from --title-match (regex) and
+// --title-glob (shell-style, case-insensitive, anchored). Either may be empty;
+// when both are empty it returns nil.
+func titlePatternFilter(pattern, glob string) (filterExpr, error) {
+	var exprs []filterExpr
+	if pattern != "" {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("invalid --title-match: %v", err))
+		}
+		exprs = append(exprs, filterCompare{field: "title", op: "matches", re: re})
+	}
+	if glob != "" {
+		exprs = append(exprs, filterCompare{field: "title", op: "matches", re: globToRegexp(glob)})
+	}
+	return combineFilters(exprs...), nil
+}
+
+// exclusionFilter builds a filter from --not-tag, --not-assignee, and
+// --not-column: cards carrying any of the tags, assigned to any of the users,
+// or in any of the columns are left out. Users and columns match by ID or
+// name, tags by name, all case-insensitively; columns also match the
+// pseudo-columns, so --not-column done leaves out closed cards. It returns
+// nil when no values are given.
+func exclusionFilter(tags, assignees, columns []string) filterExpr {
+	var exprs []filterExpr
+	exclude := func(field string, values []string) {
+		for _, value := range values {
+			value = strings.TrimSpace(value)
+			if field == "tags" {
+				value = strings.TrimPrefix(value, "#")
+			}
+			if value != "" {
+				exprs = append(exprs, filterNot{filterCompare{field: field, op: "==", value: filterValue{text: value}}})
+			}
+		}
+	}
+	exclude("tags", tags)
+	exclude("assignees", assignees)
+	for _, column := range columns {
+		if column = strings.TrimSpace(column); column != "" {
+			exprs = append(exprs, filterNot{filterPlacement{column: column}})
+		}
+	}
+	return combineFilters(exprs...)
+}
+
+// dateRangeFilter builds a filter from --<name>-after and --<name>-before,
+// keeping items whose <name>_at is on or after after and before before. Both
+// take ISO 8601 dates or times; either may be empty, and when both are it
+// returns nil.
+func dateRangeFilter(name, after, before string) (filterExpr, error) {
+	field := name + "_at"
+	var exprs []filterExpr
+	var afterTime time.Time
+	if after != "" {
+		t, ok := parseFilterTime(after)
+		if !ok {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("invalid --%s-after %q: use a date such as 2025-01-31 or a time such as 2025-01-31T09:00:00Z", name, after))
+		}
+		afterTime = t
+		exprs = append(exprs, filterCompare{field: field, op: ">=", value: filterValue{text: after}})
+	}
+	if before != "" {
+		t, ok := parseFilterTime(before)
+		if !ok {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("invalid --%s-before %q: use a date such as 2025-01-31 or a time such as 2025-01-31T09:00:00Z", name, before))
+		}
+		if after != "" && !t.After(afterTime) {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("--%s-before must be later than --%s-after", name, name))
+		}
+		exprs = append(exprs, filterCompare{field: field, op: "<", value: filterValue{text: before}})
+	}
+	return combineFilters(exprs...), nil
+}
+
+// combineFilters ANDs the non-nil expressions together.
+func combineFilters(exprs ...filterExpr) filterExpr {
+	var combined filterExpr
+	for _, expr := range exprs {
+		if expr == nil {
+			continue
+		}
+		if combined == nil {
+			combined = expr
+		} else {
+			combined = filterAnd{combined, expr}
+		}
+	}
+	return combined
+}
+
+// globToRegexp translates * and ? wildcards; everything else matches literally.
+func globToRegexp(glob string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("(?is)^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.MustCompile(sb.String())
+}
+
+type filterToken struct {
+	text   string
+	quoted bool
+}
+
+func tokenizeFilter(input string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')':
+			tokens = append(tokens, filterToken{text: string(r)})
+			i++
+		case r == '"' || r == '\'':
+			var sb strings.Builder
+			j := i + 1
+			for ; j < len(runes) && runes[j] != r; j++ {
+				// Only the quote character and backslash are escapable so
+				// regex escapes like \d pass through untouched.
+				if runes[j] == '\\' && j+1 < len(runes) && (runes[j+1] == r || runes[j+1] == '\\') {
+					j++
+				}
+				sb.WriteRune(runes[j])
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			tokens = append(tokens, filterToken{text: sb.String(), quoted: true})
+			i = j + 1
+		case strings.ContainsRune("=!<>", r):
+			op := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				op += "="
+			}
+			if op == "=" || op == "!" {
+				return nil, fmt.Errorf("unknown operator %q", op)
+			}
+			tokens = append(tokens, filterToken{text: op})
+			i += len(op)
+		default:
+			j := i
+			for j < len(runes) && !unicode.IsSpace(runes[j]) && !strings.ContainsRune("()\"'=!<>", runes[j]) {
+				j++
+			}
+			tokens = append(tokens, filterToken{text: string(runes[i:j])})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *filterParser) peekKeyword(kw string) bool {
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].quoted {
+		return false
+	}
+	return strings.EqualFold(p.tokens[p.pos].text, kw)
+}
+
+func (p *filterParser) next() (filterToken, error) {
+	if p.pos >= len(p.tokens) {
+		return filterToken{}, fmt.Errorf("unexpected end of expression")
+	}
+	tok := p.tokens[p.pos]
+	p.pos++
+	return tok, nil
+}
+
+func (p *filterParser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = filterOr{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterExpr, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = filterAnd{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseFactor() (filterExpr, error) {
+	if p.peekKeyword("not") {
+		p.pos++
+		inner, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return filterNot{inner}, nil
+	}
+	if p.peekKeyword("(") {
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.peekKeyword(")") {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return inner, nil
+	}
+
+	field, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if field.quoted || field.text == ")" {
+		return nil, fmt.Errorf("expected field name, got %q", field.text)
+	}
+	opTok, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	op := strings.ToLower(opTok.text)
+	switch op {
+	case "==", "!=", ">", ">=", "<", "<=", "contains", "matches":
+	default:
+		return nil, fmt.Errorf("unknown operator %q after %s", opTok.text, field.text)
+	}
+	valTok, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if !valTok.quoted && (valTok.text == "(" || valTok.text == ")") {
+		return nil, fmt.Errorf("expected value after %s %s", field.text, opTok.text)
+	}
+
+	cmp := filterCompare{field: field.text, op: op, value: filterValue{text: valTok.text}}
+	if !valTok.quoted && strings.EqualFold(valTok.text, "null") {
+		if op != "==" && op != "!=" {
+			return nil, fmt.Errorf("null can only be compared with == or !=")
+		}
+		cmp.value = filterValue{null: true}
+	}
+	if op == "matches" {
+		re, err := regexp.Compile(valTok.text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %v", valTok.text, err)
+		}
+		cmp.re = re
+	}
+	return cmp, nil
+}
+
+func (c filterCompare) match(item map[string]any) bool {
+	actual := lookupFilterField(item, c.field)
+
+	if c.value.null {
+		isNull := filterIsEmpty(actual)
+		if c.op == "==" {
+			return isNull
+		}
+		return !isNull
+	}
+
+	// != is the negation of ==, so "tags != bug" means no tag is "bug".
+	if c.op == "!=" {
+		eq := c
+		eq.op = "=="
+		return !eq.match(item)
+	}
+
+	// Lists match when any element satisfies the comparison.
+	if list, ok := actual.([]any); ok {
+		for _, el := range list {
+			if c.matchScalar(el) {
+				return true
+			}
+		}
+		return false
+	}
+	return c.matchScalar(actual)
+}
+
+func (c filterCompare) matchScalar(actual any) bool {
+	if actual == nil {
+		return false
+	}
+	if m, ok := actual.(map[string]any); ok {
+		for _, key := range []string{"name", "title", "id"} {
+			if v, ok := m[key]; ok && c.matchScalar(v) {
+				return true
+			}
+		}
+		return false
+	}
+
+	text := filterScalarString(actual)
+	switch c.op {
+	case "contains":
+		return strings.Contains(strings.ToLower(text), strings.ToLower(c.value.text))
+	case "matches":
+		return c.re.MatchString(text)
+	}
+
+	cmp := compareFilterValues(actual, c.value.text)
+	switch c.op {
+	case "==":
+		return cmp == 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+	return false
+}
+
+// compareFilterValues orders actual against a literal, comparing as numbers,
+// booleans, or timestamps when both sides parse as such, and falling back to
+// case-insensitive string comparison.
+func compareFilterValues(actual any, literal string) int {
+	switch a := actual.(type) {
+	case float64:
+		if b, err := strconv.ParseFloat(literal, 64); err == nil {
+			switch {
+			case a < b:
+				return -1
+			case a > b:
+				return 1
+			}
+			return 0
+		}
+	case bool:
+		if b, err := strconv.ParseBool(literal); err == nil {
+			if a == b {
+				return 0
+			}
+			if !a {
+				return -1
+			}
+			return 1
+		}
+	case string:
+		if at, ok := parseFilterTime(a); ok {
+			if bt, ok := parseFilterTime(literal); ok {
+				return at.Compare(bt)
+			}
+		}
+	}
+	return strings.Compare(strings.ToLower(filterScalarString(actual)), strings.ToLower(literal))
+}
+
+func parseFilterTime(s string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func filterScalarString(v any) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case float64:
+		return strconv.FormatFloat(s, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func filterIsEmpty(v any) bool {
+	switch x := v.(type) {
+	case nil:
+		return true
+	case string:
+		return x == ""
+	case []any:
+		return len(x) == 0
+	case map[string]any:
+		return len(x) == 0
+	}
+	return false
+}
+
+// lookupFilterField resolves a dotted path against an item. Stepping through
+// a list collects the field from each element.
+func lookupFilterField(item map[string]any, path string) any {
+	var current any = item
+	for _, part := range strings.Split(path, ".") {
+		current = lookupFilterKey(current, part)
+		if current == nil {
+			return nil
+		}
+	}
+	return current
+}
+
+func lookupFilterKey(v any, key string) any {
+	switch x := v.(type) {
+	case map[string]any:
+		if val, ok := x[key]; ok {
+			return val
+		}
+		if val, ok := x[key+"s"]; ok {
+			return val
+		}
+		return nil
+	case []any:
+		var collected []any
+		for _, el := range x {
+			val := lookupFilterKey(el, key)
+			if nested, ok := val.([]any); ok {
+				collected = append(collected, nested...)
+			} else if val != nil {
+				collected = append(collected, val)
+			}
+		}
+		if collected == nil {
+			return []any{}
+		}
+		return collected
+	}
+	return nil
+}
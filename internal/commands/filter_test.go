@@ -0,0 +1,223 @@
+package commands
+
+import (
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+	card := map[string]any{
+		"number":     float64(42),
+		"title":      "Release 1.2 checklist",
+		"closed":     false,
+		"golden":     true,
+		"created_at": "2025-03-10T12:00:00Z",
+		"tags":       []any{"bug", "release"},
+		"assignees":  []any{map[string]any{"id": "u1", "name": "Ann"}},
+		"board":      map[string]any{"id": "b1", "name": "Engineering"},
+		"column":     nil,
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`tags contains "bug"`, true},
+		{`tags contains "feature"`, false},
+		{`tags == release`, true},
+		{`tags != release`, false},
+		{`created_at > 2025-01-01`, true},
+		{`created_at < 2025-01-01`, false},
+		{`created_at >= 2025-03-10T12:00:00Z`, true},
+		{`number >= 42 and number < 100`, true},
+		{`number == 7`, false},
+		{`closed == false and golden == true`, true},
+		{`assignee == null`, false},
+		{`column == null`, true},
+		{`column != null`, false},
+		{`missing == null`, true},
+		{`board.name == "engineering"`, true},
+		{`board == Engineering`, true},
+		{`assignees.name == Ann`, true},
+		{`title matches "^Release \d"`, true},
+		{`title contains CHECKLIST`, true},
+		{`not tags contains bug`, false},
+		{`tags contains feature or golden == true`, true},
+		{`(tags contains feature or number == 1) and golden == true`, false},
+		{`title == 'Release 1.2 checklist'`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := parseFilter(tt.expr)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+			if got := expr.match(card); got != tt.want {
+				t.Errorf("match = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFilterErrors(t *testing.T) {
+	tests := []string{
+		`tags`,
+		`tags contains`,
+		`tags ~ bug`,
+		`tags = bug`,
+		`(tags contains bug`,
+		`tags contains "bug`,
+		`number > null`,
+		`title matches "("`,
+		`tags contains bug and`,
+		`tags contains bug extra`,
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			if _, err := parseFilter(input); err == nil {
+				t.Errorf("expected error for %q", input)
+			}
+		})
+	}
+}
+
+func TestApplyFilter(t *testing.T) {
+	items := []map[string]any{
+		{"id": "1", "closed": true},
+		{"id": "2", "closed": false},
+	}
+
+	if got := applyFilter(items, nil); dataCount(got) != 2 {
+		t.Errorf("nil filter should keep all items, got %d", dataCount(got))
+	}
+
+	expr, err := parseFilter("closed == true")
+	if err != nil {
+		t.Fatalf("unexpected parse error: %v", err)
+	}
+	got := toMaps(applyFilter(items, expr))
+	if len(got) != 1 || got[0]["id"] != "1" {
+		t.Errorf("expected only item 1, got %v", got)
+	}
+}
+
+func TestTitlePatternFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		glob    string
+		title   string
+		want    bool
+	}{
+		{"glob prefix", "", "Release *", "Release 1.2", true},
+		{"glob is anchored", "", "Release *", "Pre-Release 1.2", false},
+		{"glob is case-insensitive", "", "release ?.?", "Release 1.2", true},
+		{"glob escapes regex metacharacters", "", "v1.2 (beta)*", "v1.2 (beta) notes", true},
+		{"glob dot is literal", "", "v1.2", "v1x2", false},
+		{"regex", `^Fix \w+ crash$`, "", "Fix login crash", true},
+		{"regex no match", `^Fix \w+ crash$`, "", "Fix the login crash", false},
+		{"both must match", `\d`, "Release *", "Release notes", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := titlePatternFilter(tt.pattern, tt.glob)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := expr.match(map[string]any{"title": tt.title}); got != tt.want {
+				t.Errorf("match(%q) = %v, want %v", tt.title, got, tt.want)
+			}
+		})
+	}
+
+	if expr, err := titlePatternFilter("", ""); err != nil || expr != nil {
+		t.Errorf("expected nil filter for empty patterns, got %v, %v", expr, err)
+	}
+	if _, err := titlePatternFilter("(", ""); err == nil {
+		t.Error("expected error for invalid regex")
+	}
+}
+
+func TestExclusionFilter(t *testing.T) {
+	card := map[string]any{
+		"tags":      []any{"bug", "Chore"},
+		"assignees": []any{map[string]any{"id": "u1", "name": "Ada"}},
+		"column":    map[string]any{"id": "c1", "name": "Doing"},
+	}
+	tests := []struct {
+		name      string
+		tags      []string
+		assignees []string
+		columns   []string
+		want      bool
+	}{
+		{"tag by name", []string{"chore"}, nil, nil, false},
+		{"tag with hash", []string{"#bug"}, nil, nil, false},
+		{"other tag", []string{"feature"}, nil, nil, true},
+		{"assignee by ID", nil, []string{"u1"}, nil, false},
+		{"assignee by name", nil, []string{"ada"}, nil, false},
+		{"other assignee", nil, []string{"u2"}, nil, true},
+		{"column by name", nil, nil, []string{"doing"}, false},
+		{"any value excludes", nil, nil, []string{"Done", "c1"}, false},
+		{"pseudo-column the card isn't in", nil, nil, []string{"done", "not-now", "maybe"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr := exclusionFilter(tt.tags, tt.assignees, tt.columns)
+			if got := expr.match(card); got != tt.want {
+				t.Errorf("match = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if expr := exclusionFilter(nil, nil, nil); expr != nil {
+		t.Errorf("expected nil filter without values, got %v", expr)
+	}
+	if !exclusionFilter([]string{"chore"}, nil, nil).match(map[string]any{"title": "No tags"}) {
+		t.Errorf("expected a card without tags to be kept")
+	}
+
+	closed := map[string]any{"closed": true, "column": map[string]any{"id": "c1", "name": "Doing"}}
+	if exclusionFilter(nil, nil, []string{"done"}).match(closed) {
+		t.Errorf("expected --not-column done to leave out a closed card")
+	}
+	if !exclusionFilter(nil, nil, []string{"Doing"}).match(closed) || !exclusionFilter(nil, nil, []string{"c1"}).match(closed) {
+		t.Errorf("expected a closed card to be kept by --not-column for its old column")
+	}
+	if exclusionFilter(nil, nil, []string{"maybe"}).match(map[string]any{"title": "Untriaged"}) {
+		t.Errorf("expected --not-column maybe to leave out an untriaged card")
+	}
+}
+
+func TestDateRangeFilter(t *testing.T) {
+	expr, err := dateRangeFilter("created", "2025-01-01", "2025-02-01")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for createdAt, want := range map[string]bool{
+		"2024-12-31T23:59:59Z": false,
+		"2025-01-01T00:00:00Z": true,
+		"2025-01-31T18:00:00Z": true,
+		"2025-02-01T00:00:00Z": false,
+	} {
+		if got := expr.match(map[string]any{"created_at": createdAt}); got != want {
+			t.Errorf("match(%s) = %v, want %v", createdAt, got, want)
+		}
+	}
+	if expr.match(map[string]any{"title": "No date"}) {
+		t.Error("expected an item without the date to be left out")
+	}
+
+	if expr, err := dateRangeFilter("closed", "", ""); err != nil || expr != nil {
+		t.Errorf("expected nil filter without dates, got %v, %v", expr, err)
+	}
+	if _, err := dateRangeFilter("created", "last week", ""); err == nil {
+		t.Error("expected error for a non-ISO date")
+	}
+	if _, err := dateRangeFilter("created", "2025-02-01", "2025-01-01"); err == nil {
+		t.Error("expected error for an empty range")
+	}
+}
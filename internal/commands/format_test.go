@@ -8,6 +8,7 @@ import (
 
 	"github.com/basecamp/cli/output"
 	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/i18n"
 )
 
 func TestResolveFormat(t *testing.T) {
@@ -170,6 +171,31 @@ func TestFormatIDsOutput(t *testing.T) {
 	}
 }
 
+func TestFormatIDsOutputCardNumbers(t *testing.T) {
+	mock := NewMockClient()
+	mock.GetWithPaginationResponse = &client.APIResponse{
+		StatusCode: 200,
+		Data: []any{
+			map[string]any{"id": "03f5v9zkft", "number": 42, "title": "Fix login"},
+			map[string]any{"id": "03f5v9zkfu", "number": 43, "title": "Ship it"},
+		},
+	}
+
+	SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	SetTestFormat(output.FormatIDs)
+	defer resetTest()
+
+	err := cardListCmd.RunE(cardListCmd, []string{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if raw := strings.TrimSpace(TestOutput()); raw != "42\n43" {
+		t.Errorf("expected card numbers one per line, got %q", raw)
+	}
+}
+
 func TestFormatCountOutput(t *testing.T) {
 	mock := NewMockClient()
 	mock.GetWithPaginationResponse = &client.APIResponse{
@@ -332,6 +358,7 @@ func runCobraWithArgs(args ...string) (string, error) {
 	cfgStyled = false
 	cfgMarkdown = false
 	cfgJQ = ""
+	cfgOutputFile = ""
 	testBuf.Reset()
 	lastRawOutput = ""
 	out = output.New(output.Options{Format: output.FormatJSON, Writer: &testBuf})
@@ -863,3 +890,51 @@ func TestCheckLimitAll(t *testing.T) {
 		}
 	})
 }
+
+func TestLocalizedHumanOutput(t *testing.T) {
+	boards := &client.APIResponse{
+		StatusCode: 200,
+		Data: []interface{}{
+			map[string]interface{}{"id": "1", "name": "Board 1"},
+			map[string]interface{}{"id": "2", "name": "Board 2"},
+		},
+	}
+
+	t.Run("markdown output is translated", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetWithPaginationResponse = boards
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		SetTestFormat(output.FormatMarkdown)
+		i18n.SetLocale("es")
+		defer resetTest()
+
+		if err := boardListCmd.RunE(boardListCmd, []string{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		raw := TestOutput()
+		for _, want := range []string{"2 tableros", "| Nombre", "### Siguientes pasos"} {
+			if !strings.Contains(raw, want) {
+				t.Errorf("expected %q in localized output:\n%s", want, raw)
+			}
+		}
+	})
+
+	t.Run("JSON output is not translated", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetWithPaginationResponse = boards
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		i18n.SetLocale("es")
+		defer resetTest()
+
+		if err := boardListCmd.RunE(boardListCmd, []string{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Response.Summary != "2 boards" {
+			t.Errorf("expected English summary in JSON, got %q", result.Response.Summary)
+		}
+	})
+}
@@ -0,0 +1,191 @@
+package commands
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+)
+
+// TestAPIEndpointsSnapshot checks that api_endpoints.txt lists the API
+// endpoints the commands call.
+func TestAPIEndpointsSnapshot(t *testing.T) {
+	fresh := strings.Join(scanAPIEndpoints(t), "\n") + "\n"
+	if fresh != apiEndpointsTxt {
+		t.Fatal("api_endpoints.txt is out of date. Run 'make api-endpoints' to regenerate.")
+	}
+}
+
+// TestGenerateAPIEndpoints writes api_endpoints.txt from the command sources.
+// Only runs when GENERATE_API_ENDPOINTS is set (see Makefile api-endpoints target).
+func TestGenerateAPIEndpoints(t *testing.T) {
+	if os.Getenv("GENERATE_API_ENDPOINTS") == "" {
+		t.Skip("set GENERATE_API_ENDPOINTS=1 to regenerate api_endpoints.txt")
+	}
+	_, thisFile, _, _ := runtime.Caller(0)
+	path := filepath.Join(filepath.Dir(thisFile), "api_endpoints.txt")
+	if err := os.WriteFile(path, []byte(strings.Join(scanAPIEndpoints(t), "\n")+"\n"), 0644); err != nil {
+		t.Fatalf("Failed to write api_endpoints.txt: %v", err)
+	}
+	t.Logf("Wrote %s", path)
+}
+
+// rawRequestVerbs maps the SDK's and legacy client's request methods that
+// take a path to their HTTP methods.
+var rawRequestVerbs = map[string]string{
+	"Get":                "GET",
+	"GetAll":             "GET",
+	"GetAllWithLimit":    "GET",
+	"GetWithPagination":  "GET",
+	"Post":               "POST",
+	"Put":                "PUT",
+	"Patch":              "PATCH",
+	"PatchMultipart":     "PATCH",
+	"Delete":             "DELETE",
+	"FollowLocation":     "GET",
+	"DownloadFile":       "GET",
+	"UploadFile":         "POST",
+	"CreateDirectUpload": "POST",
+}
+
+// scanAPIEndpoints finds the endpoints called from the command sources:
+// SDK service methods such as ac.Cards().Assign(...), resolved through the
+// SDK's operation registry, and requests made with a literal path such as
+// ac.GetAll(ctx, "/users.json").
+func scanAPIEndpoints(t *testing.T) []string {
+	t.Helper()
+	_, thisFile, _, _ := runtime.Caller(0)
+	files, err := filepath.Glob(filepath.Join(filepath.Dir(thisFile), "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operations := map[string]string{}
+	for operationID, method := range fizzy.OperationRegistry {
+		operations[method] = operationID
+	}
+	services := map[string]string{}
+	for _, client := range []any{&fizzy.AccountClient{}, &fizzy.Client{}} {
+		typ := reflect.TypeOf(client)
+		for i := range typ.NumMethod() {
+			m := typ.Method(i)
+			if m.Type.NumIn() == 1 && m.Type.NumOut() == 1 && strings.HasSuffix(m.Type.Out(0).String(), "Service") {
+				services[m.Name] = m.Type.Out(0).Elem().Name()
+			}
+		}
+	}
+
+	// Requests with a literal path are listed under the SDK's route pattern
+	// when there is one, so each endpoint appears once.
+	routes := map[string]string{}
+	for _, route := range fizzy.URLRoutes() {
+		for verb := range route.Operations {
+			routes[verb+" "+normalizeAPIPath(route.Pattern)] = route.Pattern
+		}
+	}
+
+	found := map[string]bool{}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		parsed, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ast.Inspect(parsed, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			// ac.Cards().Assign(...)
+			if inner, ok := sel.X.(*ast.CallExpr); ok && len(inner.Args) == 0 {
+				if accessor, ok := inner.Fun.(*ast.SelectorExpr); ok {
+					if service, ok := services[accessor.Sel.Name]; ok {
+						if operationID, ok := operations[service+"."+sel.Sel.Name]; ok {
+							if route, ok := fizzy.URLRouteByOperation(operationID); ok {
+								for verb, id := range route.Operations {
+									if id == operationID {
+										found[verb+" "+route.Pattern] = true
+									}
+								}
+							}
+						}
+						return true
+					}
+				}
+			}
+			// ac.GetAll(ctx, "/users.json")
+			verb, ok := rawRequestVerbs[sel.Sel.Name]
+			if !ok {
+				return true
+			}
+			for _, arg := range call.Args {
+				if path, ok := pathTemplate(arg); ok {
+					if pattern, ok := routes[verb+" "+normalizeAPIPath(path)]; ok {
+						path = pattern
+					}
+					found[verb+" "+path] = true
+					break
+				}
+			}
+			return true
+		})
+	}
+
+	endpoints := make([]string, 0, len(found))
+	for endpoint := range found {
+		endpoints = append(endpoints, endpoint)
+	}
+	slices.Sort(endpoints)
+	return endpoints
+}
+
+// pathTemplate renders a path expression as a template, with "{}" for the
+// parts only known at run time. Only expressions starting with a literal
+// "/" are paths.
+func pathTemplate(expr ast.Expr) (string, bool) {
+	var render func(ast.Expr) string
+	render = func(e ast.Expr) string {
+		switch e := e.(type) {
+		case *ast.BasicLit:
+			if s, err := strconv.Unquote(e.Value); err == nil && e.Kind == token.STRING {
+				return s
+			}
+		case *ast.BinaryExpr:
+			if e.Op == token.ADD {
+				return render(e.X) + render(e.Y)
+			}
+		case *ast.CallExpr:
+			// fmt.Sprintf("/cards/%s.json", number)
+			if sel, ok := e.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Sprintf" && len(e.Args) > 0 {
+				format := render(e.Args[0])
+				for _, verb := range []string{"%s", "%d", "%v"} {
+					format = strings.ReplaceAll(format, verb, "{}")
+				}
+				return format
+			}
+		}
+		return "{}"
+	}
+	path := render(expr)
+	if !strings.HasPrefix(path, "/") {
+		return "", false
+	}
+	path, _, _ = strings.Cut(path, "?")
+	return path, true
+}
@@ -0,0 +1,150 @@
+package commands
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/basecamp/cli/output"
+)
+
+// cfgGHA is the --gha flag, for GitHub Actions workflows.
+var cfgGHA bool
+
+// ghaMode reports whether --gha is set.
+func ghaMode() bool {
+	return cfgGHA
+}
+
+// writeGHAResult reports a successful result to GitHub Actions: the summary
+// as a ::notice, and the result's top-level scalar fields (number, id, url,
+// ...) as step outputs in $GITHUB_OUTPUT. Lists are reported by their count.
+func writeGHAResult(resp *output.Response) error {
+	envelope, err := envelopeJSON(resp)
+	if err != nil {
+		return err
+	}
+	summary, _ := envelope["summary"].(string)
+	if summary != "" {
+		fmt.Fprintf(outWriter, "::notice title=fizzy::%s\n", escapeGHAData(summary))
+	} else if data, ok := envelope["data"].(map[string]any); ok && getStringField(data, "title") != "" {
+		// Results without a summary, such as a created card, are announced
+		// by their number and title.
+		notice := getStringField(data, "title")
+		if number, ok := ghaScalar(data["number"]); ok {
+			notice = "#" + number + " " + notice
+		}
+		fmt.Fprintf(outWriter, "::notice title=fizzy::%s\n", escapeGHAData(notice))
+	}
+
+	outputs := map[string]string{}
+	if summary != "" {
+		outputs["summary"] = summary
+	}
+	if context, ok := envelope["context"].(map[string]any); ok {
+		if location := getStringField(context, "location"); location != "" {
+			outputs["location"] = location
+		}
+	}
+	switch data := envelope["data"].(type) {
+	case map[string]any:
+		for key, value := range data {
+			if s, ok := ghaScalar(value); ok {
+				outputs[key] = s
+			}
+		}
+	case []any:
+		outputs["count"] = strconv.Itoa(len(data))
+	default:
+		if s, ok := ghaScalar(data); ok {
+			outputs["result"] = s
+		}
+	}
+	return writeGHAOutputs(outputs)
+}
+
+// writeGHAError reports a failed command to w as an ::error, with its hint
+// on the following line.
+func writeGHAError(w io.Writer, e *output.Error) {
+	message := e.Message
+	if e.Hint != "" {
+		message += "\n" + e.Hint
+	}
+	title := "fizzy"
+	if e.Code != "" {
+		title += " " + e.Code
+	}
+	fmt.Fprintf(w, "::error title=%s::%s\n", escapeGHAProperty(title), escapeGHAData(message))
+}
+
+// writeGHAOutputs appends outputs to the $GITHUB_OUTPUT file, if the
+// command runs in a workflow step. Multi-line values use the heredoc form.
+func writeGHAOutputs(outputs map[string]string) error {
+	path := os.Getenv("GITHUB_OUTPUT")
+	if path == "" || len(outputs) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(outputs))
+	for key := range outputs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, key := range keys {
+		value := outputs[key]
+		if !strings.ContainsAny(value, "\r\n") {
+			fmt.Fprintf(&b, "%s=%s\n", key, value)
+			continue
+		}
+		delimiter, err := ghaDelimiter()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&b, "%s<<%s\n%s\n%s\n", key, delimiter, value, delimiter)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("cannot write GITHUB_OUTPUT: %w", err)
+	}
+	defer f.Close()
+	_, err = f.WriteString(b.String())
+	return err
+}
+
+func ghaScalar(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
+
+func ghaDelimiter() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "FIZZY_EOF_" + hex.EncodeToString(b), nil
+}
+
+// escapeGHAData escapes the message of a workflow command.
+func escapeGHAData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeGHAProperty escapes a workflow command property value.
+func escapeGHAProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/client"
+)
+
+func TestGHAOutput(t *testing.T) {
+	t.Run("notice and step outputs for a created card", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{
+			StatusCode: 201,
+			Location:   "/cards/42",
+			Data:       map[string]any{"id": "abc", "number": 42, "title": "Deploy failed", "tags": []any{"ci"}},
+		}
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		outputPath := filepath.Join(t.TempDir(), "github_output")
+		t.Setenv("GITHUB_OUTPUT", outputPath)
+		cfgGHA = true
+		cardCreateBoard, cardCreateTitle = "123", "Deploy failed"
+		defer func() { cardCreateBoard, cardCreateTitle = "", "" }()
+
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		assertExitCode(t, err, 0)
+
+		if got := TestOutput(); got != "::notice title=fizzy::#42 Deploy failed\n" {
+			t.Errorf("expected a notice for the card, got %q", got)
+		}
+		raw, err := os.ReadFile(outputPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, line := range []string{"id=abc\n", "number=42\n", "location=/cards/42\n", "title=Deploy failed\n"} {
+			if !strings.Contains(string(raw), line) {
+				t.Errorf("expected %q in GITHUB_OUTPUT, got:\n%s", line, raw)
+			}
+		}
+		if strings.Contains(string(raw), "tags") {
+			t.Errorf("expected lists to be left out of GITHUB_OUTPUT, got:\n%s", raw)
+		}
+	})
+
+	t.Run("multi-line values use a delimiter", func(t *testing.T) {
+		outputPath := filepath.Join(t.TempDir(), "github_output")
+		t.Setenv("GITHUB_OUTPUT", outputPath)
+
+		if err := writeGHAOutputs(map[string]string{"body": "line one\nline two"}); err != nil {
+			t.Fatal(err)
+		}
+		raw, _ := os.ReadFile(outputPath)
+		lines := strings.Split(strings.TrimSuffix(string(raw), "\n"), "\n")
+		if len(lines) != 4 || !strings.HasPrefix(lines[0], "body<<") || lines[3] != strings.TrimPrefix(lines[0], "body<<") {
+			t.Errorf("unexpected heredoc output:\n%s", raw)
+		}
+	})
+
+	t.Run("errors become escaped error commands", func(t *testing.T) {
+		var buf bytes.Buffer
+		writeGHAError(&buf, &output.Error{Code: "not_found", Message: "Card 100% gone", Hint: "Check the number"})
+		want := "::error title=fizzy not_found::Card 100%25 gone%0ACheck the number\n"
+		if buf.String() != want {
+			t.Errorf("got %q, want %q", buf.String(), want)
+		}
+	})
+
+	t.Run("rejects other format flags", func(t *testing.T) {
+		defer resetTest()
+		cfgGHA, cfgJSON = true, true
+		if _, err := resolveFormat(); err == nil {
+			t.Error("expected --gha with --json to be rejected")
+		}
+	})
+
+	t.Run("leaves download --output to the download command", func(t *testing.T) {
+		if f := rootCmd.PersistentFlags().Lookup("output"); f != nil {
+			t.Errorf("expected no global --output flag, got %q", f.Usage)
+		}
+	})
+}
@@ -0,0 +1,311 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/cache"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/state"
+	"github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+	"github.com/spf13/cobra"
+)
+
+// daemonKey is where long-running processes record their health, one key
+// per process ID.
+const daemonKey = "daemons"
+
+// DaemonStatus is the health of a long-running fizzy process, as served on
+// /healthz and recorded for 'daemon status'.
+type DaemonStatus struct {
+	Status         string      `json:"status"`
+	Command        string      `json:"command"`
+	PID            int         `json:"pid"`
+	StartedAt      time.Time   `json:"started_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+	UptimeSeconds  int64       `json:"uptime_seconds"`
+	Interval       string      `json:"interval,omitempty"`
+	HealthAddr     string      `json:"health_addr,omitempty"`
+	LastAPISuccess *time.Time  `json:"last_api_success"`
+	LastAPIError   *time.Time  `json:"last_api_error"`
+	LastError      string      `json:"last_error,omitempty"`
+	Requests       int         `json:"requests"`
+	Failures       int         `json:"failures"`
+	QueueDepth     int         `json:"queue_depth"`
+	Cache          DaemonCache `json:"cache"`
+}
+
+// DaemonCache sums the cache areas of the process's account.
+type DaemonCache struct {
+	Entries int   `json:"entries"`
+	Bytes   int64 `json:"bytes"`
+	Hits    int   `json:"hits"`
+	Misses  int   `json:"misses"`
+}
+
+// healthHooks tracks the outcome of the SDK's requests and the work queued
+// in a long-running process.
+type healthHooks struct {
+	fizzy.NoopHooks
+	mu          sync.Mutex
+	command     string
+	started     time.Time
+	interval    time.Duration
+	addr        string
+	lastSuccess time.Time
+	lastFailure time.Time
+	lastError   string
+	requests    int
+	failures    int
+	queueDepth  int
+}
+
+// daemonHealth tracks the health of the current invocation. It is only
+// reported by long-running commands.
+var daemonHealth = &healthHooks{}
+
+func (h *healthHooks) OnRequestEnd(_ context.Context, _ fizzy.RequestInfo, result fizzy.RequestResult) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.requests++
+	if result.Error != nil || result.StatusCode >= 500 {
+		h.failures++
+		h.lastFailure = time.Now().UTC()
+		if result.Error != nil {
+			h.lastError = result.Error.Error()
+		} else {
+			h.lastError = fmt.Sprintf("HTTP %d", result.StatusCode)
+		}
+		return
+	}
+	h.lastSuccess = time.Now().UTC()
+}
+
+// setQueueDepth records how much work is waiting in the current pass.
+func (h *healthHooks) setQueueDepth(n int) {
+	h.mu.Lock()
+	h.queueDepth = n
+	h.mu.Unlock()
+}
+
+// snapshot reports the process's health. It is degraded while the latest
+// API request failed.
+func (h *healthHooks) snapshot(now time.Time) DaemonStatus {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	status := DaemonStatus{
+		Status:        "ok",
+		Command:       h.command,
+		PID:           os.Getpid(),
+		StartedAt:     h.started,
+		UpdatedAt:     now,
+		UptimeSeconds: int64(now.Sub(h.started).Seconds()),
+		HealthAddr:    h.addr,
+		LastError:     h.lastError,
+		Requests:      h.requests,
+		Failures:      h.failures,
+		QueueDepth:    h.queueDepth,
+		Cache:         daemonCacheStats(),
+	}
+	if h.interval > 0 {
+		status.Interval = h.interval.String()
+	}
+	if !h.lastSuccess.IsZero() {
+		at := h.lastSuccess
+		status.LastAPISuccess = &at
+	}
+	if !h.lastFailure.IsZero() {
+		at := h.lastFailure
+		status.LastAPIError = &at
+		if h.lastFailure.After(h.lastSuccess) {
+			status.Status = "degraded"
+		}
+	}
+	return status
+}
+
+// daemonCacheStats sums the cache areas of the configured account.
+func daemonCacheStats() DaemonCache {
+	var stats DaemonCache
+	if cfg == nil {
+		return stats
+	}
+	areas, _ := cache.Usage(cfg.Account)
+	for _, a := range areas {
+		stats.Entries += a.Entries
+		stats.Bytes += a.Bytes
+		stats.Hits += a.Hits
+		stats.Misses += a.Misses
+	}
+	return stats
+}
+
+// beat records the process's health for 'daemon status'. It is best-effort.
+func (h *healthHooks) beat() {
+	_ = state.Save(daemonKey+"/"+strconv.Itoa(os.Getpid()), h.snapshot(time.Now().UTC()))
+}
+
+// startDaemonHealth marks the invocation as long-running: its health is
+// recorded after each pass and, with addr set, served on /healthz. The
+// returned function stops serving and forgets the process.
+func startDaemonHealth(cmd *cobra.Command, interval time.Duration, addr string) (func(), error) {
+	daemonHealth.mu.Lock()
+	daemonHealth.command = cmd.CommandPath()
+	daemonHealth.started = time.Now().UTC()
+	daemonHealth.interval = interval
+	daemonHealth.mu.Unlock()
+
+	var server *http.Server
+	if addr != "" {
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("cannot listen on --health-addr: %v", err))
+		}
+		daemonHealth.mu.Lock()
+		daemonHealth.addr = listener.Addr().String()
+		daemonHealth.mu.Unlock()
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/healthz", serveHealthz)
+		server = &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+		go func() { _ = server.Serve(listener) }()
+		progressf("Serving health on http://%s/healthz\n", listener.Addr())
+	}
+	daemonHealth.beat()
+
+	return func() {
+		if server != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			_ = server.Shutdown(ctx)
+		}
+		_ = state.Delete(daemonKey + "/" + strconv.Itoa(os.Getpid()))
+	}, nil
+}
+
+// serveHealthz answers with the process's health as JSON: 200 when ok, 503
+// when degraded.
+func serveHealthz(w http.ResponseWriter, r *http.Request) {
+	status := daemonHealth.snapshot(time.Now().UTC())
+	w.Header().Set("Content-Type", "application/json")
+	if status.Status != "ok" {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+var daemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "Monitor long-running fizzy processes",
+	Long: `Commands for monitoring long-running fizzy processes, such as
+'sla watch --interval'.`,
+}
+
+// Daemon status flags
+var daemonStatusAddr string
+
+var daemonStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the health of long-running fizzy processes",
+	Long: `Shows each long-running fizzy process on this machine: its command, uptime,
+last successful API call, last API error, queue depth, and cache stats.
+
+Processes record their health after every pass. One that hasn't recorded
+for more than two intervals is reported as stale; it may have been killed
+without cleaning up. A process whose latest API request failed is degraded.
+
+With --addr, the health is fetched from a process's /healthz endpoint
+instead, which is how to check a process on another machine or container.`,
+	Example: `  fizzy daemon status
+  fizzy daemon status --addr localhost:9090`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var statuses []DaemonStatus
+		if daemonStatusAddr != "" {
+			status, err := fetchHealthz(cmd.Context(), daemonStatusAddr)
+			if err != nil {
+				return err
+			}
+			statuses = append(statuses, status)
+		} else {
+			recorded, err := loadDaemonStatuses(time.Now().UTC())
+			if err != nil {
+				return err
+			}
+			statuses = recorded
+		}
+
+		items := make([]any, 0, len(statuses))
+		unhealthy := 0
+		for _, status := range statuses {
+			if status.Status != "ok" {
+				unhealthy++
+			}
+			items = append(items, normalizeAny(status))
+		}
+		summary := fmt.Sprintf("%d %s", len(statuses), pluralize(len(statuses), "process", "processes"))
+		if unhealthy > 0 {
+			summary += fmt.Sprintf(", %d not ok", unhealthy)
+		}
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("sla", "fizzy sla watch --rules sla.yaml --interval 10m --health-addr :9090", "Run a monitored watcher"),
+		}
+		printList(items, daemonColumns, summary, breadcrumbs)
+		return nil
+	},
+}
+
+// loadDaemonStatuses reads the recorded health of every process, marking the
+// ones that stopped recording as stale.
+func loadDaemonStatuses(now time.Time) ([]DaemonStatus, error) {
+	keys, err := state.List(daemonKey)
+	if err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not read daemon status: %v", err))
+	}
+	statuses := []DaemonStatus{}
+	for _, key := range keys {
+		var status DaemonStatus
+		if found, err := state.Load(key, &status); err != nil || !found {
+			continue
+		}
+		interval, _ := time.ParseDuration(status.Interval)
+		if now.Sub(status.UpdatedAt) > 2*interval+time.Minute {
+			status.Status = "stale"
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// fetchHealthz reads a process's health from its /healthz endpoint.
+func fetchHealthz(ctx context.Context, addr string) (DaemonStatus, error) {
+	var status DaemonStatus
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+addr+"/healthz", nil)
+	if err != nil {
+		return status, errors.NewInvalidArgsError(fmt.Sprintf("invalid --addr: %v", err))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return status, errors.NewError(fmt.Sprintf("Could not reach %s: %v", addr, err))
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return status, errors.NewError(fmt.Sprintf("Invalid health response from %s (HTTP %d): %v", addr, resp.StatusCode, err))
+	}
+	return status, nil
+}
+
+func init() {
+	daemonStatusCmd.Flags().StringVar(&daemonStatusAddr, "addr", "", "Fetch the health from a process's --health-addr (HOST:PORT)")
+	daemonCmd.AddCommand(daemonStatusCmd)
+	rootCmd.AddCommand(daemonCmd)
+}
@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/state"
+	"github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+)
+
+func TestDaemonHealth(t *testing.T) {
+	t.Run("is degraded while the latest request failed", func(t *testing.T) {
+		h := &healthHooks{started: time.Now().UTC().Add(-time.Minute)}
+		h.OnRequestEnd(t.Context(), fizzy.RequestInfo{}, fizzy.RequestResult{StatusCode: 200})
+		if status := h.snapshot(time.Now().UTC()); status.Status != "ok" || status.LastAPISuccess == nil || status.UptimeSeconds < 60 {
+			t.Errorf("expected ok with a last success, got %+v", status)
+		}
+
+		h.OnRequestEnd(t.Context(), fizzy.RequestInfo{}, fizzy.RequestResult{StatusCode: 503})
+		status := h.snapshot(time.Now().UTC())
+		if status.Status != "degraded" || status.LastError != "HTTP 503" || status.Requests != 2 || status.Failures != 1 {
+			t.Errorf("expected degraded after a failure, got %+v", status)
+		}
+	})
+
+	t.Run("serves healthz with 503 when degraded", func(t *testing.T) {
+		saved := daemonHealth
+		daemonHealth = &healthHooks{command: "fizzy sla watch", started: time.Now().UTC()}
+		defer func() { daemonHealth = saved }()
+		daemonHealth.setQueueDepth(3)
+
+		rec := httptest.NewRecorder()
+		serveHealthz(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+		var status DaemonStatus
+		if err := json.Unmarshal(rec.Body.Bytes(), &status); err != nil {
+			t.Fatalf("invalid healthz body: %v", err)
+		}
+		if rec.Code != http.StatusOK || status.Command != "fizzy sla watch" || status.QueueDepth != 3 {
+			t.Errorf("expected 200 with the process health, got %d %+v", rec.Code, status)
+		}
+
+		daemonHealth.OnRequestEnd(t.Context(), fizzy.RequestInfo{}, fizzy.RequestResult{Error: fmt.Errorf("connection refused")})
+		rec = httptest.NewRecorder()
+		serveHealthz(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+		if rec.Code != http.StatusServiceUnavailable {
+			t.Errorf("expected 503 when degraded, got %d", rec.Code)
+		}
+	})
+}
+
+func TestDaemonStatus(t *testing.T) {
+	t.Run("lists recorded processes and marks stale ones", func(t *testing.T) {
+		result := SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		now := time.Now().UTC()
+		_ = state.Save(daemonKey+"/100", DaemonStatus{Status: "ok", Command: "fizzy sla watch", PID: 100, Interval: "10m0s", UpdatedAt: now.Add(-5 * time.Minute)})
+		_ = state.Save(daemonKey+"/200", DaemonStatus{Status: "ok", Command: "fizzy sla watch", PID: 200, Interval: "1m0s", UpdatedAt: now.Add(-time.Hour)})
+
+		err := daemonStatusCmd.RunE(daemonStatusCmd, []string{})
+		assertExitCode(t, err, 0)
+
+		items := toMaps(result.Response.Data)
+		if len(items) != 2 {
+			t.Fatalf("expected 2 processes, got %d", len(items))
+		}
+		if items[0]["status"] != "ok" || items[1]["status"] != "stale" {
+			t.Errorf("expected ok and stale, got %v and %v", items[0]["status"], items[1]["status"])
+		}
+		if !strings.Contains(result.Response.Summary, "1 not ok") {
+			t.Errorf("expected unhealthy count in summary, got %q", result.Response.Summary)
+		}
+	})
+
+	t.Run("fetches health from --addr", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/healthz" {
+				http.NotFound(w, r)
+				return
+			}
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_ = json.NewEncoder(w).Encode(DaemonStatus{Status: "degraded", PID: 7, LastError: "HTTP 502"})
+		}))
+		defer server.Close()
+
+		result := SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		daemonStatusAddr = strings.TrimPrefix(server.URL, "http://")
+		err := daemonStatusCmd.RunE(daemonStatusCmd, []string{})
+		daemonStatusAddr = ""
+		assertExitCode(t, err, 0)
+
+		items := toMaps(result.Response.Data)
+		if len(items) != 1 || items[0]["status"] != "degraded" || items[0]["last_error"] != "HTTP 502" {
+			t.Errorf("expected the served health, got %v", items)
+		}
+	})
+
+}
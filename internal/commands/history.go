@@ -0,0 +1,294 @@
+package commands
+
+import (
+	stderrors "errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/state"
+	"github.com/spf13/cobra"
+)
+
+// historyKey is where command history is stored. History is shared across
+// profiles so 'fizzy redo' can replay commands that used --profile.
+const historyKey = "history"
+
+// historyLimit caps how many invocations are kept.
+const historyLimit = 500
+
+// historyRedacted replaces secret flag values in recorded arguments.
+const historyRedacted = "[REDACTED]"
+
+// historySecretFlags take secrets as values and are redacted when recorded.
+var historySecretFlags = map[string]bool{
+	"--token":     true,
+	"--new-token": true,
+	"--password":  true,
+}
+
+// historySkippedCommands are never recorded: they handle credentials, or
+// browse history themselves.
+var historySkippedCommands = map[string]bool{
+	"auth":       true,
+	"signup":     true,
+	"setup":      true,
+	"history":    true,
+	"redo":       true,
+	"help":       true,
+	"completion": true,
+}
+
+// historySummary is the summary of the last response printed, recorded with
+// the invocation.
+var historySummary string
+
+// HistoryEntry is one recorded CLI invocation.
+type HistoryEntry struct {
+	ID       int       `json:"id"`
+	At       time.Time `json:"at"`
+	Args     []string  `json:"args"`
+	OK       bool      `json:"ok"`
+	ExitCode int       `json:"exit_code"`
+	Summary  string    `json:"summary,omitempty"`
+	Redacted bool      `json:"redacted,omitempty"`
+}
+
+// Command returns the invocation as a shell-quoted command line.
+func (e HistoryEntry) Command() string {
+	parts := make([]string, 0, len(e.Args)+1)
+	parts = append(parts, "fizzy")
+	for _, arg := range e.Args {
+		parts = append(parts, shellQuote(arg))
+	}
+	return strings.Join(parts, " ")
+}
+
+func shellQuote(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./:=@,+%", r))
+	}) < 0 {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func loadHistory() ([]HistoryEntry, error) {
+	var entries []HistoryEntry
+	if _, err := state.Load(historyKey, &entries); err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not read command history: %v", err))
+	}
+	return entries, nil
+}
+
+// recordHistory appends an invocation to the history file. It is best-effort
+// and skips shell completion, credential commands, and history commands.
+// Setting FIZZY_NO_HISTORY disables recording.
+func recordHistory(cmd *cobra.Command, args []string, runErr error) {
+	if !historyRecordable(cmd, args) {
+		return
+	}
+
+	entry := HistoryEntry{At: time.Now().UTC(), OK: runErr == nil, Summary: historySummary}
+	entry.Args, entry.Redacted = redactHistoryArgs(args)
+	if runErr != nil {
+		e := output.AsError(runErr)
+		entry.ExitCode = errors.ExitCodeOf(runErr)
+		entry.Summary = e.Message
+	}
+
+	entries, err := loadHistory()
+	if err != nil {
+		entries = nil
+	}
+	if n := len(entries); n > 0 {
+		entry.ID = entries[n-1].ID + 1
+	} else {
+		entry.ID = 1
+	}
+	entries = append(entries, entry)
+	if len(entries) > historyLimit {
+		entries = entries[len(entries)-historyLimit:]
+	}
+	_ = state.Save(historyKey, entries)
+}
+
+// historyRecordable reports whether an invocation is recorded in history
+// (and its requests traced).
+func historyRecordable(cmd *cobra.Command, args []string) bool {
+	if os.Getenv("FIZZY_NO_HISTORY") != "" || cmd == nil || len(args) == 0 {
+		return false
+	}
+	for c := cmd; c != nil && c.HasParent(); c = c.Parent() {
+		if c.Hidden || strings.HasPrefix(c.Name(), "__") || historySkippedCommands[c.Name()] {
+			return false
+		}
+	}
+	return cmd.HasParent()
+}
+
+// redactHistoryArgs hides the values of secret flags.
+func redactHistoryArgs(args []string) ([]string, bool) {
+	redacted := false
+	out := make([]string, len(args))
+	copy(out, args)
+	for i := 0; i < len(out); i++ {
+		name, _, hasValue := strings.Cut(out[i], "=")
+		if !historySecretFlags[name] {
+			continue
+		}
+		redacted = true
+		if hasValue {
+			out[i] = name + "=" + historyRedacted
+		} else if i+1 < len(out) {
+			out[i+1] = historyRedacted
+			i++
+		}
+	}
+	return out, redacted
+}
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show recent fizzy commands",
+	Long: `Lists recently executed fizzy commands with their outcome, newest first.
+Re-run one with 'fizzy redo ID'.
+
+History is kept locally (the last 500 commands). Commands that handle
+credentials (auth, setup, signup) are never recorded, and --token and
+--password values are redacted. Set FIZZY_NO_HISTORY=1 to stop recording.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries, err := loadHistory()
+		if err != nil {
+			return err
+		}
+
+		items := make([]any, 0, len(entries))
+		for i := len(entries) - 1; i >= 0; i-- {
+			e := entries[i]
+			result := "ok"
+			if !e.OK {
+				result = fmt.Sprintf("exit %d", e.ExitCode)
+			}
+			items = append(items, map[string]any{
+				"id":       e.ID,
+				"at":       e.At.Format(time.RFC3339),
+				"command":  e.Command(),
+				"args":     e.Args,
+				"ok":       e.OK,
+				"result":   result,
+				"summary":  e.Summary,
+				"redacted": e.Redacted,
+			})
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("redo", "fizzy redo <id>", "Re-run a command"),
+			breadcrumb("clear", "fizzy history clear", "Clear history"),
+		}
+		printList(items, historyColumns, fmt.Sprintf("%d commands in history", len(items)), breadcrumbs)
+		return nil
+	},
+}
+
+var historyClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Clear command history",
+	Long:  "Deletes the local command history.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := state.Delete(historyKey); err != nil {
+			return errors.NewError(fmt.Sprintf("Could not clear command history: %v", err))
+		}
+		printMutation(map[string]any{"cleared": true}, "Command history cleared", nil)
+		return nil
+	},
+}
+
+// Redo flags
+var redoPrint bool
+
+var redoCmd = &cobra.Command{
+	Use:   "redo [ID]",
+	Short: "Re-run a command from history",
+	Long: `Re-runs a command from 'fizzy history' by ID, or the most recent command
+when no ID is given. The command runs exactly as recorded, with the current
+configuration. Use --print to show the command without running it.`,
+	Example: `  fizzy history
+  fizzy redo 42
+  fizzy redo --print`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries, err := loadHistory()
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return errors.NewNotFoundError("command history is empty")
+		}
+
+		entry := entries[len(entries)-1]
+		if len(args) == 1 {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return errors.NewInvalidArgsError(fmt.Sprintf("invalid history ID %q", args[0]))
+			}
+			found := false
+			for _, e := range entries {
+				if e.ID == id {
+					entry, found = e, true
+					break
+				}
+			}
+			if !found {
+				e := errors.NewNotFoundError(fmt.Sprintf("no command %d in history", id))
+				e.Hint = "Run 'fizzy history' to see recorded commands"
+				return e
+			}
+		}
+
+		if redoPrint {
+			printDetail(map[string]any{
+				"id":      entry.ID,
+				"command": entry.Command(),
+				"args":    entry.Args,
+			}, entry.Command(), nil)
+			return nil
+		}
+		if entry.Redacted {
+			return errors.NewInvalidArgsError(fmt.Sprintf("command %d contained a secret that was not recorded; run it again by hand", entry.ID))
+		}
+
+		exe, err := os.Executable()
+		if err != nil {
+			return errors.NewError(fmt.Sprintf("Could not locate the fizzy executable: %v", err))
+		}
+		fmt.Fprintf(os.Stderr, "Re-running: %s\n", entry.Command())
+
+		child := exec.CommandContext(cmd.Context(), exe, entry.Args...) //nolint:gosec // G204: replays the user's own recorded fizzy arguments
+		child.Stdin, child.Stdout, child.Stderr = os.Stdin, os.Stdout, os.Stderr
+		if err := child.Run(); err != nil {
+			var exitErr *exec.ExitError
+			if stderrors.As(err, &exitErr) {
+				// The re-run command already reported its own error.
+				os.Exit(exitErr.ExitCode())
+			}
+			return errors.NewError(fmt.Sprintf("Could not re-run command: %v", err))
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+	historyCmd.AddCommand(historyClearCmd)
+
+	rootCmd.AddCommand(redoCmd)
+	redoCmd.Flags().BoolVar(&redoPrint, "print", false, "Print the command instead of running it")
+}
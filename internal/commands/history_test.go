@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestHistory(t *testing.T) {
+	t.Run("records invocations and lists them newest first", func(t *testing.T) {
+		mock := NewMockClient()
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		historySummary = "3 cards"
+		recordHistory(cardListCmd, []string{"card", "list", "--tag", "bug fix"}, nil)
+		historySummary = ""
+		recordHistory(cardShowCmd, []string{"card", "show", "42"}, errors.NewNotFoundError("Card not found"))
+
+		err := historyCmd.RunE(historyCmd, []string{})
+		assertExitCode(t, err, 0)
+
+		items := result.Response.Data.([]any)
+		if len(items) != 2 {
+			t.Fatalf("expected 2 entries, got %d", len(items))
+		}
+		latest := items[0].(map[string]any)
+		if latest["id"] != float64(2) || latest["ok"] != false || latest["summary"] != "Card not found" {
+			t.Errorf("unexpected latest entry: %v", latest)
+		}
+		if latest["result"] != "exit 2" {
+			t.Errorf("expected result 'exit 2', got %v", latest["result"])
+		}
+		first := items[1].(map[string]any)
+		if first["command"] != "fizzy card list --tag 'bug fix'" || first["summary"] != "3 cards" {
+			t.Errorf("unexpected first entry: %v", first)
+		}
+	})
+
+	t.Run("redacts share passwords", func(t *testing.T) {
+		args, redacted := redactHistoryArgs([]string{"card", "share", "42", "--encrypt", "--password", "hunter2"})
+		if !redacted || args[5] != historyRedacted {
+			t.Errorf("expected the password redacted, got %v", args)
+		}
+		args, _ = redactHistoryArgs([]string{"card", "share", "open", "bundle.json", "--password=hunter2"})
+		if args[4] != "--password="+historyRedacted {
+			t.Errorf("expected the inline password redacted, got %v", args)
+		}
+	})
+
+	t.Run("redacts token values", func(t *testing.T) {
+		args, redacted := redactHistoryArgs([]string{"board", "list", "--token", "secret", "--new-token=other"})
+		if !redacted {
+			t.Fatal("expected args to be marked redacted")
+		}
+		want := []string{"board", "list", "--token", historyRedacted, "--new-token=" + historyRedacted}
+		for i := range want {
+			if args[i] != want[i] {
+				t.Errorf("arg %d: expected %q, got %q", i, want[i], args[i])
+			}
+		}
+	})
+
+	t.Run("skips credential and history commands", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		recordHistory(authLoginCmd, []string{"auth", "login", "secret"}, nil)
+		recordHistory(historyCmd, []string{"history"}, nil)
+
+		entries, err := loadHistory()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != 0 {
+			t.Errorf("expected nothing recorded, got %v", entries)
+		}
+	})
+
+	t.Run("redo --print shows the command", func(t *testing.T) {
+		result := SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		recordHistory(cardListCmd, []string{"card", "list", "--board", "b1"}, nil)
+		recordHistory(cardShowCmd, []string{"card", "show", "42"}, nil)
+
+		redoPrint = true
+		defer func() { redoPrint = false }()
+
+		err := redoCmd.RunE(redoCmd, []string{"1"})
+		assertExitCode(t, err, 0)
+		data := result.Response.Data.(map[string]any)
+		if data["command"] != "fizzy card list --board b1" {
+			t.Errorf("expected first command, got %v", data["command"])
+		}
+
+		err = redoCmd.RunE(redoCmd, []string{})
+		assertExitCode(t, err, 0)
+		data = result.Response.Data.(map[string]any)
+		if data["command"] != "fizzy card show 42" {
+			t.Errorf("expected latest command, got %v", data["command"])
+		}
+	})
+
+	t.Run("redo rejects unknown IDs", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := redoCmd.RunE(redoCmd, []string{"7"})
+		assertExitCode(t, err, errors.ExitNotFound)
+
+		recordHistory(cardShowCmd, []string{"card", "show", "42"}, nil)
+		err = redoCmd.RunE(redoCmd, []string{"7"})
+		assertExitCode(t, err, errors.ExitNotFound)
+		err = redoCmd.RunE(redoCmd, []string{"abc"})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
@@ -0,0 +1,207 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// hookData is the data of the last response printed, passed to post hooks.
+var hookData any
+
+// hookPlaceholder matches {{path.to.value}} in hook commands.
+var hookPlaceholder = regexp.MustCompile(`\{\{\s*([\w.]+)\s*\}\}`)
+
+// HookPayload is the JSON piped to a hook on stdin.
+type HookPayload struct {
+	Hook    string   `json:"hook"`
+	Command string   `json:"command"`
+	Args    []string `json:"args"`
+	Data    any      `json:"data,omitempty"`
+	Summary string   `json:"summary,omitempty"`
+}
+
+// hookCommandPath returns cmd's path without the root, e.g. "card close".
+func hookCommandPath(cmd *cobra.Command) string {
+	return strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+}
+
+// lookupHook returns the configured hook of kind ("pre" or "post") for cmd.
+func lookupHook(cmd *cobra.Command, kind string) string {
+	if cfg == nil || !cmd.HasParent() || os.Getenv("FIZZY_NO_HOOKS") != "" {
+		return ""
+	}
+	hooks := cfg.Hooks.Pre
+	if kind == "post" {
+		hooks = cfg.Hooks.Post
+	}
+	return hooks[hookCommandPath(cmd)]
+}
+
+// runPreHook runs the pre hook for cmd, if any. A failing pre hook stops the
+// command.
+func runPreHook(cmd *cobra.Command, args []string) error {
+	script := lookupHook(cmd, "pre")
+	if script == "" {
+		return nil
+	}
+	payload := HookPayload{Hook: "pre", Command: hookCommandPath(cmd), Args: args}
+	if err := runHook(script, payload, hookContext(cmd, args, nil)); err != nil {
+		return errors.NewError(fmt.Sprintf("pre hook for %q failed: %v", payload.Command, err))
+	}
+	return nil
+}
+
+// runPostHook runs the post hook for cmd, if any, with the response piped to
+// it. The command has already succeeded, so a failing post hook only warns.
+func runPostHook(cmd *cobra.Command, args []string) {
+	script := lookupHook(cmd, "post")
+	if script == "" {
+		return
+	}
+	data := normalizeAny(hookData)
+	payload := HookPayload{Hook: "post", Command: hookCommandPath(cmd), Args: args, Data: data, Summary: historySummary}
+	if err := runHook(script, payload, hookContext(cmd, args, data)); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: post hook for %q failed: %v\n", payload.Command, err)
+	}
+}
+
+// runHook expands placeholders in script and runs it with the shell, piping
+// payload as JSON to its stdin. The hook's output goes to stderr so it never
+// mixes with the command's own output.
+func runHook(script string, payload HookPayload, context map[string]any) error {
+	input, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	env := append(os.Environ(), "FIZZY_HOOK="+payload.Hook, "FIZZY_HOOK_COMMAND="+payload.Command)
+
+	var hook *exec.Cmd
+	if runtime.GOOS == "windows" {
+		// cmd.exe has no quoting that stops & | ^ in a value from running,
+		// so values go in the environment and are read back with delayed
+		// expansion, which happens after the line is parsed.
+		var values []string
+		script, values = expandHookPlaceholdersToEnv(script, context)
+		env = append(env, values...)
+		hook = exec.Command("cmd", "/V:ON", "/C", script) //nolint:gosec // G204: runs the user's own configured hook
+	} else {
+		script = expandHookPlaceholders(script, context, shellQuote)
+		hook = exec.Command("sh", "-c", script) //nolint:gosec // G204: runs the user's own configured hook
+	}
+	hook.Stdin = bytes.NewReader(input)
+	hook.Stdout, hook.Stderr = os.Stderr, os.Stderr
+	hook.Env = env
+	return hook.Run()
+}
+
+// hookContext builds the values hook placeholders can reference: "data" and
+// "args", plus "card", "board", and "column" from the command's arguments
+// and flags. When the command acts on that kind of resource, the response
+// fields are merged in too, so "card close 42" can use {{card.number}}.
+func hookContext(cmd *cobra.Command, args []string, data any) map[string]any {
+	context := map[string]any{"data": data}
+	argValues := make([]any, len(args))
+	for i, arg := range args {
+		argValues[i] = arg
+	}
+	context["args"] = argValues
+
+	resource := func(kind, value string) {
+		key := "id"
+		if kind == "card" {
+			key = "number"
+		}
+		context[kind] = map[string]any{key: value}
+	}
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if kind, ok := recentFlagKinds[f.Name]; ok && f.Value.Type() == "string" {
+			resource(kind, f.Value.String())
+		}
+	})
+	kinds := recentArgKindsFor(cmd)
+	for i, arg := range args {
+		if i < len(kinds) && kinds[i] != "" {
+			resource(kinds[i], arg)
+		}
+	}
+
+	if fields, ok := data.(map[string]any); ok && cmd.HasParent() {
+		noun := strings.Fields(hookCommandPath(cmd))[0]
+		existing, _ := context[noun].(map[string]any)
+		if existing == nil {
+			existing = map[string]any{}
+		}
+		for k, v := range fields {
+			existing[k] = v
+		}
+		context[noun] = existing
+	}
+	return context
+}
+
+// expandHookPlaceholders replaces {{path}} in script with values from
+// context, each passed through quote. Unknown paths expand to quote("").
+func expandHookPlaceholders(script string, context map[string]any, quote func(string) string) string {
+	return hookPlaceholder.ReplaceAllStringFunc(script, func(match string) string {
+		return quote(hookPlaceholderValue(hookPlaceholder.FindStringSubmatch(match)[1], context))
+	})
+}
+
+// expandHookPlaceholdersToEnv replaces {{path}} in script with references
+// to environment variables, !FIZZY_HOOK_VALUE_1! and so on for cmd.exe's
+// delayed expansion, and returns the variables to set. Empty values
+// expand to "".
+func expandHookPlaceholdersToEnv(script string, context map[string]any) (string, []string) {
+	var env []string
+	script = expandHookPlaceholders(script, context, func(value string) string {
+		if value == "" {
+			return `""`
+		}
+		name := fmt.Sprintf("FIZZY_HOOK_VALUE_%d", len(env)+1)
+		env = append(env, name+"="+value)
+		return "!" + name + "!"
+	})
+	return script, env
+}
+
+// hookPlaceholderValue resolves a dotted path in context to text. Objects
+// and lists are JSON-encoded; unknown paths are empty.
+func hookPlaceholderValue(path string, context map[string]any) string {
+	var value any = context
+	for part := range strings.SplitSeq(path, ".") {
+		switch v := value.(type) {
+		case map[string]any:
+			value = v[part]
+		case []any:
+			i, err := strconv.Atoi(part)
+			if err != nil || i < 0 || i >= len(v) {
+				return ""
+			}
+			value = v[i]
+		default:
+			return ""
+		}
+	}
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]any, []any:
+		encoded, _ := json.Marshal(v)
+		return string(encoded)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/config"
+)
+
+func TestHooks(t *testing.T) {
+	t.Run("post hook gets placeholders and the response on stdin", func(t *testing.T) {
+		dir := t.TempDir()
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{StatusCode: 204}
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		cfg.Hooks = config.Hooks{Post: map[string]string{
+			"card close": "echo {{card.number}} > " + filepath.Join(dir, "number") + " && cat > " + filepath.Join(dir, "payload"),
+		}}
+		defer resetTest()
+
+		if _, err := runCobraWithArgs("card", "close", "42"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		number, err := os.ReadFile(filepath.Join(dir, "number"))
+		if err != nil {
+			t.Fatalf("expected hook to run: %v", err)
+		}
+		if strings.TrimSpace(string(number)) != "42" {
+			t.Errorf("expected card number 42, got %q", number)
+		}
+		var payload HookPayload
+		raw, _ := os.ReadFile(filepath.Join(dir, "payload"))
+		if err := json.Unmarshal(raw, &payload); err != nil {
+			t.Fatalf("expected JSON payload, got %q: %v", raw, err)
+		}
+		if payload.Hook != "post" || payload.Command != "card close" || len(payload.Args) != 1 || payload.Args[0] != "42" {
+			t.Errorf("unexpected payload: %+v", payload)
+		}
+	})
+
+	t.Run("failing pre hook stops the command", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		cfg.Hooks = config.Hooks{Pre: map[string]string{"card close": "exit 3"}}
+		defer resetTest()
+
+		_, err := runCobraWithArgs("card", "close", "42")
+		if err == nil || !strings.Contains(err.Error(), `pre hook for "card close" failed`) {
+			t.Fatalf("expected pre hook error, got %v", err)
+		}
+		if len(mock.PostCalls) != 0 {
+			t.Errorf("expected no requests, got %v", mock.PostCalls)
+		}
+	})
+}
+
+func TestExpandHookPlaceholders(t *testing.T) {
+	context := map[string]any{
+		"card": map[string]any{"number": float64(7), "title": "It's done"},
+		"args": []any{"7"},
+	}
+	got := expandHookPlaceholders("notify {{card.number}} {{ card.title }} {{args.0}} {{missing.path}}", context, shellQuote)
+	want := `notify 7 'It'\''s done' 7 ''`
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	t.Run("passes values through the environment for cmd.exe", func(t *testing.T) {
+		context := map[string]any{"card": map[string]any{"title": "Fix & ship | now ^"}}
+		got, env := expandHookPlaceholdersToEnv("notify {{card.title}} {{missing}}", context)
+		if want := `notify !FIZZY_HOOK_VALUE_1! ""`; got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+		if want := []string{"FIZZY_HOOK_VALUE_1=Fix & ship | now ^"}; !slices.Equal(env, want) {
+			t.Errorf("expected %q, got %q", want, env)
+		}
+	})
+}
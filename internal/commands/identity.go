@@ -22,17 +22,24 @@ var identityShowCmd = &cobra.Command{
 			return err
 		}
 
-		data, _, err := getSDKClient().Identity().GetMyIdentity(cmd.Context())
+		data, resp, err := getSDKClient().Identity().GetMyIdentity(cmd.Context())
 		if err != nil {
 			return convertSDKError(err)
 		}
 
+		identity := normalizeAny(data)
+		if permission := tokenPermission(resp); permission != "" {
+			if m, ok := identity.(map[string]any); ok {
+				m["token_permission"] = permission
+			}
+		}
+
 		// Build breadcrumbs
 		breadcrumbs := []Breadcrumb{
 			breadcrumb("status", "fizzy auth status", "Auth status"),
 		}
 
-		printDetail(normalizeAny(data), "", breadcrumbs)
+		printDetail(identity, "", breadcrumbs)
 		return nil
 	},
 }
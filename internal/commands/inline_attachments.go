@@ -23,6 +23,29 @@ func resolveRichTextContent(content string, filePath string) (string, error) {
 	return markdownToHTML(content), nil
 }
 
+// resolveDescriptionInput returns rich text from --description,
+// --description_file, --description-md, or --description-md-file. The -md
+// flags always treat their input as Markdown and can't be combined with the
+// others.
+func resolveDescriptionInput(content, filePath, markdown, markdownFile string) (string, error) {
+	if markdown != "" || markdownFile != "" {
+		if markdown != "" && markdownFile != "" || content != "" || filePath != "" {
+			return "", errors.NewInvalidArgsError("--description-md and --description-md-file cannot be combined with each other or with --description or --description_file")
+		}
+	}
+	switch {
+	case markdownFile != "":
+		fileContent, err := os.ReadFile(markdownFile)
+		if err != nil {
+			return "", errors.NewInvalidArgsError(fmt.Sprintf("cannot read %s: %v", markdownFile, err))
+		}
+		return markdownToTrixHTML(string(fileContent)), nil
+	case markdown != "":
+		return markdownToTrixHTML(markdown), nil
+	}
+	return resolveRichTextContent(content, filePath)
+}
+
 func appendInlineAttachmentsToContent(content string, paths []string) (string, error) {
 	if len(paths) == 0 {
 		return content, nil
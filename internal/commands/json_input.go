@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+// jsonInputUsage is the help text of every --json-input flag.
+const jsonInputUsage = "Read the request body as a JSON object from a file, or - for stdin (flags override its fields)"
+
+// readJSONInput decodes the JSON object given with --json-input, read from
+// source or from stdin when source is "-", into req, a generated request
+// type. Fields the request doesn't define are rejected, so a typo fails
+// instead of being silently dropped.
+func readJSONInput(cmd *cobra.Command, source string, req any) error {
+	var r io.Reader
+	if source == "-" {
+		r = cmd.InOrStdin()
+	} else {
+		f, err := os.Open(source)
+		if err != nil {
+			return errors.NewInvalidArgsError(fmt.Sprintf("cannot read --json-input: %v", err))
+		}
+		defer f.Close()
+		r = f
+	}
+
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(req); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if stderrors.As(err, &typeErr) && typeErr.Field != "" {
+			return errors.NewInvalidArgsError(fmt.Sprintf("invalid --json-input: %q must be %s, not %s", typeErr.Field, jsonTypeName(typeErr.Type.Kind()), typeErr.Value))
+		}
+		if stderrors.Is(err, io.EOF) {
+			return errors.NewInvalidArgsError("invalid --json-input: no JSON object given")
+		}
+		return errors.NewInvalidArgsError(fmt.Sprintf("invalid --json-input: %v", err))
+	}
+	if dec.More() {
+		return errors.NewInvalidArgsError("invalid --json-input: expected a single JSON object")
+	}
+	return nil
+}
+
+// jsonTypeName names a Go kind the way a JSON caller would know it.
+func jsonTypeName(kind reflect.Kind) string {
+	switch kind {
+	case reflect.String:
+		return "a string"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	case reflect.Bool:
+		return "true or false"
+	case reflect.Struct, reflect.Map:
+		return "an object"
+	default:
+		return "a number"
+	}
+}
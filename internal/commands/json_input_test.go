@@ -0,0 +1,203 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestJSONInput(t *testing.T) {
+	t.Run("card create reads the body from stdin", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{
+			StatusCode: 201,
+			Data:       map[string]any{"id": "abc", "number": 42},
+		}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardCreateCmd.SetIn(strings.NewReader(`{"board_id":"123","title":"From JSON","description":"<p>It's \"quoted\"</p>","tag_names":["bug"]}`))
+		defer cardCreateCmd.SetIn(nil)
+		cardCreateJSONInput = "-"
+		cardCreateTitle = "From flag"
+		err := cardCreateCmd.RunE(cardCreateCmd, []string{})
+		cardCreateJSONInput = ""
+		cardCreateTitle = ""
+
+		assertExitCode(t, err, 0)
+		body := mock.PostCalls[0].Body.(map[string]any)
+		if body["board_id"] != "123" {
+			t.Errorf("expected board_id from JSON, got %v", body["board_id"])
+		}
+		if body["title"] != "From flag" {
+			t.Errorf("expected --title to override the JSON, got %v", body["title"])
+		}
+		if body["description"] != `<p>It's "quoted"</p>` {
+			t.Errorf("expected description passed through, got %v", body["description"])
+		}
+		if tags, _ := body["tag_names"].([]any); !slices.Equal(tags, []any{"bug"}) {
+			t.Errorf("expected tag_names from JSON, got %v", body["tag_names"])
+		}
+	})
+
+	t.Run("comment create reads the body from a file", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{
+			StatusCode: 201,
+			Data:       map[string]any{"id": "c-1"},
+		}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		path := filepath.Join(t.TempDir(), "comment.json")
+		if err := os.WriteFile(path, []byte(`{"body":"<p>Looks <b>good</b></p>"}`), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		commentCreateCard = "42"
+		commentCreateJSONInput = path
+		err := commentCreateCmd.RunE(commentCreateCmd, []string{})
+		commentCreateCard = ""
+		commentCreateJSONInput = ""
+
+		assertExitCode(t, err, 0)
+		if body := mock.PostCalls[0].Body.(map[string]any); body["body"] != "<p>Looks <b>good</b></p>" {
+			t.Errorf("expected body from JSON, got %v", body)
+		}
+	})
+
+	t.Run("rejects fields the request doesn't define", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		cardUpdateCmd.SetIn(strings.NewReader(`{"titel":"Typo"}`))
+		defer cardUpdateCmd.SetIn(nil)
+		cardUpdateJSONInput = "-"
+		err := cardUpdateCmd.RunE(cardUpdateCmd, []string{"42"})
+		cardUpdateJSONInput = ""
+
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+		if !strings.Contains(err.Error(), "titel") {
+			t.Errorf("expected the unknown field named, got %v", err)
+		}
+		if len(mock.PatchCalls) != 0 {
+			t.Errorf("expected no update, got %v", mock.PatchCalls)
+		}
+	})
+
+	t.Run("names the field with the wrong type", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		commentUpdateCmd.SetIn(strings.NewReader(`{"body":42}`))
+		defer commentUpdateCmd.SetIn(nil)
+		commentUpdateCard = "42"
+		commentUpdateJSONInput = "-"
+		err := commentUpdateCmd.RunE(commentUpdateCmd, []string{"c-1"})
+		commentUpdateCard = ""
+		commentUpdateJSONInput = ""
+
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+		if !strings.Contains(err.Error(), `"body" must be a string`) {
+			t.Errorf("expected a field type error, got %v", err)
+		}
+	})
+	t.Run("board create takes the name from JSON", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{StatusCode: 201, Data: map[string]any{"id": "b-1"}}
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		boardCreateCmd.SetIn(strings.NewReader(`{"name":"Roadmap","public_description":"What's next"}`))
+		defer boardCreateCmd.SetIn(nil)
+		boardCreateJSONInput = "-"
+		err := boardCreateCmd.RunE(boardCreateCmd, []string{})
+		boardCreateJSONInput = ""
+
+		assertExitCode(t, err, 0)
+		body := mock.PostCalls[0].Body.(map[string]any)
+		if body["name"] != "Roadmap" || body["public_description"] != "What's next" {
+			t.Errorf("expected name and public_description from JSON, got %v", body)
+		}
+	})
+
+	t.Run("board update sends an explicit all_access false", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PatchResponse = &client.APIResponse{StatusCode: 200, Data: map[string]any{"id": "b-1"}}
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		boardUpdateCmd.SetIn(strings.NewReader(`{"name":"Private","all_access":false}`))
+		defer boardUpdateCmd.SetIn(nil)
+		boardUpdateJSONInput = "-"
+		err := boardUpdateCmd.RunE(boardUpdateCmd, []string{"b-1"})
+		boardUpdateJSONInput = ""
+
+		assertExitCode(t, err, 0)
+		body := mock.PatchCalls[0].Body.(map[string]any)
+		if v, ok := body["all_access"]; !ok || v != false || body["name"] != "Private" {
+			t.Errorf("expected name and all_access false, got %v", body)
+		}
+	})
+
+	t.Run("column create requires a name from the flag or JSON", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{StatusCode: 201, Data: map[string]any{"id": "col-1"}}
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		columnCreateCmd.SetIn(strings.NewReader(`{"color":"var(--color-card-4)"}`))
+		columnCreateBoard = "123"
+		columnCreateJSONInput = "-"
+		err := columnCreateCmd.RunE(columnCreateCmd, []string{})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+
+		columnCreateCmd.SetIn(strings.NewReader(`{"name":"Review","color":"var(--color-card-4)"}`))
+		defer columnCreateCmd.SetIn(nil)
+		err = columnCreateCmd.RunE(columnCreateCmd, []string{})
+		columnCreateBoard = ""
+		columnCreateJSONInput = ""
+
+		assertExitCode(t, err, 0)
+		body := mock.PostCalls[0].Body.(map[string]any)
+		if body["name"] != "Review" || body["color"] != "var(--color-card-4)" {
+			t.Errorf("expected name and color from JSON, got %v", body)
+		}
+	})
+
+	t.Run("step update sends an explicit completed false", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PatchResponse = &client.APIResponse{StatusCode: 200, Data: map[string]any{"id": "step-1"}}
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		stepUpdateCmd.SetIn(strings.NewReader(`{"content":"Redo","completed":false}`))
+		defer stepUpdateCmd.SetIn(nil)
+		stepUpdateCard = "42"
+		stepUpdateJSONInput = "-"
+		err := stepUpdateCmd.RunE(stepUpdateCmd, []string{"step-1"})
+		stepUpdateCard = ""
+		stepUpdateJSONInput = ""
+
+		assertExitCode(t, err, 0)
+		body := mock.PatchCalls[0].Body.(map[string]any)
+		if v, ok := body["completed"]; !ok || v != false || body["content"] != "Redo" {
+			t.Errorf("expected content and completed false, got %v", body)
+		}
+	})
+}
@@ -0,0 +1,271 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+var lintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Check boards for problems",
+	Long:  "Commands that check board content for problems such as broken links and rule violations.",
+}
+
+// Lint links flags
+var lintLinksBoard string
+var lintLinksNoComments bool
+var lintLinksConcurrency int
+var lintLinksTimeout time.Duration
+
+// linkCheckClient checks links. Redirects are reported rather than followed.
+var linkCheckClient = &http.Client{
+	CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+}
+
+var lintLinksCmd = &cobra.Command{
+	Use:   "links",
+	Short: "Find broken links in card descriptions and comments",
+	Long: `Extracts URLs from the descriptions and comments of a board's cards and
+checks them concurrently, reporting each broken link (4xx/5xx or unreachable)
+and redirect by card.
+
+Links back to Fizzy itself are skipped, since they need your browser session.
+Use --no-comments to check descriptions only.`,
+	Example: `  fizzy lint links --board BOARD_ID
+  fizzy lint links --board BOARD_ID --jq '[.data[] | select(.status == "broken")]'`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		boardID, err := requireBoard(lintLinksBoard)
+		if err != nil {
+			return err
+		}
+		if lintLinksConcurrency < 1 {
+			return errors.NewInvalidArgsError("--concurrency must be at least 1")
+		}
+
+		refs, cardCount, err := collectBoardLinks(cmd.Context(), boardID, !lintLinksNoComments)
+		if err != nil {
+			return err
+		}
+
+		urls := make([]string, 0, len(refs))
+		for u := range refs {
+			urls = append(urls, u)
+		}
+		concurrency := lintLinksConcurrency
+		if !cmd.Flags().Changed("concurrency") {
+			concurrency = maxParallel(concurrency)
+		}
+		results := checkLinks(cmd.Context(), urls, concurrency, lintLinksTimeout)
+
+		problems := []any{}
+		broken, redirected := 0, 0
+		for _, u := range urls {
+			result := results[u]
+			switch result.Status {
+			case "ok":
+				continue
+			case "redirect":
+				redirected++
+			default:
+				broken++
+			}
+			for _, ref := range refs[u] {
+				entry := map[string]any{
+					"card":        ref.Card,
+					"title":       ref.Title,
+					"source":      ref.Source,
+					"url":         u,
+					"status":      result.Status,
+					"status_code": result.StatusCode,
+				}
+				if result.Location != "" {
+					entry["location"] = result.Location
+				}
+				if result.Error != "" {
+					entry["error"] = result.Error
+				}
+				problems = append(problems, entry)
+			}
+		}
+		sort.SliceStable(problems, func(i, j int) bool {
+			a, b := problems[i].(map[string]any), problems[j].(map[string]any)
+			if a["card"] != b["card"] {
+				return a["card"].(int) < b["card"].(int)
+			}
+			return a["url"].(string) < b["url"].(string)
+		})
+
+		summary := fmt.Sprintf("%d broken, %d redirected of %d links on %d cards", broken, redirected, len(urls), cardCount)
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("show", "fizzy card show <number>", "View a card"),
+		}
+		printList(problems, lintLinksColumns, summary, breadcrumbs)
+		return nil
+	},
+}
+
+// linkRef is one place a URL appears.
+type linkRef struct {
+	Card   int
+	Title  string
+	Source string
+}
+
+// linkResult is the outcome of checking a URL.
+type linkResult struct {
+	Status     string // ok, redirect, broken
+	StatusCode int
+	Location   string
+	Error      string
+}
+
+// linkPattern matches http(s) URLs in text and HTML attributes.
+var linkPattern = regexp.MustCompile(`https?://[^\s"'<>]+`)
+
+// extractLinks returns the distinct URLs in text, which may be HTML.
+func extractLinks(text string) []string {
+	var links []string
+	for _, match := range linkPattern.FindAllString(text, -1) {
+		link := strings.TrimRight(html.UnescapeString(match), ".,;:!?)]}")
+		if !slices.Contains(links, link) {
+			links = append(links, link)
+		}
+	}
+	return links
+}
+
+// collectBoardLinks maps every external URL in the board's card descriptions
+// (and comments, when requested) to where it appears.
+func collectBoardLinks(ctx context.Context, boardID string, withComments bool) (map[string][]linkRef, int, error) {
+	ac := getSDK()
+	pages, err := ac.GetAll(ctx, "/cards.json?board_ids[]="+boardID)
+	if err != nil {
+		return nil, 0, convertSDKError(err)
+	}
+	cards := toMaps(jsonAnySlice(pages))
+
+	var fizzyHost string
+	if u, err := url.Parse(cfg.APIURL); err == nil {
+		fizzyHost = u.Hostname()
+	}
+
+	refs := map[string][]linkRef{}
+	add := func(text string, ref linkRef) {
+		for _, link := range extractLinks(text) {
+			if u, err := url.Parse(link); err != nil || u.Host == "" || u.Hostname() == fizzyHost {
+				continue
+			}
+			if !slices.Contains(refs[link], ref) {
+				refs[link] = append(refs[link], ref)
+			}
+		}
+	}
+
+	for _, card := range cards {
+		n, _ := card["number"].(float64)
+		number := int(n)
+		title, _ := card["title"].(string)
+		description, _ := card["description"].(string)
+		descriptionHTML, _ := card["description_html"].(string)
+		add(description+"\n"+descriptionHTML, linkRef{Card: number, Title: title, Source: "description"})
+
+		if !withComments {
+			continue
+		}
+		commentPages, err := ac.GetAll(ctx, fmt.Sprintf("/cards/%d/comments.json", number))
+		if err != nil {
+			return nil, 0, convertSDKError(err)
+		}
+		for _, comment := range toMaps(jsonAnySlice(commentPages)) {
+			body, _ := comment["body"].(map[string]any)
+			plain, _ := body["plain_text"].(string)
+			rich, _ := body["html"].(string)
+			add(plain+"\n"+rich, linkRef{Card: number, Title: title, Source: fmt.Sprintf("comment %v", comment["id"])})
+		}
+	}
+	return refs, len(cards), nil
+}
+
+// checkLinks checks urls with up to concurrency requests in flight.
+func checkLinks(ctx context.Context, urls []string, concurrency int, timeout time.Duration) map[string]linkResult {
+	results := make([]linkResult, len(urls))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, u := range urls {
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = checkLink(ctx, u, timeout)
+		})
+	}
+	wg.Wait()
+
+	byURL := make(map[string]linkResult, len(urls))
+	for i, u := range urls {
+		byURL[u] = results[i]
+	}
+	return byURL
+}
+
+// checkLink sends a HEAD request, falling back to GET for servers that don't
+// support HEAD.
+func checkLink(ctx context.Context, link string, timeout time.Duration) linkResult {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var resp *http.Response
+	for _, method := range []string{http.MethodHead, http.MethodGet} {
+		req, err := http.NewRequestWithContext(ctx, method, link, nil)
+		if err != nil {
+			return linkResult{Status: "broken", Error: err.Error()}
+		}
+		req.Header.Set("User-Agent", "fizzy-cli/"+currentVersion()+" (link check)")
+		resp, err = linkCheckClient.Do(req)
+		if err != nil {
+			return linkResult{Status: "broken", Error: err.Error()}
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusMethodNotAllowed && resp.StatusCode != http.StatusNotImplemented {
+			break
+		}
+	}
+
+	result := linkResult{StatusCode: resp.StatusCode}
+	switch {
+	case resp.StatusCode >= 400:
+		result.Status = "broken"
+	case resp.StatusCode >= 300:
+		result.Status = "redirect"
+		result.Location = resp.Header.Get("Location")
+	default:
+		result.Status = "ok"
+	}
+	return result
+}
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+
+	lintLinksCmd.Flags().StringVar(&lintLinksBoard, "board", "", "Board ID (defaults to the configured board)")
+	lintLinksCmd.Flags().BoolVar(&lintLinksNoComments, "no-comments", false, "Only check card descriptions")
+	lintLinksCmd.Flags().IntVar(&lintLinksConcurrency, "concurrency", 8, "Maximum links checked at once (defaults to max_parallel_requests when that is lower)")
+	lintLinksCmd.Flags().DurationVar(&lintLinksTimeout, "timeout", 10*time.Second, "Timeout per link")
+	lintCmd.AddCommand(lintLinksCmd)
+}
@@ -0,0 +1,268 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+// Lint board flags
+var lintBoardBoard string
+var lintBoardRuleFlags []string
+var lintBoardWIP int
+var lintBoardStaleAfter time.Duration
+var lintBoardFailOn string
+
+// lintSeverities ranks severities from least to most severe.
+var lintSeverities = []string{"info", "warning", "error"}
+
+// lintBoardRuleDefaults are the rules 'lint board' applies and their default
+// severities.
+var lintBoardRuleDefaults = map[string]string{
+	"unassigned-active": "warning",
+	"empty-description": "info",
+	"wip-limit":         "error",
+	"stale-golden":      "warning",
+}
+
+// defaultStaleAfter is how long a golden card may go without activity
+// before stale-golden reports it.
+const defaultStaleAfter = 14 * 24 * time.Hour
+
+var lintBoardCmd = &cobra.Command{
+	Use:   "board",
+	Short: "Check a board against workspace rules",
+	Long: `Checks a board's open cards against workspace rules and reports each finding
+with a severity:
+
+  unassigned-active   card in a column (not triage) with no assignees (warning)
+  empty-description   card with no description (info)
+  wip-limit           column holding more cards than its WIP limit (error)
+  stale-golden        golden card with no activity for --stale-after (warning)
+
+Severities and limits come from the lint section of config, and a project's
+.fizzy.yaml can adjust them:
+
+  lint:
+    rules:
+      empty-description: off
+      unassigned-active: error
+    wip_limits:
+      "*": 5
+      Review: 3
+    stale_after: 336h
+
+--rule, --wip, and --stale-after override config for one run. The command
+exits with code 10 when any finding is at or above --fail-on (error by
+default), so it can gate CI; --fail-on never always exits 0.`,
+	Example: `  fizzy lint board --board BOARD_ID
+  fizzy lint board --board BOARD_ID --wip 5 --fail-on warning
+  fizzy lint board --board BOARD_ID --rule empty-description=off`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		boardID, err := requireBoard(lintBoardBoard)
+		if err != nil {
+			return err
+		}
+		if lintBoardFailOn != "never" && !slices.Contains(lintSeverities, lintBoardFailOn) {
+			return errors.NewInvalidArgsError(fmt.Sprintf("invalid --fail-on %q (use error, warning, info, or never)", lintBoardFailOn))
+		}
+		rules, err := lintBoardRules()
+		if err != nil {
+			return err
+		}
+		wipLimits := lintBoardWIPLimits(cmd)
+		staleAfter, err := lintBoardStaleDuration(cmd)
+		if err != nil {
+			return err
+		}
+
+		pages, err := getSDK().GetAll(cmd.Context(), "/cards.json?board_ids[]="+boardID)
+		if err != nil {
+			return convertSDKError(err)
+		}
+		cards := toMaps(jsonAnySlice(pages))
+
+		findings := lintBoardCards(cards, rules, wipLimits, staleAfter, time.Now())
+		failing := 0
+		counts := map[string]int{}
+		for _, f := range findings {
+			severity := f["severity"].(string)
+			counts[severity]++
+			if lintBoardFailOn != "never" && lintSeverityRank(severity) >= lintSeverityRank(lintBoardFailOn) {
+				failing++
+			}
+		}
+
+		items := make([]any, len(findings))
+		for i, f := range findings {
+			items[i] = f
+		}
+		summary := fmt.Sprintf("%d errors, %d warnings, %d info on %d cards", counts["error"], counts["warning"], counts["info"], len(cards))
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("show", "fizzy card show <number>", "View a card"),
+			breadcrumb("links", fmt.Sprintf("fizzy lint links --board %s", boardID), "Check links"),
+		}
+		printList(items, lintBoardColumns, summary, breadcrumbs)
+
+		if failing > 0 {
+			return errors.NewLintFindingsError(fmt.Sprintf("%d %s at or above %s", failing, pluralize(failing, "finding", "findings"), lintBoardFailOn))
+		}
+		return nil
+	},
+}
+
+// lintBoardRules returns the severity of each enabled rule: the defaults,
+// then config, then --rule.
+func lintBoardRules() (map[string]string, error) {
+	rules := map[string]string{}
+	for rule, severity := range lintBoardRuleDefaults {
+		rules[rule] = severity
+	}
+	set := func(rule, severity, source string) error {
+		if _, ok := lintBoardRuleDefaults[rule]; !ok {
+			return errors.NewInvalidArgsError(fmt.Sprintf("unknown lint rule %q in %s", rule, source))
+		}
+		if severity != "off" && !slices.Contains(lintSeverities, severity) {
+			return errors.NewInvalidArgsError(fmt.Sprintf("invalid severity %q for %s in %s (use error, warning, info, or off)", severity, rule, source))
+		}
+		rules[rule] = severity
+		return nil
+	}
+	if cfg != nil {
+		for rule, severity := range cfg.Lint.Rules {
+			if err := set(rule, strings.ToLower(severity), "config"); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, value := range lintBoardRuleFlags {
+		rule, severity, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("invalid --rule %q (use NAME=SEVERITY)", value))
+		}
+		if err := set(strings.TrimSpace(rule), strings.ToLower(strings.TrimSpace(severity)), "--rule"); err != nil {
+			return nil, err
+		}
+	}
+	for rule, severity := range rules {
+		if severity == "off" {
+			delete(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+// lintBoardWIPLimits returns the WIP limits by column name; "*" is the limit
+// for columns without one. --wip replaces the "*" limit.
+func lintBoardWIPLimits(cmd *cobra.Command) map[string]int {
+	limits := map[string]int{}
+	if cfg != nil {
+		for column, limit := range cfg.Lint.WIPLimits {
+			limits[column] = limit
+		}
+	}
+	if cmd.Flags().Changed("wip") {
+		limits["*"] = lintBoardWIP
+	}
+	return limits
+}
+
+func lintBoardStaleDuration(cmd *cobra.Command) (time.Duration, error) {
+	if cmd.Flags().Changed("stale-after") {
+		return lintBoardStaleAfter, nil
+	}
+	if cfg != nil && cfg.Lint.StaleAfter != "" {
+		d, err := time.ParseDuration(cfg.Lint.StaleAfter)
+		if err != nil {
+			return 0, errors.NewInvalidArgsError(fmt.Sprintf("invalid lint stale_after %q in config: %v", cfg.Lint.StaleAfter, err))
+		}
+		return d, nil
+	}
+	return defaultStaleAfter, nil
+}
+
+// lintBoardCards applies rules to a board's open cards and returns the
+// findings, most severe first.
+func lintBoardCards(cards []map[string]any, rules map[string]string, wipLimits map[string]int, staleAfter time.Duration, now time.Time) []map[string]any {
+	var findings []map[string]any
+	add := func(rule string, card map[string]any, column, message string) {
+		severity, ok := rules[rule]
+		if !ok {
+			return
+		}
+		finding := map[string]any{"rule": rule, "severity": severity, "message": message}
+		if card != nil {
+			finding["card"] = card["number"]
+			finding["title"] = card["title"]
+		}
+		if column != "" {
+			finding["column"] = column
+		}
+		findings = append(findings, finding)
+	}
+
+	perColumn := map[string]int{}
+	var columnOrder []string
+	for _, card := range cards {
+		column, _ := card["column"].(map[string]any)
+		columnName, _ := column["name"].(string)
+		if column != nil && column["id"] != nil {
+			if perColumn[columnName] == 0 {
+				columnOrder = append(columnOrder, columnName)
+			}
+			perColumn[columnName]++
+			if assignees, _ := card["assignees"].([]any); len(assignees) == 0 {
+				add("unassigned-active", card, columnName, "Card is in a column but has no assignees")
+			}
+		}
+
+		if description, _ := card["description"].(string); strings.TrimSpace(description) == "" {
+			add("empty-description", card, columnName, "Card has no description")
+		}
+
+		if golden, _ := card["golden"].(bool); golden {
+			lastActive, _ := card["last_active_at"].(string)
+			if at, err := time.Parse(time.RFC3339, lastActive); err == nil && now.Sub(at) > staleAfter {
+				add("stale-golden", card, columnName, fmt.Sprintf("Golden card has had no activity since %s", at.Format("2006-01-02")))
+			}
+		}
+	}
+
+	for _, column := range columnOrder {
+		limit, ok := wipLimits[column]
+		if !ok {
+			limit, ok = wipLimits["*"]
+		}
+		if ok && limit > 0 && perColumn[column] > limit {
+			add("wip-limit", nil, column, fmt.Sprintf("Column has %d cards, over its WIP limit of %d", perColumn[column], limit))
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return lintSeverityRank(findings[i]["severity"].(string)) > lintSeverityRank(findings[j]["severity"].(string))
+	})
+	return findings
+}
+
+func lintSeverityRank(severity string) int {
+	return slices.Index(lintSeverities, severity)
+}
+
+func init() {
+	lintBoardCmd.Flags().StringVar(&lintBoardBoard, "board", "", "Board ID (defaults to the configured board)")
+	lintBoardCmd.Flags().StringArrayVar(&lintBoardRuleFlags, "rule", nil, "Set a rule's severity (error, warning, info) or turn it off, as NAME=SEVERITY (repeatable)")
+	lintBoardCmd.Flags().IntVar(&lintBoardWIP, "wip", 0, "WIP limit for every column without its own limit in config")
+	lintBoardCmd.Flags().DurationVar(&lintBoardStaleAfter, "stale-after", defaultStaleAfter, "How long a golden card may go without activity")
+	lintBoardCmd.Flags().StringVar(&lintBoardFailOn, "fail-on", "error", "Exit nonzero on findings at or above this severity (error, warning, info, never)")
+	lintCmd.AddCommand(lintBoardCmd)
+}
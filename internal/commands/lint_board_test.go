@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestLintBoard(t *testing.T) {
+	doing := map[string]any{"id": "col-1", "name": "Doing"}
+	cards := []any{
+		map[string]any{"number": float64(1), "title": "Unowned", "description": "x", "column": doing},
+		map[string]any{"number": float64(2), "title": "Owned", "description": "x", "column": doing,
+			"assignees": []any{map[string]any{"id": "u1"}}},
+		map[string]any{"number": float64(3), "title": "Triage", "description": ""},
+		map[string]any{"number": float64(4), "title": "Old gold", "description": "x", "golden": true,
+			"last_active_at": time.Now().Add(-30 * 24 * time.Hour).UTC().Format(time.RFC3339)},
+	}
+
+	run := func(t *testing.T, setup func()) (*CommandResult, error) {
+		t.Helper()
+		mock := NewMockClient()
+		mock.OnGet("/cards.json?board_ids[]=b1", &client.APIResponse{StatusCode: 200, Data: cards})
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		t.Cleanup(resetTest)
+
+		lintBoardBoard = "b1"
+		t.Cleanup(func() {
+			lintBoardBoard, lintBoardRuleFlags, lintBoardFailOn = "", nil, "error"
+			lintBoardCmd.Flags().Lookup("wip").Changed = false
+		})
+		if setup != nil {
+			setup()
+		}
+		return result, lintBoardCmd.RunE(lintBoardCmd, nil)
+	}
+	rulesOf := func(result *CommandResult) map[string]int {
+		counts := map[string]int{}
+		for _, f := range result.Response.Data.([]any) {
+			counts[f.(map[string]any)["rule"].(string)]++
+		}
+		return counts
+	}
+
+	t.Run("reports findings below the failing severity without failing", func(t *testing.T) {
+		result, err := run(t, nil)
+		assertExitCode(t, err, 0)
+		rules := rulesOf(result)
+		if rules["unassigned-active"] != 1 || rules["empty-description"] != 1 || rules["stale-golden"] != 1 || rules["wip-limit"] != 0 {
+			t.Errorf("unexpected findings: %v", result.Response.Data)
+		}
+	})
+
+	t.Run("fails on a WIP limit violation", func(t *testing.T) {
+		result, err := run(t, func() {
+			_ = lintBoardCmd.Flags().Set("wip", "1")
+		})
+		if !errors.IsFindings(err) || errors.ExitCodeOf(err) != errors.ExitFindings {
+			t.Fatalf("expected a lint findings error, got %v", err)
+		}
+		first := result.Response.Data.([]any)[0].(map[string]any)
+		if first["rule"] != "wip-limit" || first["severity"] != "error" || first["column"] != "Doing" {
+			t.Errorf("expected the WIP violation first, got %v", first)
+		}
+	})
+
+	t.Run("config and --rule adjust severities", func(t *testing.T) {
+		result, err := run(t, func() {
+			cfg.Lint.Rules = map[string]string{"empty-description": "off"}
+			lintBoardRuleFlags = []string{"unassigned-active=error"}
+		})
+		if errors.ExitCodeOf(err) != errors.ExitFindings {
+			t.Errorf("expected exit code %d, got %v", errors.ExitFindings, err)
+		}
+		if rules := rulesOf(result); rules["empty-description"] != 0 {
+			t.Errorf("expected empty-description to be off, got %v", rules)
+		}
+	})
+
+	t.Run("rejects unknown rules", func(t *testing.T) {
+		_, err := run(t, func() {
+			lintBoardRuleFlags = []string{"nope=error"}
+		})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
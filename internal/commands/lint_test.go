@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+)
+
+func TestLintLinks(t *testing.T) {
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+		case "/get-only":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		case "/moved":
+			http.Redirect(w, r, "/ok", http.StatusMovedPermanently)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer site.Close()
+
+	mock := NewMockClient()
+	mock.OnGet("/cards.json?board_ids[]=b1", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{
+			"number":           float64(1),
+			"title":            "Docs",
+			"description":      "See " + site.URL + "/ok and " + site.URL + "/missing.",
+			"description_html": `<a href="` + site.URL + `/get-only">x</a> <a href="https://api.example.com/cards/2">card</a>`,
+		},
+		map[string]any{"number": float64(2), "title": "Plain", "description": "no links"},
+	}})
+	mock.OnGet("/cards/1/comments.json", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"id": "c1", "body": map[string]any{"plain_text": "moved to " + site.URL + "/moved"}},
+	}})
+	mock.OnGet("/cards/2/comments.json", &client.APIResponse{StatusCode: 200, Data: []any{}})
+
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	lintLinksBoard = "b1"
+	defer func() { lintLinksBoard = "" }()
+
+	err := lintLinksCmd.RunE(lintLinksCmd, []string{})
+	assertExitCode(t, err, 0)
+
+	problems := result.Response.Data.([]any)
+	if len(problems) != 2 {
+		t.Fatalf("expected 2 problems, got %d: %v", len(problems), problems)
+	}
+	got := map[string]map[string]any{}
+	for _, p := range problems {
+		entry := p.(map[string]any)
+		got[entry["url"].(string)] = entry
+	}
+	if missing := got[site.URL+"/missing"]; missing["status"] != "broken" || missing["status_code"] != float64(404) || missing["source"] != "description" {
+		t.Errorf("unexpected entry for missing link: %v", missing)
+	}
+	if moved := got[site.URL+"/moved"]; moved["status"] != "redirect" || moved["location"] != "/ok" || moved["source"] != "comment c1" {
+		t.Errorf("unexpected entry for moved link: %v", moved)
+	}
+	if result.Response.Summary != "1 broken, 1 redirected of 4 links on 2 cards" {
+		t.Errorf("unexpected summary: %q", result.Response.Summary)
+	}
+}
+
+func TestExtractLinks(t *testing.T) {
+	links := extractLinks(`Read https://example.com/a?x=1&amp;y=2, then <a href="https://example.com/b">b</a> (https://example.com/c).`)
+	want := []string{"https://example.com/a?x=1&y=2", "https://example.com/b", "https://example.com/c"}
+	if len(links) != len(want) {
+		t.Fatalf("expected %v, got %v", want, links)
+	}
+	for i := range want {
+		if links[i] != want[i] {
+			t.Errorf("link %d: expected %q, got %q", i, want[i], links[i])
+		}
+	}
+}
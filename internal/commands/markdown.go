@@ -2,7 +2,9 @@ package commands
 
 import (
 	"bytes"
+	"encoding/xml"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/yuin/goldmark"
@@ -67,3 +69,215 @@ func markdownToHTML(content string) string {
 
 	return result
 }
+
+var subheadingTagRegex = regexp.MustCompile(`<(/?)h[2-6]>`)
+var taskCheckboxRegex = regexp.MustCompile(`<input( checked="")? disabled="" type="checkbox">\s*`)
+var tableRowRegex = regexp.MustCompile(`(?s)<tr>(.*?)</tr>\n?`)
+var tableCellRegex = regexp.MustCompile(`(?s)<(t[hd])[^>]*>(.*?)</t[hd]>`)
+var tableTagRegex = regexp.MustCompile(`</?(table|thead|tbody)>\n?`)
+
+// markdownToTrixHTML converts content that is known to be Markdown to the
+// HTML the rich text editor understands. Unlike markdownToHTML, plain text is
+// converted too, so it is wrapped in paragraphs. The editor has a single
+// heading level and no task lists or tables, so all headings become <h1>,
+// task list checkboxes become ☐ and ☑, and table rows become lines with their
+// cells separated by |, header cells in bold.
+func markdownToTrixHTML(content string) string {
+	if strings.TrimSpace(content) == "" {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(content), &buf); err != nil {
+		return markdownToHTML(content)
+	}
+	result := subheadingTagRegex.ReplaceAllString(buf.String(), "<${1}h1>")
+	result = taskCheckboxRegex.ReplaceAllStringFunc(result, func(match string) string {
+		if strings.Contains(match, "checked") {
+			return "☑ "
+		}
+		return "☐ "
+	})
+	result = tableRowRegex.ReplaceAllStringFunc(result, func(row string) string {
+		var cells []string
+		for _, cell := range tableCellRegex.FindAllStringSubmatch(row, -1) {
+			if cell[1] == "th" {
+				cells = append(cells, "<strong>"+cell[2]+"</strong>")
+			} else {
+				cells = append(cells, cell[2])
+			}
+		}
+		return "<div>" + strings.Join(cells, " | ") + "</div>\n"
+	})
+	return tableTagRegex.ReplaceAllString(result, "")
+}
+
+// htmlNode is an element or text node of a parsed HTML fragment.
+type htmlNode struct {
+	tag      string // empty for text nodes
+	attrs    map[string]string
+	text     string
+	children []*htmlNode
+}
+
+// parseHTMLFragment parses rich text HTML leniently, closing void and
+// unclosed elements the way browsers do. Anything after a parse error is
+// dropped rather than failing the whole fragment.
+func parseHTMLFragment(content string) *htmlNode {
+	root := &htmlNode{tag: "root"}
+	d := xml.NewDecoder(strings.NewReader("<root>" + content + "</root>"))
+	d.Strict = false
+	d.AutoClose = xml.HTMLAutoClose
+	d.Entity = xml.HTMLEntity
+
+	stack := []*htmlNode{root}
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			break
+		}
+		top := stack[len(stack)-1]
+		switch t := tok.(type) {
+		case xml.StartElement:
+			node := &htmlNode{tag: strings.ToLower(t.Name.Local), attrs: map[string]string{}}
+			for _, a := range t.Attr {
+				node.attrs[strings.ToLower(a.Name.Local)] = a.Value
+			}
+			top.children = append(top.children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			name := strings.ToLower(t.Name.Local)
+			for i := len(stack) - 1; i > 0; i-- {
+				if stack[i].tag == name {
+					stack = stack[:i]
+					break
+				}
+			}
+		case xml.CharData:
+			top.children = append(top.children, &htmlNode{text: string(t)})
+		}
+	}
+	return root
+}
+
+var whitespaceRegex = regexp.MustCompile(`\s+`)
+var blankLinesRegex = regexp.MustCompile(`\n{3,}`)
+
+// htmlToMarkdown converts rich text HTML, such as a card description or a
+// comment body, to Markdown. Attachments become links, or images when they
+// are pictures.
+func htmlToMarkdown(content string) string {
+	var b strings.Builder
+	renderMarkdownNodes(&b, parseHTMLFragment(content).children, false)
+
+	lines := strings.Split(b.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	result := blankLinesRegex.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+	return strings.TrimSpace(result)
+}
+
+func renderMarkdownNodes(b *strings.Builder, nodes []*htmlNode, pre bool) {
+	for _, n := range nodes {
+		renderMarkdownNode(b, n, pre)
+	}
+}
+
+func renderMarkdownNode(b *strings.Builder, n *htmlNode, pre bool) {
+	if n.tag == "" {
+		if pre {
+			b.WriteString(n.text)
+		} else {
+			b.WriteString(whitespaceRegex.ReplaceAllString(n.text, " "))
+		}
+		return
+	}
+
+	inner := func() string {
+		var ib strings.Builder
+		renderMarkdownNodes(&ib, n.children, pre)
+		return strings.TrimSpace(ib.String())
+	}
+	wrap := func(marker string) {
+		if text := inner(); text != "" {
+			b.WriteString(marker + text + marker)
+		}
+	}
+
+	switch n.tag {
+	case "p", "div", "figure", "section":
+		b.WriteString("\n\n" + inner() + "\n\n")
+	case "br":
+		b.WriteString("\n")
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		b.WriteString("\n\n" + strings.Repeat("#", int(n.tag[1]-'0')) + " " + inner() + "\n\n")
+	case "strong", "b":
+		wrap("**")
+	case "em", "i":
+		wrap("_")
+	case "s", "del", "strike":
+		wrap("~~")
+	case "code":
+		wrap("`")
+	case "pre":
+		var pb strings.Builder
+		renderMarkdownNodes(&pb, n.children, true)
+		b.WriteString("\n\n```\n" + strings.Trim(pb.String(), "\n") + "\n```\n\n")
+	case "a":
+		text, href := inner(), n.attrs["href"]
+		switch {
+		case href == "":
+			b.WriteString(text)
+		case text == "" || text == href:
+			b.WriteString("<" + href + ">")
+		default:
+			b.WriteString("[" + text + "](" + href + ")")
+		}
+	case "img":
+		b.WriteString("![" + n.attrs["alt"] + "](" + n.attrs["src"] + ")")
+	case "action-text-attachment":
+		name := n.attrs["caption"]
+		if name == "" {
+			name = n.attrs["filename"]
+		}
+		url := n.attrs["url"]
+		switch {
+		case url == "":
+			b.WriteString("[" + name + "]")
+		case strings.HasPrefix(n.attrs["content-type"], "image/"):
+			b.WriteString("![" + name + "](" + url + ")")
+		default:
+			b.WriteString("[" + name + "](" + url + ")")
+		}
+	case "hr":
+		b.WriteString("\n\n---\n\n")
+	case "blockquote":
+		lines := strings.Split(inner(), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight("> "+line, " ")
+		}
+		b.WriteString("\n\n" + strings.Join(lines, "\n") + "\n\n")
+	case "ul", "ol":
+		b.WriteString("\n\n")
+		number := 0
+		for _, child := range n.children {
+			if child.tag != "li" {
+				continue
+			}
+			number++
+			marker := "- "
+			if n.tag == "ol" {
+				marker = strconv.Itoa(number) + ". "
+			}
+			var lb strings.Builder
+			renderMarkdownNodes(&lb, child.children, pre)
+			item := blankLinesRegex.ReplaceAllString(strings.TrimSpace(lb.String()), "\n\n")
+			item = strings.ReplaceAll(item, "\n\n", "\n")
+			item = strings.ReplaceAll(item, "\n", "\n"+strings.Repeat(" ", len(marker)))
+			b.WriteString(marker + item + "\n")
+		}
+		b.WriteString("\n")
+	default:
+		renderMarkdownNodes(b, n.children, pre)
+	}
+}
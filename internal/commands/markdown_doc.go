@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+// documentFormat validates the --format flag of card show, card list, and
+// board show, reporting whether it asks for a Markdown document.
+func documentFormat(format string) (bool, error) {
+	switch format {
+	case "":
+		return false, nil
+	case "markdown", "md":
+	default:
+		return false, errors.NewInvalidArgsError(fmt.Sprintf("unsupported --format %q (use markdown)", format))
+	}
+	if cfgMarkdown {
+		return false, errors.NewInvalidArgsError("--format markdown prints a document; it cannot be combined with --markdown, which prints the usual output as Markdown")
+	}
+	if cfgJSON || cfgQuiet || cfgIDsOnly || cfgCount || cfgStyled || cfgNDJSON || cfgTemplate != "" || ghaMode() || cfgJQ != "" {
+		return false, errors.NewInvalidArgsError("--format markdown prints a document; it cannot be combined with JSON, styled, or other output flags")
+	}
+	return true, nil
+}
+
+// writeDocument prints a rendered document as the command's whole output.
+func writeDocument(doc string) {
+	writeOutputString(doc)
+	captureResponse()
+}
+
+// cardMarkdown renders a card as a Markdown document: its title, details,
+// description, steps, and comments. level is the heading level of the title,
+// so cards can be nested under a list heading.
+func cardMarkdown(card map[string]any, comments []any, level int) string {
+	heading := strings.Repeat("#", level)
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s #%v %s\n\n", heading, card["number"], getStringField(card, "title"))
+
+	details := [][2]string{}
+	if board, ok := card["board"].(map[string]any); ok && getStringField(board, "name") != "" {
+		details = append(details, [2]string{"Board", getStringField(board, "name")})
+	}
+	details = append(details, [2]string{"Column", cardPlacement(card)})
+	if tags := cardTagNames(card); len(tags) > 0 {
+		details = append(details, [2]string{"Tags", strings.Join(tags, ", ")})
+	}
+	if assignees := userNames(card["assignees"]); len(assignees) > 0 {
+		details = append(details, [2]string{"Assignees", strings.Join(assignees, ", ")})
+	}
+	if creator, ok := card["creator"].(map[string]any); ok && getStringField(creator, "name") != "" {
+		details = append(details, [2]string{"Created by", getStringField(creator, "name")})
+	}
+	if url := getStringField(card, "url"); url != "" {
+		details = append(details, [2]string{"Link", url})
+	}
+	for _, detail := range details {
+		fmt.Fprintf(&b, "- **%s:** %s\n", detail[0], detail[1])
+	}
+
+	description := htmlToMarkdown(getStringField(card, "description_html"))
+	if description == "" {
+		description = strings.TrimSpace(getStringField(card, "description"))
+	}
+	if description != "" {
+		b.WriteString("\n" + description + "\n")
+	}
+
+	if steps := toMaps(card["steps"]); len(steps) > 0 {
+		fmt.Fprintf(&b, "\n%s# Steps\n\n", heading)
+		for _, step := range steps {
+			check := " "
+			if getBoolField(step, "completed") {
+				check = "x"
+			}
+			fmt.Fprintf(&b, "- [%s] %s\n", check, getStringField(step, "content"))
+		}
+	}
+
+	if len(comments) > 0 {
+		fmt.Fprintf(&b, "\n%s# Comments\n", heading)
+		for _, comment := range toMaps(comments) {
+			author := "Unknown"
+			if creator, ok := comment["creator"].(map[string]any); ok && getStringField(creator, "name") != "" {
+				author = getStringField(creator, "name")
+			}
+			fmt.Fprintf(&b, "\n**%s** · %s\n\n", author, getStringField(comment, "created_at"))
+			body, _ := comment["body"].(map[string]any)
+			text := htmlToMarkdown(getStringField(body, "html"))
+			if text == "" {
+				text = strings.TrimSpace(getStringField(body, "plain_text"))
+			}
+			b.WriteString(text + "\n")
+		}
+	}
+	return b.String()
+}
+
+// cardListMarkdown renders cards as one Markdown document under a heading,
+// each card as its own section.
+func cardListMarkdown(title string, cards []map[string]any) string {
+	sections := []string{"# " + title + "\n"}
+	for _, card := range cards {
+		sections = append(sections, cardMarkdown(card, nil, 2))
+	}
+	if len(cards) == 0 {
+		sections = append(sections, "_No cards_\n")
+	}
+	return strings.Join(sections, "\n")
+}
+
+// boardMarkdown renders a board as a Markdown document with a section per
+// column listing its cards.
+func boardMarkdown(board map[string]any, columns []boardPrintColumn) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", getStringField(board, "name"))
+	if description := htmlToMarkdown(getStringField(board, "description_html")); description != "" {
+		b.WriteString("\n" + description + "\n")
+	}
+	if url := getStringField(board, "url"); url != "" {
+		fmt.Fprintf(&b, "\n<%s>\n", url)
+	}
+	for _, column := range columns {
+		fmt.Fprintf(&b, "\n## %s (%d)\n\n", column.name, len(column.cards))
+		if len(column.cards) == 0 {
+			b.WriteString("_No cards_\n")
+		}
+		for _, card := range column.cards {
+			fmt.Fprintf(&b, "- #%v %s\n", card["number"], getStringField(card, "title"))
+		}
+	}
+	return b.String()
+}
+
+// userNames returns the names of a list of users.
+func userNames(users any) []string {
+	var names []string
+	for _, user := range toMaps(users) {
+		if name := getStringField(user, "name"); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
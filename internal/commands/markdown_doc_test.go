@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestCardShowMarkdownDocument(t *testing.T) {
+	mock := NewMockClient()
+	mock.OnGet("/cards/42", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+		"number":           42,
+		"title":            "Fix login",
+		"board":            map[string]any{"name": "Roadmap"},
+		"column":           map[string]any{"name": "Doing"},
+		"tags":             []any{"bug"},
+		"assignees":        []any{map[string]any{"name": "Ann"}},
+		"url":              "https://app.fizzy.do/1/cards/42",
+		"description_html": "<div>Users get <strong>logged out</strong>.</div>",
+		"steps": []any{
+			map[string]any{"content": "Reproduce", "completed": true},
+			map[string]any{"content": "Fix", "completed": false},
+		},
+	}})
+	mock.OnGet("/cards/42/comments.json", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"creator": map[string]any{"name": "Bo"}, "created_at": "2026-01-02T10:00:00Z", "body": map[string]any{"html": "<div>On it</div>"}},
+	}})
+	SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	cardShowFormat = "markdown"
+	defer func() { cardShowFormat = "" }()
+
+	err := cardShowCmd.RunE(cardShowCmd, []string{"42"})
+	assertExitCode(t, err, 0)
+
+	want := `# #42 Fix login
+
+- **Board:** Roadmap
+- **Column:** Doing
+- **Tags:** bug
+- **Assignees:** Ann
+- **Link:** https://app.fizzy.do/1/cards/42
+
+Users get **logged out**.
+
+## Steps
+
+- [x] Reproduce
+- [ ] Fix
+
+## Comments
+
+**Bo** · 2026-01-02T10:00:00Z
+
+On it
+`
+	if got := TestOutput(); got != want {
+		t.Errorf("unexpected document:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestCardListMarkdownDocument(t *testing.T) {
+	mock := NewMockClient()
+	mock.GetWithPaginationResponse = &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"number": 1, "title": "First", "description_html": "<div>One</div>"},
+		map[string]any{"number": 2, "title": "Second", "closed": true},
+	}}
+	SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	cardListFormat = "markdown"
+	defer func() { cardListFormat = "" }()
+
+	err := cardListCmd.RunE(cardListCmd, []string{})
+	assertExitCode(t, err, 0)
+
+	got := TestOutput()
+	for _, part := range []string{"# 2 cards\n", "## #1 First\n", "One\n", "## #2 Second\n", "- **Column:** Done\n"} {
+		if !strings.Contains(got, part) {
+			t.Errorf("expected %q in document:\n%s", part, got)
+		}
+	}
+}
+
+func TestBoardShowMarkdownDocument(t *testing.T) {
+	mock := NewMockClient()
+	mock.OnGet("/boards/b1.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{"id": "b1", "name": "Roadmap"}})
+	mock.OnGet("/boards/b1/columns.json", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"id": "col1", "name": "Doing"},
+	}})
+	mock.OnGet("/cards.json?board_ids[]=b1&indexed_by=all", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"number": 2, "title": "Build it", "column": map[string]any{"name": "Doing"}},
+	}})
+	SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	boardShowFormat = "markdown"
+	defer func() { boardShowFormat = "" }()
+
+	err := boardShowCmd.RunE(boardShowCmd, []string{"b1"})
+	assertExitCode(t, err, 0)
+
+	want := "# Roadmap\n\n## Maybe? (0)\n\n_No cards_\n\n## Doing (1)\n\n- #2 Build it\n"
+	if got := TestOutput(); got != want {
+		t.Errorf("unexpected document:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDocumentFormatValidation(t *testing.T) {
+	defer resetTest()
+	if _, err := documentFormat("html"); err == nil {
+		t.Error("expected an unsupported format to be rejected")
+	}
+	cfgJSON = true
+	_, err := documentFormat("markdown")
+	assertExitCode(t, err, errors.ExitInvalidArgs)
+
+	cfgJSON, cfgMarkdown = false, true
+	_, err = documentFormat("md")
+	assertExitCode(t, err, errors.ExitInvalidArgs)
+}
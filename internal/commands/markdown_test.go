@@ -97,3 +97,85 @@ func TestMarkdownToHTML(t *testing.T) {
 		})
 	}
 }
+
+func TestMarkdownToTrixHTML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+		not   []string
+	}{
+		{"plain text becomes a paragraph", "Just text", []string{"<p>Just text</p>"}, nil},
+		{"subheadings become h1", "## Plan\n\n### Details", []string{"<h1>Plan</h1>", "<h1>Details</h1>"}, []string{"<h2>", "<h3>"}},
+		{"task lists become boxes", "- [x] done\n- [ ] todo", []string{"<li>☑ done</li>", "<li>☐ todo</li>"}, []string{"<input"}},
+		{"tables become lines of cells", "| a | b |\n|---|---|\n| 1 | 2 |", []string{"<div><strong>a</strong> | <strong>b</strong></div>", "<div>1 | 2</div>"}, []string{"<table", "<td", "<tr"}},
+		{"empty input stays empty", "  \n", nil, []string{"<p>"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := markdownToTrixHTML(tt.input)
+			for _, s := range tt.want {
+				if !strings.Contains(result, s) {
+					t.Errorf("expected output to contain %q\ngot: %s", s, result)
+				}
+			}
+			for _, s := range tt.not {
+				if strings.Contains(result, s) {
+					t.Errorf("expected output NOT to contain %q\ngot: %s", s, result)
+				}
+			}
+		})
+	}
+}
+
+func TestHTMLToMarkdown(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "inline formatting and line breaks",
+			input: `<div>Fix <strong>login</strong> &amp; <em>logout</em><br>then <code>deploy</code></div>`,
+			want:  "Fix **login** & _logout_\nthen `deploy`",
+		},
+		{
+			name:  "paragraphs and links",
+			input: `<p>See <a href="https://example.com/docs">the docs</a></p><p><a href="https://example.com">https://example.com</a></p>`,
+			want:  "See [the docs](https://example.com/docs)\n\n<https://example.com>",
+		},
+		{
+			name:  "nested and ordered lists",
+			input: `<ul><li>one</li><li>two<ul><li>nested</li></ul></li></ul><ol><li>first</li><li>second</li></ol>`,
+			want:  "- one\n- two\n  - nested\n\n1. first\n2. second",
+		},
+		{
+			name:  "code blocks keep their whitespace",
+			input: "<pre>if a &lt; b {\n  return\n}</pre>",
+			want:  "```\nif a < b {\n  return\n}\n```",
+		},
+		{
+			name:  "quotes and headings",
+			input: `<h1>Plan</h1><blockquote>quoted<br>twice</blockquote>`,
+			want:  "# Plan\n\n> quoted\n> twice",
+		},
+		{
+			name:  "attachments become images or links",
+			input: `<action-text-attachment content-type="image/png" url="https://example.com/a.png" filename="a.png"><figure><img src="x"></figure></action-text-attachment><action-text-attachment content-type="application/pdf" url="https://example.com/b.pdf" filename="b.pdf"></action-text-attachment>`,
+			want:  "![a.png](https://example.com/a.png)[b.pdf](https://example.com/b.pdf)",
+		},
+		{
+			name:  "empty",
+			input: "",
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := htmlToMarkdown(tt.input); got != tt.want {
+				t.Errorf("htmlToMarkdown() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
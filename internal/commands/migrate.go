@@ -25,6 +25,7 @@ var migrateBoardIncludeComments bool
 var migrateBoardIncludeSteps bool
 var migrateBoardIncludeImages bool
 var migrateBoardDryRun bool
+var migrateBoardApprove bool
 
 var migrateBoardCmd = &cobra.Command{
 	Use:   "board BOARD_ID",
@@ -44,8 +45,22 @@ What cannot be migrated:
 - Comment authors (will become the migrating user)
 
 Example:
-  fizzy migrate board 12345 --from personal --to team-acme
-  fizzy migrate board 12345 --from personal --to team-acme --include-comments --include-steps`,
+  fizzy migrate board 12345 --from personal --to team-acme --dry-run
+  fizzy migrate board 12345 --from personal --to team-acme --include-comments --include-steps --approve
+
+The plan, each card to be copied, is shown for confirmation first; --approve
+skips the prompt, and is required when not running in a terminal. --dry-run
+prints the plan without migrating anything.
+
+Save the JSON result (--json -o report.json) to check the copy afterwards with
+'fizzy migrate verify --mapping report.json'.
+
+If some cards fail to migrate, the result lists them under "failed" (with
+"succeeded" for the rest) and the command exits with code 9.
+
+Use --events ndjson to replace the progress messages on stderr with one JSON
+event per line (started, fetched, board_created, column_created, card_created,
+warning, done), or add --events-file to write them to a file.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runMigrateBoard,
 }
@@ -79,6 +94,12 @@ func runMigrateBoard(cmd *cobra.Command, args []string) error {
 		return errors.NewInvalidArgsError("--from and --to accounts must be different")
 	}
 
+	stopEvents, err := startEvents()
+	defer stopEvents()
+	if err != nil {
+		return err
+	}
+
 	sourceBoardID := args[0]
 	stats := &migrationStats{
 		cardMapping: make(map[int]int),
@@ -89,40 +110,49 @@ func runMigrateBoard(cmd *cobra.Command, args []string) error {
 	targetClient := createClientForAccount(migrateBoardTo)
 
 	// 1. Verify access to both accounts
-	fmt.Fprintf(os.Stderr, "Verifying access to accounts...\n")
+	emitEvent("started", map[string]any{"board_id": sourceBoardID, "from": migrateBoardFrom, "to": migrateBoardTo, "dry_run": migrateBoardDryRun})
+	progressf("Verifying access to accounts...\n")
 	if err := verifyAccountAccess(migrateBoardFrom, migrateBoardTo); err != nil {
 		return err
 	}
 
 	// 2. Get source board
-	fmt.Fprintf(os.Stderr, "Fetching source board...\n")
+	progressf("Fetching source board...\n")
 	sourceBoard, err := getBoard(sourceClient, sourceBoardID)
 	if err != nil {
 		return errors.NewError(fmt.Sprintf("Failed to fetch source board: %v", err))
 	}
 
 	boardName := getStringField(sourceBoard, "name")
-	fmt.Fprintf(os.Stderr, "Source board: %s\n", boardName)
+	progressf("Source board: %s\n", boardName)
 
 	// 3. Get source columns
-	fmt.Fprintf(os.Stderr, "Fetching source columns...\n")
+	progressf("Fetching source columns...\n")
 	sourceColumns, err := getColumns(sourceClient, sourceBoardID)
 	if err != nil {
 		return errors.NewError(fmt.Sprintf("Failed to fetch source columns: %v", err))
 	}
 
 	// 4. Get all cards from source board
-	fmt.Fprintf(os.Stderr, "Fetching source cards...\n")
+	progressf("Fetching source cards...\n")
 	sourceCards, err := getAllCards(sourceClient, sourceBoardID)
 	if err != nil {
 		return errors.NewError(fmt.Sprintf("Failed to fetch source cards: %v", err))
 	}
 
-	fmt.Fprintf(os.Stderr, "Found %d cards to migrate\n", len(sourceCards))
+	progressf("Found %d cards to migrate\n", len(sourceCards))
+	emitEvent("fetched", map[string]any{"board": boardName, "columns": countRealColumns(sourceColumns), "cards": len(sourceCards)})
+
+	action := fmt.Sprintf("migrate %d %s from %q to %s", len(sourceCards), pluralize(len(sourceCards), "card", "cards"), boardName, migrateBoardTo)
+	changes := make([]planChange, 0, len(sourceCards))
+	for _, card := range toMaps(sourceCards) {
+		changes = append(changes, planChange{Card: card["number"], Title: getStringField(card, "title"), Change: "copy to " + migrateBoardTo})
+	}
 
 	// Dry run: just show what would be done
 	if migrateBoardDryRun {
 		printDryRunSummary(boardName, sourceColumns, sourceCards)
+		emitEvent("done", map[string]any{"dry_run": true})
 		printMutation(map[string]any{
 			"dry_run":      true,
 			"board":        boardName,
@@ -130,12 +160,23 @@ func runMigrateBoard(cmd *cobra.Command, args []string) error {
 			"cards":        len(sourceCards),
 			"from_account": migrateBoardFrom,
 			"to_account":   migrateBoardTo,
+			"plan":         changes,
 		}, "", nil)
 		return nil
 	}
 
+	approved, err := approvePlan(action, changes, migrateBoardApprove)
+	if err != nil {
+		return err
+	}
+	if !approved {
+		emitEvent("done", map[string]any{"cancelled": true})
+		printMutation(map[string]any{"cancelled": true, "board": boardName}, "Migration cancelled; nothing was copied", nil)
+		return nil
+	}
+
 	// 5. Create target board
-	fmt.Fprintf(os.Stderr, "Creating target board...\n")
+	progressf("Creating target board...\n")
 	targetBoardID, err := createBoard(targetClient, boardName)
 	if err != nil {
 		return errors.NewError(fmt.Sprintf("Failed to create target board: %v", err))
@@ -143,9 +184,10 @@ func runMigrateBoard(cmd *cobra.Command, args []string) error {
 	stats.boardCreated = true
 	stats.targetBoardID = targetBoardID
 	stats.targetBoardName = boardName
+	emitEvent("board_created", map[string]any{"board_id": targetBoardID, "name": boardName})
 
 	// 6. Create columns in target (preserve order)
-	fmt.Fprintf(os.Stderr, "Creating columns...\n")
+	progressf("Creating columns...\n")
 	columnMapping := make(map[string]string) // source column ID -> target column ID
 	for _, col := range sourceColumns {
 		colMap, ok := col.(map[string]any)
@@ -167,15 +209,17 @@ func runMigrateBoard(cmd *cobra.Command, args []string) error {
 
 		targetColID, err := createColumn(targetClient, targetBoardID, colName, colColor)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: Failed to create column '%s': %v\n", colName, err)
+			warnf("Warning: Failed to create column '%s': %v\n", colName, err)
 			continue
 		}
 		columnMapping[sourceColID] = targetColID
 		stats.columnsCreated++
+		emitEvent("column_created", map[string]any{"source_id": sourceColID, "id": targetColID, "name": colName})
 	}
 
 	// 7. Migrate cards
-	fmt.Fprintf(os.Stderr, "Migrating cards...\n")
+	progressf("Migrating cards...\n")
+	cards := &bulkResult{}
 	for i, card := range sourceCards {
 		cardMap, ok := card.(map[string]any)
 		if !ok {
@@ -183,23 +227,38 @@ func runMigrateBoard(cmd *cobra.Command, args []string) error {
 		}
 
 		sourceCardNum := getIntField(cardMap, "number")
-		fmt.Fprintf(os.Stderr, "  [%d/%d] Card #%d: %s\n", i+1, len(sourceCards), sourceCardNum, getStringField(cardMap, "title"))
+		progressf("  [%d/%d] Card #%d: %s\n", i+1, len(sourceCards), sourceCardNum, getStringField(cardMap, "title"))
 
 		targetCardNum, err := migrateCard(sourceClient, targetClient, cardMap, targetBoardID, columnMapping, stats)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "  Warning: Failed to migrate card #%d: %v\n", sourceCardNum, err)
+			warnf("  Warning: Failed to migrate card #%d: %v\n", sourceCardNum, err)
+			if cards.fail(sourceCardNum, err) {
+				warnf("  Rate limited; skipping the remaining %d cards\n", len(sourceCards)-i-1)
+				for _, rest := range sourceCards[i+1:] {
+					if restMap, ok := rest.(map[string]any); ok {
+						cards.skip(getIntField(restMap, "number"))
+					}
+				}
+				break
+			}
 			continue
 		}
 
 		stats.cardMapping[sourceCardNum] = targetCardNum
 		stats.cardsCreated++
+		cards.succeed(map[string]any{"source_number": sourceCardNum, "number": targetCardNum})
+		emitEvent("card_created", map[string]any{"source_number": sourceCardNum, "number": targetCardNum, "index": i + 1, "total": len(sourceCards)})
 	}
 
 	// Print summary
 	printMigrationSummary(stats)
+	emitEvent("done", map[string]any{"board_id": stats.targetBoardID, "columns_created": stats.columnsCreated, "cards_created": stats.cardsCreated})
 
-	printMutation(map[string]any{
+	return printBulkResult(map[string]any{
 		"migrated":         true,
+		"source_board_id":  sourceBoardID,
+		"from_account":     migrateBoardFrom,
+		"to_account":       migrateBoardTo,
 		"board_id":         stats.targetBoardID,
 		"board_name":       stats.targetBoardName,
 		"columns_created":  stats.columnsCreated,
@@ -209,20 +268,29 @@ func runMigrateBoard(cmd *cobra.Command, args []string) error {
 		"steps_created":    stats.stepsCreated,
 		"images_migrated":  stats.imagesMigrated,
 		"card_mapping":     stats.cardMapping,
-	}, "", nil)
-
-	return nil
+		"included": map[string]bool{
+			"comments": migrateBoardIncludeComments,
+			"steps":    migrateBoardIncludeSteps,
+			"images":   migrateBoardIncludeImages,
+		},
+	}, cards, "cards", "", nil)
 }
 
 func createClientForAccount(account string) client.API {
 	c := client.New(cfg.APIURL, cfg.Token, account)
 	c.Verbose = cfgVerbose
+	// Invalid TLS settings are reported by verifyAccountAccess, which runs
+	// before either client is used.
+	_ = configureHTTPClient(c)
 	return c
 }
 
 func verifyAccountAccess(sourceAccount, targetAccount string) error {
 	// Get identity to verify access to both accounts
 	c := client.New(cfg.APIURL, cfg.Token, "")
+	if err := configureHTTPClient(c); err != nil {
+		return err
+	}
 	resp, err := c.Get(cfg.APIURL + "/my/identity.json")
 	if err != nil {
 		return errors.NewError(fmt.Sprintf("Failed to fetch identity: %v", err))
@@ -404,41 +472,11 @@ func migrateCard(sourceClient, targetClient client.API, sourceCard map[string]an
 		cardParams["created_at"] = createdAt
 	}
 
-	body := map[string]any{
-		"board_id": targetBoardID,
-		"card":     cardParams,
-	}
-
-	resp, err := targetClient.Post("/cards.json", body)
+	newCardNum, err := createCardOnBoard(targetClient, targetBoardID, cardParams)
 	if err != nil {
 		return 0, err
 	}
-
-	// Get the new card number
-	var newCardNum int
-	var newCardData map[string]any
-
-	if resp.Location != "" {
-		followResp, err := targetClient.FollowLocation(resp.Location)
-		if err == nil && followResp != nil {
-			if data, ok := followResp.Data.(map[string]any); ok {
-				newCardData = data
-				newCardNum = getIntField(data, "number")
-			}
-		}
-	}
-	if newCardNum == 0 {
-		if data, ok := resp.Data.(map[string]any); ok {
-			newCardData = data
-			newCardNum = getIntField(data, "number")
-		}
-	}
-	if newCardNum == 0 {
-		return 0, errors.NewError("Failed to get new card number")
-	}
-
 	newCardNumStr := strconv.Itoa(newCardNum)
-	_ = newCardData // might use later for additional operations
 
 	// Apply tags
 	if tags, ok := sourceCard["tags"].([]any); ok {
@@ -449,7 +487,7 @@ func migrateCard(sourceClient, targetClient client.API, sourceCard map[string]an
 			}
 			err := applyTag(targetClient, newCardNumStr, tagName)
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "    Warning: Failed to apply tag '%s': %v\n", tagName, err)
+				warnf("    Warning: Failed to apply tag '%s': %v\n", tagName, err)
 			} else {
 				stats.tagsApplied++
 			}
@@ -462,7 +500,7 @@ func migrateCard(sourceClient, targetClient client.API, sourceCard map[string]an
 		if targetColumnID, ok := columnMapping[sourceColumnID]; ok {
 			err := moveToColumn(targetClient, newCardNumStr, targetColumnID)
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "    Warning: Failed to move card to column: %v\n", err)
+				warnf("    Warning: Failed to move card to column: %v\n", err)
 			}
 		}
 	}
@@ -475,7 +513,7 @@ func migrateCard(sourceClient, targetClient client.API, sourceCard map[string]an
 	if status == "closed" {
 		err := closeCard(targetClient, newCardNumStr)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "    Warning: Failed to close card: %v\n", err)
+			warnf("    Warning: Failed to close card: %v\n", err)
 		}
 	}
 
@@ -487,7 +525,7 @@ func migrateCard(sourceClient, targetClient client.API, sourceCard map[string]an
 	if golden {
 		err := markGolden(targetClient, newCardNumStr)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "    Warning: Failed to mark card as golden: %v\n", err)
+			warnf("    Warning: Failed to mark card as golden: %v\n", err)
 		}
 	}
 
@@ -495,7 +533,7 @@ func migrateCard(sourceClient, targetClient client.API, sourceCard map[string]an
 	if migrateBoardIncludeComments {
 		commentsCreated, err := migrateComments(sourceClient, targetClient, strconv.Itoa(sourceCardNum), newCardNumStr)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "    Warning: Failed to migrate comments: %v\n", err)
+			warnf("    Warning: Failed to migrate comments: %v\n", err)
 		}
 		stats.commentsCreated += commentsCreated
 	}
@@ -504,7 +542,7 @@ func migrateCard(sourceClient, targetClient client.API, sourceCard map[string]an
 	if migrateBoardIncludeSteps {
 		stepsCreated, err := migrateSteps(sourceClient, targetClient, sourceCard, newCardNumStr)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "    Warning: Failed to migrate steps: %v\n", err)
+			warnf("    Warning: Failed to migrate steps: %v\n", err)
 		}
 		stats.stepsCreated += stepsCreated
 	}
@@ -515,7 +553,7 @@ func migrateCard(sourceClient, targetClient client.API, sourceCard map[string]an
 		if imageURL != "" {
 			err := migrateCardImage(sourceClient, targetClient, imageURL, newCardNumStr)
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "    Warning: Failed to migrate image: %v\n", err)
+				warnf("    Warning: Failed to migrate image: %v\n", err)
 			} else {
 				stats.imagesMigrated++
 			}
@@ -525,6 +563,40 @@ func migrateCard(sourceClient, targetClient client.API, sourceCard map[string]an
 	return newCardNum, nil
 }
 
+// createCardOnBoard creates a card on boardID from cardParams and returns its
+// number.
+func createCardOnBoard(c client.API, boardID string, cardParams map[string]any) (int, error) {
+	body := map[string]any{
+		"board_id": boardID,
+		"card":     cardParams,
+	}
+
+	resp, err := c.Post("/cards.json", body)
+	if err != nil {
+		return 0, err
+	}
+
+	// Get the new card number
+	var newCardNum int
+	if resp.Location != "" {
+		followResp, err := c.FollowLocation(resp.Location)
+		if err == nil && followResp != nil {
+			if data, ok := followResp.Data.(map[string]any); ok {
+				newCardNum = getIntField(data, "number")
+			}
+		}
+	}
+	if newCardNum == 0 {
+		if data, ok := resp.Data.(map[string]any); ok {
+			newCardNum = getIntField(data, "number")
+		}
+	}
+	if newCardNum == 0 {
+		return 0, errors.NewError("Failed to get new card number")
+	}
+	return newCardNum, nil
+}
+
 func getCardColumnID(card map[string]any) string {
 	// Try column_id directly
 	if colID, ok := card["column_id"].(string); ok && colID != "" {
@@ -626,7 +698,7 @@ func migrateComments(sourceClient, targetClient client.API, sourceCardNum, targe
 
 		_, err := targetClient.Post("/cards/"+targetCardNum+"/comments.json", reqBody)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "      Warning: Failed to create comment: %v\n", err)
+			warnf("      Warning: Failed to create comment: %v\n", err)
 			continue
 		}
 		created++
@@ -688,7 +760,7 @@ func migrateSteps(sourceClient, targetClient client.API, sourceCard map[string]a
 
 		_, err := targetClient.Post("/cards/"+targetCardNum+"/steps.json", reqBody)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "      Warning: Failed to create step: %v\n", err)
+			warnf("      Warning: Failed to create step: %v\n", err)
 			continue
 		}
 		created++
@@ -760,40 +832,9 @@ func migrateInlineAttachments(sourceClient, targetClient client.API, html string
 			continue
 		}
 
-		// Create temp file for download
-		tempDir := os.TempDir()
-		tempFile := filepath.Join(tempDir, fmt.Sprintf("fizzy-migrate-attachment-%d-%s", attachment.Index, attachment.Filename))
-
-		// Download the attachment
-		err := sourceClient.DownloadFile(attachment.DownloadURL, tempFile)
-		if err != nil {
-			_ = os.Remove(tempFile)
-			fmt.Fprintf(os.Stderr, "      Warning: Failed to download attachment '%s': %v\n", attachment.Filename, err)
-			continue
-		}
-
-		// Upload to target account
-		uploadResp, err := targetClient.UploadFile(tempFile)
-		_ = os.Remove(tempFile) // Clean up temp file
+		newSGID, err := reuploadAttachment(sourceClient, targetClient, attachment)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "      Warning: Failed to upload attachment '%s': %v\n", attachment.Filename, err)
-			continue
-		}
-
-		// Get the new SGID from upload response
-		uploadData, ok := uploadResp.Data.(map[string]any)
-		if !ok {
-			fmt.Fprintf(os.Stderr, "      Warning: Invalid upload response for '%s'\n", attachment.Filename)
-			continue
-		}
-
-		newSGID := getStringField(uploadData, "attachable_sgid")
-		if newSGID == "" {
-			// Try signed_id as fallback
-			newSGID = getStringField(uploadData, "signed_id")
-		}
-		if newSGID == "" {
-			fmt.Fprintf(os.Stderr, "      Warning: No SGID in upload response for '%s'\n", attachment.Filename)
+			warnf("      Warning: Failed to migrate attachment '%s': %v\n", attachment.Filename, err)
 			continue
 		}
 
@@ -808,43 +849,43 @@ func migrateInlineAttachments(sourceClient, targetClient client.API, html string
 }
 
 func printDryRunSummary(boardName string, columns, cards []any) {
-	fmt.Fprintf(os.Stderr, "\n=== DRY RUN SUMMARY ===\n")
-	fmt.Fprintf(os.Stderr, "Would migrate board: %s\n", boardName)
-	fmt.Fprintf(os.Stderr, "Columns to create: %d\n", countRealColumns(columns))
-	fmt.Fprintf(os.Stderr, "Cards to migrate: %d\n", len(cards))
+	progressf("\n=== DRY RUN SUMMARY ===\n")
+	progressf("Would migrate board: %s\n", boardName)
+	progressf("Columns to create: %d\n", countRealColumns(columns))
+	progressf("Cards to migrate: %d\n", len(cards))
 
 	if migrateBoardIncludeComments {
-		fmt.Fprintf(os.Stderr, "Comments: will be included\n")
+		progressf("Comments: will be included\n")
 	}
 	if migrateBoardIncludeSteps {
-		fmt.Fprintf(os.Stderr, "Steps: will be included\n")
+		progressf("Steps: will be included\n")
 	}
 	if migrateBoardIncludeImages {
-		fmt.Fprintf(os.Stderr, "Images: will be included\n")
+		progressf("Images: will be included\n")
 	}
 
-	fmt.Fprintf(os.Stderr, "\nNo changes were made.\n")
+	progressf("\nNo changes were made.\n")
 }
 
 func printMigrationSummary(stats *migrationStats) {
-	fmt.Fprintf(os.Stderr, "\n=== MIGRATION COMPLETE ===\n")
-	fmt.Fprintf(os.Stderr, "Board created: %s (ID: %s)\n", stats.targetBoardName, stats.targetBoardID)
-	fmt.Fprintf(os.Stderr, "Columns created: %d\n", stats.columnsCreated)
-	fmt.Fprintf(os.Stderr, "Cards migrated: %d\n", stats.cardsCreated)
-	fmt.Fprintf(os.Stderr, "Tags applied: %d\n", stats.tagsApplied)
+	progressf("\n=== MIGRATION COMPLETE ===\n")
+	progressf("Board created: %s (ID: %s)\n", stats.targetBoardName, stats.targetBoardID)
+	progressf("Columns created: %d\n", stats.columnsCreated)
+	progressf("Cards migrated: %d\n", stats.cardsCreated)
+	progressf("Tags applied: %d\n", stats.tagsApplied)
 
 	if migrateBoardIncludeComments {
-		fmt.Fprintf(os.Stderr, "Comments created: %d\n", stats.commentsCreated)
+		progressf("Comments created: %d\n", stats.commentsCreated)
 	}
 	if migrateBoardIncludeSteps {
-		fmt.Fprintf(os.Stderr, "Steps created: %d\n", stats.stepsCreated)
+		progressf("Steps created: %d\n", stats.stepsCreated)
 	}
 	if migrateBoardIncludeImages {
-		fmt.Fprintf(os.Stderr, "Images migrated: %d\n", stats.imagesMigrated)
+		progressf("Images migrated: %d\n", stats.imagesMigrated)
 	}
 
-	fmt.Fprintf(os.Stderr, "\nNote: Card creators and comment authors are now you (the migrating user).\n")
-	fmt.Fprintf(os.Stderr, "      User assignments were not migrated - reassign as needed.\n")
+	progressf("\nNote: Card creators and comment authors are now you (the migrating user).\n")
+	progressf("      User assignments were not migrated - reassign as needed.\n")
 }
 
 func countRealColumns(columns []any) int {
@@ -900,5 +941,7 @@ func init() {
 	migrateBoardCmd.Flags().BoolVar(&migrateBoardIncludeSteps, "include-steps", false, "Also migrate card steps (to-do items)")
 	migrateBoardCmd.Flags().BoolVar(&migrateBoardIncludeImages, "include-images", false, "Also migrate card header images")
 	migrateBoardCmd.Flags().BoolVar(&migrateBoardDryRun, "dry-run", false, "Show what would be migrated without making changes")
+	migrateBoardCmd.Flags().BoolVar(&migrateBoardApprove, "approve", false, "Migrate without the confirmation prompt")
+	addEventFlags(migrateBoardCmd)
 	migrateCmd.AddCommand(migrateBoardCmd)
 }
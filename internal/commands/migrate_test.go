@@ -1,8 +1,12 @@
 package commands
 
 import (
+	"os"
+	"path/filepath"
+	"slices"
 	"testing"
 
+	"github.com/basecamp/fizzy-cli/internal/client"
 	"github.com/basecamp/fizzy-cli/internal/errors"
 )
 
@@ -251,3 +255,77 @@ func TestMigrateInlineAttachments(t *testing.T) {
 // Note: Full dry run integration test is complex due to multi-client setup.
 // The migrate command creates separate clients for source and target accounts,
 // which makes mocking challenging. E2E tests cover the full flow.
+
+func TestMigrateVerify(t *testing.T) {
+	t.Run("reads the migrate board result", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "report.json")
+		report := `{"ok": true, "data": {"source_board_id": "src", "board_id": "dst", "card_mapping": {"1": 7, "2": 8}, "included": {"comments": true, "images": false}}}`
+		if err := os.WriteFile(path, []byte(report), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := readMigrationReport(path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.sourceBoardID != "src" || got.targetBoardID != "dst" || got.cardMapping[1] != 7 || got.cardMapping[2] != 8 {
+			t.Errorf("unexpected report: %+v", got)
+		}
+		if !got.checkComments || got.checkAttachments {
+			t.Errorf("expected checks to follow what was migrated, got %+v", got)
+		}
+	})
+
+	t.Run("rejects a report without a mapping", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "report.json")
+		if err := os.WriteFile(path, []byte(`{"board_id": "dst"}`), 0600); err != nil {
+			t.Fatal(err)
+		}
+		_, err := readMigrationReport(path)
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+
+	t.Run("reports discrepancies", func(t *testing.T) {
+		source := NewMockClient()
+		source.GetWithPaginationResponse = &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"number": float64(1), "title": "Same", "tags": []any{"b", "a"}},
+			map[string]any{"number": float64(2), "title": "Renamed", "tags": []any{"a"}},
+			map[string]any{"number": float64(3), "title": "Lost"},
+		}}
+		target := NewMockClient()
+		target.GetWithPaginationResponse = &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"number": float64(7), "title": "Same", "tags": []any{"a", "b"}},
+			map[string]any{"number": float64(8), "title": "Renamed later", "tags": []any{}},
+		}}
+
+		discrepancies, checked, err := verifyMigration(source, target, &migrationReport{
+			sourceBoardID: "src",
+			targetBoardID: "dst",
+			cardMapping:   map[int]int{1: 7, 2: 8},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if checked != 2 {
+			t.Errorf("expected 2 cards checked, got %d", checked)
+		}
+		var checks []string
+		for _, d := range discrepancies {
+			checks = append(checks, d.(map[string]any)["check"].(string))
+		}
+		if want := []string{"card_count", "title", "tags", "missing"}; !slices.Equal(checks, want) {
+			t.Errorf("expected %v, got %v", want, checks)
+		}
+	})
+
+	t.Run("requires --mapping", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		migrateVerifyFrom, migrateVerifyTo = "source", "target"
+		defer func() { migrateVerifyFrom, migrateVerifyTo = "", "" }()
+		err := migrateVerifyCmd.RunE(migrateVerifyCmd, nil)
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
@@ -0,0 +1,302 @@
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+// Migrate verify flags
+var migrateVerifyFrom string
+var migrateVerifyTo string
+var migrateVerifyMapping string
+
+var migrateVerifyCmd = &cobra.Command{
+	Use:   "verify [SOURCE_BOARD_ID]",
+	Short: "Check a migrated board against its source",
+	Long: `Re-fetches the source and target boards of a migration and compares them,
+so the copy can be trusted before the source is deleted. --mapping is the JSON
+result of 'fizzy migrate board', saved with --json -o report.json; it names
+the target board and maps each source card to its copy.
+
+These checks run:
+
+  card_count    the boards have the same number of cards
+  missing       each source card has a copy
+  title         titles match
+  tags          tags match
+  comments      comment counts match (when comments were migrated)
+  attachments   description attachments match by SHA-256 (when images were migrated)
+
+Each discrepancy is listed, and the command exits with code 10 when there
+are any. SOURCE_BOARD_ID is only needed for reports from before the source
+board was recorded in them.`,
+	Example: `  fizzy migrate board 12345 --from personal --to team-acme --approve --json -o report.json
+  fizzy migrate verify --from personal --to team-acme --mapping report.json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuth(); err != nil {
+			return err
+		}
+		if migrateVerifyFrom == "" {
+			return newRequiredFlagError("from")
+		}
+		if migrateVerifyTo == "" {
+			return newRequiredFlagError("to")
+		}
+		if migrateVerifyMapping == "" {
+			return newRequiredFlagError("mapping")
+		}
+
+		report, err := readMigrationReport(migrateVerifyMapping)
+		if err != nil {
+			return err
+		}
+		if len(args) == 1 {
+			report.sourceBoardID = args[0]
+		}
+		if report.sourceBoardID == "" {
+			return errors.NewInvalidArgsError("the mapping report does not name the source board; pass SOURCE_BOARD_ID")
+		}
+
+		if err := verifyAccountAccess(migrateVerifyFrom, migrateVerifyTo); err != nil {
+			return err
+		}
+		discrepancies, checked, err := verifyMigration(createClientForAccount(migrateVerifyFrom), createClientForAccount(migrateVerifyTo), report)
+		if err != nil {
+			return err
+		}
+
+		summary := fmt.Sprintf("%d %s checked, %d %s", checked, pluralize(checked, "card", "cards"), len(discrepancies), pluralize(len(discrepancies), "discrepancy", "discrepancies"))
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("target", fmt.Sprintf("fizzy board show %s", report.targetBoardID), "View migrated board"),
+		}
+		printList(discrepancies, migrateVerifyColumns, summary, breadcrumbs)
+
+		if len(discrepancies) > 0 {
+			return errors.NewVerifyMismatchError(fmt.Sprintf("%d %s between the source and migrated boards", len(discrepancies), pluralize(len(discrepancies), "discrepancy", "discrepancies")))
+		}
+		return nil
+	},
+}
+
+// migrationReport is what verify needs from a 'migrate board' result.
+type migrationReport struct {
+	sourceBoardID    string
+	targetBoardID    string
+	cardMapping      map[int]int
+	checkComments    bool
+	checkAttachments bool
+}
+
+// readMigrationReport reads a 'migrate board' JSON result, either the full
+// response envelope or its data.
+func readMigrationReport(path string) (*migrationReport, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.NewInvalidArgsError(fmt.Sprintf("cannot read mapping report: %v", err))
+	}
+	var data map[string]any
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, errors.NewInvalidArgsError(fmt.Sprintf("mapping report is not valid JSON: %v", err))
+	}
+	if inner, ok := data["data"].(map[string]any); ok {
+		data = inner
+	}
+
+	report := &migrationReport{
+		sourceBoardID:    getStringField(data, "source_board_id"),
+		targetBoardID:    getStringField(data, "board_id"),
+		cardMapping:      map[int]int{},
+		checkComments:    true,
+		checkAttachments: true,
+	}
+	mapping, ok := data["card_mapping"].(map[string]any)
+	if report.targetBoardID == "" || !ok {
+		return nil, errors.NewInvalidArgsError("mapping report has no board_id or card_mapping; use the JSON result of 'fizzy migrate board'")
+	}
+	for source, target := range mapping {
+		sourceNum, err := strconv.Atoi(source)
+		targetNum, ok := target.(float64)
+		if err != nil || !ok {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("mapping report has an invalid card mapping %q", source))
+		}
+		report.cardMapping[sourceNum] = int(targetNum)
+	}
+	if included, ok := data["included"].(map[string]any); ok {
+		report.checkComments = getBoolField(included, "comments")
+		report.checkAttachments = getBoolField(included, "images")
+	}
+	return report, nil
+}
+
+// verifyMigration compares the cards on both boards of a migration and
+// returns the discrepancies found, with the number of card pairs checked.
+func verifyMigration(source, target client.API, report *migrationReport) ([]any, int, error) {
+	progressf("Fetching cards...\n")
+	sourceCards, err := getAllCards(source, report.sourceBoardID)
+	if err != nil {
+		return nil, 0, errors.NewError(fmt.Sprintf("Failed to fetch source cards: %v", err))
+	}
+	targetCards, err := getAllCards(target, report.targetBoardID)
+	if err != nil {
+		return nil, 0, errors.NewError(fmt.Sprintf("Failed to fetch migrated cards: %v", err))
+	}
+
+	discrepancies := []any{}
+	add := func(check string, card, targetCard int, sourceValue, targetValue any) {
+		item := map[string]any{"check": check, "source": sourceValue, "target": targetValue}
+		if card != 0 {
+			item["card"] = card
+		}
+		if targetCard != 0 {
+			item["target_card"] = targetCard
+		}
+		discrepancies = append(discrepancies, item)
+	}
+
+	if len(sourceCards) != len(targetCards) {
+		add("card_count", 0, 0, len(sourceCards), len(targetCards))
+	}
+	byNumber := map[int]map[string]any{}
+	for _, card := range toMaps(targetCards) {
+		byNumber[getIntField(card, "number")] = card
+	}
+
+	checked := 0
+	for i, sourceCard := range toMaps(sourceCards) {
+		number := getIntField(sourceCard, "number")
+		targetNumber, ok := report.cardMapping[number]
+		targetCard := byNumber[targetNumber]
+		if !ok || targetCard == nil {
+			add("missing", number, targetNumber, getStringField(sourceCard, "title"), nil)
+			continue
+		}
+		checked++
+		progressf("  [%d/%d] Card #%d -> #%d\n", i+1, len(sourceCards), number, targetNumber)
+
+		if sourceTitle, targetTitle := getStringField(sourceCard, "title"), getStringField(targetCard, "title"); sourceTitle != targetTitle {
+			add("title", number, targetNumber, sourceTitle, targetTitle)
+		}
+		if sourceTags, targetTags := cardTagNames(sourceCard), cardTagNames(targetCard); !slices.Equal(sourceTags, targetTags) {
+			add("tags", number, targetNumber, strings.Join(sourceTags, ", "), strings.Join(targetTags, ", "))
+		}
+
+		if report.checkComments {
+			sourceCount, err := countComments(source, number)
+			if err != nil {
+				return nil, 0, errors.NewError(fmt.Sprintf("Failed to fetch comments on source card #%d: %v", number, err))
+			}
+			targetCount, err := countComments(target, targetNumber)
+			if err != nil {
+				return nil, 0, errors.NewError(fmt.Sprintf("Failed to fetch comments on migrated card #%d: %v", targetNumber, err))
+			}
+			if sourceCount != targetCount {
+				add("comments", number, targetNumber, sourceCount, targetCount)
+			}
+		}
+
+		if report.checkAttachments {
+			sourceSums, err := attachmentChecksums(source, getStringField(sourceCard, "description_html"))
+			if err != nil {
+				return nil, 0, errors.NewError(fmt.Sprintf("Failed to download attachments on source card #%d: %v", number, err))
+			}
+			targetSums, err := attachmentChecksums(target, getStringField(targetCard, "description_html"))
+			if err != nil {
+				return nil, 0, errors.NewError(fmt.Sprintf("Failed to download attachments on migrated card #%d: %v", targetNumber, err))
+			}
+			if !slices.Equal(sourceSums, targetSums) {
+				add("attachments", number, targetNumber, strings.Join(sourceSums, ", "), strings.Join(targetSums, ", "))
+			}
+		}
+	}
+	return discrepancies, checked, nil
+}
+
+// cardTagNames returns a card's tag names, sorted.
+func cardTagNames(card map[string]any) []string {
+	var names []string
+	tags, _ := card["tags"].([]any)
+	for _, tag := range tags {
+		switch tag := tag.(type) {
+		case string:
+			names = append(names, tag)
+		case map[string]any:
+			names = append(names, getStringField(tag, "title"))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func countComments(c client.API, cardNumber int) (int, error) {
+	resp, err := c.GetWithPagination("/cards/"+strconv.Itoa(cardNumber)+"/comments.json", true)
+	if err != nil {
+		return 0, err
+	}
+	comments, _ := resp.Data.([]any)
+	return len(comments), nil
+}
+
+// attachmentChecksums downloads the attachments embedded in html and returns
+// the SHA-256 of each, in order.
+func attachmentChecksums(c client.API, html string) ([]string, error) {
+	attachments := parseAttachments(html)
+	if len(attachments) == 0 {
+		return nil, nil
+	}
+	dir, err := os.MkdirTemp("", "fizzy-verify-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	sums := make([]string, 0, len(attachments))
+	for _, attachment := range attachments {
+		if attachment.DownloadURL == "" {
+			continue
+		}
+		path := filepath.Join(dir, strconv.Itoa(attachment.Index))
+		if err := c.DownloadFile(attachment.DownloadURL, path); err != nil {
+			return nil, err
+		}
+		sum, err := fileSHA256(path)
+		if err != nil {
+			return nil, err
+		}
+		sums = append(sums, sum)
+	}
+	return sums, nil
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func init() {
+	migrateVerifyCmd.Flags().StringVar(&migrateVerifyFrom, "from", "", "Source account slug (required)")
+	migrateVerifyCmd.Flags().StringVar(&migrateVerifyTo, "to", "", "Target account slug (required)")
+	migrateVerifyCmd.Flags().StringVar(&migrateVerifyMapping, "mapping", "", "JSON result of 'fizzy migrate board' (required)")
+	migrateCmd.AddCommand(migrateVerifyCmd)
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// cfgNDJSON is the --ndjson flag.
+var cfgNDJSON bool
+
+// writeNDJSON writes data for --ndjson: each item of a list as its own
+// compact JSON line, or anything else as a single line.
+func writeNDJSON(data any) error {
+	if v := reflect.ValueOf(data); v.Kind() == reflect.Slice {
+		for i := range v.Len() {
+			if err := writeNDJSONLine(v.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return writeNDJSONLine(data)
+}
+
+func writeNDJSONLine(item any) error {
+	line, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	_, err = outWriter.Write(append(line, '\n'))
+	return err
+}
+
+// ndjsonStreamer writes card list items as they are fetched, pruned to
+// --fields, for --ndjson with --all.
+type ndjsonStreamer struct {
+	err error
+}
+
+// emit writes items, remembering the first write error.
+func (s *ndjsonStreamer) emit(items []map[string]any) {
+	for _, item := range items {
+		if s.err != nil {
+			return
+		}
+		var data any = item
+		if cfgFields != "" {
+			data = selectFields(item, cfgFields)
+		}
+		s.err = writeNDJSONLine(data)
+	}
+}
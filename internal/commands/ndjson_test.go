@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+)
+
+func TestNDJSONCardListAll(t *testing.T) {
+	mock := NewMockClient()
+	mock.OnGet("/cards.json?board_ids[]=b1", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"id": "c2", "number": 2, "title": "Second", "status": "open"},
+	}, LinkNext: "/test-account/cards.json?board_ids[]=b1&page=2"})
+	mock.OnGet("/cards.json?board_ids[]=b1&page=2", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"id": "c1", "number": 1, "title": "First", "status": "open"},
+	}})
+
+	SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	cfgNDJSON, cfgFields = true, "number,title"
+	cardListBoard, cardListAll = []string{"b1"}, true
+	defer func() { cardListBoard, cardListAll = nil, false }()
+
+	err := cardListCmd.RunE(cardListCmd, []string{})
+	assertExitCode(t, err, 0)
+
+	want := `{"number":2,"title":"Second"}` + "\n" + `{"number":1,"title":"First"}` + "\n"
+	if got := TestOutput(); got != want {
+		t.Errorf("expected one line per card:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestNDJSONDetail(t *testing.T) {
+	mock := NewMockClient()
+	mock.OnGet("/boards/b1.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{"id": "b1", "name": "Roadmap"}})
+	SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	cfgNDJSON = true
+	err := boardShowCmd.RunE(boardShowCmd, []string{"b1"})
+	assertExitCode(t, err, 0)
+
+	if got := TestOutput(); strings.Count(got, "\n") != 1 || !strings.HasPrefix(got, `{"id":"b1"`) {
+		t.Errorf("expected the board on one line, got %q", got)
+	}
+}
+
+func TestNDJSONCardListAllWithSteps(t *testing.T) {
+	mock := NewMockClient()
+	mock.OnGet("/cards.json?board_ids[]=b1", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"id": "c7", "number": 7, "title": "Checklist"},
+	}})
+	mock.OnGet("/cards/7/steps.json", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"content": "one", "completed": true},
+		map[string]any{"content": "two", "completed": false},
+	}})
+
+	SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	cfgNDJSON, cfgFields = true, "number,steps_progress"
+	cardListBoard, cardListAll, cardListWith = []string{"b1"}, true, []string{"steps"}
+	defer func() { cardListBoard, cardListAll, cardListWith = nil, false, nil }()
+
+	err := cardListCmd.RunE(cardListCmd, []string{})
+	assertExitCode(t, err, 0)
+
+	want := `{"number":7,"steps_progress":"1/2"}` + "\n"
+	if got := TestOutput(); got != want {
+		t.Errorf("expected the streamed card with its steps progress:\n%s\ngot:\n%s", want, got)
+	}
+}
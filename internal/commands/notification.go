@@ -1,9 +1,14 @@
 package commands
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"strconv"
+	"time"
 
+	"github.com/basecamp/fizzy-cli/internal/cache"
+	"github.com/basecamp/fizzy-cli/internal/errors"
 	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
 	"github.com/spf13/cobra"
 )
@@ -92,6 +97,226 @@ var notificationListCmd = &cobra.Command{
 	},
 }
 
+var notificationShowCmd = &cobra.Command{
+	Use:   "show NOTIFICATION_ID",
+	Short: "Show a notification with its card and comment",
+	Long: `Shows a single notification together with the card it refers to (number,
+title, board, column) and, for comment notifications, the triggering comment.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		ctx := cmd.Context()
+		notif, err := findNotification(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		detail, _ := normalizeAny(notif).(map[string]any)
+		if detail == nil {
+			detail = map[string]any{}
+		}
+
+		cardNumber := ""
+		if notif.Card.Number > 0 {
+			cardNumber = strconv.Itoa(int(notif.Card.Number))
+			card, _, err := getSDK().Cards().Get(ctx, cardNumber)
+			if err != nil {
+				return convertSDKError(err)
+			}
+			detail["card"] = notificationCardContext(card)
+
+			if notif.SourceType == "Comment" {
+				if comment := findNotificationComment(ctx, cardNumber, notif); comment != nil {
+					detail["comment"] = map[string]any{
+						"id":         comment.Id,
+						"body":       comment.Body.PlainText,
+						"creator":    comment.Creator.Name,
+						"created_at": comment.CreatedAt,
+						"url":        comment.Url,
+					}
+				}
+			}
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("read", fmt.Sprintf("fizzy notification read %s", notif.Id), "Mark as read"),
+		}
+		if cardNumber != "" {
+			breadcrumbs = append(breadcrumbs,
+				breadcrumb("card", fmt.Sprintf("fizzy card show %s", cardNumber), "View card"),
+				breadcrumb("comment", fmt.Sprintf("fizzy comment create --card %s --body \"text\"", cardNumber), "Reply on the card"),
+			)
+		}
+
+		printDetail(detail, "", breadcrumbs)
+		return nil
+	},
+}
+
+// findNotification pages through the notification list until it finds id.
+// The API has no single-notification endpoint.
+func findNotification(ctx context.Context, id string) (*generated.Notification, error) {
+	ac := getSDK()
+	for page := 1; ; page++ {
+		path := "/notifications.json"
+		if page > 1 {
+			path += "?page=" + strconv.Itoa(page)
+		}
+		items, resp, err := ac.Notifications().List(ctx, path)
+		if err != nil {
+			return nil, convertSDKError(err)
+		}
+		for i := range items {
+			if items[i].Id == id {
+				return &items[i], nil
+			}
+		}
+		if len(items) == 0 || parseSDKLinkNext(resp) == "" {
+			return nil, errors.NewNotFoundError(fmt.Sprintf("Notification not found: %s", id))
+		}
+	}
+}
+
+// notificationCardContext trims a card to the fields needed to act on it.
+func notificationCardContext(card *generated.Card) map[string]any {
+	ctx := map[string]any{
+		"number": card.Number,
+		"title":  card.Title,
+		"status": card.Status,
+		"closed": card.Closed,
+		"url":    card.Url,
+	}
+	if card.Board.Id != "" {
+		ctx["board"] = map[string]any{"id": card.Board.Id, "name": card.Board.Name}
+	}
+	if card.Column.Id != "" {
+		ctx["column"] = map[string]any{"id": card.Column.Id, "name": card.Column.Name}
+	}
+	return ctx
+}
+
+var notificationCommentAnchor = regexp.MustCompile(`comment_([A-Za-z0-9]+)`)
+
+// findNotificationComment resolves the comment that triggered a notification:
+// from the comment anchor in its URL when present, otherwise the creator's
+// latest comment on the card at or before the notification time.
+func findNotificationComment(ctx context.Context, cardNumber string, notif *generated.Notification) *generated.Comment {
+	if m := notificationCommentAnchor.FindStringSubmatch(notif.Url); m != nil {
+		if comment, _, err := getSDK().Comments().Get(ctx, cardNumber, m[1]); err == nil {
+			return comment
+		}
+	}
+
+	comments, _, err := getSDK().Comments().List(ctx, cardNumber, "")
+	if err != nil {
+		return nil
+	}
+	var match *generated.Comment
+	for i := range comments {
+		c := &comments[i]
+		if c.Creator.Id != notif.Creator.Id {
+			continue
+		}
+		if notif.CreatedAt != "" && c.CreatedAt > notif.CreatedAt {
+			continue
+		}
+		if match == nil || c.CreatedAt > match.CreatedAt {
+			match = c
+		}
+	}
+	return match
+}
+
+// notificationCountTTL is how long a notification count is served from the
+// cache, so prompts that redraw on every keystroke don't hit the API.
+const notificationCountTTL = 30 * time.Second
+
+// Notification count flags
+var notificationCountUnread bool
+var notificationCountMaxAge time.Duration
+
+var notificationCountCmd = &cobra.Command{
+	Use:   "count",
+	Short: "Print the number of notifications",
+	Long: `Prints the number of notifications as a bare integer, for shell prompts and
+status bars such as tmux or starship. Use --unread to count only unread
+notifications (the tray, which holds at most 100).
+
+Counts are cached for 30 seconds; use --max-age to change that, or --max-age 0
+to always ask the API. Reading notifications through the CLI clears the cache.
+With --json, --quiet, --jq, or --agent the count is printed as {"count": N}.`,
+	Example: `  fizzy notification count --unread
+  fizzy notification count --unread --max-age 2m`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		key := notificationCountCacheKey(notificationCountUnread)
+		var count int
+		cached := notificationCountMaxAge > 0 && cache.Load(key, notificationCountMaxAge, &count)
+		if !cached {
+			var err error
+			if count, err = fetchNotificationCount(cmd.Context(), notificationCountUnread); err != nil {
+				return err
+			}
+			_ = cache.Store(key, count)
+		}
+
+		if cfgJSON || cfgQuiet || cfgJQ != "" || cfgAgent {
+			printDetail(map[string]any{"count": count}, "", nil)
+			return nil
+		}
+		writeOutputString(strconv.Itoa(count) + "\n")
+		captureResponse()
+		return nil
+	},
+}
+
+func notificationCountCacheKey(unread bool) string {
+	account := ""
+	if cfg != nil {
+		account = cfg.Account
+	}
+	if unread {
+		return "notifications/" + account + "/unread-count"
+	}
+	return "notifications/" + account + "/count"
+}
+
+// invalidateNotificationCounts drops cached counts after read-state changes.
+func invalidateNotificationCounts() {
+	_ = cache.Invalidate(notificationCountCacheKey(true))
+	_ = cache.Invalidate(notificationCountCacheKey(false))
+}
+
+func fetchNotificationCount(ctx context.Context, unread bool) (int, error) {
+	ac := getSDK()
+	if !unread {
+		pages, err := ac.GetAll(ctx, "/notifications.json")
+		if err != nil {
+			return 0, convertSDKError(err)
+		}
+		return dataCount(jsonAnySlice(pages)), nil
+	}
+
+	items, _, err := ac.Notifications().GetTray(ctx, nil)
+	if err != nil {
+		return 0, convertSDKError(err)
+	}
+	count := 0
+	for _, n := range items {
+		if !n.Read {
+			count++
+		}
+	}
+	return count, nil
+}
+
 var notificationReadCmd = &cobra.Command{
 	Use:   "read NOTIFICATION_ID",
 	Short: "Mark notification as read",
@@ -106,6 +331,7 @@ var notificationReadCmd = &cobra.Command{
 		if err != nil {
 			return convertSDKError(err)
 		}
+		invalidateNotificationCounts()
 
 		// Build breadcrumbs
 		breadcrumbs := []Breadcrumb{
@@ -131,6 +357,7 @@ var notificationUnreadCmd = &cobra.Command{
 		if err != nil {
 			return convertSDKError(err)
 		}
+		invalidateNotificationCounts()
 
 		// Build breadcrumbs
 		breadcrumbs := []Breadcrumb{
@@ -155,6 +382,7 @@ var notificationReadAllCmd = &cobra.Command{
 		if err != nil {
 			return convertSDKError(err)
 		}
+		invalidateNotificationCounts()
 
 		// Build breadcrumbs
 		breadcrumbs := []Breadcrumb{
@@ -282,6 +510,14 @@ func init() {
 	notificationTrayCmd.Flags().BoolVar(&notificationTrayIncludeRead, "include-read", false, "Include read notifications")
 	notificationCmd.AddCommand(notificationTrayCmd)
 
+	// Show
+	notificationCmd.AddCommand(notificationShowCmd)
+
+	// Count
+	notificationCountCmd.Flags().BoolVar(&notificationCountUnread, "unread", false, "Count only unread notifications")
+	notificationCountCmd.Flags().DurationVar(&notificationCountMaxAge, "max-age", notificationCountTTL, "Serve a cached count younger than this (0 disables the cache)")
+	notificationCmd.AddCommand(notificationCountCmd)
+
 	// Read/Unread
 	notificationCmd.AddCommand(notificationReadCmd)
 	notificationCmd.AddCommand(notificationUnreadCmd)
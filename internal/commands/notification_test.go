@@ -214,3 +214,161 @@ func TestNotificationSettingsUpdate(t *testing.T) {
 		assertExitCode(t, err, errors.ExitInvalidArgs)
 	})
 }
+
+func TestNotificationShow(t *testing.T) {
+	notifications := []any{
+		map[string]any{
+			"id":          "n1",
+			"source_type": "Comment",
+			"title":       "Jane commented",
+			"url":         "https://app.fizzy.do/acme/cards/42#comment_c9",
+			"created_at":  "2026-01-02T10:00:00Z",
+			"creator":     map[string]any{"id": "u2", "name": "Jane"},
+			"card":        map[string]any{"id": "card-42", "number": 42, "title": "Fix login"},
+		},
+		map[string]any{
+			"id":          "n2",
+			"source_type": "Comment",
+			"created_at":  "2026-01-02T10:00:00Z",
+			"creator":     map[string]any{"id": "u2", "name": "Jane"},
+			"card":        map[string]any{"id": "card-42", "number": 42, "title": "Fix login"},
+		},
+	}
+	card := map[string]any{
+		"id":     "card-42",
+		"number": 42,
+		"title":  "Fix login",
+		"board":  map[string]any{"id": "b1", "name": "Roadmap"},
+		"column": map[string]any{"id": "col1", "name": "Doing"},
+	}
+
+	t.Run("embeds card and comment from anchor", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/notifications.json", &client.APIResponse{StatusCode: 200, Data: notifications})
+		mock.OnGet("/cards/42", &client.APIResponse{StatusCode: 200, Data: card})
+		mock.OnGet("/cards/42/comments/c9", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+			"id":      "c9",
+			"body":    map[string]any{"plain_text": "Looks good"},
+			"creator": map[string]any{"id": "u2", "name": "Jane"},
+		}})
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := notificationShowCmd.RunE(notificationShowCmd, []string{"n1"})
+		assertExitCode(t, err, 0)
+
+		data := result.Response.Data.(map[string]any)
+		cardCtx := data["card"].(map[string]any)
+		if cardCtx["title"] != "Fix login" {
+			t.Errorf("expected card title, got %v", cardCtx["title"])
+		}
+		if board := cardCtx["board"].(map[string]any); board["name"] != "Roadmap" {
+			t.Errorf("expected board name, got %v", board)
+		}
+		comment := data["comment"].(map[string]any)
+		if comment["body"] != "Looks good" {
+			t.Errorf("expected comment body, got %v", comment["body"])
+		}
+	})
+
+	t.Run("falls back to creator's latest comment", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/notifications.json", &client.APIResponse{StatusCode: 200, Data: notifications})
+		mock.OnGet("/cards/42", &client.APIResponse{StatusCode: 200, Data: card})
+		mock.OnGet("/cards/42/comments.json", &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"id": "c1", "body": map[string]any{"plain_text": "older"}, "created_at": "2026-01-01T09:00:00Z", "creator": map[string]any{"id": "u2"}},
+			map[string]any{"id": "c2", "body": map[string]any{"plain_text": "triggering"}, "created_at": "2026-01-02T09:59:00Z", "creator": map[string]any{"id": "u2"}},
+			map[string]any{"id": "c3", "body": map[string]any{"plain_text": "other user"}, "created_at": "2026-01-02T09:59:30Z", "creator": map[string]any{"id": "u3"}},
+			map[string]any{"id": "c4", "body": map[string]any{"plain_text": "later"}, "created_at": "2026-01-03T09:00:00Z", "creator": map[string]any{"id": "u2"}},
+		}})
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := notificationShowCmd.RunE(notificationShowCmd, []string{"n2"})
+		assertExitCode(t, err, 0)
+
+		data := result.Response.Data.(map[string]any)
+		comment := data["comment"].(map[string]any)
+		if comment["id"] != "c2" {
+			t.Errorf("expected comment c2, got %v", comment["id"])
+		}
+	})
+
+	t.Run("returns not found for unknown id", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/notifications.json", &client.APIResponse{StatusCode: 200, Data: notifications})
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := notificationShowCmd.RunE(notificationShowCmd, []string{"missing"})
+		assertExitCode(t, err, errors.ExitNotFound)
+	})
+}
+
+func TestNotificationCount(t *testing.T) {
+	tray := &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"id": "1", "read": false},
+		map[string]any{"id": "2", "read": false},
+		map[string]any{"id": "3", "read": true},
+	}}
+
+	t.Run("prints bare unread count and caches it", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/notifications/tray.json", tray)
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		notificationCountUnread = true
+		notificationCountMaxAge = notificationCountTTL
+		defer func() { notificationCountUnread = false }()
+
+		err := notificationCountCmd.RunE(notificationCountCmd, []string{})
+		assertExitCode(t, err, 0)
+		if got := TestOutput(); got != "2\n" {
+			t.Fatalf("expected bare count, got %q", got)
+		}
+
+		err = notificationCountCmd.RunE(notificationCountCmd, []string{})
+		assertExitCode(t, err, 0)
+		if len(mock.GetCalls) != 1 {
+			t.Errorf("expected second count to be served from cache, got %d API calls", len(mock.GetCalls))
+		}
+
+		err = notificationReadCmd.RunE(notificationReadCmd, []string{"n1"})
+		assertExitCode(t, err, 0)
+		err = notificationCountCmd.RunE(notificationCountCmd, []string{})
+		assertExitCode(t, err, 0)
+		if len(mock.GetCalls) != 2 {
+			t.Errorf("expected marking read to invalidate the cached count, got %d API calls", len(mock.GetCalls))
+		}
+	})
+
+	t.Run("prints minimal JSON with --json", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/notifications/tray.json", tray)
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		cfgJSON = true
+		defer resetTest()
+
+		notificationCountUnread = true
+		notificationCountMaxAge = 0
+		defer func() { notificationCountUnread, notificationCountMaxAge = false, notificationCountTTL }()
+
+		err := notificationCountCmd.RunE(notificationCountCmd, []string{})
+		assertExitCode(t, err, 0)
+		data := result.Response.Data.(map[string]any)
+		if data["count"] != float64(2) {
+			t.Errorf("expected count 2, got %v", data["count"])
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+// notifyAfter is how long a command must run before --notify alerts, so
+// quick commands stay silent.
+const notifyAfter = 10 * time.Second
+
+// notifyBell receives the terminal bell.
+var notifyBell io.Writer = os.Stderr
+
+// desktopNotify shows a desktop notification. It is best-effort: without a
+// notifier on the system only the bell rings.
+var desktopNotify = func(title, body string) {
+	var notifier *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %q with title %q", body, title)
+		notifier = exec.Command("osascript", "-e", script) //nolint:gosec // G204: fixed program; values are quoted AppleScript strings
+	case "linux", "freebsd", "openbsd":
+		if _, err := exec.LookPath("notify-send"); err != nil {
+			return
+		}
+		notifier = exec.Command("notify-send", title, body) //nolint:gosec // G204: fixed program with plain arguments
+	default:
+		return
+	}
+	_ = notifier.Run()
+}
+
+// notifyCompletion rings the terminal bell and sends a desktop notification
+// when --notify is set and the command ran for at least notifyAfter.
+func notifyCompletion(cmd *cobra.Command, runErr error, elapsed time.Duration) {
+	if !cfgNotify || cmd == nil || elapsed < notifyAfter {
+		return
+	}
+	fmt.Fprint(notifyBell, "\a")
+
+	name := strings.TrimSpace(cmd.CommandPath())
+	title := "fizzy: done"
+	body := fmt.Sprintf("%s finished in %s", name, elapsed.Round(time.Second))
+	switch {
+	case errors.IsPartialFailure(runErr):
+		title = "fizzy: finished with failures"
+		body = fmt.Sprintf("%s: %s", name, runErr.Error())
+	case runErr != nil && !errors.IsFindings(runErr):
+		title = "fizzy: failed"
+		body = fmt.Sprintf("%s failed after %s", name, elapsed.Round(time.Second))
+	}
+	desktopNotify(title, body)
+}
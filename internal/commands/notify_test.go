@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestNotifyCompletion(t *testing.T) {
+	var bell bytes.Buffer
+	var titles []string
+	origBell, origNotify := notifyBell, desktopNotify
+	notifyBell = &bell
+	desktopNotify = func(title, body string) { titles = append(titles, title) }
+	defer func() { notifyBell, desktopNotify, cfgNotify = origBell, origNotify, false }()
+
+	notifyCompletion(migrateBoardCmd, nil, time.Minute)
+	if bell.Len() != 0 || len(titles) != 0 {
+		t.Fatal("expected no notification without --notify")
+	}
+
+	cfgNotify = true
+	notifyCompletion(migrateBoardCmd, nil, time.Second)
+	if bell.Len() != 0 || len(titles) != 0 {
+		t.Fatal("expected quick commands to stay silent")
+	}
+
+	notifyCompletion(migrateBoardCmd, nil, time.Minute)
+	notifyCompletion(migrateBoardCmd, errors.NewPartialFailureError("1 of 3 cards failed"), time.Minute)
+	notifyCompletion(migrateBoardCmd, errors.NewError("boom"), time.Minute)
+	if bell.String() != "\a\a\a" {
+		t.Errorf("expected three bells, got %q", bell.String())
+	}
+	want := []string{"fizzy: done", "fizzy: finished with failures", "fizzy: failed"}
+	for i := range want {
+		if i >= len(titles) || titles[i] != want[i] {
+			t.Fatalf("expected notifications %v, got %v", want, titles)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/cache"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+// The offline store mirrors cards the CLI fetches into the cache directory,
+// one entry per card and per card listing, when offline_cache is enabled.
+// 'card show' and 'card list' read it back with --offline, or automatically
+// when the network is down, marking results with stale_as_of.
+
+func offlineStoreEnabled() bool {
+	return cfg != nil && cfg.OfflineCache
+}
+
+func offlineStoreKey(kind, name string) string {
+	return "store/" + cfg.Account + "/" + kind + "/" + name
+}
+
+// offlineListKey names a listing by a hash of its API path, which may be
+// longer than a file name allows.
+func offlineListKey(path string) string {
+	sum := sha256.Sum256([]byte(path))
+	return offlineStoreKey("lists", hex.EncodeToString(sum[:12]))
+}
+
+// saveOfflineCard stores a fetched card. It is best-effort and a no-op unless
+// the offline store is enabled.
+func saveOfflineCard(card any) {
+	m, ok := normalizeAny(card).(map[string]any)
+	if !offlineStoreEnabled() || !ok || m["number"] == nil {
+		return
+	}
+	_ = cache.Store(offlineStoreKey("cards", fmt.Sprint(m["number"])), m)
+}
+
+// saveOfflineCardList stores a fetched card listing under its API path, and
+// each card in it.
+func saveOfflineCardList(path string, cards any) {
+	if !offlineStoreEnabled() {
+		return
+	}
+	items := toSliceAny(normalizeAny(cards))
+	if items == nil {
+		items = []any{}
+	}
+	_ = cache.Store(offlineListKey(path), items)
+	for _, card := range items {
+		saveOfflineCard(card)
+	}
+}
+
+// loadOfflineCard returns a stored card marked with stale_as_of.
+func loadOfflineCard(number string) (map[string]any, error) {
+	var card map[string]any
+	storedAt, ok := cache.LoadStored(offlineStoreKey("cards", number), &card)
+	if !ok {
+		return nil, offlineMissError(fmt.Sprintf("Card #%s is not in the offline store", number))
+	}
+	card["stale_as_of"] = storedAt.UTC().Format(time.RFC3339)
+	return card, nil
+}
+
+// loadOfflineCardList returns a stored listing with each card marked with
+// stale_as_of.
+func loadOfflineCardList(path string) ([]any, error) {
+	var cards []any
+	storedAt, ok := cache.LoadStored(offlineListKey(path), &cards)
+	if !ok {
+		return nil, offlineMissError("This card listing is not in the offline store")
+	}
+	staleAsOf := storedAt.UTC().Format(time.RFC3339)
+	for _, card := range cards {
+		if m, ok := card.(map[string]any); ok {
+			m["stale_as_of"] = staleAsOf
+		}
+	}
+	return cards, nil
+}
+
+// loadOfflineSyncedList returns a stored listing as fetched, without
+// stale_as_of, and when it was stored.
+func loadOfflineSyncedList(path string) ([]map[string]any, time.Time, bool) {
+	var cards []map[string]any
+	storedAt, ok := cache.LoadStored(offlineListKey(path), &cards)
+	return cards, storedAt, ok
+}
+
+func offlineMissError(message string) error {
+	e := errors.NewNotFoundError(message)
+	e.HTTPStatus = 0
+	e.Hint = "Enable offline_cache and fetch it online first, or run 'fizzy cache refresh'"
+	return e
+}
+
+// offlineFallback reports whether err is a network failure that the offline
+// store should cover, warning that stored data is being served.
+func offlineFallback(err error) bool {
+	if !offlineStoreEnabled() || output.AsError(err).Code != output.CodeNetwork {
+		return false
+	}
+	fmt.Fprintln(os.Stderr, "Warning: network unavailable; serving cards from the offline store")
+	return true
+}
@@ -0,0 +1,164 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestOfflineStore(t *testing.T) {
+	card := map[string]any{"id": "c1", "number": 42, "title": "Offline card", "board": map[string]any{"id": "b1"}}
+
+	t.Run("card show saves the card for network failures", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards/42", &client.APIResponse{StatusCode: 200, Data: card})
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		cfg.OfflineCache = true
+		defer resetTest()
+
+		err := cardShowCmd.RunE(cardShowCmd, []string{"42"})
+		assertExitCode(t, err, 0)
+
+		if !offlineFallback(errors.NewNetworkError("connection refused")) {
+			t.Fatal("expected network errors to fall back to the store")
+		}
+		if offlineFallback(errors.NewNotFoundError("Card not found")) {
+			t.Error("expected other errors not to fall back")
+		}
+		stored, err := loadOfflineCard("42")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stored["title"] != "Offline card" || stored["stale_as_of"] == nil {
+			t.Errorf("expected stored card with stale_as_of, got %v", stored)
+		}
+	})
+
+	t.Run("card show --offline without a stored card is not found", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		cfg.OfflineCache = true
+		defer resetTest()
+
+		cardShowOffline = true
+		defer func() { cardShowOffline = false }()
+
+		err := cardShowCmd.RunE(cardShowCmd, []string{"7"})
+		assertExitCode(t, err, errors.ExitNotFound)
+		if len(mock.GetWithPaginationCalls) != 0 {
+			t.Errorf("expected no API calls, got %v", mock.GetWithPaginationCalls)
+		}
+	})
+
+	t.Run("nothing is stored or served when the store is disabled", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards/42", &client.APIResponse{StatusCode: 200, Data: card})
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := cardShowCmd.RunE(cardShowCmd, []string{"42"})
+		assertExitCode(t, err, 0)
+
+		if offlineFallback(errors.NewNetworkError("connection refused")) {
+			t.Error("expected no fallback with the store disabled")
+		}
+		if _, err := loadOfflineCard("42"); err == nil {
+			t.Error("expected the card not to be stored")
+		}
+	})
+
+	t.Run("cache refresh stores listings card list --offline serves", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/cards.json", &client.APIResponse{StatusCode: 200, Data: []any{card}})
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		cfg.OfflineCache = true
+		defer resetTest()
+
+		err := cacheRefreshCmd.RunE(cacheRefreshCmd, nil)
+		assertExitCode(t, err, 0)
+		if data := result.Response.Data.(map[string]any); data["cards"] != float64(1) || data["listings"] != float64(2) {
+			t.Errorf("expected 1 card in 2 listings, got %v", data)
+		}
+
+		calls := len(mock.GetWithPaginationCalls)
+		cardListOffline, cardListBoard = true, []string{"b1"}
+		defer func() { cardListOffline, cardListBoard = false, nil }()
+
+		err = cardListCmd.RunE(cardListCmd, nil)
+		assertExitCode(t, err, 0)
+		if len(mock.GetWithPaginationCalls) != calls {
+			t.Errorf("expected no API calls, got %v", mock.GetWithPaginationCalls[calls:])
+		}
+		items := result.Response.Data.([]any)
+		if len(items) != 1 || items[0].(map[string]any)["stale_as_of"] == nil {
+			t.Errorf("expected stored card with stale_as_of, got %v", items)
+		}
+	})
+
+	t.Run("cache refresh syncs only changed cards after a full refresh", func(t *testing.T) {
+		old := map[string]any{"id": "c1", "number": 1, "title": "Old", "last_active_at": "2020-01-01T00:00:00Z"}
+		stale := map[string]any{"id": "c2", "number": 2, "title": "Before", "last_active_at": "2020-01-02T00:00:00Z"}
+		mock := NewMockClient()
+		mock.OnGet("/cards.json", &client.APIResponse{StatusCode: 200, Data: []any{stale, old}})
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		cfg.OfflineCache = true
+		defer resetTest()
+
+		err := cacheRefreshCmd.RunE(cacheRefreshCmd, nil)
+		assertExitCode(t, err, 0)
+		if data := result.Response.Data.(map[string]any); data["mode"] != "full" {
+			t.Fatalf("expected a full first refresh, got %v", data)
+		}
+
+		edited := map[string]any{"id": "c2", "number": 2, "title": "After", "last_active_at": time.Now().UTC().Format(time.RFC3339)}
+		added := map[string]any{"id": "c3", "number": 3, "title": "New", "last_active_at": time.Now().UTC().Format(time.RFC3339)}
+		mock.OnGet("/cards.json", &client.APIResponse{StatusCode: 200, Data: []any{added, edited, old},
+			LinkNext: "/test-account/cards.json?page=2"})
+		calls := len(mock.GetWithPaginationCalls)
+
+		err = cacheRefreshCmd.RunE(cacheRefreshCmd, nil)
+		assertExitCode(t, err, 0)
+		data := result.Response.Data.(map[string]any)
+		if data["mode"] != "delta" || data["changed"] != float64(2) || data["cards"] != float64(3) {
+			t.Errorf("expected a delta of 2 changed out of 3 cards, got %v", data)
+		}
+		if got := len(mock.GetWithPaginationCalls) - calls; got != 1 {
+			t.Errorf("expected the delta to stop after one page, got %d requests", got)
+		}
+		stored, err := loadOfflineCard("2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stored["title"] != "After" {
+			t.Errorf("expected the edited card to be stored, got %v", stored)
+		}
+
+		cacheRefreshFull = true
+		defer func() { cacheRefreshFull = false }()
+		err = cacheRefreshCmd.RunE(cacheRefreshCmd, nil)
+		assertExitCode(t, err, 0)
+		if data := result.Response.Data.(map[string]any); data["mode"] != "full" {
+			t.Errorf("expected --full to re-list everything, got %v", data)
+		}
+	})
+
+	t.Run("cache refresh requires offline_cache", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		err := cacheRefreshCmd.RunE(cacheRefreshCmd, nil)
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
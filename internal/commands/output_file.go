@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/fsutil"
+	"github.com/spf13/cobra"
+)
+
+// downloadOutputAnnotation marks commands whose -o names a downloaded file
+// rather than the file command output is written to.
+const downloadOutputAnnotation = "fizzy_download_output"
+
+// pendingOutputFile receives command output when --output-file is set. It is
+// committed after a successful run and discarded otherwise.
+var pendingOutputFile *fsutil.AtomicFile
+
+// openOutputFile starts writing command output to --output-file, if set.
+// Download commands use -o for the downloaded file instead, so for them the
+// path is handed to their --output flag.
+func openOutputFile(cmd *cobra.Command) (*fsutil.AtomicFile, error) {
+	if cfgOutputFile == "" {
+		return nil, nil
+	}
+	if cmd.Annotations[downloadOutputAnnotation] == "true" {
+		if f := cmd.Flags().Lookup("output"); f != nil && !f.Changed {
+			if err := f.Value.Set(cfgOutputFile); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+	// Output files are readable like a shell redirect's would be.
+	f, err := fsutil.CreateAtomic(cfgOutputFile, 0o644)
+	if err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not write to %s: %v", cfgOutputFile, err))
+	}
+	pendingOutputFile = f
+	return f, nil
+}
+
+// commitOutputFile moves the pending output file into place after a
+// successful run.
+func commitOutputFile() error {
+	f := pendingOutputFile
+	if f == nil {
+		return nil
+	}
+	pendingOutputFile = nil
+	if err := f.Commit(); err != nil {
+		return errors.NewError(fmt.Sprintf("Could not write to %s: %v", f.Path(), err))
+	}
+	return nil
+}
+
+// discardOutputFile drops the pending output file when a command fails, so a
+// previous file at the same path is kept.
+func discardOutputFile() {
+	if pendingOutputFile != nil {
+		pendingOutputFile.Discard()
+		pendingOutputFile = nil
+	}
+}
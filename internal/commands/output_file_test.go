@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestOutputFile(t *testing.T) {
+	t.Run("writes output to the file", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetWithPaginationResponse = &client.APIResponse{StatusCode: 200, Data: []any{
+			map[string]any{"id": "b1", "name": "One"},
+		}}
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		path := filepath.Join(t.TempDir(), "boards.json")
+		raw, err := runCobraWithArgs("board", "list", "--all", "-o", path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if raw != "" {
+			t.Errorf("expected nothing on stdout, got %q", raw)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var resp map[string]any
+		if err := json.Unmarshal(data, &resp); err != nil {
+			t.Fatalf("expected JSON in file, got %q", data)
+		}
+		if boards, _ := resp["data"].([]any); len(boards) != 1 {
+			t.Errorf("expected 1 board in file, got %v", resp["data"])
+		}
+	})
+
+	t.Run("keeps the previous file when the command fails", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetWithPaginationError = errors.NewError("server error")
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		dir := t.TempDir()
+		path := filepath.Join(dir, "boards.json")
+		if err := os.WriteFile(path, []byte("previous"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := runCobraWithArgs("board", "list", "--output-file", path); err == nil {
+			t.Fatal("expected error")
+		}
+		discardOutputFile()
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "previous" {
+			t.Errorf("expected previous contents to be kept, got %q", data)
+		}
+		entries, _ := os.ReadDir(dir)
+		if len(entries) != 1 {
+			t.Errorf("expected temporary file to be removed, found %d entries", len(entries))
+		}
+	})
+}
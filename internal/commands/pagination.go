@@ -0,0 +1,238 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	fizzy "github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+	"golang.org/x/sync/errgroup"
+)
+
+// timeWindows are the presets the API accepts for the creation and closure
+// filters.
+var timeWindows = []string{"today", "yesterday", "thisweek", "lastweek", "thismonth", "lastmonth"}
+
+// catchUpCreationWindows are the creation filters used to re-fetch a listing
+// in smaller segments when its pagination looks truncated.
+var catchUpCreationWindows = timeWindows
+
+// fetchAllCards fetches every page of a card listing. If the pagination looks
+// truncated, because the server keeps reporting more through a next link
+// that repeats, the listing is re-fetched in segments (both sort orders,
+// then creation-date windows) and the results merged. The windows reach
+// back to last month only, so older cards that are neither among the newest
+// nor the oldest can still be missed, and the warning says so. The segments
+// are fetched concurrently, since on big boards they are most of the work.
+func fetchAllCards(ctx context.Context, ac *fizzy.AccountClient, path string) ([]map[string]any, error) {
+	cards, truncated, err := fetchPages(ctx, ac, path)
+	if err != nil || !truncated {
+		return cards, err
+	}
+
+	// The first failing segment cancels the rest, so a rate limit doesn't
+	// keep the others paging for results that would be thrown away.
+	segments := catchUpSegments(path)
+	perSegment := make([][]map[string]any, len(segments))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxParallel(bulkConcurrency))
+	for i, segment := range segments {
+		g.Go(func() error {
+			var err error
+			perSegment[i], _, err = fetchPages(gctx, ac, segment)
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	for _, card := range cards {
+		seen[cardKey(card)] = true
+	}
+	before := len(cards)
+	for _, more := range perSegment {
+		for _, card := range more {
+			if key := cardKey(card); !seen[key] {
+				seen[key] = true
+				cards = append(cards, card)
+			}
+		}
+	}
+
+	sortCardsLike(cards, path)
+	warnTruncatedCards(len(cards) - before)
+	return cards, nil
+}
+
+// streamAllCards is fetchAllCards for streaming: emit gets each page of
+// cards as it arrives, and cards found by catching up after truncated
+// pagination come last, in no particular order, rather than merged in.
+func streamAllCards(ctx context.Context, ac *fizzy.AccountClient, path string, emit func([]map[string]any)) error {
+	seen := map[string]bool{}
+	emitNew := func(page []map[string]any) bool {
+		var fresh []map[string]any
+		for _, card := range page {
+			if key := cardKey(card); !seen[key] {
+				seen[key] = true
+				fresh = append(fresh, card)
+			}
+		}
+		emit(fresh)
+		return false
+	}
+
+	_, truncated, err := fetchPagesUntil(ctx, ac, path, emitNew)
+	if err != nil || !truncated {
+		return err
+	}
+	before := len(seen)
+	for _, segment := range catchUpSegments(path) {
+		if _, _, err := fetchPagesUntil(ctx, ac, segment, emitNew); err != nil {
+			return err
+		}
+	}
+	warnTruncatedCards(len(seen) - before)
+	return nil
+}
+
+// warnTruncatedCards says how many cards catching up found, and that the
+// listing may still be incomplete.
+func warnTruncatedCards(found int) {
+	fmt.Fprintf(os.Stderr, "Warning: pagination looked truncated; fetched %d more cards in date segments, but results may be incomplete (cards created before last month can be missed)\n", found)
+}
+
+// fetchPages follows next links from path, reporting whether the pagination
+// looked truncated: the server reported more pages, but a next link
+// repeated or couldn't be followed.
+func fetchPages(ctx context.Context, ac *fizzy.AccountClient, path string) ([]map[string]any, bool, error) {
+	return fetchPagesUntil(ctx, ac, path, nil)
+}
+
+// fetchPagesUntil is fetchPages, stopping early after a page for which stop
+// returns true.
+func fetchPagesUntil(ctx context.Context, ac *fizzy.AccountClient, path string, stop func([]map[string]any) bool) ([]map[string]any, bool, error) {
+	items := []map[string]any{}
+	seen := map[string]bool{}
+
+	resp, err := ac.Get(ctx, path)
+	for {
+		if err != nil {
+			return nil, false, convertSDKError(err)
+		}
+		var page []map[string]any
+		if err := json.Unmarshal(resp.Data, &page); err != nil {
+			return nil, false, convertSDKError(fmt.Errorf("failed to parse response: %w", err))
+		}
+		items = append(items, page...)
+		if stop != nil && stop(page) {
+			return items, false, nil
+		}
+
+		next := parseSDKLinkNext(resp)
+		if next == "" {
+			return items, false, nil
+		}
+		if seen[next] {
+			return items, true, nil
+		}
+		seen[next] = true
+
+		// Next links are absolute; request them relative to the configured
+		// base URL so pagination can't leave the API origin.
+		u, parseErr := url.Parse(next)
+		if parseErr != nil {
+			return items, true, nil
+		}
+		resp, err = getSDKClient().Get(ctx, u.RequestURI())
+	}
+}
+
+// fetchCardsChangedSince returns the cards on a listing that were active at or
+// after since. The API has no updated-since filter, so it relies on the
+// default most-recently-active order and stops paging at the first card last
+// active before since.
+func fetchCardsChangedSince(ctx context.Context, ac *fizzy.AccountClient, path string, since time.Time) ([]map[string]any, error) {
+	cards, _, err := fetchPagesUntil(ctx, ac, path, func(page []map[string]any) bool {
+		return slices.ContainsFunc(page, func(card map[string]any) bool { return !cardActiveSince(card, since) })
+	})
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(cards, func(card map[string]any) bool { return !cardActiveSince(card, since) }), nil
+}
+
+// cardActiveSince reports whether card was last active at or after since.
+// Cards without a readable last_active_at count as active.
+func cardActiveSince(card map[string]any, since time.Time) bool {
+	value, _ := card["last_active_at"].(string)
+	at, err := time.Parse(time.RFC3339, value)
+	return err != nil || !at.Before(since)
+}
+
+// catchUpSegments returns variants of a listing path that together cover
+// what a truncated listing may have missed.
+func catchUpSegments(path string) []string {
+	route, query, _ := strings.Cut(path, "?")
+	var params []string
+	if query != "" {
+		params = strings.Split(query, "&")
+	}
+	withoutParam := func(name string) []string {
+		return slices.DeleteFunc(slices.Clone(params), func(p string) bool {
+			return strings.HasPrefix(p, name+"=") || strings.HasPrefix(p, "page=")
+		})
+	}
+	build := func(ps []string) string {
+		if len(ps) == 0 {
+			return route
+		}
+		return route + "?" + strings.Join(ps, "&")
+	}
+
+	var segments []string
+	for _, order := range []string{"newest", "oldest"} {
+		segments = append(segments, build(append(withoutParam("sorted_by"), "sorted_by="+order)))
+	}
+	if !strings.Contains("&"+query, "&creation=") {
+		for _, window := range catchUpCreationWindows {
+			segments = append(segments, build(append(withoutParam("sorted_by"), "creation="+window)))
+		}
+	}
+	return segments
+}
+
+// sortCardsLike orders merged cards by the listing's sorted_by parameter.
+func sortCardsLike(cards []map[string]any, path string) {
+	field, desc := "last_active_at", true
+	_, query, _ := strings.Cut(path, "?")
+	for param := range strings.SplitSeq(query, "&") {
+		switch param {
+		case "sorted_by=newest":
+			field = "created_at"
+		case "sorted_by=oldest":
+			field, desc = "created_at", false
+		}
+	}
+	slices.SortStableFunc(cards, func(a, b map[string]any) int {
+		x, _ := a[field].(string)
+		y, _ := b[field].(string)
+		if desc {
+			return strings.Compare(y, x)
+		}
+		return strings.Compare(x, y)
+	})
+}
+
+func cardKey(card map[string]any) string {
+	if id, ok := card["id"]; ok && id != nil {
+		return fmt.Sprint(id)
+	}
+	return fmt.Sprint(card["number"])
+}
@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+)
+
+func TestCardListAllCatchUp(t *testing.T) {
+	card := func(n float64, created string) map[string]any {
+		return map[string]any{"id": created, "number": n, "created_at": created, "last_active_at": created}
+	}
+
+	mock := NewMockClient()
+	// The second page links to itself, so pagination never ends properly.
+	mock.OnGet("/cards.json?board_ids[]=b1", &client.APIResponse{StatusCode: 200, Data: []any{
+		card(3, "2026-03-01T00:00:00Z"),
+	}, LinkNext: "/test-account/cards.json?board_ids[]=b1&page=2"})
+	mock.OnGet("/cards.json?board_ids[]=b1&page=2", &client.APIResponse{StatusCode: 200, Data: []any{
+		card(2, "2026-02-01T00:00:00Z"),
+	}, LinkNext: "/test-account/cards.json?board_ids[]=b1&page=2"})
+	mock.OnGet("/cards.json?board_ids[]=b1&sorted_by=oldest", &client.APIResponse{StatusCode: 200, Data: []any{
+		card(1, "2026-01-01T00:00:00Z"),
+		card(2, "2026-02-01T00:00:00Z"),
+	}})
+	mock.OnGet("/cards.json", &client.APIResponse{StatusCode: 200, Data: []any{}})
+
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	cardListBoard, cardListAll = []string{"b1"}, true
+	defer func() { cardListBoard, cardListAll = nil, false }()
+
+	err := cardListCmd.RunE(cardListCmd, []string{})
+	assertExitCode(t, err, 0)
+
+	cards := result.Response.Data.([]any)
+	var numbers []float64
+	for _, c := range cards {
+		numbers = append(numbers, c.(map[string]any)["number"].(float64))
+	}
+	if len(numbers) != 3 || numbers[0] != 3 || numbers[1] != 2 || numbers[2] != 1 {
+		t.Errorf("expected cards 3, 2, 1 after catch-up, got %v", numbers)
+	}
+}
+
+func TestCardListAllCatchUpFailure(t *testing.T) {
+	mock := NewMockClient()
+	mock.OnGet("/cards.json?board_ids[]=b1", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"id": "c1", "number": float64(1)},
+	}, LinkNext: "/test-account/cards.json?board_ids[]=b1"})
+	mock.OnGet("/cards.json?board_ids[]=b1&sorted_by=oldest", &client.APIResponse{StatusCode: 403, Data: map[string]any{"error": "Forbidden"}})
+	mock.OnGet("/cards.json", &client.APIResponse{StatusCode: 200, Data: []any{}})
+
+	SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	cardListBoard, cardListAll = []string{"b1"}, true
+	defer func() { cardListBoard, cardListAll = nil, false }()
+
+	if err := cardListCmd.RunE(cardListCmd, []string{}); err == nil {
+		t.Error("expected a failed catch-up segment to fail the listing")
+	}
+}
+
+func TestCardListAllCatchUpFailureCancelsSegments(t *testing.T) {
+	mock := NewMockClient()
+	mock.OnGet("/cards.json?board_ids[]=b1", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"id": "c1", "number": float64(1)},
+	}, LinkNext: "/test-account/cards.json?board_ids[]=b1"})
+	mock.OnGet("/cards.json?board_ids[]=b1&sorted_by=newest", &client.APIResponse{StatusCode: 403, Data: map[string]any{"error": "Forbidden"}})
+	mock.OnGet("/cards.json", &client.APIResponse{StatusCode: 200, Data: []any{}})
+
+	SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+	// One segment at a time, so the failing first segment runs alone.
+	cfg.MaxParallelRequests = 1
+
+	cardListBoard, cardListAll = []string{"b1"}, true
+	defer func() { cardListBoard, cardListAll = nil, false }()
+
+	if err := cardListCmd.RunE(cardListCmd, []string{}); err == nil {
+		t.Fatal("expected a failed catch-up segment to fail the listing")
+	}
+	for _, call := range mock.GetWithPaginationCalls {
+		if call.Path != "/cards.json?board_ids[]=b1" && call.Path != "/cards.json?board_ids[]=b1&sorted_by=newest" {
+			t.Errorf("expected the remaining segments to be cancelled, got a request for %s", call.Path)
+		}
+	}
+}
+
+func TestCardListAllFullLastPage(t *testing.T) {
+	page := make([]any, defaultPageSize)
+	for i := range page {
+		page[i] = map[string]any{"id": fmt.Sprint(i), "number": float64(i + 1)}
+	}
+	mock := NewMockClient()
+	mock.OnGet("/cards.json?board_ids[]=b1", &client.APIResponse{StatusCode: 200, Data: page})
+
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	cardListBoard, cardListAll = []string{"b1"}, true
+	defer func() { cardListBoard, cardListAll = nil, false }()
+
+	err := cardListCmd.RunE(cardListCmd, []string{})
+	assertExitCode(t, err, 0)
+
+	if n := len(result.Response.Data.([]any)); n != defaultPageSize {
+		t.Errorf("expected %d cards, got %d", defaultPageSize, n)
+	}
+	if len(mock.GetCalls) != 1 {
+		t.Errorf("expected no catch-up for an exactly full last page, got %v", mock.GetCalls)
+	}
+}
+
+func TestCatchUpSegments(t *testing.T) {
+	segments := catchUpSegments("/cards.json?board_ids[]=b1&sorted_by=latest&creation=thisweek&page=3")
+	want := []string{
+		"/cards.json?board_ids[]=b1&creation=thisweek&sorted_by=newest",
+		"/cards.json?board_ids[]=b1&creation=thisweek&sorted_by=oldest",
+	}
+	if len(segments) != len(want) {
+		t.Fatalf("expected %v, got %v", want, segments)
+	}
+	for i := range want {
+		if segments[i] != want[i] {
+			t.Errorf("segment %d: expected %q, got %q", i, want[i], segments[i])
+		}
+	}
+}
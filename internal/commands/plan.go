@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/charmbracelet/huh"
+	"github.com/mattn/go-isatty"
+)
+
+// planChange is one change a bulk command is about to make to a card.
+type planChange struct {
+	Card   any    `json:"card"`
+	Title  string `json:"title,omitempty"`
+	Change string `json:"change"`
+}
+
+// renderPlan formats a plan for review, one change per line.
+func renderPlan(action string, changes []planChange) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Plan: %s\n\n", action)
+	for _, c := range changes {
+		label := fmt.Sprintf("#%v", c.Card)
+		if c.Title != "" {
+			label += " " + c.Title
+		}
+		fmt.Fprintf(&b, "  ~ %s: %s\n", label, c.Change)
+	}
+	return b.String()
+}
+
+// approvePlan decides whether a bulk command may apply its plan. --approve
+// applies it as is. In a terminal the plan is shown on stderr and confirmed;
+// anywhere else it is refused, so scripts and agents can't make sweeping
+// changes without an explicit review point. A declined plan returns false.
+func approvePlan(action string, changes []planChange, approve bool) (bool, error) {
+	if approve {
+		return true, nil
+	}
+	if IsMachineOutput() || !(isatty.IsTerminal(os.Stdin.Fd()) || isatty.IsCygwinTerminal(os.Stdin.Fd())) {
+		return false, errors.NewInvalidArgsError(fmt.Sprintf("Refusing to %s without approval; review the plan with --dry-run, then pass --approve", action))
+	}
+	fmt.Fprint(os.Stderr, renderPlan(action, changes)+"\n")
+	confirmed := false
+	if err := huh.NewConfirm().Title("Apply this plan?").Value(&confirmed).Run(); err != nil {
+		return false, nil //nolint:nilerr // an aborted prompt declines the plan
+	}
+	return confirmed, nil
+}
@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestRenderPlan(t *testing.T) {
+	got := renderPlan("close 2 cards", []planChange{
+		{Card: float64(12), Title: "Fix login", Change: "Doing → Done"},
+		{Card: float64(13), Change: "Doing → Done"},
+	})
+	want := "Plan: close 2 cards\n\n  ~ #12 Fix login: Doing → Done\n  ~ #13: Doing → Done\n"
+	if got != want {
+		t.Errorf("renderPlan() = %q, want %q", got, want)
+	}
+}
+
+func TestApprovePlan(t *testing.T) {
+	SetTestModeWithSDK(NewMockClient())
+	defer resetTest()
+	changes := []planChange{{Card: 1, Change: "Doing → Done"}}
+
+	if ok, err := approvePlan("close 1 card", changes, true); !ok || err != nil {
+		t.Errorf("expected --approve to apply the plan, got %v, %v", ok, err)
+	}
+
+	cfgJSON = true
+	ok, err := approvePlan("close 1 card", changes, false)
+	if ok {
+		t.Error("expected the plan to be refused without --approve")
+	}
+	assertExitCode(t, err, errors.ExitInvalidArgs)
+}
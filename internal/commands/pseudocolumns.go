@@ -39,3 +39,34 @@ func parsePseudoColumnID(id string) (pseudoColumn, bool) {
 		return pseudoColumn{}, false
 	}
 }
+
+// withResolvedColumn sets resolved_column on each card to the pseudo-column it
+// was listed under, so consumers don't need to re-derive placement from
+// closed/postponed flags.
+func withResolvedColumn(items any, c pseudoColumn) any {
+	for _, card := range toMaps(items) {
+		card["resolved_column"] = map[string]any{
+			"id":   c.ID,
+			"name": c.Name,
+			"kind": c.Kind,
+		}
+	}
+	return items
+}
+
+// cardPlacement returns the name of the column a card sits in, using the
+// pseudo-column names for closed, postponed, and triage cards.
+func cardPlacement(card map[string]any) string {
+	if closed, _ := card["closed"].(bool); closed {
+		return pseudoColumnDone.Name
+	}
+	if postponed, _ := card["postponed"].(bool); postponed {
+		return pseudoColumnNotNow.Name
+	}
+	if column, ok := card["column"].(map[string]any); ok {
+		if name, _ := column["name"].(string); name != "" {
+			return name
+		}
+	}
+	return pseudoColumnMaybe.Name
+}
@@ -0,0 +1,399 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/state"
+	"github.com/spf13/cobra"
+)
+
+// tombstone is a delete waiting out the grace period before 'fizzy purge'
+// carries it out. Parent is the board of a column, or the card of a comment
+// or step.
+type tombstone struct {
+	ID         int       `json:"id"`
+	Kind       string    `json:"kind"`
+	Target     string    `json:"target"`
+	Parent     string    `json:"parent,omitempty"`
+	Archive    bool      `json:"archive,omitempty"`
+	Command    string    `json:"command"`
+	DeletedAt  time.Time `json:"deleted_at"`
+	PurgeAfter time.Time `json:"purge_after"`
+}
+
+// tombstones is the pending delete list of one account.
+type tombstones struct {
+	NextID  int         `json:"next_id"`
+	Pending []tombstone `json:"pending"`
+}
+
+// deleteNow is set by --now on the delete commands, shared like the event
+// flags.
+var deleteNow bool
+
+// addDeleteNowFlag registers --now on a delete command that honors
+// delete_grace.
+func addDeleteNowFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&deleteNow, "now", false, "Delete right away, even when delete_grace is set")
+}
+
+// deleteGrace returns how long deletes wait before 'fizzy purge' carries
+// them out. Zero means deletes happen right away.
+func deleteGrace() (time.Duration, error) {
+	if cfg == nil || cfg.DeleteGrace == "" {
+		return 0, nil
+	}
+	grace, err := time.ParseDuration(cfg.DeleteGrace)
+	if err != nil || grace < 0 {
+		return 0, errors.NewInvalidArgsError(fmt.Sprintf("invalid delete_grace %q (use a duration such as 72h)", cfg.DeleteGrace))
+	}
+	return grace, nil
+}
+
+// deferDelete records a delete as pending instead of carrying it out when
+// delete_grace is set and --now isn't. It reports whether the delete was
+// deferred, in which case it has already printed the result.
+func deferDelete(cmd *cobra.Command, t tombstone) (bool, error) {
+	grace, err := deleteGrace()
+	if err != nil || grace == 0 || deleteNow {
+		return false, err
+	}
+	// A delete that would fail later shouldn't be accepted now.
+	if err := checkTombstoneTarget(cmd.Context(), t); err != nil {
+		return false, err
+	}
+
+	list, err := loadTombstones()
+	if err != nil {
+		return false, err
+	}
+	list.NextID++
+	now := time.Now().UTC()
+	t.ID = list.NextID
+	t.Command = strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+	t.DeletedAt = now
+	t.PurgeAfter = now.Add(grace)
+	list.Pending = append(list.Pending, t)
+	if err := saveTombstones(list); err != nil {
+		return false, err
+	}
+
+	data := tombstoneData(t)
+	data["deleted"] = false
+	data["pending"] = true
+	summary := fmt.Sprintf("%s will be deleted by 'fizzy purge' after %s", tombstoneLabel(t), t.PurgeAfter.Local().Format("2006-01-02 15:04"))
+	printMutation(data, summary, []Breadcrumb{
+		breadcrumb("cancel", fmt.Sprintf("fizzy purge cancel %d", t.ID), "Keep it"),
+		breadcrumb("pending", "fizzy purge list", "List pending deletes"),
+	})
+	return true, nil
+}
+
+// Purge flags
+var purgeAll bool
+var purgeDryRun bool
+
+var purgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Carry out pending deletes",
+	Long: `Carries out the deletes that have waited out their grace period.
+
+With delete_grace set (e.g. delete_grace: 72h in config.yaml, per profile with
+'fizzy purge grace', or FIZZY_DELETE_GRACE), 'board delete', 'card delete',
+'column delete', 'comment delete', and 'step delete' don't delete anything.
+They add the resource to a local list of pending deletes instead, so a script
+that deleted the wrong thing can be undone with 'fizzy purge cancel'.
+--now on a delete skips the wait.
+
+Run 'fizzy purge' from cron or by hand to delete what is due; --all also
+deletes what isn't due yet. A delete that fails stays pending, and one whose
+resource is already gone is dropped.`,
+	Example: `  fizzy purge list
+  fizzy purge cancel 3
+  fizzy purge
+  fizzy purge --all --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		list, err := loadTombstones()
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		var due []any
+		for _, t := range list.Pending {
+			if purgeAll || !now.Before(t.PurgeAfter) {
+				due = append(due, t)
+			}
+		}
+		waiting := len(list.Pending) - len(due)
+
+		if purgeDryRun {
+			items := make([]any, len(due))
+			for i, t := range due {
+				items[i] = tombstoneData(t.(tombstone))
+			}
+			summary := fmt.Sprintf("Would delete %d, %d not due yet (dry run; nothing was deleted)", len(due), waiting)
+			printList(items, purgeColumns, summary, nil)
+			return nil
+		}
+
+		result := runBulk(due, 1, func(item any) error {
+			err := purgeTombstone(cmd.Context(), item.(tombstone))
+			if errors.ExitCodeOf(err) == errors.ExitNotFound {
+				return nil
+			}
+			return err
+		})
+
+		// Drop what was deleted; reload first so deletes deferred meanwhile
+		// aren't lost.
+		done := map[int]bool{}
+		for i, item := range result.succeeded {
+			t := item.(tombstone)
+			done[t.ID] = true
+			result.succeeded[i] = tombstoneData(t)
+		}
+		for _, f := range result.failed {
+			failure := f.(map[string]any)
+			failure["item"] = tombstoneData(failure["item"].(tombstone))
+		}
+		if list, err = loadTombstones(); err != nil {
+			return err
+		}
+		list.Pending = slices.DeleteFunc(list.Pending, func(t tombstone) bool { return done[t.ID] })
+		if err := saveTombstones(list); err != nil {
+			return err
+		}
+
+		summary := fmt.Sprintf("Deleted %d of %d due, %d not due yet", len(result.succeeded), len(due), waiting)
+		return printBulkResult(map[string]any{"waiting": waiting}, result, "deletes", summary, []Breadcrumb{
+			breadcrumb("pending", "fizzy purge list", "List pending deletes"),
+		})
+	},
+}
+
+var purgeListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List pending deletes",
+	Long:  "Lists the deletes waiting for 'fizzy purge', oldest first, with when each becomes due.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		list, err := loadTombstones()
+		if err != nil {
+			return err
+		}
+		items := make([]any, len(list.Pending))
+		for i, t := range list.Pending {
+			items[i] = tombstoneData(t)
+		}
+
+		summary := fmt.Sprintf("%d pending %s", len(items), pluralize(len(items), "delete", "deletes"))
+		printList(items, purgeColumns, summary, []Breadcrumb{
+			breadcrumb("cancel", "fizzy purge cancel <id>", "Keep a resource"),
+			breadcrumb("purge", "fizzy purge", "Delete what is due"),
+		})
+		return nil
+	},
+}
+
+var purgeCancelCmd = &cobra.Command{
+	Use:   "cancel ID",
+	Short: "Keep a resource that is pending delete",
+	Long:  "Removes a delete from the pending list, so 'fizzy purge' leaves the resource alone.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		list, err := loadTombstones()
+		if err != nil {
+			return err
+		}
+		id, _ := strconv.Atoi(args[0])
+		i := slices.IndexFunc(list.Pending, func(t tombstone) bool { return t.ID == id })
+		if i < 0 {
+			return errors.NewNotFoundError(fmt.Sprintf("Pending delete %s not found; see 'fizzy purge list'", args[0]))
+		}
+		t := list.Pending[i]
+		list.Pending = slices.Delete(list.Pending, i, i+1)
+		if err := saveTombstones(list); err != nil {
+			return err
+		}
+
+		data := tombstoneData(t)
+		data["cancelled"] = true
+		printMutation(data, fmt.Sprintf("%s will not be deleted", tombstoneLabel(t)), []Breadcrumb{
+			breadcrumb("pending", "fizzy purge list", "List pending deletes"),
+		})
+		return nil
+	},
+}
+
+var purgeGraceCmd = &cobra.Command{
+	Use:   "grace [DURATION|off]",
+	Short: "Show or set the delete grace period of the active profile",
+	Long: `Shows the delete grace period in effect, or sets it on the active profile so
+each profile can have its own. "off" removes the profile's setting, falling
+back to delete_grace in config.yaml.`,
+	Example: `  fizzy purge grace
+  fizzy purge grace 72h
+  fizzy purge grace off`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			grace, err := deleteGrace()
+			if err != nil {
+				return err
+			}
+			printDetail(map[string]any{"profile": cfg.Account, "delete_grace": grace.String(), "deferred": grace > 0}, "", nil)
+			return nil
+		}
+
+		var value any
+		if args[0] != "off" {
+			grace, err := time.ParseDuration(args[0])
+			if err != nil || grace < 0 {
+				return errors.NewInvalidArgsError(fmt.Sprintf("invalid grace period %q (use a duration such as 72h, or off)", args[0]))
+			}
+			value = grace.String()
+		}
+		p, err := activeProfile()
+		if err != nil {
+			return err
+		}
+		if err := saveProfileExtra(p, "delete_grace", value); err != nil {
+			return err
+		}
+
+		data := map[string]any{"profile": p.Name, "delete_grace": value}
+		summary := fmt.Sprintf("Deletes on profile %s wait %v before 'fizzy purge'", p.Name, value)
+		if value == nil {
+			summary = fmt.Sprintf("Removed the delete grace period from profile %s", p.Name)
+		}
+		printMutation(data, summary, nil)
+		return nil
+	},
+}
+
+// purgeTombstone carries out one pending delete.
+func purgeTombstone(ctx context.Context, t tombstone) error {
+	ac := getSDK()
+	var err error
+	switch t.Kind {
+	case "board":
+		if t.Archive {
+			if _, err := snapshotBoard(ctx, t.Target); err != nil {
+				return err
+			}
+		}
+		_, err = ac.Boards().Delete(ctx, t.Target)
+	case "card":
+		_, err = ac.Cards().Delete(ctx, t.Target)
+	case "column":
+		_, err = ac.Columns().Delete(ctx, t.Parent, t.Target)
+		invalidateColumnCache(t.Parent)
+	case "comment":
+		_, err = ac.Comments().Delete(ctx, t.Parent, t.Target)
+	case "step":
+		_, err = ac.Steps().Delete(ctx, t.Parent, t.Target)
+	default:
+		return errors.NewError(fmt.Sprintf("unknown pending delete kind %q", t.Kind))
+	}
+	if err != nil {
+		return convertSDKError(err)
+	}
+	return nil
+}
+
+// checkTombstoneTarget fetches what a pending delete is for, so a missing
+// target is reported as not found instead of being queued.
+func checkTombstoneTarget(ctx context.Context, t tombstone) error {
+	ac := getSDK()
+	var err error
+	switch t.Kind {
+	case "board":
+		_, _, err = ac.Boards().Get(ctx, t.Target)
+	case "card":
+		_, _, err = ac.Cards().Get(ctx, t.Target)
+	case "column":
+		_, _, err = ac.Columns().Get(ctx, t.Parent, t.Target)
+	case "comment":
+		_, _, err = ac.Comments().Get(ctx, t.Parent, t.Target)
+	case "step":
+		_, _, err = ac.Steps().Get(ctx, t.Parent, t.Target)
+	}
+	if err != nil {
+		return convertSDKError(err)
+	}
+	return nil
+}
+
+// tombstoneLabel names a pending delete, e.g. "card #12" or "step X on card #12".
+func tombstoneLabel(t tombstone) string {
+	switch t.Kind {
+	case "card":
+		return "card #" + t.Target
+	case "column":
+		return fmt.Sprintf("column %s on board %s", t.Target, t.Parent)
+	case "comment", "step":
+		return fmt.Sprintf("%s %s on card #%s", t.Kind, t.Target, t.Parent)
+	}
+	return t.Kind + " " + t.Target
+}
+
+func tombstoneData(t tombstone) map[string]any {
+	return map[string]any{
+		"id":          t.ID,
+		"kind":        t.Kind,
+		"target":      t.Target,
+		"parent":      t.Parent,
+		"description": tombstoneLabel(t),
+		"command":     t.Command,
+		"deleted_at":  t.DeletedAt.Format(time.RFC3339),
+		"purge_after": t.PurgeAfter.Format(time.RFC3339),
+	}
+}
+
+func tombstonesKey() string {
+	return "tombstones/" + cfg.Account
+}
+
+func loadTombstones() (*tombstones, error) {
+	list := &tombstones{}
+	if _, err := state.Load(tombstonesKey(), list); err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not read pending deletes: %v", err))
+	}
+	return list, nil
+}
+
+func saveTombstones(list *tombstones) error {
+	if err := state.Save(tombstonesKey(), list); err != nil {
+		return errors.NewError(fmt.Sprintf("Could not save pending deletes: %v", err))
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(purgeCmd)
+
+	purgeCmd.Flags().BoolVar(&purgeAll, "all", false, "Also delete what isn't due yet")
+	purgeCmd.Flags().BoolVar(&purgeDryRun, "dry-run", false, "Show what would be deleted without deleting it")
+	purgeCmd.AddCommand(purgeListCmd)
+	purgeCmd.AddCommand(purgeCancelCmd)
+	purgeCmd.AddCommand(purgeGraceCmd)
+}
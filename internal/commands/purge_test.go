@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/basecamp/cli/profile"
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestPurge(t *testing.T) {
+	mock := NewMockClient()
+	mock.DeleteResponse = &client.APIResponse{StatusCode: 204, Data: map[string]any{}}
+	mock.OnGet("/cards/12", &client.APIResponse{StatusCode: 200, Data: map[string]any{"number": 12}})
+	mock.OnGet("/cards/12/steps/step-1", &client.APIResponse{StatusCode: 200, Data: map[string]any{"id": "step-1"}})
+	mock.OnGet("/cards/99", &client.APIResponse{StatusCode: 404, Data: map[string]any{"error": "Not Found"}})
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+	cfg.DeleteGrace = "72h"
+
+	t.Run("deletes are deferred during the grace period", func(t *testing.T) {
+		err := cardDeleteCmd.RunE(cardDeleteCmd, []string{"12"})
+		assertExitCode(t, err, 0)
+		stepDeleteCard = "12"
+		err = stepDeleteCmd.RunE(stepDeleteCmd, []string{"step-1"})
+		stepDeleteCard = ""
+		assertExitCode(t, err, 0)
+
+		if len(mock.DeleteCalls) != 0 {
+			t.Fatalf("expected nothing deleted, got %v", mock.DeleteCalls)
+		}
+		data := result.Response.Data.(map[string]any)
+		if data["pending"] != true || data["kind"] != "step" || data["parent"] != "12" {
+			t.Errorf("unexpected deferred delete %v", data)
+		}
+
+		err = purgeListCmd.RunE(purgeListCmd, []string{})
+		assertExitCode(t, err, 0)
+		if items := toMaps(result.Response.Data); len(items) != 2 || items[0]["description"] != "card #12" {
+			t.Errorf("expected two pending deletes, got %v", items)
+		}
+	})
+
+	t.Run("a missing target is not found rather than deferred", func(t *testing.T) {
+		err := cardDeleteCmd.RunE(cardDeleteCmd, []string{"99"})
+		assertExitCode(t, err, errors.ExitNotFound)
+
+		list, err := loadTombstones()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(list.Pending) != 2 {
+			t.Errorf("expected no pending delete added, got %v", list.Pending)
+		}
+	})
+
+	t.Run("--now deletes right away", func(t *testing.T) {
+		deleteNow = true
+		commentDeleteCard = "12"
+		err := commentDeleteCmd.RunE(commentDeleteCmd, []string{"comment-1"})
+		commentDeleteCard = ""
+		deleteNow = false
+		assertExitCode(t, err, 0)
+
+		if len(mock.DeleteCalls) != 1 || mock.DeleteCalls[0].Path != "/cards/12/comments/comment-1" {
+			t.Errorf("expected the comment deleted, got %v", mock.DeleteCalls)
+		}
+		mock.DeleteCalls = nil
+	})
+
+	t.Run("cancel keeps the resource", func(t *testing.T) {
+		err := purgeCancelCmd.RunE(purgeCancelCmd, []string{"2"})
+		assertExitCode(t, err, 0)
+		err = purgeCancelCmd.RunE(purgeCancelCmd, []string{"2"})
+		assertExitCode(t, err, errors.ExitNotFound)
+	})
+
+	t.Run("purge deletes only what is due unless --all", func(t *testing.T) {
+		err := purgeCmd.RunE(purgeCmd, []string{})
+		assertExitCode(t, err, 0)
+		if len(mock.DeleteCalls) != 0 {
+			t.Fatalf("expected nothing due yet, got %v", mock.DeleteCalls)
+		}
+
+		purgeAll = true
+		err = purgeCmd.RunE(purgeCmd, []string{})
+		purgeAll = false
+		assertExitCode(t, err, 0)
+		if len(mock.DeleteCalls) != 1 || mock.DeleteCalls[0].Path != "/cards/12" {
+			t.Errorf("expected card 12 deleted, got %v", mock.DeleteCalls)
+		}
+		if list, _ := loadTombstones(); len(list.Pending) != 0 {
+			t.Errorf("expected no pending deletes left, got %v", list.Pending)
+		}
+	})
+}
+
+func TestPurgeGrace(t *testing.T) {
+	store := profile.NewStore(filepath.Join(t.TempDir(), "config.json"))
+	if err := store.Create(&profile.Profile{Name: "acme", BaseURL: "https://fizzy.example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	SetTestModeWithSDK(NewMockClient())
+	SetTestProfiles(store)
+	SetTestConfig("token", "acme", "https://fizzy.example.com")
+	defer resetTest()
+
+	err := purgeGraceCmd.RunE(purgeGraceCmd, []string{"48h"})
+	assertExitCode(t, err, 0)
+	p, err := store.Get("acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var grace string
+	if err := json.Unmarshal(p.Extra["delete_grace"], &grace); err != nil || grace != "48h0m0s" {
+		t.Errorf("expected delete_grace stored on the profile, got %q", p.Extra["delete_grace"])
+	}
+
+	err = purgeGraceCmd.RunE(purgeGraceCmd, []string{"soon"})
+	assertExitCode(t, err, errors.ExitInvalidArgs)
+
+	err = purgeGraceCmd.RunE(purgeGraceCmd, []string{"off"})
+	assertExitCode(t, err, 0)
+	if p, _ := store.Get("acme"); p.Extra["delete_grace"] != nil {
+		t.Errorf("expected delete_grace removed, got %s", p.Extra["delete_grace"])
+	}
+}
@@ -0,0 +1,167 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
+	"github.com/spf13/cobra"
+)
+
+// Quick flags
+var quickBoard string
+
+var quickCmd = &cobra.Command{
+	Use:   "quick TEXT",
+	Short: "Create a card from a one-line description",
+	Long: `Creates a card from a compact one-liner and applies everything it names:
+
+  #tag        tag the card (a # followed by a letter; #42 stays in the title)
+  @user       assign a user, by name, email, or ID as with 'fizzy user find'
+  !golden     mark the card golden
+  > Column    place the card in a column (name, ID, or maybe/not-now/done);
+              everything after the > is the column name
+
+The remaining words are the title. Users and the column are checked before
+the card is created, so a typo fails without leaving a half-made card.`,
+	Example: `  fizzy quick "Fix login #bug @alice !golden > In Progress"
+  fizzy quick "Write release notes #docs" --board BOARD_ID`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		boardID, err := requireBoard(quickBoard)
+		if err != nil {
+			return err
+		}
+		quick, err := parseQuickCard(args[0])
+		if err != nil {
+			return err
+		}
+
+		ac := getSDK()
+
+		// Resolve users and the column up front so a bad name fails before
+		// the card exists.
+		assignees := make([]string, 0, len(quick.Assignees))
+		if len(quick.Assignees) > 0 {
+			pages, err := ac.GetAll(cmd.Context(), "/users.json")
+			if err != nil {
+				return convertSDKError(err)
+			}
+			users := toMaps(jsonAnySlice(pages))
+			for _, query := range quick.Assignees {
+				user, err := matchUser(users, query)
+				if err != nil {
+					return err
+				}
+				if id := fmt.Sprintf("%v", user["id"]); !slices.Contains(assignees, id) {
+					assignees = append(assignees, id)
+				}
+			}
+		}
+		column := quick.Column
+		if column != "" {
+			if _, ok := parsePseudoColumnID(column); !ok {
+				if column, err = resolveColumnID(cmd.Context(), ac, boardID, column); err != nil {
+					return err
+				}
+			}
+		}
+
+		items, cardNumber, location, err := createCard(cmd.Context(), ac, &generated.CreateCardRequest{
+			BoardId: boardID,
+			Title:   quick.Title,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(quick.Tags) > 0 || len(assignees) > 0 || quick.Golden || column != "" {
+			if cardNumber == "" {
+				return errors.NewError("Card was created, but its number is unknown, so its tags, assignees, golden flag, and column were not applied")
+			}
+			if err := applyCardFollowUps(cmd.Context(), ac, cardNumber, cardFollowUps{
+				Tags:      quick.Tags,
+				Assignees: assignees,
+				Column:    column,
+				Golden:    quick.Golden,
+			}); err != nil {
+				return err
+			}
+			// Show the card as it is after the follow-up calls.
+			if followData, _, followErr := ac.Cards().Get(cmd.Context(), cardNumber); followErr == nil {
+				items = normalizeAny(followData)
+			}
+		}
+
+		var breadcrumbs []Breadcrumb
+		if cardNumber != "" {
+			breadcrumbs = []Breadcrumb{
+				breadcrumb("show", fmt.Sprintf("fizzy card show %s", cardNumber), "View card details"),
+				breadcrumb("comment", fmt.Sprintf("fizzy comment create --card %s --body \"text\"", cardNumber), "Add comment"),
+			}
+		}
+
+		if location != "" {
+			printMutationWithLocation(items, location, breadcrumbs)
+		} else {
+			printMutation(items, "", breadcrumbs)
+		}
+		return nil
+	},
+}
+
+// quickCard is a card described in quick's one-line syntax.
+type quickCard struct {
+	Title     string
+	Tags      []string
+	Assignees []string
+	Golden    bool
+	Column    string
+}
+
+// parseQuickCard splits a one-liner into a title and the tags, assignees,
+// golden flag, and column it names.
+func parseQuickCard(text string) (quickCard, error) {
+	var quick quickCard
+	words := strings.Fields(text)
+	if i := slices.Index(words, ">"); i >= 0 {
+		quick.Column = strings.Join(words[i+1:], " ")
+		words = words[:i]
+		if quick.Column == "" {
+			return quick, errors.NewInvalidArgsError("expected a column name after '>'")
+		}
+	}
+
+	var title []string
+	for _, word := range words {
+		switch {
+		case len(word) > 1 && word[0] == '#' && unicode.IsLetter([]rune(word[1:])[0]):
+			if tag := word[1:]; !slices.Contains(quick.Tags, tag) {
+				quick.Tags = append(quick.Tags, tag)
+			}
+		case len(word) > 1 && word[0] == '@':
+			quick.Assignees = append(quick.Assignees, word[1:])
+		case strings.EqualFold(word, "!golden"):
+			quick.Golden = true
+		default:
+			title = append(title, word)
+		}
+	}
+	quick.Title = strings.Join(title, " ")
+	if quick.Title == "" {
+		return quick, errors.NewInvalidArgsError(fmt.Sprintf("no title in %q", text))
+	}
+	return quick, nil
+}
+
+func init() {
+	quickCmd.Flags().StringVar(&quickBoard, "board", "", "Board ID (defaults to the configured board)")
+	rootCmd.AddCommand(quickCmd)
+}
@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestParseQuickCard(t *testing.T) {
+	quick, err := parseQuickCard("Fix login #bug @alice !golden regression #42 #bug > In Progress")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if quick.Title != "Fix login regression #42" {
+		t.Errorf("expected title without markers, got %q", quick.Title)
+	}
+	if !slices.Equal(quick.Tags, []string{"bug"}) {
+		t.Errorf("expected tags [bug], got %v", quick.Tags)
+	}
+	if !slices.Equal(quick.Assignees, []string{"alice"}) {
+		t.Errorf("expected assignees [alice], got %v", quick.Assignees)
+	}
+	if !quick.Golden {
+		t.Error("expected !golden to mark the card golden")
+	}
+	if quick.Column != "In Progress" {
+		t.Errorf("expected column 'In Progress', got %q", quick.Column)
+	}
+
+	if _, err := parseQuickCard("#bug @alice"); err == nil {
+		t.Error("expected an error without a title")
+	}
+	if _, err := parseQuickCard("Fix login >"); err == nil {
+		t.Error("expected an error for '>' without a column")
+	}
+}
+
+func TestQuick(t *testing.T) {
+	t.Run("creates the card and applies the one-liner", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.PostResponse = &client.APIResponse{
+			StatusCode: 201,
+			Data:       map[string]any{"id": "abc", "number": 42, "title": "Fix login"},
+		}
+		mock.OnGet("/users.json", &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"id": "u-1", "name": "Alice Smith", "email_address": "alice@example.com"},
+				map[string]any{"id": "u-2", "name": "Bob Jones", "email_address": "bob@example.com"},
+			},
+		})
+		mock.OnGet("/boards/123/columns.json", &client.APIResponse{
+			StatusCode: 200,
+			Data:       []any{map[string]any{"id": "col-1", "name": "In Progress"}},
+		})
+		mock.OnGet("/cards/42", &client.APIResponse{
+			StatusCode: 200,
+			Data:       map[string]any{"id": "abc", "number": 42, "title": "Fix login", "golden": true},
+		})
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		quickBoard = "123"
+		err := quickCmd.RunE(quickCmd, []string{"Fix login #bug @alice !golden > in progress"})
+		quickBoard = ""
+
+		assertExitCode(t, err, 0)
+		want := []string{"/cards.json", "/cards/42/taggings.json", "/cards/42/assignments.json", "/cards/42/triage.json", "/cards/42/goldness.json"}
+		if len(mock.PostCalls) != len(want) {
+			t.Fatalf("expected %d POST calls, got %v", len(want), mock.PostCalls)
+		}
+		for i, path := range want {
+			if mock.PostCalls[i].Path != path {
+				t.Errorf("call %d: expected %s, got %s", i, path, mock.PostCalls[i].Path)
+			}
+		}
+		if body := mock.PostCalls[0].Body.(map[string]any); body["title"] != "Fix login" || body["board_id"] != "123" {
+			t.Errorf("expected title and board in create body, got %v", body)
+		}
+		if body := mock.PostCalls[1].Body.(map[string]any); body["tag_title"] != "bug" {
+			t.Errorf("expected tag_title 'bug', got %v", body)
+		}
+		if body := mock.PostCalls[2].Body.(map[string]any); body["assignee_id"] != "u-1" {
+			t.Errorf("expected assignee_id 'u-1', got %v", body)
+		}
+		if body := mock.PostCalls[3].Body.(map[string]any); body["column_id"] != "col-1" {
+			t.Errorf("expected column_id 'col-1', got %v", body)
+		}
+		if data := result.Response.Data.(map[string]any); data["golden"] != true {
+			t.Errorf("expected refreshed card in output, got %v", data)
+		}
+	})
+
+	t.Run("fails before creating when a user doesn't match", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.OnGet("/users.json", &client.APIResponse{
+			StatusCode: 200,
+			Data:       []any{map[string]any{"id": "u-1", "name": "Alice Smith"}},
+		})
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		quickBoard = "123"
+		err := quickCmd.RunE(quickCmd, []string{"Fix login @zed"})
+		quickBoard = ""
+
+		assertExitCode(t, err, errors.ExitNotFound)
+		if len(mock.PostCalls) != 0 {
+			t.Errorf("expected no card to be created, got %v", mock.PostCalls)
+		}
+	})
+}
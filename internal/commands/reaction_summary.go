@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+)
+
+// withReactions is the --with value that embeds reactions and a
+// reaction_summary rollup into card show and comment list output.
+const withReactions = "reactions"
+
+// parseWithOptions validates --with values against the supported set and
+// reports which were requested.
+func parseWithOptions(values []string, supported ...string) (map[string]bool, error) {
+	requested := map[string]bool{}
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" {
+			continue
+		}
+		known := false
+		for _, s := range supported {
+			if value == s {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("unknown --with value %q (supported: %s)", value, strings.Join(supported, ", ")))
+		}
+		requested[value] = true
+	}
+	return requested, nil
+}
+
+// summarizeReactions counts reactions by content, e.g. {"👍": 3, "🎉": 1}.
+func summarizeReactions(reactions any) map[string]int {
+	summary := map[string]int{}
+	for _, reaction := range toMaps(reactions) {
+		if content, ok := reaction["content"].(string); ok && content != "" {
+			summary[content]++
+		}
+	}
+	return summary
+}
+
+// attachCardReactions fetches the card's reactions and adds them, along with
+// their reaction_summary, to card.
+func attachCardReactions(ctx context.Context, ac *fizzy.AccountClient, cardNumber string, card map[string]any) error {
+	data, _, err := ac.Reactions().ListCard(ctx, cardNumber)
+	if err != nil {
+		return convertSDKError(err)
+	}
+	reactions := normalizeAny(data)
+	if reactions == nil {
+		reactions = []any{}
+	}
+	card["reactions"] = reactions
+	card["reaction_summary"] = summarizeReactions(reactions)
+	return nil
+}
+
+// attachCommentReactions fetches each comment's reactions, several comments
+// at once, and adds them, along with their reaction_summary, to the comment.
+func attachCommentReactions(ctx context.Context, ac *fizzy.AccountClient, cardNumber string, items any) error {
+	comments := toMaps(items)
+	errs := make([]error, len(comments))
+	sem := make(chan struct{}, maxParallel(bulkConcurrency))
+	var wg sync.WaitGroup
+	for i, comment := range comments {
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			data, _, err := ac.Reactions().ListComment(ctx, cardNumber, fmt.Sprintf("%v", comment["id"]))
+			if err != nil {
+				errs[i] = convertSDKError(err)
+				return
+			}
+			reactions := normalizeAny(data)
+			if reactions == nil {
+				reactions = []any{}
+			}
+			comment["reactions"] = reactions
+			comment["reaction_summary"] = summarizeReactions(reactions)
+		})
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
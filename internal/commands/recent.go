@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/state"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// lastPlaceholder stands for the most recently used resource of the kind an
+// argument or flag expects, e.g. 'fizzy comment create --card @last'.
+const lastPlaceholder = "@last"
+
+// recentLimit is how many recently used resources are kept per kind.
+const recentLimit = 10
+
+// recentArgKinds maps positional argument names in a command's Use line to
+// the kind of resource they take.
+var recentArgKinds = map[string]string{
+	"CARD_NUMBER": "card",
+	"BOARD_ID":    "board",
+	"COLUMN_ID":   "column",
+}
+
+// recentFlagKinds maps flag names to the kind of resource they take.
+var recentFlagKinds = map[string]string{
+	"card":   "card",
+	"board":  "board",
+	"column": "column",
+}
+
+func recentKey() string {
+	return "recent/" + cfg.Account
+}
+
+// loadRecent returns recently used resources by kind, most recent first. An
+// unreadable history is treated as empty.
+func loadRecent() map[string][]string {
+	recent := map[string][]string{}
+	if _, err := state.Load(recentKey(), &recent); err != nil || recent == nil {
+		return map[string][]string{}
+	}
+	return recent
+}
+
+// rememberRecent records value as the most recently used resource of kind.
+// It is best-effort: failing to save history never fails a command.
+func rememberRecent(kind, value string) {
+	if cfg == nil || value == "" || value == lastPlaceholder {
+		return
+	}
+	recent := loadRecent()
+	values := slices.DeleteFunc(recent[kind], func(v string) bool { return v == value })
+	values = append([]string{value}, values...)
+	if len(values) > recentLimit {
+		values = values[:recentLimit]
+	}
+	recent[kind] = values
+	_ = state.Save(recentKey(), recent)
+}
+
+// lastRecent returns the most recently used resource of kind.
+func lastRecent(kind string) (string, error) {
+	if values := loadRecent()[kind]; len(values) > 0 {
+		return values[0], nil
+	}
+	return "", errors.NewInvalidArgsError(fmt.Sprintf("no recently used %s for %s; use a %s in a command first", kind, lastPlaceholder, kind))
+}
+
+// recentArgKindsFor returns the resource kind of each positional argument in
+// cmd's Use line, or "" for arguments that don't take a tracked resource.
+func recentArgKindsFor(cmd *cobra.Command) []string {
+	fields := strings.Fields(cmd.Use)
+	if len(fields) < 2 {
+		return nil
+	}
+	kinds := make([]string, 0, len(fields)-1)
+	for _, field := range fields[1:] {
+		kinds = append(kinds, recentArgKinds[strings.Trim(field, "[].")])
+	}
+	return kinds
+}
+
+// expandRecentPlaceholders replaces @last in card, board, and column flags
+// and positional arguments with the most recently used resource of that kind.
+func expandRecentPlaceholders(cmd *cobra.Command, args []string) error {
+	var expandErr error
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		kind, ok := recentFlagKinds[f.Name]
+		if !ok || expandErr != nil || f.Value.Type() != "string" || f.Value.String() != lastPlaceholder {
+			return
+		}
+		value, err := lastRecent(kind)
+		if err != nil {
+			expandErr = err
+			return
+		}
+		expandErr = f.Value.Set(value)
+	})
+	if expandErr != nil {
+		return expandErr
+	}
+
+	kinds := recentArgKindsFor(cmd)
+	for i, arg := range args {
+		if arg != lastPlaceholder {
+			continue
+		}
+		if i >= len(kinds) || kinds[i] == "" {
+			return errors.NewInvalidArgsError(fmt.Sprintf("%s is only supported for cards, boards, and columns", lastPlaceholder))
+		}
+		value, err := lastRecent(kinds[i])
+		if err != nil {
+			return err
+		}
+		args[i] = value
+	}
+	return nil
+}
+
+// recordRecentResources remembers the cards, boards, and columns a successful
+// command was given. Deleted resources aren't worth returning to, so delete
+// commands are skipped.
+func recordRecentResources(cmd *cobra.Command, args []string) {
+	if cmd.Name() == "delete" {
+		return
+	}
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if kind, ok := recentFlagKinds[f.Name]; ok && f.Value.Type() == "string" {
+			rememberRecent(kind, f.Value.String())
+		}
+	})
+	kinds := recentArgKindsFor(cmd)
+	for i, arg := range args {
+		if i < len(kinds) && kinds[i] != "" {
+			rememberRecent(kinds[i], arg)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestRememberRecent(t *testing.T) {
+	SetTestModeWithSDK(NewMockClient())
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	for i := 1; i <= recentLimit+2; i++ {
+		rememberRecent("card", fmt.Sprint(i))
+	}
+	rememberRecent("card", "5")
+
+	cards := loadRecent()["card"]
+	if len(cards) != recentLimit {
+		t.Fatalf("expected %d cards, got %v", recentLimit, cards)
+	}
+	if cards[0] != "5" || cards[1] != "12" {
+		t.Errorf("expected 5 then 12 most recent first, got %v", cards)
+	}
+	for _, c := range cards[1:] {
+		if c == "5" {
+			t.Errorf("expected 5 to appear once, got %v", cards)
+		}
+	}
+}
+
+func TestLastPlaceholder(t *testing.T) {
+	t.Run("expands to the most recently used card", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetResponse = &client.APIResponse{StatusCode: 200, Data: map[string]any{"number": 42, "title": "Card"}}
+		mock.PostResponse = &client.APIResponse{StatusCode: 201, Data: map[string]any{"id": "c1"}}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+		defer func() { commentCreateCard, commentCreateBody = "", "" }()
+
+		if _, err := runCobraWithArgs("card", "show", "42"); err != nil {
+			t.Fatalf("card show failed: %v", err)
+		}
+		if _, err := runCobraWithArgs("comment", "create", "--card", "@last", "--body", "done"); err != nil {
+			t.Fatalf("comment create failed: %v", err)
+		}
+
+		if len(mock.PostCalls) != 1 || mock.PostCalls[0].Path != "/cards/42/comments.json" {
+			t.Errorf("expected comment on card 42, got %+v", mock.PostCalls)
+		}
+	})
+
+	t.Run("positional argument", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetResponse = &client.APIResponse{StatusCode: 200, Data: map[string]any{"number": 7}}
+
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		rememberRecent("card", "7")
+		if _, err := runCobraWithArgs("card", "show", "@last"); err != nil {
+			t.Fatalf("card show failed: %v", err)
+		}
+		if mock.GetCalls[len(mock.GetCalls)-1].Path != "/cards/7" {
+			t.Errorf("expected /cards/7, got %+v", mock.GetCalls)
+		}
+	})
+
+	t.Run("fails without history", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		_, err := runCobraWithArgs("card", "show", "@last")
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
@@ -8,22 +8,29 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"net/http"
 	"os"
 	"path/filepath"
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/basecamp/cli/credstore"
 	"github.com/basecamp/cli/output"
 	"github.com/basecamp/cli/profile"
+	"github.com/basecamp/fizzy-cli/internal/cache"
 	"github.com/basecamp/fizzy-cli/internal/client"
 	"github.com/basecamp/fizzy-cli/internal/config"
 	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/fsutil"
+	"github.com/basecamp/fizzy-cli/internal/i18n"
 	"github.com/basecamp/fizzy-cli/internal/render"
 	fizzy "github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+	"github.com/charmbracelet/x/term"
 	"github.com/itchyny/gojq"
 	"github.com/mattn/go-isatty"
+	"github.com/muesli/termenv"
 	"github.com/spf13/cobra"
 )
 
@@ -32,19 +39,28 @@ type Breadcrumb = output.Breadcrumb
 
 var (
 	// Global flags
-	cfgToken    string
-	cfgProfile  string
-	cfgAPIURL   string
-	cfgVerbose  bool
-	cfgJSON     bool
-	cfgQuiet    bool
-	cfgIDsOnly  bool
-	cfgCount    bool
-	cfgAgent    bool
-	cfgStyled   bool
-	cfgMarkdown bool
-	cfgLimit    int
-	cfgJQ       string
+	cfgToken      string
+	cfgProfile    string
+	cfgAPIURL     string
+	cfgVerbose    bool
+	cfgJSON       bool
+	cfgQuiet      bool
+	cfgIDsOnly    bool
+	cfgCount      bool
+	cfgAgent      bool
+	cfgStyled     bool
+	cfgMarkdown   bool
+	cfgLimit      int
+	cfgWidth      int
+	cfgJQ         string
+	cfgOutputFile string
+	cfgNotify     bool
+
+	// TLS flags
+	cfgCACert             string
+	cfgClientCert         string
+	cfgClientKey          string
+	cfgInsecureSkipVerify bool
 
 	// Loaded config
 	cfg *config.Config
@@ -78,6 +94,9 @@ var rootCmd = &cobra.Command{
 	RunE:    runRootDefault,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		errOutputWrite = nil
+		hookData = nil
+		deprecations = nil
+		noteDeprecatedFlags(cmd)
 		// Early jq validation: check flag conflicts first (actionable message),
 		// then parse + compile before RunE so invalid expressions are rejected
 		// with no side effects. The compiled code is reused below to avoid
@@ -97,27 +116,39 @@ var rootCmd = &cobra.Command{
 			}
 		}
 
+		version, err := parseCompat(cfgCompat)
+		if err != nil {
+			return err
+		}
+		compatVersion = version
+
 		// Resolve output format from parsed flags (must happen post-parse).
 		format, err := resolveFormat()
 		if err != nil {
 			return &output.Error{Code: output.CodeUsage, Message: err.Error()}
 		}
+		var dest io.Writer = os.Stdout
 		if lastResult != nil {
 			// Test mode — preserve test buffer as writer.
-			outWriter = &testBuf
-			var w io.Writer = &testBuf
-			if jqCode != nil {
-				w = newJQWriterWithCode(&testBuf, jqCode)
-			}
-			out = output.New(output.Options{Format: format, Writer: w})
-		} else {
-			outWriter = os.Stdout
-			var w io.Writer = os.Stdout
-			if jqCode != nil {
-				w = newJQWriterWithCode(os.Stdout, jqCode)
-			}
-			out = output.New(output.Options{Format: format, Writer: w})
+			dest = &testBuf
 		}
+		outputFile, err := openOutputFile(cmd)
+		if err != nil {
+			return err
+		}
+		if outputFile != nil {
+			dest = outputFile
+		}
+		outWriter = dest
+		if cfgWidth < 0 {
+			return &output.Error{Code: output.CodeUsage, Message: "--width must not be negative"}
+		}
+		render.SetWidth(outputWidth())
+		w := dest
+		if jqCode != nil {
+			w = newJQWriterWithCode(dest, jqCode)
+		}
+		out = output.New(output.Options{Format: format, Writer: w})
 
 		// In test mode, cfg is already set by SetTestConfig - don't overwrite
 		if cfg == nil {
@@ -125,6 +156,15 @@ var rootCmd = &cobra.Command{
 			cfg = config.Load()
 		}
 
+		outputTemplate = nil
+		if cfgTemplate != "" {
+			tmpl, err := compileTemplate(cfgTemplate, cfg.Templates)
+			if err != nil {
+				return &output.Error{Code: output.CodeUsage, Message: err.Error()}
+			}
+			outputTemplate = tmpl
+		}
+
 		// Initialize credential store (skip in test mode)
 		if creds == nil && lastResult == nil {
 			fallbackDir := ""
@@ -157,6 +197,34 @@ var rootCmd = &cobra.Command{
 			cfg.APIURL = cfgAPIURL
 		}
 
+		// TLS flags override config and environment
+		if cfgCACert != "" {
+			cfg.CACert = cfgCACert
+		}
+		if cfgClientCert != "" {
+			cfg.ClientCert = cfgClientCert
+		}
+		if cfgClientKey != "" {
+			cfg.ClientKey = cfgClientKey
+		}
+		if cfgInsecureSkipVerify {
+			cfg.InsecureSkipVerify = true
+		}
+		if cfg.InsecureSkipVerify {
+			fmt.Fprintln(os.Stderr, "Warning: TLS certificate verification is disabled.")
+		}
+
+		// Localize human-readable output. Agent mode stays in English so its
+		// output is stable, and test mode ignores the developer's environment.
+		switch {
+		case cfgAgent:
+			i18n.SetLocale(i18n.DefaultLocale)
+		case lastResult != nil:
+			i18n.SetLocale(cfg.Locale)
+		default:
+			i18n.SetLocale(i18n.Detect(cfg.Locale))
+		}
+
 		// FIZZY_DEBUG enables verbose output
 		if os.Getenv("FIZZY_DEBUG") != "" {
 			cfgVerbose = true
@@ -171,14 +239,26 @@ var rootCmd = &cobra.Command{
 			}
 		}
 
-		return nil
+		if err := expandRecentPlaceholders(cmd, args); err != nil {
+			return err
+		}
+
+		warnIfOverPrivileged(cmd)
+
+		return runPreHook(cmd, args)
 	},
 	PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
 		if errOutputWrite != nil {
 			err := errOutputWrite
 			errOutputWrite = nil
+			discardOutputFile()
+			return err
+		}
+		if err := commitOutputFile(); err != nil {
 			return err
 		}
+		recordRecentResources(cmd, args)
+		runPostHook(cmd, args)
 		if RefreshSkillsIfVersionChanged() && !IsMachineOutput() {
 			fmt.Fprintf(os.Stderr, "Agent skill updated to match CLI %s\n", currentVersion())
 		}
@@ -201,11 +281,40 @@ func SetVersion(v string) {
 func Execute() {
 	configureCLIUX()
 
+	// Windows consoles need virtual terminal processing enabled before ANSI
+	// styling renders; this is a no-op elsewhere and when stdout is redirected.
+	restoreConsole, _ := termenv.EnableVirtualTerminalProcessing(termenv.NewOutput(os.Stdout))
+	defer func() { _ = restoreConsole() }()
+
 	// Default to Auto — PersistentPreRunE will re-resolve from parsed flags.
 	outWriter = os.Stdout
 	out = output.New(output.Options{Format: output.FormatAuto, Writer: os.Stdout})
+	started := time.Now()
 	cmd, err := rootCmd.ExecuteC()
+	elapsed := time.Since(started)
+	attempted := requestTraces.attempted()
+	notifyCompletion(cmd, err, elapsed)
+	recordUsageStats(cmd, err, elapsed)
+	recordHistory(cmd, os.Args[1:], err)
+	recordTraces(cmd, os.Args[1:])
+	_ = cache.FlushStats()
+	if errors.IsPrinted(err) {
+		// The result (succeeded and failed items, or lint findings) was
+		// already printed; keep that output and only signal the failure
+		// through the exit code.
+		if commitErr := commitOutputFile(); commitErr != nil {
+			fmt.Fprintln(os.Stderr, commitErr)
+		}
+		if ghaMode() {
+			writeGHAError(os.Stdout, output.AsError(err))
+		} else if isHumanOutput() {
+			fmt.Fprintln(os.Stderr, output.AsError(err).Message)
+		}
+		_ = restoreConsole()
+		os.Exit(errors.ExitCodeOf(err))
+	}
 	if err != nil {
+		discardOutputFile()
 		if format, formatErr := resolveFormat(); formatErr == nil {
 			out = output.New(output.Options{Format: format, Writer: os.Stdout})
 		}
@@ -232,12 +341,21 @@ func Execute() {
 			}
 			out = output.New(output.Options{Format: format, Writer: outWriter})
 		}
-		if isHumanOutput() {
+		var explanation *errorExplanation
+		if cfgExplain {
+			explanation = explainError(cmd, e, attempted)
+		}
+		switch {
+		case ghaMode():
+			writeGHAError(os.Stdout, e)
+		case isHumanOutput():
 			printHumanError(cmd, e)
-		} else {
-			_ = out.Err(e)
+			printExplanation(os.Stderr, explanation)
+		default:
+			_ = errEnvelope(e, explanation)
 		}
-		os.Exit(e.ExitCode())
+		_ = restoreConsole()
+		os.Exit(errors.ExitCodeOf(e))
 	}
 }
 
@@ -278,6 +396,35 @@ func resolveFormat() (output.Format, error) {
 		return 0, fmt.Errorf("--jq filters JSON output; use it with default JSON output or --quiet, not with --styled, --markdown, --ids-only, or --count")
 	}
 
+	// --ndjson is its own format: one JSON line per item, no envelope.
+	if cfgNDJSON && (cfgJSON || cfgQuiet || cfgIDsOnly || cfgCount || cfgStyled || cfgMarkdown || cfgJQ != "") {
+		return 0, fmt.Errorf("--ndjson cannot be combined with --json, --quiet, --ids-only, --count, --styled, --markdown, or --jq")
+	}
+	if cfgNDJSON {
+		return output.FormatQuiet, nil
+	}
+
+	// --gha writes workflow commands in place of any other format.
+	if ghaMode() && (cfgJSON || cfgQuiet || cfgIDsOnly || cfgCount || cfgStyled || cfgMarkdown || cfgNDJSON || cfgTemplate != "" || cfgJQ != "") {
+		return 0, fmt.Errorf("--gha cannot be combined with other output format flags, --ndjson, --template, or --jq")
+	}
+	if ghaMode() {
+		return output.FormatQuiet, nil
+	}
+
+	// --template renders the JSON envelope as text, in place of any other format.
+	if cfgTemplate != "" && (cfgJSON || cfgIDsOnly || cfgCount || cfgStyled || cfgMarkdown || cfgNDJSON || cfgJQ != "") {
+		return 0, fmt.Errorf("--template cannot be combined with --json, --ids-only, --count, --styled, --markdown, --ndjson, or --jq")
+	}
+	if cfgTemplate != "" {
+		return output.FormatQuiet, nil
+	}
+
+	// --fields prunes JSON data; human renderers pick their own columns.
+	if cfgFields != "" && (cfgStyled || cfgMarkdown || cfgIDsOnly) {
+		return 0, fmt.Errorf("--fields selects JSON fields; use it with default JSON output, --quiet, or --jq, not with --styled, --markdown, or --ids-only")
+	}
+
 	// Explicit format flag wins
 	switch {
 	case cfgQuiet:
@@ -313,7 +460,7 @@ func resolveFormat() (output.Format, error) {
 // IsMachineOutput returns true when output should be treated as machine-consumable.
 // True when any machine format flag is set, --agent is set, or stdout/stdin is not a TTY.
 func IsMachineOutput() bool {
-	if cfgAgent || cfgJSON || cfgQuiet || cfgIDsOnly || cfgCount || cfgJQ != "" {
+	if cfgAgent || cfgJSON || cfgQuiet || cfgIDsOnly || cfgCount || cfgNDJSON || cfgTemplate != "" || cfgGHA || cfgJQ != "" {
 		return true
 	}
 	if !isatty.IsTerminal(os.Stdout.Fd()) && !isatty.IsCygwinTerminal(os.Stdout.Fd()) {
@@ -381,13 +528,26 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&cfgVerbose, "verbose", false, "Show request/response details")
 	rootCmd.PersistentFlags().BoolVar(&cfgJSON, "json", false, "JSON envelope output")
 	rootCmd.PersistentFlags().BoolVar(&cfgQuiet, "quiet", false, "Raw JSON data without envelope")
-	rootCmd.PersistentFlags().BoolVar(&cfgIDsOnly, "ids-only", false, "Print one ID per line")
+	rootCmd.PersistentFlags().BoolVar(&cfgIDsOnly, "ids-only", false, "Print one ID per line (card numbers for cards)")
 	rootCmd.PersistentFlags().BoolVar(&cfgCount, "count", false, "Print count of results")
 	rootCmd.PersistentFlags().BoolVar(&cfgAgent, "agent", false, "Agent mode (default: quiet format, no interactive prompts)")
 	rootCmd.PersistentFlags().BoolVar(&cfgStyled, "styled", false, "Styled terminal output with colors")
 	rootCmd.PersistentFlags().BoolVar(&cfgMarkdown, "markdown", false, "Markdown formatted output")
 	rootCmd.PersistentFlags().IntVar(&cfgLimit, "limit", 0, "Maximum number of results to display")
+	rootCmd.PersistentFlags().IntVar(&cfgWidth, "width", 0, "Width of styled output in characters (default: terminal width; under 80 stacks list fields)")
+	rootCmd.PersistentFlags().BoolVar(&cfgNDJSON, "ndjson", false, "Print results as one JSON object per line (card list --all streams them as pages arrive)")
+	rootCmd.PersistentFlags().BoolVar(&cfgGHA, "gha", false, "Write GitHub Actions workflow commands and step outputs")
+	rootCmd.PersistentFlags().StringVar(&cfgTemplate, "template", "", "Render output with a Go template over the JSON envelope, or a named template from the config")
+	rootCmd.PersistentFlags().StringVar(&cfgFields, "fields", "", "Keep only these comma-separated fields in JSON output (dotted paths, e.g. number,title,column.name)")
 	rootCmd.PersistentFlags().StringVar(&cfgJQ, "jq", "", "Apply jq filter to JSON output (built-in, no external jq required; implies --json)")
+	rootCmd.PersistentFlags().StringVarP(&cfgOutputFile, "output-file", "o", "", "Write output to a file, replaced atomically and left untouched if the command fails")
+	rootCmd.PersistentFlags().StringVar(&cfgCompat, "compat", "", "Keep JSON output in an older schema version's shape (e.g. v1)")
+	rootCmd.PersistentFlags().BoolVar(&cfgExplain, "explain", false, "On failure, add likely causes, the failed API request, and suggested commands to the error")
+	rootCmd.PersistentFlags().BoolVar(&cfgNotify, "notify", false, "Ring the bell and send a desktop notification when a command running over 10s finishes")
+	rootCmd.PersistentFlags().StringVar(&cfgCACert, "ca-cert", "", "PEM CA bundle to trust for self-hosted instances")
+	rootCmd.PersistentFlags().StringVar(&cfgClientCert, "client-cert", "", "PEM client certificate for mTLS")
+	rootCmd.PersistentFlags().StringVar(&cfgClientKey, "client-key", "", "PEM client key for mTLS")
+	rootCmd.PersistentFlags().BoolVar(&cfgInsecureSkipVerify, "insecure-skip-verify", false, "Skip TLS certificate verification (unsafe)")
 
 	installAgentHelp()
 }
@@ -400,9 +560,110 @@ func getClient() client.API {
 	}
 	c := client.New(cfg.APIURL, cfg.Token, cfg.Account)
 	c.Verbose = cfgVerbose
+	// Commands only get here after initSDK, which rejects invalid settings.
+	_ = configureHTTPClient(c)
 	return c
 }
 
+// configureHTTPClient applies the configured TLS settings and extra headers to
+// a legacy API client. It returns a usage error when a certificate or key
+// can't be loaded, leaving the client untouched.
+func configureHTTPClient(c *client.Client) error {
+	transport, err := httpTransport()
+	if err != nil {
+		return err
+	}
+	if transport != nil {
+		c.HTTPClient.Transport = transport
+	}
+	return nil
+}
+
+// tlsOptions returns the TLS settings resolved from config, env, and flags.
+func tlsOptions() client.TLSOptions {
+	if cfg == nil {
+		return client.TLSOptions{}
+	}
+	return client.TLSOptions{
+		CACert:             cfg.CACert,
+		ClientCert:         cfg.ClientCert,
+		ClientKey:          cfg.ClientKey,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+}
+
+// requestHeaders returns the extra request headers with environment
+// references in their values expanded.
+func requestHeaders() map[string]string {
+	if cfg == nil || len(cfg.Headers) == 0 {
+		return nil
+	}
+	headers := make(map[string]string, len(cfg.Headers))
+	for name, value := range cfg.Headers {
+		headers[name] = os.ExpandEnv(value)
+	}
+	return headers
+}
+
+// apiLimiter enforces max_parallel_requests and requests_per_second. It is
+// shared by every API client the process creates, so the limits hold across
+// the SDK, the legacy client, and both sides of a migration.
+var apiLimiter *client.Limiter
+
+// requestLimiter returns the shared limiter, or nil when no limit is set.
+func requestLimiter() *client.Limiter {
+	if apiLimiter == nil && cfg != nil {
+		apiLimiter = client.NewLimiter(cfg.MaxParallelRequests, cfg.RequestsPerSecond)
+	}
+	return apiLimiter
+}
+
+// maxParallel caps a feature's own concurrency at max_parallel_requests.
+func maxParallel(n int) int {
+	if cfg != nil && cfg.MaxParallelRequests > 0 && cfg.MaxParallelRequests < n {
+		return cfg.MaxParallelRequests
+	}
+	return n
+}
+
+// httpTransport builds the HTTP transport for the configured TLS settings,
+// extra headers, secondary token, and request limits. It returns nil when
+// none is configured.
+func httpTransport() (http.RoundTripper, error) {
+	opts := tlsOptions()
+	headers := requestHeaders()
+	limiter := requestLimiter()
+	primary, secondary := "", ""
+	if cfg != nil && cfg.SecondaryToken != cfg.Token {
+		primary, secondary = cfg.Token, cfg.SecondaryToken
+	}
+	if opts.IsZero() && len(headers) == 0 && secondary == "" && limiter == nil {
+		return nil, nil
+	}
+	transport, err := client.NewTransport(opts)
+	if err != nil {
+		return nil, &output.Error{
+			Code:    output.CodeUsage,
+			Message: fmt.Sprintf("Invalid TLS configuration: %v", err),
+			Hint:    "Check --ca-cert, --client-cert, --client-key or the ca_cert, client_cert, client_key config keys",
+		}
+	}
+	rt := client.NewFailoverTransport(transport, primary, secondary, func() {
+		fmt.Fprintln(os.Stderr, "Warning: primary token was rejected; using the secondary token. Run 'fizzy auth rotate --new-token <token>' to replace it.")
+	})
+	return client.NewLimitTransport(client.NewHeaderTransport(rt, headers), limiter), nil
+}
+
+// transportClientOptions returns SDK options applying the configured TLS
+// settings, extra headers, and request limits.
+func transportClientOptions() ([]fizzy.ClientOption, error) {
+	transport, err := httpTransport()
+	if err != nil || transport == nil {
+		return nil, err
+	}
+	return []fizzy.ClientOption{fizzy.WithTransport(transport)}, nil
+}
+
 // errSDKInit stores any error from SDK initialization so commands can return it.
 var errSDKInit error
 
@@ -440,11 +701,16 @@ func initSDK(cmd *cobra.Command, apiURL, token, account string) (initErr error)
 	sdkCfg := &fizzy.Config{
 		BaseURL: apiURL,
 	}
-	var opts []fizzy.ClientOption
+	opts, err := transportClientOptions()
+	if err != nil {
+		return err
+	}
 	opts = append(opts, fizzy.WithUserAgent("fizzy-cli/"+cmd.Root().Version))
+	hooks := []fizzy.Hooks{requestTraces, daemonHealth}
 	if cfgVerbose {
-		opts = append(opts, fizzy.WithHooks(fizzy.NewSlogHooks(slog.New(slog.NewTextHandler(os.Stderr, nil)))))
+		hooks = append(hooks, fizzy.NewSlogHooks(slog.New(slog.NewTextHandler(os.Stderr, nil))))
 	}
+	opts = append(opts, fizzy.WithHooks(fizzy.NewChainHooks(hooks...)))
 	sdk = fizzy.NewClient(sdkCfg, &fizzy.StaticTokenProvider{Token: token}, opts...)
 	sdkAccount = func() *fizzy.AccountClient {
 		return sdk.ForAccount(account)
@@ -590,6 +856,18 @@ func defaultBoard(board string) string {
 	return effectiveConfig().Board
 }
 
+// defaultBoards returns the boards given, or the configured default board
+// when none were.
+func defaultBoards(boards []string) []string {
+	if len(boards) > 0 {
+		return boards
+	}
+	if board := effectiveConfig().Board; board != "" {
+		return []string{board}
+	}
+	return nil
+}
+
 func requireBoard(board string) (string, error) {
 	board = defaultBoard(board)
 	if board == "" {
@@ -621,7 +899,25 @@ func recordOutputError(err error) {
 	}
 }
 
+// outputWidth is the width styled output fits into: --width, or the width of
+// the terminal output goes to, or 0 when it isn't a terminal.
+func outputWidth() int {
+	if cfgWidth > 0 {
+		return cfgWidth
+	}
+	if f, ok := outWriter.(*os.File); ok {
+		if w, _, err := term.GetSize(f.Fd()); err == nil && w > 0 {
+			return w
+		}
+	}
+	return 0
+}
+
 func writeOutputString(s string) {
+	// Markdown is often redirected into a file; give Windows editors CRLF.
+	if out != nil && out.EffectiveFormat() == output.FormatMarkdown {
+		s = fsutil.NativeLineEndings(s)
+	}
 	_, err := io.WriteString(outWriter, s)
 	recordOutputError(err)
 }
@@ -650,7 +946,7 @@ func printSuccess(data any) {
 		writeOutputString(renderHumanData(data, "", true))
 		captureResponse()
 	default:
-		recordOutputError(out.OK(data))
+		recordOutputError(okEnvelope(data))
 		captureResponse()
 	}
 }
@@ -666,13 +962,13 @@ func printSuccessWithBreadcrumbs(data any, summary string, breadcrumbs []Breadcr
 	if summary != "" {
 		opts = append(opts, output.WithSummary(summary))
 	}
-	recordOutputError(out.OK(data, opts...))
+	recordOutputError(okEnvelope(data, opts...))
 	captureResponse()
 }
 
 // printSuccessWithLocationAndBreadcrumbs prints a success response with both location and breadcrumbs.
 func printSuccessWithLocationAndBreadcrumbs(data any, location string, breadcrumbs []Breadcrumb) {
-	recordOutputError(out.OK(data,
+	recordOutputError(okEnvelope(data,
 		output.WithBreadcrumbs(breadcrumbs...),
 		output.WithContext("location", location),
 	))
@@ -728,6 +1024,8 @@ func dataCount(data any) int {
 // printList renders list data with format-aware dispatch.
 // For non-paginated lists (no --all flag). Applies --limit truncation.
 func printList(data any, cols render.Columns, summary string, breadcrumbs []Breadcrumb) {
+	historySummary = summary
+	hookData = data
 	data, originalCount := truncateData(data)
 
 	// For non-paginated lists, generate a simple limit notice (no --all to suggest)
@@ -753,7 +1051,7 @@ func printList(data any, cols render.Columns, summary string, breadcrumbs []Brea
 		if notice != "" {
 			opts = append(opts, output.WithNotice(notice))
 		}
-		recordOutputError(out.OK(data, opts...))
+		recordOutputError(okEnvelope(data, opts...))
 		captureResponse()
 	}
 }
@@ -761,6 +1059,8 @@ func printList(data any, cols render.Columns, summary string, breadcrumbs []Brea
 // printListPaginated renders paginated list data with format-aware dispatch.
 // For paginated lists (commands with --all flag). Applies --limit truncation and truncation notices.
 func printListPaginated(data any, cols render.Columns, hasNext bool, nextURL string, all bool, summary string, breadcrumbs []Breadcrumb) {
+	historySummary = summary
+	hookData = data
 	data, _ = truncateData(data)
 	notice := output.TruncationNotice(dataCount(data), defaultPageSize, all, cfgLimit)
 
@@ -787,7 +1087,7 @@ func printListPaginated(data any, cols render.Columns, hasNext bool, nextURL str
 				"next_url": nextURL,
 			}))
 		}
-		recordOutputError(out.OK(data, opts...))
+		recordOutputError(okEnvelope(data, opts...))
 		captureResponse()
 	}
 }
@@ -799,6 +1099,8 @@ func printDetail(data any, summary string, breadcrumbs []Breadcrumb) {
 
 // printDetailPaginated renders a single object and includes pagination context when present.
 func printDetailPaginated(data any, summary string, breadcrumbs []Breadcrumb, hasNext bool, nextURL string) {
+	historySummary = summary
+	hookData = data
 	switch out.EffectiveFormat() {
 	case output.FormatStyled:
 		body := render.StyledDetail(toMap(data), summary)
@@ -819,13 +1121,14 @@ func printDetailPaginated(data any, summary string, breadcrumbs []Breadcrumb, ha
 				"next_url": nextURL,
 			}))
 		}
-		recordOutputError(out.OK(data, opts...))
+		recordOutputError(okEnvelope(data, opts...))
 		captureResponse()
 	}
 }
 
 // printMutationWithLocation renders a mutation result that includes a location URL.
 func printMutationWithLocation(data any, location string, breadcrumbs []Breadcrumb) {
+	hookData = data
 	switch out.EffectiveFormat() {
 	case output.FormatStyled:
 		body := render.StyledDetail(toMap(data), "")
@@ -843,6 +1146,8 @@ func printMutationWithLocation(data any, location string, breadcrumbs []Breadcru
 // printMutation renders a mutation result with format-aware dispatch.
 // For styled/markdown, uses summary rendering for simple confirmations.
 func printMutation(data any, summary string, breadcrumbs []Breadcrumb) {
+	historySummary = summary
+	hookData = data
 	switch out.EffectiveFormat() {
 	case output.FormatStyled:
 		body := render.StyledSummary(toMap(data), summary)
@@ -902,17 +1207,17 @@ func appendHumanSections(body, notice, location string, breadcrumbs []Breadcrumb
 		if markdown {
 			sb.WriteString("> ")
 		}
-		sb.WriteString(notice)
+		sb.WriteString(i18n.T(notice))
 		sb.WriteString("\n")
 	}
 	if location != "" {
 		sb.WriteString("\n")
 		if markdown {
-			sb.WriteString("**Location:** `")
+			sb.WriteString("**" + i18n.T("Location:") + "** `")
 			sb.WriteString(location)
 			sb.WriteString("`\n")
 		} else {
-			sb.WriteString("Location: ")
+			sb.WriteString(i18n.T("Location:") + " ")
 			sb.WriteString(location)
 			sb.WriteString("\n")
 		}
@@ -920,7 +1225,7 @@ func appendHumanSections(body, notice, location string, breadcrumbs []Breadcrumb
 	if len(breadcrumbs) > 0 {
 		sb.WriteString("\n")
 		if markdown {
-			sb.WriteString("### Next steps\n")
+			sb.WriteString("### " + i18n.T("Next steps") + "\n")
 			for _, crumb := range breadcrumbs {
 				sb.WriteString("- `")
 				sb.WriteString(crumb.Cmd)
@@ -932,7 +1237,7 @@ func appendHumanSections(body, notice, location string, breadcrumbs []Breadcrumb
 				sb.WriteString("\n")
 			}
 		} else {
-			sb.WriteString("Next steps:\n")
+			sb.WriteString(i18n.T("Next steps:") + "\n")
 			for _, crumb := range breadcrumbs {
 				sb.WriteString("  ")
 				sb.WriteString(crumb.Cmd)
@@ -1118,6 +1423,39 @@ func credsDeleteProfileToken(profileName string) error {
 	return creds.Delete(profile.CredentialKey(profileName, ""))
 }
 
+// secondaryCredentialKey is the credential store key for a profile's
+// failover token ("profile:<name>:secondary").
+func secondaryCredentialKey(profileName string) string {
+	return profile.CredentialKey(profileName, "") + ":secondary"
+}
+
+// credsSaveSecondaryToken saves the failover token for a profile.
+func credsSaveSecondaryToken(profileName, token string) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	return creds.Save(secondaryCredentialKey(profileName), data)
+}
+
+// credsLoadSecondaryToken loads the failover token for a profile.
+func credsLoadSecondaryToken(profileName string) (string, error) {
+	data, err := creds.Load(secondaryCredentialKey(profileName))
+	if err != nil {
+		return "", err
+	}
+	var token string
+	if json.Unmarshal(data, &token) == nil {
+		return token, nil
+	}
+	return string(data), nil
+}
+
+// credsDeleteSecondaryToken removes the failover token for a profile.
+func credsDeleteSecondaryToken(profileName string) error {
+	return creds.Delete(secondaryCredentialKey(profileName))
+}
+
 // credsLoadLegacyToken loads a token from a legacy credstore entry.
 // Checks both the old single "token" key and the account-scoped "token:<account>" key.
 func credsLoadLegacyToken(account string) (string, error) {
@@ -1212,6 +1550,18 @@ func resolveProfile() error {
 			cfg.Board = board
 		}
 	}
+	if graceRaw, ok := p.Extra["delete_grace"]; ok {
+		var grace string
+		if json.Unmarshal(graceRaw, &grace) == nil && grace != "" && os.Getenv("FIZZY_DELETE_GRACE") == "" {
+			cfg.DeleteGrace = grace
+		}
+	}
+	for name, value := range profileHeaders(p) {
+		if cfg.Headers == nil {
+			cfg.Headers = map[string]string{}
+		}
+		cfg.Headers[name] = value
+	}
 	return nil
 }
 
@@ -1222,7 +1572,7 @@ func profileEnvVar() string {
 		return v
 	}
 	if v := os.Getenv("FIZZY_ACCOUNT"); v != "" {
-		fmt.Fprintln(os.Stderr, "Warning: FIZZY_ACCOUNT is deprecated, use FIZZY_PROFILE instead")
+		warnDeprecated("FIZZY_ACCOUNT", "FIZZY_ACCOUNT is deprecated, use FIZZY_PROFILE instead")
 		return v
 	}
 	return ""
@@ -1239,6 +1589,9 @@ func resolveToken() {
 			// Try profile-scoped token first
 			if t, err := credsLoadProfileToken(profileName); err == nil && t != "" {
 				cfg.Token = t
+				if s, err := credsLoadSecondaryToken(profileName); err == nil {
+					cfg.SecondaryToken = s
+				}
 			} else {
 				// Legacy migration: old keys → profile-scoped key
 				migrateLegacyToken(profileName)
@@ -1253,10 +1606,16 @@ func resolveToken() {
 	// 3. env var (overrides credstore)
 	if t := os.Getenv("FIZZY_TOKEN"); t != "" {
 		cfg.Token = t
+		cfg.SecondaryToken = ""
 	}
 	// 4. CLI flag (overrides everything)
 	if cfgToken != "" {
 		cfg.Token = cfgToken
+		cfg.SecondaryToken = ""
+	}
+	// A secondary token from the environment pairs with whichever primary won.
+	if t := os.Getenv("FIZZY_SECONDARY_TOKEN"); t != "" {
+		cfg.SecondaryToken = t
 	}
 }
 
@@ -1364,6 +1723,7 @@ func SetTestConfig(token, account, apiURL string) {
 
 // ResetTestMode resets the test mode configuration.
 func ResetTestMode() {
+	i18n.SetLocale(i18n.DefaultLocale)
 	clientFactory = nil
 	sdk = nil
 	sdkAccount = nil
@@ -1382,8 +1742,23 @@ func ResetTestMode() {
 	cfgStyled = false
 	cfgMarkdown = false
 	cfgLimit = 0
+	cfgWidth = 0
+	render.SetWidth(0)
 	cfgJQ = ""
+	cfgFields = ""
+	cfgNDJSON = false
+	cfgTemplate = ""
+	outputTemplate = nil
+	cfgGHA = false
 	cfgProfile = ""
+	cfgOutputFile = ""
+	cfgNotify = false
+	cfgCompat = ""
+	cfgExplain = false
+	compatVersion = schemaVersion
+	deprecations = nil
+	apiLimiter = nil
+	discardOutputFile()
 }
 
 // GetRootCmd returns the root command for testing.
@@ -1,12 +1,15 @@
 package commands
 
 import (
+	"errors"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/client"
 	"github.com/spf13/cobra"
 )
 
@@ -71,3 +74,63 @@ func TestPrintHumanErrorUsesCommandSpecificHelp(t *testing.T) {
 		t.Fatalf("expected root usage hint to be omitted, got:\n%s", out)
 	}
 }
+
+func TestTLSClientOptions(t *testing.T) {
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	opts, err := transportClientOptions()
+	if err != nil || opts != nil {
+		t.Fatalf("expected no options without TLS settings, got %v, %v", opts, err)
+	}
+
+	cfg.InsecureSkipVerify = true
+	opts, err = transportClientOptions()
+	if err != nil || len(opts) != 1 {
+		t.Fatalf("expected a transport option, got %v, %v", opts, err)
+	}
+
+	cfg.CACert = filepath.Join(t.TempDir(), "missing.pem")
+	_, err = transportClientOptions()
+	var outErr *output.Error
+	if !errors.As(err, &outErr) || outErr.Code != output.CodeUsage {
+		t.Fatalf("expected usage error for unreadable CA, got %v", err)
+	}
+
+	if check := checkDoctorTLS(); check.Status != "fail" {
+		t.Errorf("expected failing TLS doctor check, got %s", check.Status)
+	}
+
+	if err := configureHTTPClient(client.New(cfg.APIURL, cfg.Token, "")); !errors.As(err, &outErr) || outErr.Code != output.CodeUsage {
+		t.Errorf("expected usage error configuring the legacy client, got %v", err)
+	}
+	if err := verifyAccountAccess("1", "2"); !errors.As(err, &outErr) || outErr.Code != output.CodeUsage {
+		t.Errorf("expected usage error verifying account access, got %v", err)
+	}
+}
+
+func TestRequestLimits(t *testing.T) {
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	if transport, err := httpTransport(); err != nil || transport != nil {
+		t.Fatalf("expected no transport without limits, got %v, %v", transport, err)
+	}
+	if got := maxParallel(8); got != 8 {
+		t.Errorf("expected concurrency unchanged without limits, got %d", got)
+	}
+
+	cfg.MaxParallelRequests = 2
+	cfg.RequestsPerSecond = 5
+	transport, err := httpTransport()
+	if err != nil || transport == nil {
+		t.Fatalf("expected a limiting transport, got %v, %v", transport, err)
+	}
+	limiter := requestLimiter()
+	if limiter == nil || requestLimiter() != limiter {
+		t.Error("expected one limiter shared by every client")
+	}
+	if got := maxParallel(8); got != 2 {
+		t.Errorf("expected concurrency capped at max_parallel_requests, got %d", got)
+	}
+}
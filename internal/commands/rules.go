@@ -0,0 +1,378 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/state"
+	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// rulesHandledRetention is how long processed events are remembered, so
+// a redelivered event isn't acted on twice.
+const rulesHandledRetention = 30 * 24 * time.Hour
+
+var rulesCmd = &cobra.Command{
+	Use:   "rules",
+	Short: "Automate cards with local rules",
+	Long:  "Commands for applying local automation rules to Fizzy events.",
+}
+
+// automationRuleSet is a rules file for 'rules process'.
+type automationRuleSet struct {
+	Rules []automationRule `yaml:"rules"`
+}
+
+// automationRule acts on the card of each event it matches.
+type automationRule struct {
+	Name    string            `yaml:"name"`
+	On      []string          `yaml:"on"`
+	Board   string            `yaml:"board"`
+	Match   string            `yaml:"match"`
+	Actions automationActions `yaml:"actions"`
+
+	match filterExpr
+}
+
+// automationActions is what a rule does to the event's card.
+type automationActions struct {
+	Tag     string `yaml:"tag"`
+	Comment string `yaml:"comment"`
+	Assign  string `yaml:"assign"`
+	Column  string `yaml:"column"`
+	Notify  bool   `yaml:"notify"`
+}
+
+type rulesState struct {
+	Handled map[string]time.Time `json:"handled"`
+}
+
+// Rules process flags
+var rulesProcessRules string
+var rulesProcessDryRun bool
+
+var rulesProcessCmd = &cobra.Command{
+	Use:   "process FILE",
+	Short: "Apply rules to events read from a file or stdin",
+	Long: `Reads Fizzy events as JSON from FILE, or from stdin with -, and applies the
+actions of every rule in --rules that matches, so automation can be composed
+from a webhook relay, 'fizzy activity list --ndjson', or any other source.
+Input may be one event, an array of events, or one event per line, and each
+event is acted on as soon as it is read, so a live stream can be piped in.
+
+Events have the shape of webhook payloads and activities: an action, the
+eventable (a card, or a comment with its card), the board, and the creator.
+
+  rules:
+    - name: triage-crashes
+      on: [card_published]          # event actions; any action if empty
+      board: 03f5v9zjysoy0fqs9yg0ei3hq
+      match: 'eventable.title contains "crash"'
+      actions:
+        tag: bug
+        column: Investigating       # ID, name, or maybe/not-now/done
+        assign: USER_ID
+        comment: "Filed by {creator}; tagged by {rule}."
+        notify: true
+
+match is a --filter expression evaluated against the event. Comments are
+Markdown and may use {rule}, {action}, {card}, and {creator}. Tagging and
+assigning are skipped when the card already has the tag or assignee.
+
+Each event is acted on once per rule: event IDs are remembered for 30 days,
+so redelivered events are skipped. --dry-run reports what would be done
+without acting or recording anything.`,
+	Example: `  fizzy rules process - --rules rules.yaml < event.json
+  fizzy activity list --board BOARD_ID --ndjson | fizzy rules process - --rules rules.yaml --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+		if rulesProcessRules == "" {
+			return newRequiredFlagError("rules")
+		}
+		rules, err := loadAutomationRules(rulesProcessRules)
+		if err != nil {
+			return err
+		}
+
+		var input io.Reader
+		if args[0] == "-" {
+			input = cmd.InOrStdin()
+		} else {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return errors.NewInvalidArgsError(fmt.Sprintf("cannot read events: %v", err))
+			}
+			defer f.Close()
+			input = f
+		}
+
+		key := rulesStateKey()
+		var st rulesState
+		if _, err := state.Load(key, &st); err != nil {
+			return errors.NewError(fmt.Sprintf("Could not read rules state: %v", err))
+		}
+		if st.Handled == nil {
+			st.Handled = map[string]time.Time{}
+		}
+		now := time.Now().UTC()
+		for id, at := range st.Handled {
+			if now.Sub(at) > rulesHandledRetention {
+				delete(st.Handled, id)
+			}
+		}
+
+		// Events are acted on as they are read, so a stream from a relay is
+		// handled live, and the state is saved after each one acted on so an
+		// interrupted run doesn't act on them again.
+		var results []any
+		var failed []string
+		notify := 0
+		events, err := readRuleEvents(input, func(event map[string]any) error {
+			applied := false
+			for _, rule := range rules {
+				if !ruleMatches(rule, event) {
+					continue
+				}
+				result := map[string]any{
+					"event":  getStringField(event, "id"),
+					"action": getStringField(event, "action"),
+					"rule":   rule.Name,
+				}
+				number := eventCardNumber(event)
+				result["card"] = number
+				handledKey := getStringField(event, "id") + "/" + rule.Name
+				switch {
+				case number == "":
+					result["status"] = "skipped: no card"
+				case getStringField(event, "id") != "" && !st.Handled[handledKey].IsZero():
+					result["status"] = "skipped: already handled"
+				case rulesProcessDryRun:
+					result["status"] = "would apply"
+				default:
+					if err := ruleAct(cmd.Context(), rule, event, number); err != nil {
+						result["status"] = "failed"
+						failed = append(failed, fmt.Sprintf("#%s %s: %v", number, rule.Name, err))
+						break
+					}
+					result["status"] = "applied"
+					if getStringField(event, "id") != "" {
+						st.Handled[handledKey] = now
+						applied = true
+					}
+					if rule.Actions.Notify {
+						notify++
+					}
+				}
+				results = append(results, result)
+			}
+			if applied {
+				if err := state.Save(key, st); err != nil {
+					return errors.NewError(fmt.Sprintf("Could not save rules state: %v", err))
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if notify > 0 {
+			desktopNotify("fizzy: rules", fmt.Sprintf("Rules acted on %d %s", notify, pluralize(notify, "event", "events")))
+		}
+
+		summary := fmt.Sprintf("%d %s, %d %s matched", events, pluralize(events, "event", "events"), len(results), pluralize(len(results), "rule", "rules"))
+		if rulesProcessDryRun {
+			summary += " (dry run; nothing was done)"
+		}
+		printList(results, rulesProcessColumns, summary, []Breadcrumb{
+			breadcrumb("show", "fizzy card show <number>", "View a card"),
+		})
+
+		if len(failed) > 0 {
+			return errors.NewPartialFailureError(fmt.Sprintf("%d %s failed: %s", len(failed), pluralize(len(failed), "rule", "rules"), strings.Join(failed, "; ")))
+		}
+		return nil
+	},
+}
+
+// loadAutomationRules reads and validates a rules file.
+func loadAutomationRules(path string) ([]automationRule, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.NewInvalidArgsError(fmt.Sprintf("cannot read --rules: %v", err))
+	}
+	var set automationRuleSet
+	if err := yaml.Unmarshal(content, &set); err != nil {
+		return nil, errors.NewInvalidArgsError(fmt.Sprintf("invalid rules file %s: %v", path, err))
+	}
+	if len(set.Rules) == 0 {
+		return nil, errors.NewInvalidArgsError(fmt.Sprintf("rules file %s has no rules", path))
+	}
+
+	names := map[string]bool{}
+	rules := make([]automationRule, 0, len(set.Rules))
+	for i, rule := range set.Rules {
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("rule-%d", i+1)
+		}
+		if names[rule.Name] {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("duplicate rule name %q in %s", rule.Name, path))
+		}
+		names[rule.Name] = true
+		if rule.Actions == (automationActions{}) {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("rule %s has no actions (use tag, comment, assign, column, or notify)", rule.Name))
+		}
+		if rule.match, err = parseFilter(rule.Match); err != nil {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("invalid match in rule %s: %v", rule.Name, strings.TrimPrefix(err.Error(), "invalid --filter: ")))
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// readRuleEvents decodes one event, an array of events, or one event per
+// line from r, calling each for every event as soon as it is read. It
+// returns the number of events.
+func readRuleEvents(r io.Reader, each func(map[string]any) error) (int, error) {
+	count := 0
+	decoder := json.NewDecoder(r)
+	for {
+		var value any
+		if err := decoder.Decode(&value); err == io.EOF {
+			break
+		} else if err != nil {
+			return count, errors.NewInvalidArgsError(fmt.Sprintf("invalid event JSON: %v", err))
+		}
+		items, ok := value.([]any)
+		if !ok {
+			items = []any{value}
+		}
+		for _, item := range items {
+			event, ok := item.(map[string]any)
+			if !ok {
+				return count, errors.NewInvalidArgsError("each event must be a JSON object")
+			}
+			count++
+			if err := each(event); err != nil {
+				return count, err
+			}
+		}
+	}
+	if count == 0 {
+		return 0, errors.NewInvalidArgsError("no events in the input")
+	}
+	return count, nil
+}
+
+// ruleMatches reports whether an event's action, board, and fields match a
+// rule.
+func ruleMatches(rule automationRule, event map[string]any) bool {
+	if len(rule.On) > 0 && !slices.Contains(rule.On, getStringField(event, "action")) {
+		return false
+	}
+	if rule.Board != "" {
+		board, _ := event["board"].(map[string]any)
+		if getStringField(board, "id") != rule.Board && !strings.EqualFold(getStringField(board, "name"), rule.Board) {
+			return false
+		}
+	}
+	return rule.match == nil || rule.match.match(event)
+}
+
+// eventCardNumber returns the number of the card an event is about: the
+// eventable itself, or the card of a comment.
+func eventCardNumber(event map[string]any) string {
+	eventable, _ := event["eventable"].(map[string]any)
+	if eventable != nil && eventable["number"] != nil {
+		return fmt.Sprintf("%v", eventable["number"])
+	}
+	for _, parent := range []any{eventable["card"], event["card"]} {
+		if card, ok := parent.(map[string]any); ok && card["number"] != nil {
+			return fmt.Sprintf("%v", card["number"])
+		}
+	}
+	return ""
+}
+
+// ruleAct applies a rule's actions to a card. Notifications are sent once
+// per run by the caller.
+func ruleAct(ctx context.Context, rule automationRule, event map[string]any, number string) error {
+	ac := getSDK()
+	var card map[string]any
+	if rule.Actions.Tag != "" || rule.Actions.Assign != "" {
+		// Tagging and assigning toggle, so check the card first.
+		data, _, err := ac.Cards().Get(ctx, number)
+		if err != nil {
+			return convertSDKError(err)
+		}
+		card, _ = normalizeAny(data).(map[string]any)
+	}
+
+	if rule.Actions.Tag != "" && !slaHasTag(card, rule.Actions.Tag) {
+		if _, err := ac.Cards().Tag(ctx, number, &generated.TagCardRequest{TagTitle: rule.Actions.Tag}); err != nil {
+			return convertSDKError(err)
+		}
+	}
+	if rule.Actions.Assign != "" && !ruleHasAssignee(card, rule.Actions.Assign) {
+		if _, err := ac.Cards().Assign(ctx, number, &generated.AssignCardRequest{AssigneeId: rule.Actions.Assign}); err != nil {
+			return convertSDKError(err)
+		}
+	}
+	if rule.Actions.Column != "" {
+		// The card may have moved boards since the event, so resolve the
+		// column against the board it's on now.
+		var boardID string
+		if card != nil {
+			board, _ := card["board"].(map[string]any)
+			boardID = getStringField(board, "id")
+		}
+		if err := moveCardToColumn(ctx, ac, number, boardID, rule.Actions.Column); err != nil {
+			return err
+		}
+	}
+	if rule.Actions.Comment != "" {
+		creator, _ := event["creator"].(map[string]any)
+		body := strings.NewReplacer(
+			"{rule}", rule.Name,
+			"{action}", getStringField(event, "action"),
+			"{card}", number,
+			"{creator}", getStringField(creator, "name"),
+		).Replace(rule.Actions.Comment)
+		if _, _, err := ac.Comments().Create(ctx, number, &generated.CreateCommentRequest{Body: markdownToHTML(body)}); err != nil {
+			return convertSDKError(err)
+		}
+	}
+	return nil
+}
+
+func ruleHasAssignee(card map[string]any, user string) bool {
+	for _, assignee := range toMaps(card["assignees"]) {
+		if getStringField(assignee, "id") == user {
+			return true
+		}
+	}
+	return false
+}
+
+func rulesStateKey() string {
+	return "rules/" + cfg.Account
+}
+
+func init() {
+	rulesProcessCmd.Flags().StringVar(&rulesProcessRules, "rules", "", "Rules file (YAML, required)")
+	rulesProcessCmd.Flags().BoolVar(&rulesProcessDryRun, "dry-run", false, "Report what would be done without doing it")
+	rulesCmd.AddCommand(rulesProcessCmd)
+	rootCmd.AddCommand(rulesCmd)
+}
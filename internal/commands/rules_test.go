@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/state"
+)
+
+func TestRulesProcess(t *testing.T) {
+	dir := t.TempDir()
+	rulesFile := filepath.Join(dir, "rules.yaml")
+	rules := `rules:
+  - name: triage-crashes
+    on: [card_published]
+    board: Support
+    match: 'eventable.title contains "crash"'
+    actions:
+      tag: bug
+      comment: "Thanks {creator}, tagged by {rule}"
+  - name: thank-commenters
+    on: [comment_created]
+    actions:
+      notify: true
+`
+	if err := os.WriteFile(rulesFile, []byte(rules), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	events := `{"id":"e1","action":"card_published","board":{"id":"b1","name":"Support"},"creator":{"name":"Ann"},"eventable":{"number":7,"title":"App crash on launch"}}
+{"id":"e2","action":"card_published","board":{"id":"b1","name":"Support"},"eventable":{"number":8,"title":"Typo"}}
+{"id":"e3","action":"comment_created","board":{"id":"b1","name":"Support"},"eventable":{"id":"c1","card":{"number":7}}}
+`
+
+	mock := NewMockClient()
+	mock.OnGet("/cards/7", &client.APIResponse{StatusCode: 200, Data: map[string]any{"number": float64(7), "tags": []any{}}})
+	mock.PostResponse = &client.APIResponse{StatusCode: 201, Data: map[string]any{}}
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	var notified []string
+	defer func(orig func(string, string)) { desktopNotify = orig }(desktopNotify)
+	desktopNotify = func(title, body string) { notified = append(notified, body) }
+
+	rulesProcessRules = rulesFile
+	defer func() { rulesProcessRules = "" }()
+
+	t.Run("dry run reports matches without acting", func(t *testing.T) {
+		rulesProcessDryRun = true
+		rulesProcessCmd.SetIn(strings.NewReader(events))
+		err := rulesProcessCmd.RunE(rulesProcessCmd, []string{"-"})
+		rulesProcessDryRun = false
+		assertExitCode(t, err, 0)
+
+		items := toMaps(result.Response.Data)
+		if len(items) != 2 || len(mock.PostCalls) != 0 || len(notified) != 0 {
+			t.Fatalf("expected 2 matches and no actions, got %v, %d posts", items, len(mock.PostCalls))
+		}
+		if items[0]["rule"] != "triage-crashes" || items[0]["card"] != "7" || items[1]["rule"] != "thank-commenters" || items[1]["card"] != "7" {
+			t.Errorf("unexpected matches: %v", items)
+		}
+	})
+
+	t.Run("applies actions once per event", func(t *testing.T) {
+		rulesProcessCmd.SetIn(strings.NewReader(events))
+		err := rulesProcessCmd.RunE(rulesProcessCmd, []string{"-"})
+		assertExitCode(t, err, 0)
+
+		var tagged, commented bool
+		for _, call := range mock.PostCalls {
+			body, _ := call.Body.(map[string]any)
+			switch call.Path {
+			case "/cards/7/taggings.json":
+				tagged = body["tag_title"] == "bug"
+			case "/cards/7/comments.json":
+				commented = strings.Contains(body["body"].(string), "Thanks Ann, tagged by triage-crashes")
+			}
+		}
+		if !tagged || !commented {
+			t.Errorf("expected card 7 tagged and commented on, got %v", mock.PostCalls)
+		}
+		if len(notified) != 1 {
+			t.Errorf("expected one notification, got %v", notified)
+		}
+
+		posts := len(mock.PostCalls)
+		rulesProcessCmd.SetIn(strings.NewReader(events))
+		err = rulesProcessCmd.RunE(rulesProcessCmd, []string{"-"})
+		assertExitCode(t, err, 0)
+		if len(mock.PostCalls) != posts {
+			t.Errorf("expected redelivered events to be skipped, got %d more posts", len(mock.PostCalls)-posts)
+		}
+		for _, item := range toMaps(result.Response.Data) {
+			if item["status"] != "skipped: already handled" {
+				t.Errorf("expected already handled, got %v", item)
+			}
+		}
+	})
+
+	t.Run("records each event as soon as it is acted on", func(t *testing.T) {
+		mock.OnGet("/cards/9", &client.APIResponse{StatusCode: 200, Data: map[string]any{"number": float64(9), "tags": []any{}}})
+		stream := `{"id":"e4","action":"card_published","board":{"id":"b1","name":"Support"},"eventable":{"number":9,"title":"Crash on save"}}
+{"id":"e5", truncated`
+		rulesProcessCmd.SetIn(strings.NewReader(stream))
+		err := rulesProcessCmd.RunE(rulesProcessCmd, []string{"-"})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+
+		var st rulesState
+		if _, err := state.Load(rulesStateKey(), &st); err != nil {
+			t.Fatal(err)
+		}
+		if st.Handled["e4/triage-crashes"].IsZero() {
+			t.Errorf("expected e4 recorded before the bad input, got %v", st.Handled)
+		}
+	})
+
+	t.Run("rejects rules without actions", func(t *testing.T) {
+		bad := filepath.Join(dir, "bad.yaml")
+		if err := os.WriteFile(bad, []byte("rules:\n  - name: nothing\n    on: [card_closed]\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		rulesProcessRules = bad
+		rulesProcessCmd.SetIn(strings.NewReader(events))
+		err := rulesProcessCmd.RunE(rulesProcessCmd, []string{"-"})
+		rulesProcessRules = rulesFile
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
@@ -1,10 +1,11 @@
 package commands
 
 import (
-	"errors"
+	stderrors "errors"
 	"net"
 
 	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/errors"
 	fizzy "github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
 )
 
@@ -15,7 +16,7 @@ func convertSDKError(err error) error {
 		return nil
 	}
 
-	if errors.Is(err, fizzy.ErrCircuitOpen) {
+	if stderrors.Is(err, fizzy.ErrCircuitOpen) {
 		return &output.Error{
 			Code:      output.CodeAPI,
 			Message:   "Service temporarily unavailable (circuit breaker open)",
@@ -23,7 +24,7 @@ func convertSDKError(err error) error {
 			Retryable: true,
 		}
 	}
-	if errors.Is(err, fizzy.ErrBulkheadFull) {
+	if stderrors.Is(err, fizzy.ErrBulkheadFull) {
 		return &output.Error{
 			Code:      output.CodeAPI,
 			Message:   "Too many concurrent requests",
@@ -31,7 +32,7 @@ func convertSDKError(err error) error {
 			Retryable: true,
 		}
 	}
-	if errors.Is(err, fizzy.ErrRateLimited) {
+	if stderrors.Is(err, fizzy.ErrRateLimited) {
 		return &output.Error{
 			Code:      output.CodeRateLimit,
 			Message:   "Rate limit exceeded",
@@ -41,7 +42,7 @@ func convertSDKError(err error) error {
 	}
 
 	var sdkErr *fizzy.Error
-	if errors.As(err, &sdkErr) {
+	if stderrors.As(err, &sdkErr) {
 		e := &output.Error{
 			Code:       mapSDKCode(sdkErr.Code),
 			Message:    sdkErr.Message,
@@ -53,12 +54,26 @@ func convertSDKError(err error) error {
 		if sdkErr.Code == fizzy.CodeAuth && e.Hint == "" {
 			e.Hint = "Run 'fizzy auth login TOKEN' or set FIZZY_TOKEN"
 		}
+		// The SDK reports conflicts and locks as generic API errors.
+		var specific *output.Error
+		switch sdkErr.HTTPStatus {
+		case 409:
+			specific = errors.NewConflictError(sdkErr.Message)
+		case 423:
+			specific = errors.NewLockedError(sdkErr.Message)
+		}
+		if specific != nil {
+			e.Code, e.Retryable = specific.Code, specific.Retryable
+			if e.Hint == "" {
+				e.Hint = specific.Hint
+			}
+		}
 		return e
 	}
 
 	// Catch raw network errors that weren't wrapped by the SDK
 	var netErr net.Error
-	if errors.As(err, &netErr) {
+	if stderrors.As(err, &netErr) {
 		return &output.Error{
 			Code:      output.CodeNetwork,
 			Message:   netErr.Error(),
@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	fizzy "github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+)
+
+func TestConvertSDKError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       *fizzy.Error
+		code      string
+		exit      int
+		retryable bool
+	}{
+		{"conflict", &fizzy.Error{Code: fizzy.CodeAPI, Message: "Card was changed", HTTPStatus: 409}, errors.CodeConflict, errors.ExitConflict, false},
+		{"locked", &fizzy.Error{Code: fizzy.CodeAPI, Message: "Board is locked", HTTPStatus: 423}, errors.CodeLocked, errors.ExitLocked, true},
+		{"rate limited", &fizzy.Error{Code: fizzy.CodeRateLimit, Message: "Rate limited", HTTPStatus: 429, Retryable: true}, output.CodeRateLimit, errors.ExitRateLimit, true},
+		{"other API errors", &fizzy.Error{Code: fizzy.CodeAPI, Message: "Teapot", HTTPStatus: 418}, output.CodeAPI, errors.ExitAPI, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := output.AsError(convertSDKError(tt.err))
+			if e.Code != tt.code || e.Retryable != tt.retryable || e.Message != tt.err.Message {
+				t.Errorf("expected %s (retryable=%v), got %+v", tt.code, tt.retryable, e)
+			}
+			if got := errors.ExitCodeOf(e); got != tt.exit {
+				t.Errorf("expected exit code %d, got %d", tt.exit, got)
+			}
+			if (tt.code == errors.CodeConflict || tt.code == errors.CodeLocked) && e.Hint == "" {
+				t.Error("expected a hint")
+			}
+		})
+	}
+}
@@ -1,12 +1,23 @@
 package commands
 
 import (
+	"context"
 	"fmt"
+	"net/url"
 	"strings"
+	"sync"
 
 	"github.com/spf13/cobra"
 )
 
+// Search flags
+var searchFilter string
+var searchTitleMatch string
+var searchTitleGlob string
+var searchAccounts string
+var searchRecent bool
+var searchShare bool
+
 var searchCmd = &cobra.Command{
 	Use:   "search QUERY...",
 	Short: "Search cards",
@@ -16,22 +27,80 @@ The query is sent as a single string. If the query exactly matches a card ID,
 that card is returned directly.
 
 To filter cards by structured criteria (board, tag, assignee, status, etc.),
-use 'fizzy card list' with --search and the relevant filter flags.`,
-	Args: cobra.MinimumNArgs(1),
+use 'fizzy card list' with --search and the relevant filter flags. Use --filter
+to narrow results client-side with an expression such as
+'tags contains "bug" and closed == false', or match titles exactly with
+--title-match (regex) or --title-glob (* and ? wildcards).
+
+Use --accounts all to search every account your token can access at once, or
+--accounts with a comma-separated list of account slugs. Each result is tagged
+with the account it came from.
+
+Queries are remembered per account: --recent lists the latest ones, and shell
+completion suggests them by prefix.`,
+	Example: `  fizzy search "login error"
+  fizzy search --accounts all "invoice export"
+  fizzy search --recent`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if searchRecent {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.MinimumNArgs(1)(cmd, args)
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 || cfg == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return recentSearchSuggestions(toComplete), cobra.ShellCompDirectiveNoFileComp
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if searchRecent {
+			return printRecentSearches()
+		}
 		if err := requireAuthAndAccount(); err != nil {
 			return err
 		}
 
+		filter, err := parseFilter(searchFilter)
+		if err != nil {
+			return err
+		}
+		titleFilter, err := titlePatternFilter(searchTitleMatch, searchTitleGlob)
+		if err != nil {
+			return err
+		}
+		filter = combineFilters(filter, titleFilter)
+
 		query := strings.Join(args, " ")
+		if searchShare {
+			printShare(cmd, args, "/search?q="+url.QueryEscape(query), nil)
+			return nil
+		}
 
-		ac := getSDK()
-		raw, _, err := ac.Search().Search(cmd.Context(), &query)
-		if err != nil {
-			return convertSDKError(err)
+		var results any
+		columns := searchColumns
+		if searchAccounts != "" {
+			slugs, err := searchAccountSlugs(cmd.Context(), searchAccounts)
+			if err != nil {
+				return err
+			}
+			results, err = searchAcrossAccounts(cmd.Context(), slugs, query)
+			if err != nil {
+				return err
+			}
+			columns = searchAccountsColumns
+		} else {
+			ac := getSDK()
+			raw, _, err := ac.Search().Search(cmd.Context(), &query)
+			if err != nil {
+				return convertSDKError(err)
+			}
+			results = normalizeAny(raw)
 		}
 
-		items := normalizeAny(raw)
+		rememberRecent("search", query)
+
+		items := applyFilter(results, filter)
 		count := dataCount(items)
 		summary := fmt.Sprintf("%d results for %q", count, query)
 
@@ -40,11 +109,106 @@ use 'fizzy card list' with --search and the relevant filter flags.`,
 			breadcrumb("filter", fmt.Sprintf("fizzy card list --search %q --board <id>", query), "Filter cards by criteria"),
 		}
 
-		printList(items, searchColumns, summary, breadcrumbs)
+		printList(items, columns, summary, breadcrumbs)
 		return nil
 	},
 }
 
+// printRecentSearches lists remembered search queries, most recent first.
+func printRecentSearches() error {
+	queries := loadRecent()["search"]
+	items := make([]any, 0, len(queries))
+	for _, query := range queries {
+		items = append(items, map[string]any{"query": query})
+	}
+
+	breadcrumbs := []Breadcrumb{
+		breadcrumb("search", "fizzy search <query>", "Run a search"),
+	}
+	printList(items, recentSearchColumns, fmt.Sprintf("%d recent searches", len(items)), breadcrumbs)
+	return nil
+}
+
+// recentSearchSuggestions returns remembered queries starting with prefix,
+// ignoring case.
+func recentSearchSuggestions(prefix string) []string {
+	var suggestions []string
+	for _, query := range loadRecent()["search"] {
+		if strings.HasPrefix(strings.ToLower(query), strings.ToLower(prefix)) {
+			suggestions = append(suggestions, query)
+		}
+	}
+	return suggestions
+}
+
+// searchAccountSlugs resolves the --accounts value, either "all" or a
+// comma-separated list of slugs, IDs, or names, to account slugs.
+func searchAccountSlugs(ctx context.Context, value string) ([]string, error) {
+	accounts, err := fetchIdentityAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var slugs []string
+	if strings.TrimSpace(value) == "all" {
+		for _, a := range accounts {
+			slugs = append(slugs, a.Slug)
+		}
+		return slugs, nil
+	}
+	for _, arg := range strings.Split(value, ",") {
+		if strings.TrimSpace(arg) == "" {
+			continue
+		}
+		account, err := matchAccount(accounts, arg)
+		if err != nil {
+			return nil, err
+		}
+		slugs = append(slugs, account.Slug)
+	}
+	if len(slugs) == 0 {
+		return nil, newRequiredFlagError("accounts")
+	}
+	return slugs, nil
+}
+
+// searchAcrossAccounts runs query against each account concurrently and
+// merges the results in account order, tagging each with its account slug.
+func searchAcrossAccounts(ctx context.Context, slugs []string, query string) ([]any, error) {
+	results := make([][]any, len(slugs))
+	errs := make([]error, len(slugs))
+	var wg sync.WaitGroup
+	for i, slug := range slugs {
+		wg.Go(func() {
+			raw, _, err := getSDKClient().ForAccount(slug).Search().Search(ctx, &query)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			for _, item := range toMaps(normalizeAny(raw)) {
+				item["account"] = slug
+				results[i] = append(results[i], item)
+			}
+		})
+	}
+	wg.Wait()
+
+	merged := []any{}
+	for i := range slugs {
+		if errs[i] != nil {
+			return nil, convertSDKError(errs[i])
+		}
+		merged = append(merged, results[i]...)
+	}
+	return merged, nil
+}
+
 func init() {
+	searchCmd.Flags().StringVar(&searchFilter, "filter", "", "Filter results client-side with an expression (e.g. 'tags contains \"bug\"')")
+	searchCmd.Flags().StringVar(&searchTitleMatch, "title-match", "", "Only show results whose title matches a regular expression")
+	searchCmd.Flags().StringVar(&searchAccounts, "accounts", "", "Search several accounts: 'all' or a comma-separated list of account slugs")
+	searchCmd.Flags().BoolVar(&searchShare, "share", false, shareFlagUsage)
+	searchCmd.Flags().BoolVar(&searchRecent, "recent", false, "List your recent search queries")
+	searchCmd.Flags().StringVar(&searchTitleGlob, "title-glob", "", "Only show results whose title matches a glob such as 'Release *' (case-insensitive)")
 	rootCmd.AddCommand(searchCmd)
 }
@@ -102,6 +102,31 @@ func TestSearch(t *testing.T) {
 		}
 	})
 
+	t.Run("narrows results with --title-glob", func(t *testing.T) {
+		mock := NewMockClient()
+		mock.GetWithPaginationResponse = &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"number": float64(1), "title": "Release 2.0"},
+				map[string]any{"number": float64(2), "title": "Plan the release"},
+			},
+		}
+
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		searchTitleGlob = "Release *"
+		err := searchCmd.RunE(searchCmd, []string{"release"})
+		searchTitleGlob = ""
+		assertExitCode(t, err, 0)
+
+		items := toMaps(result.Response.Data)
+		if len(items) != 1 || items[0]["number"] != float64(1) {
+			t.Errorf("expected only card #1, got %v", items)
+		}
+	})
+
 	t.Run("requires at least one arg", func(t *testing.T) {
 		if err := searchCmd.Args(searchCmd, []string{}); err == nil {
 			t.Error("expected error when no query args provided")
@@ -130,3 +155,71 @@ func TestSearch(t *testing.T) {
 		assertExitCode(t, err, errors.ExitNotFound)
 	})
 }
+
+func TestSearchAccounts(t *testing.T) {
+	mock := NewMockClient()
+	mock.OnGet("/my/identity.json", identityWithAccounts())
+	mock.OnGet("/search.json?q=bug", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"id": "1", "number": float64(42), "title": "Bug fix"},
+	}})
+
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "acme", "https://api.example.com")
+	defer resetTest()
+
+	t.Run("all searches every account and tags results", func(t *testing.T) {
+		searchAccounts = "all"
+		defer func() { searchAccounts = "" }()
+
+		err := searchCmd.RunE(searchCmd, []string{"bug"})
+		assertExitCode(t, err, 0)
+
+		items := result.Response.Data.([]any)
+		if len(items) != 2 {
+			t.Fatalf("expected 2 results, got %d", len(items))
+		}
+		if got := items[0].(map[string]any)["account"]; got != "acme" {
+			t.Errorf("expected first result from acme, got %v", got)
+		}
+		if got := items[1].(map[string]any)["account"]; got != "globex" {
+			t.Errorf("expected second result from globex, got %v", got)
+		}
+	})
+
+	t.Run("unknown account is rejected", func(t *testing.T) {
+		searchAccounts = "acme,initech"
+		defer func() { searchAccounts = "" }()
+
+		err := searchCmd.RunE(searchCmd, []string{"bug"})
+		assertExitCode(t, err, errors.ExitNotFound)
+	})
+}
+
+func TestSearchRecent(t *testing.T) {
+	mock := NewMockClient()
+	mock.GetWithPaginationResponse = &client.APIResponse{StatusCode: 200, Data: []any{}}
+
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	for _, query := range []string{"login error", "invoice", "login timeout"} {
+		assertExitCode(t, searchCmd.RunE(searchCmd, []string{query}), 0)
+	}
+
+	searchRecent = true
+	defer func() { searchRecent = false }()
+
+	err := searchCmd.RunE(searchCmd, []string{})
+	assertExitCode(t, err, 0)
+
+	items := result.Response.Data.([]any)
+	if len(items) != 3 || items[0].(map[string]any)["query"] != "login timeout" {
+		t.Fatalf("expected recent queries newest first, got %v", items)
+	}
+
+	suggestions := recentSearchSuggestions("LOG")
+	if len(suggestions) != 2 || suggestions[0] != "login timeout" || suggestions[1] != "login error" {
+		t.Errorf("unexpected suggestions: %v", suggestions)
+	}
+}
@@ -1,16 +1,20 @@
 package commands
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"path/filepath"
 	"strings"
 
 	"github.com/basecamp/cli/output"
 	"github.com/basecamp/fizzy-cli/internal/config"
 	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/i18n"
 	"github.com/basecamp/fizzy-cli/internal/tui"
 	fizzy "github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
 	"github.com/charmbracelet/huh"
 	"github.com/spf13/cobra"
 )
@@ -31,7 +35,7 @@ type Board struct {
 var setupCmd = &cobra.Command{
 	Use:   "setup",
 	Short: "Interactive setup wizard",
-	Long:  "Configure Fizzy CLI with your API token, account, and default board.\nNew users without an account will be guided through signup.",
+	Long:  "Configure Fizzy CLI with your API token, account, and default board.\nNew users without an account will be guided through signup.\nOptionally creates a starter board, installs shell completion, and installs the agent skill.",
 	RunE:  runSetup,
 }
 
@@ -49,23 +53,23 @@ func runSetup(cmd *cobra.Command, args []string) error {
 
 	aw, wait := tui.AnimateBannerAsync(cmd.ErrOrStderr())
 	fmt.Fprintln(aw)
-	fmt.Fprintln(aw, "Welcome to Fizzy CLI setup!")
+	fmt.Fprintln(aw, i18n.T("Welcome to Fizzy CLI setup!"))
 	fmt.Fprintln(aw)
 	wait()
 
 	// Ask if user has an account before checking existing config
 	var hasAccount string
 	err := huh.NewSelect[string]().
-		Title("Do you have a Fizzy account?").
+		Title(i18n.T("Do you have a Fizzy account?")).
 		Options(
-			huh.NewOption("Yes, I have an account", "yes"),
-			huh.NewOption("No, I'd like to sign up", "no"),
+			huh.NewOption(i18n.T("Yes, I have an account"), "yes"),
+			huh.NewOption(i18n.T("No, I'd like to sign up"), "no"),
 		).
 		Value(&hasAccount).
 		Run()
 
 	if err != nil {
-		fmt.Println("Setup cancelled.")
+		fmt.Println(i18n.T("Setup cancelled."))
 		return nil //nolint:nilerr // user cancelled prompt
 	}
 
@@ -79,23 +83,23 @@ func runSetup(cmd *cobra.Command, args []string) error {
 
 	if globalExists || localPath != "" {
 		var reconfigure bool
-		configLocation := "global config"
+		configLocation := i18n.T("global config")
 		if localPath != "" {
-			configLocation = "local config (" + localPath + ")"
+			configLocation = i18n.Tf("local config (%s)", localPath)
 		}
 
 		err = huh.NewConfirm().
-			Title(fmt.Sprintf("Existing %s found. Reconfigure?", configLocation)).
+			Title(i18n.Tf("Existing %s found. Reconfigure?", configLocation)).
 			Value(&reconfigure).
 			Run()
 
 		if err != nil {
-			fmt.Println("Setup cancelled.")
+			fmt.Println(i18n.T("Setup cancelled."))
 			return nil //nolint:nilerr // user cancelled prompt
 		}
 
 		if !reconfigure {
-			fmt.Println("Setup cancelled. Existing configuration unchanged.")
+			fmt.Println(i18n.T("Setup cancelled. Existing configuration unchanged."))
 			return nil
 		}
 	}
@@ -103,30 +107,30 @@ func runSetup(cmd *cobra.Command, args []string) error {
 	// Ask hosted vs self-hosted
 	var hostingType string
 	err = huh.NewSelect[string]().
-		Title("Are you using the hosted or self-hosted version?").
+		Title(i18n.T("Are you using the hosted or self-hosted version?")).
 		Options(
-			huh.NewOption("Hosted (app.fizzy.do)", "hosted"),
-			huh.NewOption("Self-hosted", "selfhosted"),
+			huh.NewOption(i18n.T("Hosted (app.fizzy.do)"), "hosted"),
+			huh.NewOption(i18n.T("Self-hosted"), "selfhosted"),
 		).
 		Value(&hostingType).
 		Run()
 
 	if err != nil {
-		fmt.Println("Setup cancelled.")
+		fmt.Println(i18n.T("Setup cancelled."))
 		return nil //nolint:nilerr // user cancelled prompt
 	}
 
 	apiURL := config.DefaultAPIURL
 	if hostingType == "selfhosted" {
 		err = huh.NewInput().
-			Title("Enter your Fizzy URL").
+			Title(i18n.T("Enter your Fizzy URL")).
 			Placeholder("https://fizzy.example.com").
 			Value(&apiURL).
 			Validate(validateAPIURL).
 			Run()
 
 		if err != nil {
-			fmt.Println("Setup cancelled.")
+			fmt.Println(i18n.T("Setup cancelled."))
 			return nil //nolint:nilerr // user cancelled prompt
 		}
 	}
@@ -137,8 +141,8 @@ func runSetup(cmd *cobra.Command, args []string) error {
 
 	for {
 		err = huh.NewInput().
-			Title("Enter your API token").
-			Description("Visit My Profile → Personal Access Tokens").
+			Title(i18n.T("Enter your API token")).
+			Description(i18n.T("Visit My Profile → Personal Access Tokens")).
 			Placeholder("fizzy_...").
 			Value(&token).
 			EchoMode(huh.EchoModePassword).
@@ -151,24 +155,24 @@ func runSetup(cmd *cobra.Command, args []string) error {
 			Run()
 
 		if err != nil {
-			fmt.Println("Setup cancelled.")
+			fmt.Println(i18n.T("Setup cancelled."))
 			return nil //nolint:nilerr // user cancelled prompt
 		}
 
 		// Validate token
-		fmt.Print("Validating token... ")
+		fmt.Print(i18n.T("Validating token... "))
 		accounts, err = validateToken(cmd, apiURL, token)
 		if err != nil {
 			fmt.Println("✗")
 
 			var retry bool
 			_ = huh.NewConfirm().
-				Title("Invalid token. Would you like to try again?").
+				Title(i18n.T("Invalid token. Would you like to try again?")).
 				Value(&retry).
 				Run()
 
 			if !retry {
-				fmt.Println("Setup cancelled.")
+				fmt.Println(i18n.T("Setup cancelled."))
 				return nil
 			}
 			continue
@@ -186,7 +190,7 @@ func runSetup(cmd *cobra.Command, args []string) error {
 	var selectedAccountSlug string
 	if len(accounts) == 1 {
 		selectedAccountSlug = accounts[0].Slug
-		fmt.Printf("Using account: %s (%s)\n", accounts[0].Name, accounts[0].Slug)
+		fmt.Println(i18n.Tf("Using account: %s (%s)", accounts[0].Name, accounts[0].Slug))
 	} else {
 		accountOptions := make([]huh.Option[string], len(accounts))
 		for i, acc := range accounts {
@@ -194,24 +198,24 @@ func runSetup(cmd *cobra.Command, args []string) error {
 		}
 
 		err = huh.NewSelect[string]().
-			Title("Select your account").
+			Title(i18n.T("Select your account")).
 			Options(accountOptions...).
 			Value(&selectedAccountSlug).
 			Run()
 
 		if err != nil {
-			fmt.Println("Setup cancelled.")
+			fmt.Println(i18n.T("Setup cancelled."))
 			return nil //nolint:nilerr // user cancelled prompt
 		}
 	}
 
 	// Fetch boards for selected account
-	fmt.Print("Fetching boards... ")
+	fmt.Print(i18n.T("Fetching boards... "))
 	boards, err := fetchBoards(cmd, apiURL, token, selectedAccountSlug)
 	if err != nil {
 		fmt.Println("✗")
 		// Non-fatal, just skip board selection
-		fmt.Println("Could not fetch boards. Skipping board selection.")
+		fmt.Println(i18n.T("Could not fetch boards. Skipping board selection."))
 		boards = nil
 	} else {
 		fmt.Println("✓")
@@ -221,36 +225,71 @@ func runSetup(cmd *cobra.Command, args []string) error {
 	var selectedBoardID string
 	if len(boards) > 0 {
 		boardOptions := make([]huh.Option[string], len(boards)+1)
-		boardOptions[0] = huh.NewOption("None (skip)", "")
+		boardOptions[0] = huh.NewOption(i18n.T("None (skip)"), "")
 		for i, board := range boards {
 			boardOptions[i+1] = huh.NewOption(board.Name, board.ID)
 		}
 
 		err = huh.NewSelect[string]().
-			Title("Select default board (optional)").
+			Title(i18n.T("Select default board (optional)")).
 			Options(boardOptions...).
 			Value(&selectedBoardID).
 			Run()
 
 		if err != nil {
-			fmt.Println("Setup cancelled.")
+			fmt.Println(i18n.T("Setup cancelled."))
 			return nil //nolint:nilerr // user cancelled prompt
 		}
 	}
 
+	// Optional onboarding extras
+	extras, err := promptSetupExtras(selectedBoardID == "")
+	if err != nil {
+		fmt.Println(i18n.T("Setup cancelled."))
+		return nil //nolint:nilerr // user cancelled prompt
+	}
+
+	if extras[setupExtraStarterBoard] {
+		boardName := "Getting Started"
+		err = huh.NewInput().
+			Title(i18n.T("Starter board name")).
+			Value(&boardName).
+			Validate(func(s string) error {
+				if strings.TrimSpace(s) == "" {
+					return fmt.Errorf("name is required")
+				}
+				return nil
+			}).
+			Run()
+		if err != nil {
+			fmt.Println(i18n.T("Setup cancelled."))
+			return nil //nolint:nilerr // user cancelled prompt
+		}
+
+		fmt.Print(i18n.T("Creating starter board... "))
+		board, err := createStarterBoard(cmd.Context(), apiURL, token, selectedAccountSlug, strings.TrimSpace(boardName))
+		if err != nil {
+			fmt.Println("✗")
+			fmt.Printf("Could not create starter board: %v\n", err)
+		} else {
+			fmt.Println("✓")
+			selectedBoardID = board.ID
+		}
+	}
+
 	// Ask where to save
 	var saveGlobal bool
 	err = huh.NewSelect[bool]().
-		Title("Where should we save the configuration?").
+		Title(i18n.T("Where should we save the configuration?")).
 		Options(
-			huh.NewOption("Global (~/.config/fizzy/config.yaml)", true),
-			huh.NewOption("Local (.fizzy.yaml in current directory)", false),
+			huh.NewOption(i18n.T("Global (~/.config/fizzy/config.yaml)"), true),
+			huh.NewOption(i18n.T("Local (.fizzy.yaml in current directory)"), false),
 		).
 		Value(&saveGlobal).
 		Run()
 
 	if err != nil {
-		fmt.Println("Setup cancelled.")
+		fmt.Println(i18n.T("Setup cancelled."))
 		return nil //nolint:nilerr // user cancelled prompt
 	}
 
@@ -316,6 +355,25 @@ func runSetup(cmd *cobra.Command, args []string) error {
 		fmt.Println("⚠ Remember to add .fizzy.yaml to your .gitignore to avoid committing your token!")
 	}
 
+	if extras[setupExtraCompletion] {
+		if path, err := installCompletion(cmd.Root(), detectShell()); err != nil {
+			fmt.Printf("⚠ Could not install shell completion: %v\n", err)
+		} else {
+			fmt.Printf("✓ Shell completion installed to %s\n", path)
+			if detectShell() == "zsh" {
+				fmt.Printf("  Add 'fpath=(%s $fpath)' before compinit in ~/.zshrc if it isn't already there.\n", filepath.Dir(path))
+			}
+		}
+	}
+
+	if extras[setupExtraSkill] {
+		if path, err := installSkillFiles(); err != nil {
+			fmt.Printf("⚠ Could not install agent skill: %v\n", err)
+		} else {
+			fmt.Printf("✓ Agent skill installed to %s\n", path)
+		}
+	}
+
 	// Coding agent integration
 	if err := setupAgents(cmd); err != nil {
 		return err
@@ -327,11 +385,87 @@ func runSetup(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// Optional onboarding steps offered by the setup wizard.
+const (
+	setupExtraStarterBoard = "starter-board"
+	setupExtraCompletion   = "completion"
+	setupExtraSkill        = "skill"
+)
+
+// starterColumns are created on a starter board. Every board already has the
+// Maybe?, Not Now, and Done pseudo-columns, so these cover work in progress.
+var starterColumns = []string{"Up Next", "In Progress", "Review"}
+
+// promptSetupExtras asks which optional onboarding steps to run. Steps that
+// don't apply (a board was already chosen, the shell is unknown, the skill is
+// already installed) are not offered.
+func promptSetupExtras(offerBoard bool) (map[string]bool, error) {
+	var options []huh.Option[string]
+	if offerBoard {
+		options = append(options, huh.NewOption(i18n.T("Create a starter board with columns"), setupExtraStarterBoard).Selected(true))
+	}
+	if shell := detectShell(); shell != "" {
+		options = append(options, huh.NewOption(i18n.Tf("Install %s shell completion", shell), setupExtraCompletion).Selected(true))
+	}
+	if !baselineSkillInstalled() {
+		options = append(options, huh.NewOption(i18n.T("Install the agent skill (~/.agents/skills/fizzy)"), setupExtraSkill))
+	}
+
+	chosen := map[string]bool{}
+	if len(options) == 0 {
+		return chosen, nil
+	}
+
+	var selected []string
+	err := huh.NewMultiSelect[string]().
+		Title(i18n.T("Optional extras")).
+		Description(i18n.T("Space to toggle, enter to continue")).
+		Options(options...).
+		Value(&selected).
+		Run()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range selected {
+		chosen[s] = true
+	}
+	return chosen, nil
+}
+
+// createStarterBoard creates a board with starterColumns via the SDK.
+// Column failures are not fatal; the board is still usable.
+func createStarterBoard(ctx context.Context, apiURL, token, accountSlug, name string) (Board, error) {
+	opts, err := transportClientOptions()
+	if err != nil {
+		return Board{}, err
+	}
+	sdkCfg := &fizzy.Config{BaseURL: apiURL}
+	ac := fizzy.NewClient(sdkCfg, &fizzy.StaticTokenProvider{Token: token}, opts...).ForAccount(accountSlug)
+
+	created, _, err := ac.Boards().Create(ctx, &generated.CreateBoardRequest{Name: name})
+	if err != nil {
+		return Board{}, err
+	}
+	if created == nil || created.Id == "" {
+		return Board{}, fmt.Errorf("board created but no ID returned")
+	}
+
+	for _, column := range starterColumns {
+		_, _, _ = ac.Columns().Create(ctx, created.Id, &generated.CreateColumnRequest{Name: column})
+	}
+
+	return Board{ID: created.Id, Name: created.Name}, nil
+}
+
 // validateToken validates the token by calling the identity endpoint via the SDK.
 // Returns the list of accounts on success.
 func validateToken(cmd *cobra.Command, apiURL, token string) ([]Account, error) {
+	opts, err := transportClientOptions()
+	if err != nil {
+		return nil, err
+	}
 	sdkCfg := &fizzy.Config{BaseURL: apiURL}
-	testClient := fizzy.NewClient(sdkCfg, &fizzy.StaticTokenProvider{Token: token})
+	testClient := fizzy.NewClient(sdkCfg, &fizzy.StaticTokenProvider{Token: token}, opts...)
 	_, resp, err := testClient.Identity().GetMyIdentity(cmd.Context())
 	if err != nil {
 		return nil, err
@@ -384,8 +518,12 @@ func parseAccounts(data json.RawMessage) ([]Account, error) {
 
 // fetchBoards fetches the list of boards for the given account via the SDK.
 func fetchBoards(cmd *cobra.Command, apiURL, token, accountSlug string) ([]Board, error) {
+	opts, err := transportClientOptions()
+	if err != nil {
+		return nil, err
+	}
 	sdkCfg := &fizzy.Config{BaseURL: apiURL}
-	testClient := fizzy.NewClient(sdkCfg, &fizzy.StaticTokenProvider{Token: token})
+	testClient := fizzy.NewClient(sdkCfg, &fizzy.StaticTokenProvider{Token: token}, opts...)
 	ac := testClient.ForAccount(accountSlug)
 
 	pages, err := ac.GetAll(cmd.Context(), "/boards.json")
@@ -1,7 +1,10 @@
 package commands
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
@@ -209,6 +212,55 @@ func TestParseBoards(t *testing.T) {
 	})
 }
 
+func TestCreateStarterBoard(t *testing.T) {
+	t.Run("creates board and starter columns", func(t *testing.T) {
+		var paths []string
+		var columnNames []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			paths = append(paths, r.Method+" "+r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			if strings.HasSuffix(r.URL.Path, "/columns.json") {
+				var body map[string]any
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				if name, ok := body["name"].(string); ok {
+					columnNames = append(columnNames, name)
+				}
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"id":"col"}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"board-1","name":"Getting Started"}`))
+		}))
+		defer server.Close()
+
+		board, err := createStarterBoard(context.Background(), server.URL, "token", "acct", "Getting Started")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if board.ID != "board-1" || board.Name != "Getting Started" {
+			t.Errorf("unexpected board: %+v", board)
+		}
+		if len(paths) == 0 || paths[0] != "POST /acct/boards.json" {
+			t.Errorf("expected board create first, got %v", paths)
+		}
+		if strings.Join(columnNames, ",") != strings.Join(starterColumns, ",") {
+			t.Errorf("expected starter columns %v, got %v", starterColumns, columnNames)
+		}
+	})
+
+	t.Run("returns error when board create fails", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, `{"error":"forbidden"}`, http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		if _, err := createStarterBoard(context.Background(), server.URL, "token", "acct", "Board"); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
 func TestValidateToken(t *testing.T) {
 	t.Run("returns accounts on successful validation", func(t *testing.T) {
 		mock := NewMockClient().WithGetData(map[string]any{
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// shareSkippedFlags are flags left out of shared commands: --share itself,
+// and pagination, which teammates should choose for themselves.
+var shareSkippedFlags = []string{"share", "page", "all"}
+
+// clientSideFlags are filters applied by the CLI after fetching, which the
+// web app can't express.
+var clientSideFlags = []string{"filter", "title-match", "title-glob", "not-tag", "not-assignee", "not-column", "created-after", "created-before", "closed-after", "closed-before", "sort-by", "desc", "with-closure-info", "accounts"}
+
+// printShare outputs the web app URL for path and the canonical CLI command
+// equivalent to cmd's invocation, for pasting into chat. extra adds flags
+// the command resolved implicitly, such as the default board.
+func printShare(cmd *cobra.Command, args []string, path string, extra map[string]string) {
+	command, omitted := shareCommand(cmd, args, extra)
+
+	share := map[string]any{
+		"url":     shareURL(path),
+		"command": command,
+	}
+	if len(omitted) > 0 {
+		share["url_omits"] = omitted
+	}
+
+	printDetail(share, command, nil)
+}
+
+// shareURL converts an API path such as /cards.json?board_ids[]=1 into the
+// matching web app URL.
+func shareURL(path string) string {
+	route, query, _ := strings.Cut(path, "?")
+	url := strings.TrimSuffix(cfg.APIURL, "/") + "/" + strings.TrimPrefix(cfg.Account, "/") + strings.TrimSuffix(route, ".json")
+	if query != "" {
+		url += "?" + query
+	}
+	return url
+}
+
+// shareCommand renders cmd's invocation with flags in alphabetical order and
+// values shell-quoted, so the same query always produces the same string. It
+// also returns the client-side flags the web URL can't carry.
+func shareCommand(cmd *cobra.Command, args []string, extra map[string]string) (string, []string) {
+	flags := map[string]string{}
+	inherited := cmd.InheritedFlags()
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if slices.Contains(shareSkippedFlags, f.Name) || f.Deprecated != "" || inherited.Lookup(f.Name) != nil {
+			return
+		}
+		flags[f.Name] = f.Value.String()
+		if slice, ok := f.Value.(pflag.SliceValue); ok {
+			flags[f.Name] = strings.Join(slice.GetSlice(), ",")
+		}
+		if f.Value.Type() == "bool" {
+			flags[f.Name] = ""
+		}
+	})
+	for name, value := range extra {
+		if _, ok := flags[name]; !ok && value != "" {
+			flags[name] = value
+		}
+	}
+
+	parts := []string{cmd.CommandPath()}
+	names := make([]string, 0, len(flags))
+	for name := range flags {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var omitted []string
+	for _, name := range names {
+		parts = append(parts, "--"+name)
+		if flags[name] != "" {
+			parts = append(parts, shellQuote(flags[name]))
+		}
+		if slices.Contains(clientSideFlags, name) {
+			omitted = append(omitted, "--"+name)
+		}
+	}
+	if len(args) > 0 {
+		parts = append(parts, shellQuote(strings.Join(args, " ")))
+	}
+	return strings.Join(parts, " "), omitted
+}
+
+// shareFlagUsage is the help text for --share.
+const shareFlagUsage = "Print the equivalent web app URL and a canonical fizzy command instead of results"
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"testing"
+)
+
+func TestCardListShare(t *testing.T) {
+	mock := NewMockClient()
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "acme", "https://app.fizzy.do")
+	cfg.Board = "b1"
+	defer resetTest()
+
+	flags := cardListCmd.Flags()
+	for name, value := range map[string]string{"tag": "t1", "search": "login bug", "filter": "closed == false", "share": "true"} {
+		if err := flags.Set(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer func() {
+		for _, name := range []string{"tag", "search", "filter", "share"} {
+			flags.Lookup(name).Changed = false
+		}
+		cardListTag, cardListSearch, cardListFilter, cardListShare = nil, "", "", false
+	}()
+
+	err := cardListCmd.RunE(cardListCmd, []string{})
+	assertExitCode(t, err, 0)
+
+	if len(mock.GetWithPaginationCalls) != 0 {
+		t.Errorf("expected no requests, got %v", mock.GetWithPaginationCalls)
+	}
+	share := result.Response.Data.(map[string]any)
+	if got := share["url"]; got != "https://app.fizzy.do/acme/cards?board_ids[]=b1&tag_ids[]=t1&terms[]=login&terms[]=bug" {
+		t.Errorf("unexpected url: %v", got)
+	}
+	if got := share["command"]; got != "fizzy card list --board b1 --filter 'closed == false' --search 'login bug' --tag t1" {
+		t.Errorf("unexpected command: %v", got)
+	}
+	if omits, _ := share["url_omits"].([]any); len(omits) != 1 || omits[0] != "--filter" {
+		t.Errorf("expected url_omits [--filter], got %v", share["url_omits"])
+	}
+}
+
+func TestSearchShare(t *testing.T) {
+	mock := NewMockClient()
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "acme", "https://app.fizzy.do")
+	defer resetTest()
+
+	searchShare = true
+	defer func() { searchShare = false }()
+
+	err := searchCmd.RunE(searchCmd, []string{"login", "error"})
+	assertExitCode(t, err, 0)
+
+	share := result.Response.Data.(map[string]any)
+	if got := share["url"]; got != "https://app.fizzy.do/acme/search?q=login+error" {
+		t.Errorf("unexpected url: %v", got)
+	}
+	if got := share["command"]; got != "fizzy search 'login error'" {
+		t.Errorf("unexpected command: %v", got)
+	}
+}
@@ -583,7 +583,7 @@ func signupAPIURL() string {
 // Redirects are validated to prevent SSRF via open redirectors.
 func newSignupHTTPClient() *http.Client {
 	jar, _ := cookiejar.New(nil)
-	return &http.Client{
+	c := &http.Client{
 		Timeout: 30 * time.Second,
 		Jar:     jar,
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
@@ -593,6 +593,10 @@ func newSignupHTTPClient() *http.Client {
 			return validateSignupURL(req.URL.String())
 		},
 	}
+	if transport, err := httpTransport(); err == nil && transport != nil {
+		c.Transport = transport
+	}
+	return c
 }
 
 // signupHTTPError is returned by signupPost for HTTP error responses (status >= 400),
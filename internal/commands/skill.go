@@ -10,6 +10,7 @@ import (
 	"github.com/basecamp/fizzy-cli/internal/config"
 	"github.com/basecamp/fizzy-cli/internal/errors"
 	"github.com/basecamp/fizzy-cli/internal/harness"
+	"github.com/basecamp/fizzy-cli/internal/i18n"
 	"github.com/basecamp/fizzy-cli/skills"
 	"github.com/charmbracelet/huh"
 	"github.com/spf13/cobra"
@@ -140,7 +141,7 @@ func installSkillFiles() (string, error) {
 // runSkillWizard runs the interactive skill installation wizard.
 func runSkillWizard() error {
 	fmt.Println()
-	fmt.Println("Fizzy Skill Installation")
+	fmt.Println(i18n.T("Fizzy Skill Installation"))
 	fmt.Println()
 
 	// Build options
@@ -149,25 +150,25 @@ func runSkillWizard() error {
 		label := fmt.Sprintf("%s (%s)", loc.Name, loc.Path)
 		options[i] = huh.NewOption(label, loc.Path)
 	}
-	options[len(skillLocations)] = huh.NewOption("Other (custom path)", "other")
+	options[len(skillLocations)] = huh.NewOption(i18n.T("Other (custom path)"), "other")
 
 	var selectedPath string
 	err := huh.NewSelect[string]().
-		Title("Where would you like to install the Fizzy skill?").
+		Title(i18n.T("Where would you like to install the Fizzy skill?")).
 		Options(options...).
 		Value(&selectedPath).
 		Run()
 
 	if err != nil {
-		fmt.Println("Installation cancelled.")
+		fmt.Println(i18n.T("Installation cancelled."))
 		return nil //nolint:nilerr // user cancelled prompt
 	}
 
 	// Handle custom path
 	if selectedPath == "other" {
 		err = huh.NewInput().
-			Title("Enter custom path").
-			Description("You can enter a directory or full path ending in SKILL.md").
+			Title(i18n.T("Enter custom path")).
+			Description(i18n.T("You can enter a directory or full path ending in SKILL.md")).
 			Placeholder("/path/to/skills/fizzy/SKILL.md").
 			Value(&selectedPath).
 			Validate(func(s string) error {
@@ -179,7 +180,7 @@ func runSkillWizard() error {
 			Run()
 
 		if err != nil {
-			fmt.Println("Installation cancelled.")
+			fmt.Println(i18n.T("Installation cancelled."))
 			return nil //nolint:nilerr // user cancelled prompt
 		}
 
@@ -192,12 +193,12 @@ func runSkillWizard() error {
 	if fileExists(expandedPath) {
 		var overwrite bool
 		err = huh.NewConfirm().
-			Title(fmt.Sprintf("File already exists at %s. Overwrite?", sanitizeLogValue(selectedPath))).
+			Title(i18n.Tf("File already exists at %s. Overwrite?", sanitizeLogValue(selectedPath))).
 			Value(&overwrite).
 			Run()
 
 		if err != nil || !overwrite {
-			fmt.Println("Installation cancelled.")
+			fmt.Println(i18n.T("Installation cancelled."))
 			return nil //nolint:nilerr // user cancelled or declined
 		}
 	}
@@ -0,0 +1,452 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/state"
+	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var slaCmd = &cobra.Command{
+	Use:   "sla",
+	Short: "Enforce service levels on cards",
+	Long:  "Commands for checking cards against service-level rules.",
+}
+
+// slaRuleSet is a rules file for 'sla watch'.
+type slaRuleSet struct {
+	Board string    `yaml:"board"`
+	Rules []slaRule `yaml:"rules"`
+}
+
+// slaRule is one threshold. Exactly one of MaxInColumn and MaxUnassigned is
+// set.
+type slaRule struct {
+	Name          string     `yaml:"name"`
+	Board         string     `yaml:"board"`
+	Column        string     `yaml:"column"`
+	MaxInColumn   string     `yaml:"max_in_column"`
+	MaxUnassigned string     `yaml:"max_unassigned"`
+	Actions       slaActions `yaml:"actions"`
+
+	limit time.Duration
+}
+
+// slaActions is what happens when a card breaches a rule.
+type slaActions struct {
+	Tag     string `yaml:"tag"`
+	Comment string `yaml:"comment"`
+	Notify  bool   `yaml:"notify"`
+}
+
+// slaCardState is what 'sla watch' remembers about a card between runs. The
+// API doesn't say when a card entered its column, so that is observed here.
+type slaCardState struct {
+	Column          string               `json:"column"`
+	InColumnSince   time.Time            `json:"in_column_since"`
+	UnassignedSince time.Time            `json:"unassigned_since,omitzero"`
+	Breached        map[string]time.Time `json:"breached,omitempty"`
+}
+
+type slaBoardState struct {
+	Cards map[string]*slaCardState `json:"cards"`
+}
+
+// Sla watch flags
+var slaWatchRules string
+var slaWatchBoard string
+var slaWatchInterval time.Duration
+var slaWatchDryRun bool
+var slaWatchHealthAddr string
+
+var slaWatchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Act on cards that breach SLA rules",
+	Long: `Checks open cards against the rules in --rules and acts on each card that
+breaches one: it can tag the card, comment on it, and send a desktop
+notification. Each breach is acted on once; a card that leaves the column or
+gets an assignee starts over.
+
+  board: 03f5v9zjysoy0fqs9yg0ei3hq   # default for rules without a board
+  rules:
+    - name: review-within-a-day
+      column: Review                  # ID, name, or maybe/not-now; any column if empty
+      max_in_column: 24h
+      actions:
+        tag: sla-breach
+        comment: "Waiting in {column} for {age}, over the {limit} limit."
+    - name: pick-up-within-4h
+      max_unassigned: 4h
+      actions:
+        notify: true
+
+Comments are Markdown and may use {rule}, {column}, {age}, and {limit}.
+
+The API doesn't record when a card entered its column, so fizzy remembers
+where it saw each card. The first time a card is seen, its last activity
+stands in, which errs toward late rather than false alerts. Run it from cron,
+or keep it running with --interval. --dry-run reports breaches without
+acting or recording anything.
+
+A watcher kept running reports its health to 'fizzy daemon status', and
+with --health-addr also serves it on /healthz.`,
+	Example: `  fizzy sla watch --rules sla.yaml
+  fizzy sla watch --rules sla.yaml --interval 10m
+  fizzy sla watch --rules sla.yaml --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+		if slaWatchRules == "" {
+			return newRequiredFlagError("rules")
+		}
+		if slaWatchInterval < 0 {
+			return errors.NewInvalidArgsError("--interval must not be negative")
+		}
+		byBoard, err := loadSLARules(slaWatchRules, slaWatchBoard)
+		if err != nil {
+			return err
+		}
+
+		if slaWatchInterval == 0 {
+			if slaWatchHealthAddr != "" {
+				return errors.NewInvalidArgsError("--health-addr requires --interval")
+			}
+			return slaWatchPass(cmd.Context(), byBoard, time.Now())
+		}
+
+		stopHealth, err := startDaemonHealth(cmd, slaWatchInterval, slaWatchHealthAddr)
+		if err != nil {
+			return err
+		}
+		defer stopHealth()
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		progressf("Watching %d %s every %s (Ctrl-C to stop)\n", len(byBoard), pluralize(len(byBoard), "board", "boards"), slaWatchInterval)
+		ticker := time.NewTicker(slaWatchInterval)
+		defer ticker.Stop()
+		for {
+			if err := slaWatchPass(ctx, byBoard, time.Now()); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				warnf("Warning: SLA check failed: %v\n", err)
+			}
+			daemonHealth.beat()
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	},
+}
+
+// loadSLARules reads and validates a rules file and groups its rules by
+// board. A rule's board comes from the rule, then the file, then --board or
+// the configured default.
+func loadSLARules(path, boardFlag string) (map[string][]slaRule, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.NewInvalidArgsError(fmt.Sprintf("cannot read --rules: %v", err))
+	}
+	var set slaRuleSet
+	if err := yaml.Unmarshal(content, &set); err != nil {
+		return nil, errors.NewInvalidArgsError(fmt.Sprintf("invalid rules file %s: %v", path, err))
+	}
+	if len(set.Rules) == 0 {
+		return nil, errors.NewInvalidArgsError(fmt.Sprintf("rules file %s has no rules", path))
+	}
+
+	byBoard := map[string][]slaRule{}
+	names := map[string]bool{}
+	for i, rule := range set.Rules {
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("rule-%d", i+1)
+		}
+		if names[rule.Name] {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("duplicate rule name %q in %s", rule.Name, path))
+		}
+		names[rule.Name] = true
+
+		limit := rule.MaxInColumn
+		if (rule.MaxInColumn == "") == (rule.MaxUnassigned == "") {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("rule %s must set one of max_in_column or max_unassigned", rule.Name))
+		}
+		if limit == "" {
+			limit = rule.MaxUnassigned
+		}
+		if rule.limit, err = time.ParseDuration(limit); err != nil || rule.limit <= 0 {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("invalid limit %q in rule %s (use a duration such as 4h)", limit, rule.Name))
+		}
+		if rule.Actions == (slaActions{}) {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("rule %s has no actions (use tag, comment, or notify)", rule.Name))
+		}
+
+		board := rule.Board
+		if board == "" {
+			board = set.Board
+		}
+		if board == "" {
+			board = defaultBoard(boardFlag)
+		}
+		if board == "" {
+			return nil, errors.NewInvalidArgsError(fmt.Sprintf("rule %s has no board; set board in the rules file or pass --board", rule.Name))
+		}
+		byBoard[board] = append(byBoard[board], rule)
+	}
+	return byBoard, nil
+}
+
+// slaWatchPass checks every board once, acts on new breaches, and prints
+// the breaches found.
+func slaWatchPass(ctx context.Context, byBoard map[string][]slaRule, now time.Time) error {
+	boards := make([]string, 0, len(byBoard))
+	for board := range byBoard {
+		boards = append(boards, board)
+	}
+	sort.Strings(boards)
+
+	var breaches []map[string]any
+	var failed []string
+	for i, board := range boards {
+		daemonHealth.setQueueDepth(len(boards) - i)
+		found, err := slaCheckBoard(ctx, board, byBoard[board], now)
+		if err != nil {
+			return err
+		}
+		for _, breach := range found {
+			if msg, ok := breach["error"].(string); ok {
+				failed = append(failed, msg)
+			}
+		}
+		breaches = append(breaches, found...)
+	}
+	daemonHealth.setQueueDepth(0)
+
+	fresh := 0
+	notify := false
+	for _, breach := range breaches {
+		if breach["new"] == true {
+			fresh++
+			if breach["notify"] == true {
+				notify = true
+			}
+		}
+	}
+	if notify && !slaWatchDryRun {
+		desktopNotify("fizzy: SLA breach", fmt.Sprintf("%d %s breached SLA rules", fresh, pluralize(fresh, "card", "cards")))
+	}
+
+	items := make([]any, len(breaches))
+	for i, breach := range breaches {
+		delete(breach, "notify")
+		items[i] = breach
+	}
+	summary := fmt.Sprintf("%d %s, %d new", len(breaches), pluralize(len(breaches), "breach", "breaches"), fresh)
+	if slaWatchDryRun {
+		summary += " (dry run; nothing was done)"
+	}
+	printList(items, slaWatchColumns, summary, []Breadcrumb{
+		breadcrumb("show", "fizzy card show <number>", "View a card"),
+	})
+
+	if len(failed) > 0 {
+		return errors.NewPartialFailureError(fmt.Sprintf("%d SLA %s failed: %s", len(failed), pluralize(len(failed), "action", "actions"), strings.Join(failed, "; ")))
+	}
+	return nil
+}
+
+// slaCheckBoard checks one board's open cards against its rules. It records
+// where each card was seen and which breaches were acted on, unless this is
+// a dry run.
+func slaCheckBoard(ctx context.Context, board string, rules []slaRule, now time.Time) ([]map[string]any, error) {
+	ac := getSDK()
+	pages, err := ac.GetAll(ctx, "/cards.json?board_ids[]="+board)
+	if err != nil {
+		return nil, convertSDKError(err)
+	}
+	cards := toMaps(jsonAnySlice(pages))
+
+	key := slaStateKey(board)
+	var prev slaBoardState
+	if _, err := state.Load(key, &prev); err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not read SLA state: %v", err))
+	}
+	next := slaBoardState{Cards: map[string]*slaCardState{}}
+
+	var breaches []map[string]any
+	for _, card := range cards {
+		number := fmt.Sprintf("%v", card["number"])
+		seen := prev.Cards[number]
+		current := slaObserveCard(card, seen, now)
+		next.Cards[number] = current
+
+		for _, rule := range rules {
+			since := current.InColumnSince
+			if rule.MaxUnassigned != "" {
+				if current.UnassignedSince.IsZero() {
+					continue
+				}
+				since = current.UnassignedSince
+			} else if rule.Column != "" && !slaColumnMatches(card, rule.Column) {
+				continue
+			}
+			age := now.Sub(since)
+			if age <= rule.limit {
+				continue
+			}
+
+			breach := map[string]any{
+				"card":   card["number"],
+				"title":  card["title"],
+				"board":  board,
+				"rule":   rule.Name,
+				"column": cardPlacement(card),
+				"since":  since.UTC().Format(time.RFC3339),
+				"age":    age.Round(time.Minute).String(),
+				"limit":  rule.limit.String(),
+				"new":    false,
+				"notify": rule.Actions.Notify,
+			}
+			if seen != nil && seen.Breached[rule.Name].Equal(since) {
+				current.Breached[rule.Name] = since
+			} else {
+				breach["new"] = true
+				if !slaWatchDryRun {
+					if err := slaAct(ctx, card, rule, breach); err != nil {
+						breach["error"] = fmt.Sprintf("#%s %s: %v", number, rule.Name, err)
+					} else {
+						current.Breached[rule.Name] = since
+					}
+				}
+			}
+			breaches = append(breaches, breach)
+		}
+	}
+
+	if !slaWatchDryRun {
+		if err := state.Save(key, next); err != nil {
+			return nil, errors.NewError(fmt.Sprintf("Could not save SLA state: %v", err))
+		}
+	}
+	return breaches, nil
+}
+
+// slaObserveCard returns the card's state now, given what was seen on the
+// previous run. A card seen for the first time is dated from its last
+// activity; a change seen since the previous run is dated now.
+func slaObserveCard(card map[string]any, seen *slaCardState, now time.Time) *slaCardState {
+	firstSeen := now
+	for _, field := range []string{"last_active_at", "created_at"} {
+		if at, err := time.Parse(time.RFC3339, getStringField(card, field)); err == nil {
+			firstSeen = at
+			break
+		}
+	}
+
+	column := cardPlacement(card)
+	if col, ok := card["column"].(map[string]any); ok && col["id"] != nil {
+		column = fmt.Sprintf("%v", col["id"])
+	}
+	current := &slaCardState{Column: column, Breached: map[string]time.Time{}}
+	switch {
+	case seen == nil:
+		current.InColumnSince = firstSeen
+	case seen.Column == column:
+		current.InColumnSince = seen.InColumnSince
+	default:
+		current.InColumnSince = now
+	}
+
+	if assignees, _ := card["assignees"].([]any); len(assignees) == 0 {
+		switch {
+		case seen == nil:
+			current.UnassignedSince = firstSeen
+		case !seen.UnassignedSince.IsZero():
+			current.UnassignedSince = seen.UnassignedSince
+		default:
+			current.UnassignedSince = now
+		}
+	}
+	return current
+}
+
+// slaColumnMatches reports whether card sits in column, given as an ID, a
+// name, or a pseudo-column.
+func slaColumnMatches(card map[string]any, column string) bool {
+	if pseudo, ok := parsePseudoColumnID(column); ok {
+		return cardPlacement(card) == pseudo.Name
+	}
+	col, _ := card["column"].(map[string]any)
+	if col == nil {
+		return false
+	}
+	return fmt.Sprintf("%v", col["id"]) == column || strings.EqualFold(getStringField(col, "name"), strings.TrimSpace(column))
+}
+
+// slaAct tags and comments on a card for a breach. Notifications are sent
+// once per run by the caller.
+func slaAct(ctx context.Context, card map[string]any, rule slaRule, breach map[string]any) error {
+	number := fmt.Sprintf("%v", card["number"])
+	ac := getSDK()
+
+	if rule.Actions.Tag != "" && !slaHasTag(card, rule.Actions.Tag) {
+		// Tagging toggles, so it is skipped when the card already has the tag.
+		if _, err := ac.Cards().Tag(ctx, number, &generated.TagCardRequest{TagTitle: rule.Actions.Tag}); err != nil {
+			return convertSDKError(err)
+		}
+		// Record the tag so a later rule breached by the same card in this
+		// pass doesn't toggle it back off.
+		tags, _ := card["tags"].([]any)
+		card["tags"] = append(tags, rule.Actions.Tag)
+	}
+	if rule.Actions.Comment != "" {
+		body := strings.NewReplacer(
+			"{rule}", rule.Name,
+			"{column}", fmt.Sprintf("%v", breach["column"]),
+			"{age}", fmt.Sprintf("%v", breach["age"]),
+			"{limit}", fmt.Sprintf("%v", breach["limit"]),
+		).Replace(rule.Actions.Comment)
+		if _, _, err := ac.Comments().Create(ctx, number, &generated.CreateCommentRequest{Body: markdownToHTML(body)}); err != nil {
+			return convertSDKError(err)
+		}
+	}
+	return nil
+}
+
+func slaHasTag(card map[string]any, tag string) bool {
+	tags, _ := card["tags"].([]any)
+	for _, t := range tags {
+		if name, _ := t.(string); strings.EqualFold(name, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func slaStateKey(board string) string {
+	return "sla/" + cfg.Account + "/" + board
+}
+
+func init() {
+	rootCmd.AddCommand(slaCmd)
+
+	slaWatchCmd.Flags().StringVar(&slaWatchRules, "rules", "", "SLA rules file (YAML, required)")
+	slaWatchCmd.Flags().StringVar(&slaWatchBoard, "board", "", "Board for rules that don't name one (defaults to the configured board)")
+	slaWatchCmd.Flags().StringVar(&slaWatchHealthAddr, "health-addr", "", "With --interval, serve /healthz on this address (e.g. :9090)")
+	slaWatchCmd.Flags().DurationVar(&slaWatchInterval, "interval", 0, "Keep running and check again at this interval (e.g. 10m)")
+	slaWatchCmd.Flags().BoolVar(&slaWatchDryRun, "dry-run", false, "Report breaches without acting or recording them")
+	slaCmd.AddCommand(slaWatchCmd)
+}
@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestSLAWatch(t *testing.T) {
+	dir := t.TempDir()
+	rulesFile := filepath.Join(dir, "sla.yaml")
+	rules := `board: "123"
+rules:
+  - name: review-within-a-day
+    column: review
+    max_in_column: 24h
+    actions:
+      tag: sla-breach
+      comment: "Waiting in {column} for over {limit}"
+  - name: pick-up-within-4h
+    max_unassigned: 4h
+    actions:
+      notify: true
+`
+	if err := os.WriteFile(rulesFile, []byte(rules), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	stale := time.Now().Add(-48 * time.Hour).UTC().Format(time.RFC3339)
+	recent := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	mock := NewMockClient()
+	mock.OnGet("/cards.json", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"number": float64(1), "title": "Stuck", "last_active_at": stale, "column": map[string]any{"id": "col-2", "name": "Review"}},
+		map[string]any{"number": float64(2), "title": "Moving", "last_active_at": recent, "column": map[string]any{"id": "col-2", "name": "Review"}, "assignees": []any{map[string]any{"id": "u1"}}},
+	}})
+	mock.PostResponse = &client.APIResponse{StatusCode: 201, Data: map[string]any{}}
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	var notified []string
+	defer func(orig func(string, string)) { desktopNotify = orig }(desktopNotify)
+	desktopNotify = func(title, body string) { notified = append(notified, body) }
+
+	slaWatchRules = rulesFile
+	defer func() { slaWatchRules = "" }()
+
+	t.Run("dry run reports without acting", func(t *testing.T) {
+		slaWatchDryRun = true
+		err := slaWatchCmd.RunE(slaWatchCmd, []string{})
+		slaWatchDryRun = false
+		assertExitCode(t, err, 0)
+
+		if len(toMaps(result.Response.Data)) != 2 || len(mock.PostCalls) != 0 || len(notified) != 0 {
+			t.Errorf("expected 2 breaches and no actions, got %v, %d posts", result.Response.Data, len(mock.PostCalls))
+		}
+	})
+
+	t.Run("acts on new breaches once", func(t *testing.T) {
+		err := slaWatchCmd.RunE(slaWatchCmd, []string{})
+		assertExitCode(t, err, 0)
+
+		breaches := toMaps(result.Response.Data)
+		if len(breaches) != 2 || breaches[0]["card"] != float64(1) || breaches[0]["new"] != true {
+			t.Fatalf("expected two new breaches on card 1, got %v", breaches)
+		}
+		if len(mock.PostCalls) != 2 {
+			t.Fatalf("expected a tag and a comment, got %d posts", len(mock.PostCalls))
+		}
+		comment := mock.PostCalls[1]
+		if comment.Path != "/cards/1/comments.json" || !strings.Contains(comment.Body.(map[string]any)["body"].(string), "Waiting in Review for over 24h0m0s") {
+			t.Errorf("unexpected comment %v", comment)
+		}
+		if len(notified) != 1 {
+			t.Errorf("expected one notification, got %v", notified)
+		}
+
+		err = slaWatchCmd.RunE(slaWatchCmd, []string{})
+		assertExitCode(t, err, 0)
+		breaches = toMaps(result.Response.Data)
+		if len(breaches) != 2 || breaches[0]["new"] != false || len(mock.PostCalls) != 2 || len(notified) != 1 {
+			t.Errorf("expected known breaches to be left alone, got %v and %d posts", breaches, len(mock.PostCalls))
+		}
+	})
+
+	t.Run("tags once when several rules with the same tag breach", func(t *testing.T) {
+		twice := filepath.Join(dir, "twice.yaml")
+		if err := os.WriteFile(twice, []byte(`board: "123"
+rules:
+  - name: review-within-two-days
+    column: review
+    max_in_column: 36h
+    actions: {tag: late}
+  - name: review-within-half-a-day
+    column: review
+    max_in_column: 12h
+    actions: {tag: late}
+`), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		slaWatchRules = twice
+		before := len(mock.PostCalls)
+		err := slaWatchCmd.RunE(slaWatchCmd, []string{})
+		slaWatchRules = rulesFile
+		assertExitCode(t, err, 0)
+
+		if tags := len(mock.PostCalls) - before; tags != 1 {
+			t.Errorf("expected one tag call, got %d", tags)
+		}
+	})
+
+	t.Run("rejects rules without a limit", func(t *testing.T) {
+		bad := filepath.Join(dir, "bad.yaml")
+		if err := os.WriteFile(bad, []byte("board: \"123\"\nrules:\n  - name: x\n    actions: {notify: true}\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		slaWatchRules = bad
+		err := slaWatchCmd.RunE(slaWatchCmd, []string{})
+		slaWatchRules = rulesFile
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+	t.Run("rejects --health-addr without --interval", func(t *testing.T) {
+		slaWatchHealthAddr = ":0"
+		err := slaWatchCmd.RunE(slaWatchCmd, []string{})
+		slaWatchHealthAddr = ""
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
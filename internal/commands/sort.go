@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"sort"
+	"strings"
+)
+
+// sortByField orders items by a field, given as a dotted path as in
+// --filter, smallest first or, with desc, largest first. Numbers compare as
+// numbers and timestamps as times; everything else compares as
+// case-insensitive text. A list sorts by its first element and an object
+// such as a user or column by its name. Items without the field go last
+// either way, and ties keep their fetched order.
+func sortByField(items []map[string]any, field string, desc bool) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a := sortKey(lookupFilterField(items[i], field))
+		b := sortKey(lookupFilterField(items[j], field))
+		switch {
+		case a == nil || b == nil:
+			return a != nil
+		case desc:
+			return compareSortKeys(a, b) > 0
+		default:
+			return compareSortKeys(a, b) < 0
+		}
+	})
+}
+
+// sortKey reduces a field value to the scalar it sorts by, or nil when it
+// is empty.
+func sortKey(v any) any {
+	switch x := v.(type) {
+	case []any:
+		if len(x) == 0 {
+			return nil
+		}
+		return sortKey(x[0])
+	case []map[string]any:
+		if len(x) == 0 {
+			return nil
+		}
+		return sortKey(x[0])
+	case map[string]any:
+		for _, key := range []string{"name", "title", "id"} {
+			if val, ok := x[key]; ok {
+				return sortKey(val)
+			}
+		}
+		return nil
+	case string:
+		if x == "" {
+			return nil
+		}
+	case int:
+		return float64(x)
+	case int32:
+		return float64(x)
+	case int64:
+		return float64(x)
+	}
+	return v
+}
+
+func compareSortKeys(a, b any) int {
+	if af, ok := a.(float64); ok {
+		if bf, ok := b.(float64); ok {
+			switch {
+			case af < bf:
+				return -1
+			case af > bf:
+				return 1
+			}
+			return 0
+		}
+	}
+	if as, ok := a.(string); ok {
+		if bs, ok := b.(string); ok {
+			if at, ok := parseFilterTime(as); ok {
+				if bt, ok := parseFilterTime(bs); ok {
+					return at.Compare(bt)
+				}
+			}
+		}
+	}
+	return strings.Compare(strings.ToLower(filterScalarString(a)), strings.ToLower(filterScalarString(b)))
+}
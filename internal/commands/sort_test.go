@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSortByField(t *testing.T) {
+	cards := func() []map[string]any {
+		return []map[string]any{
+			{"number": 10, "title": "beta", "created_at": "2025-03-01T00:00:00Z", "assignees": []any{map[string]any{"name": "Cy"}}},
+			{"number": 2, "title": "Alpha", "created_at": "2025-01-15T00:00:00Z", "assignees": []any{}},
+			{"number": 33, "title": "gamma", "created_at": "2025-02-01T00:00:00Z", "assignees": []any{map[string]any{"name": "Al"}}},
+		}
+	}
+	numbers := func(items []map[string]any) string {
+		var out []any
+		for _, item := range items {
+			out = append(out, item["number"])
+		}
+		return fmt.Sprint(out)
+	}
+
+	tests := []struct {
+		field string
+		desc  bool
+		want  string
+	}{
+		{"number", false, "[2 10 33]"},
+		{"number", true, "[33 10 2]"},
+		{"title", false, "[2 10 33]"},
+		{"created_at", true, "[10 33 2]"},
+		{"assignee", false, "[33 10 2]"},
+		{"assignee", true, "[10 33 2]"},
+		{"closed_at", false, "[10 2 33]"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s desc=%v", tt.field, tt.desc), func(t *testing.T) {
+			items := cards()
+			sortByField(items, tt.field, tt.desc)
+			if got := numbers(items); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
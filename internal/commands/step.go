@@ -54,11 +54,12 @@ var stepShowCmd = &cobra.Command{
 var stepCreateCard string
 var stepCreateContent string
 var stepCreateCompleted bool
+var stepCreateJSONInput string
 
 var stepCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a step",
-	Long:  "Creates a new step (to-do item) on a card.",
+	Long:  "Creates a new step (to-do item) on a card.\n\n--json-input reads the request body (e.g. {\"content\": \"Ship it\", \"completed\": true}) as JSON from a file, or from stdin with -. Flags given alongside override its fields.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
@@ -67,9 +68,22 @@ var stepCreateCmd = &cobra.Command{
 		if stepCreateCard == "" {
 			return newRequiredFlagError("card")
 		}
-		if stepCreateContent == "" {
+
+		req := &generated.CreateStepRequest{}
+		if stepCreateJSONInput != "" {
+			if err := readJSONInput(cmd, stepCreateJSONInput, req); err != nil {
+				return err
+			}
+		}
+		if stepCreateContent != "" {
+			req.Content = stepCreateContent
+		}
+		if req.Content == "" {
 			return newRequiredFlagError("content")
 		}
+		if stepCreateCompleted {
+			req.Completed = true
+		}
 
 		cardNumber := stepCreateCard
 		ac := getSDK()
@@ -80,10 +94,6 @@ var stepCreateCmd = &cobra.Command{
 			breadcrumb("step", fmt.Sprintf("fizzy step create --card %s --content \"text\"", cardNumber), "Add another step"),
 		}
 
-		req := &generated.CreateStepRequest{Content: stepCreateContent}
-		if stepCreateCompleted {
-			req.Completed = true
-		}
 		data, resp, err := ac.Steps().Create(cmd.Context(), cardNumber, req)
 		if err != nil {
 			return convertSDKError(err)
@@ -104,11 +114,19 @@ var stepUpdateCard string
 var stepUpdateContent string
 var stepUpdateCompleted bool
 var stepUpdateNotCompleted bool
+var stepUpdateJSONInput string
+
+// stepUpdateInput is the --json-input of step update. Completed is a pointer
+// so an explicit false survives decoding.
+type stepUpdateInput struct {
+	generated.UpdateStepRequest
+	Completed *bool `json:"completed,omitempty"`
+}
 
 var stepUpdateCmd = &cobra.Command{
 	Use:   "update STEP_ID",
 	Short: "Update a step",
-	Long:  "Updates an existing step.",
+	Long:  "Updates an existing step.\n\n--json-input reads the request body as JSON from a file, or from stdin with -. Flags given alongside override its fields.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
@@ -122,16 +140,31 @@ var stepUpdateCmd = &cobra.Command{
 		stepID := args[0]
 		cardNumber := stepUpdateCard
 
+		input := &stepUpdateInput{}
+		if stepUpdateJSONInput != "" {
+			if err := readJSONInput(cmd, stepUpdateJSONInput, input); err != nil {
+				return err
+			}
+		}
+		req := &input.UpdateStepRequest
+		if stepUpdateContent != "" {
+			req.Content = stepUpdateContent
+		}
+		if stepUpdateCompleted || stepUpdateNotCompleted {
+			completed := !stepUpdateNotCompleted
+			input.Completed = &completed
+		}
+
 		ac := getSDK()
 
-		// When --not_completed is set, we must send `"completed": false` explicitly.
+		// When completed is set to false, we must send `"completed": false` explicitly.
 		// The SDK's UpdateStepRequest uses `omitempty` on Completed (bool), which
 		// silently drops false values. Use a raw Patch with map body for this case.
 		var data any
-		if stepUpdateNotCompleted {
+		if input.Completed != nil && !*input.Completed {
 			body := map[string]any{"completed": false}
-			if stepUpdateContent != "" {
-				body["content"] = stepUpdateContent
+			if req.Content != "" {
+				body["content"] = req.Content
 			}
 			resp, patchErr := ac.Patch(cmd.Context(), fmt.Sprintf("/cards/%s/steps/%s", cardNumber, stepID), body)
 			if patchErr != nil {
@@ -139,13 +172,7 @@ var stepUpdateCmd = &cobra.Command{
 			}
 			data = resp.Data
 		} else {
-			req := &generated.UpdateStepRequest{}
-			if stepUpdateContent != "" {
-				req.Content = stepUpdateContent
-			}
-			if stepUpdateCompleted {
-				req.Completed = true
-			}
+			req.Completed = input.Completed != nil && *input.Completed
 			var updateErr error
 			data, _, updateErr = ac.Steps().Update(cmd.Context(), cardNumber, stepID, req)
 			if updateErr != nil {
@@ -187,6 +214,10 @@ var stepDeleteCmd = &cobra.Command{
 
 		cardNumber := stepDeleteCard
 
+		if deferred, err := deferDelete(cmd, tombstone{Kind: "step", Target: args[0], Parent: cardNumber}); deferred || err != nil {
+			return err
+		}
+
 		_, err := getSDK().Steps().Delete(cmd.Context(), cardNumber, args[0])
 		if err != nil {
 			return convertSDKError(err)
@@ -211,7 +242,7 @@ var stepListCard string
 var stepListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List steps on a card",
-	Long:  "Lists all steps (to-do items) on a card.",
+	Long:  "Lists all steps (to-do items) on a card with their IDs and completion, for finding the step to update. The summary counts how many are done.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
@@ -231,9 +262,16 @@ var stepListCmd = &cobra.Command{
 		items := normalizeAny(data)
 
 		count := dataCount(items)
-		summary := fmt.Sprintf("%d steps", count)
+		done := 0
+		for _, step := range toMaps(items) {
+			if completed, _ := step["completed"].(bool); completed {
+				done++
+			}
+		}
+		summary := fmt.Sprintf("%d steps, %d done", count, done)
 
 		breadcrumbs := []Breadcrumb{
+			breadcrumb("complete", fmt.Sprintf("fizzy step update <id> --card %s --completed", cardNumber), "Check off a step"),
 			breadcrumb("create", fmt.Sprintf("fizzy step create --card %s --content \"text\"", cardNumber), "Add step"),
 			breadcrumb("card", fmt.Sprintf("fizzy card show %s", cardNumber), "View card"),
 		}
@@ -258,6 +296,7 @@ func init() {
 	stepCreateCmd.Flags().StringVar(&stepCreateCard, "card", "", "Card number (required)")
 	stepCreateCmd.Flags().StringVar(&stepCreateContent, "content", "", "Step content (required)")
 	stepCreateCmd.Flags().BoolVar(&stepCreateCompleted, "completed", false, "Mark as completed")
+	stepCreateCmd.Flags().StringVar(&stepCreateJSONInput, "json-input", "", jsonInputUsage)
 	stepCmd.AddCommand(stepCreateCmd)
 
 	// Update
@@ -265,9 +304,11 @@ func init() {
 	stepUpdateCmd.Flags().StringVar(&stepUpdateContent, "content", "", "Step content")
 	stepUpdateCmd.Flags().BoolVar(&stepUpdateCompleted, "completed", false, "Mark as completed")
 	stepUpdateCmd.Flags().BoolVar(&stepUpdateNotCompleted, "not_completed", false, "Mark as not completed")
+	stepUpdateCmd.Flags().StringVar(&stepUpdateJSONInput, "json-input", "", jsonInputUsage)
 	stepCmd.AddCommand(stepUpdateCmd)
 
 	// Delete
 	stepDeleteCmd.Flags().StringVar(&stepDeleteCard, "card", "", "Card number (required)")
+	addDeleteNowFlag(stepDeleteCmd)
 	stepCmd.AddCommand(stepDeleteCmd)
 }
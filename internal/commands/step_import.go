@@ -0,0 +1,193 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
+	"github.com/spf13/cobra"
+)
+
+// Step import flags
+var stepImportCard string
+var stepImportFile string
+var stepImportDryRun bool
+var stepImportApprove bool
+
+var stepImportCmd = &cobra.Command{
+	Use:   "import [FILE]",
+	Short: "Sync a markdown checklist onto a card's steps",
+	Long: `Reads the task list items ("- [ ] ..." and "- [x] ...") of a markdown file
+and syncs them onto a card's steps, so a checklist kept in a doc or a PR
+template can be brought onto the card. Everything else in the file is
+ignored; FILE - reads stdin. The file can also be given with --file.
+
+Items are matched to steps by their text, ignoring case and extra spaces.
+Items without a step are created, and steps whose completion differs from the
+file are checked or unchecked. Steps that aren't in the file are left alone.
+Running it again after editing the file applies only the changes.
+
+The changes are shown as a plan and confirmed in a terminal; elsewhere pass
+--approve after reviewing them with --dry-run. A step that fails to change
+doesn't stop the others, and the command exits 9 when any failed.`,
+	Example: `  fizzy step import RELEASE.md --card 42 --dry-run
+  fizzy step import RELEASE.md --card 42 --approve
+  fizzy step import --card 42 --file checklist.md --approve`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+		if stepImportCard == "" {
+			return newRequiredFlagError("card")
+		}
+		file := stepImportFile
+		switch {
+		case len(args) == 1 && file != "":
+			return errors.NewInvalidArgsError("give the checklist as FILE or --file, not both")
+		case len(args) == 1:
+			file = args[0]
+		case file == "":
+			return newRequiredFlagError("file")
+		}
+
+		var content []byte
+		var err error
+		if file == "-" {
+			content, err = io.ReadAll(cmd.InOrStdin())
+		} else {
+			content, err = os.ReadFile(file)
+		}
+		if err != nil {
+			return errors.NewInvalidArgsError(fmt.Sprintf("cannot read checklist: %v", err))
+		}
+		items := parseTaskList(string(content))
+		if len(items) == 0 {
+			return errors.NewInvalidArgsError(fmt.Sprintf("no task list items (\"- [ ] ...\") found in %s", file))
+		}
+
+		cardNumber := stepImportCard
+		ac := getSDK()
+		data, _, err := ac.Steps().List(cmd.Context(), cardNumber)
+		if err != nil {
+			return convertSDKError(err)
+		}
+		steps := map[string]map[string]any{}
+		for _, step := range toMaps(normalizeAny(data)) {
+			key := stepImportKey(getStringField(step, "content"))
+			if _, ok := steps[key]; !ok {
+				steps[key] = step
+			}
+		}
+
+		results := make([]map[string]any, 0, len(items))
+		var changes []planChange
+		counts := map[string]int{}
+		seen := map[string]bool{}
+		for _, item := range items {
+			key := stepImportKey(item.Content)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			result := map[string]any{"content": item.Content, "completed": item.Completed}
+			step, exists := steps[key]
+			action := "unchanged"
+			switch {
+			case !exists:
+				action = "created"
+			case getBoolField(step, "completed") != item.Completed && item.Completed:
+				action = "checked"
+			case getBoolField(step, "completed") != item.Completed:
+				action = "unchecked"
+			}
+			if exists {
+				result["step_id"] = getStringField(step, "id")
+			}
+			if action != "unchanged" {
+				changes = append(changes, planChange{Card: cardNumber, Title: item.Content, Change: stepImportVerbs[action]})
+			}
+			result["action"] = action
+			counts[action]++
+			results = append(results, result)
+		}
+
+		summary := fmt.Sprintf("%d created, %d checked, %d unchecked, %d unchanged", counts["created"], counts["checked"], counts["unchecked"], counts["unchanged"])
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("steps", fmt.Sprintf("fizzy step list --card %s", cardNumber), "List steps"),
+			breadcrumb("card", fmt.Sprintf("fizzy card show %s", cardNumber), "View card"),
+		}
+		if stepImportDryRun || len(changes) == 0 {
+			if stepImportDryRun {
+				summary += " (dry run; nothing was changed)"
+			}
+			printList(results, stepImportColumns, summary, breadcrumbs)
+			return nil
+		}
+
+		action := fmt.Sprintf("change %d %s on card #%s", len(changes), pluralize(len(changes), "step", "steps"), cardNumber)
+		approved, err := approvePlan(action, changes, stepImportApprove)
+		if err != nil {
+			return err
+		}
+		if !approved {
+			printMutation(map[string]any{"cancelled": true, "plan": changes}, "Import cancelled; no steps changed", breadcrumbs)
+			return nil
+		}
+
+		bulk := &bulkResult{}
+		for i, result := range results {
+			var err error
+			step := steps[stepImportKey(result["content"].(string))]
+			switch result["action"] {
+			case "created":
+				var created any
+				created, _, err = ac.Steps().Create(cmd.Context(), cardNumber, &generated.CreateStepRequest{Content: result["content"].(string), Completed: result["completed"].(bool)})
+				if m, ok := normalizeAny(created).(map[string]any); ok && err == nil {
+					result["step_id"] = getStringField(m, "id")
+				}
+			case "checked":
+				_, _, err = ac.Steps().Update(cmd.Context(), cardNumber, getStringField(step, "id"), &generated.UpdateStepRequest{Completed: true})
+			case "unchecked":
+				// UpdateStepRequest drops a false Completed, as in step update.
+				_, err = ac.Patch(cmd.Context(), fmt.Sprintf("/cards/%s/steps/%s", cardNumber, getStringField(step, "id")), map[string]any{"completed": false})
+			default:
+				continue
+			}
+			if err != nil {
+				if bulk.fail(result["content"], err) {
+					for _, rest := range results[i+1:] {
+						if rest["action"] != "unchanged" {
+							bulk.skip(rest["content"])
+						}
+					}
+					break
+				}
+				continue
+			}
+			bulk.succeed(result["content"])
+		}
+
+		return printBulkResult(map[string]any{"card": cardNumber, "steps": results}, bulk, "step changes", summary, breadcrumbs)
+	},
+}
+
+// stepImportVerbs names the change behind each import action in the plan.
+var stepImportVerbs = map[string]string{"created": "create", "checked": "check", "unchecked": "uncheck"}
+
+// stepImportKey is the text a checklist item and a step are matched by.
+func stepImportKey(content string) string {
+	return strings.ToLower(strings.Join(strings.Fields(content), " "))
+}
+
+func init() {
+	stepImportCmd.Flags().StringVar(&stepImportCard, "card", "", "Card number (required)")
+	stepImportCmd.Flags().StringVar(&stepImportFile, "file", "", "Markdown checklist to import (- for stdin), instead of FILE")
+	stepImportCmd.Flags().BoolVar(&stepImportDryRun, "dry-run", false, "Show what would change without changing it")
+	stepImportCmd.Flags().BoolVar(&stepImportApprove, "approve", false, "Apply the changes without the confirmation prompt")
+	stepCmd.AddCommand(stepImportCmd)
+}
@@ -1,6 +1,9 @@
 package commands
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/basecamp/fizzy-cli/internal/client"
@@ -188,12 +191,12 @@ func TestStepList(t *testing.T) {
 		mock.GetWithPaginationResponse = &client.APIResponse{
 			StatusCode: 200,
 			Data: []any{
-				map[string]any{"id": "step-1", "content": "Step 1"},
+				map[string]any{"id": "step-1", "content": "Step 1", "completed": true},
 				map[string]any{"id": "step-2", "content": "Step 2"},
 			},
 		}
 
-		SetTestModeWithSDK(mock)
+		result := SetTestModeWithSDK(mock)
 		SetTestConfig("token", "account", "https://api.example.com")
 		defer resetTest()
 
@@ -208,6 +211,9 @@ func TestStepList(t *testing.T) {
 		if mock.GetWithPaginationCalls[0].Path != "/cards/42/steps.json" {
 			t.Errorf("expected path '/cards/42/steps.json', got '%s'", mock.GetWithPaginationCalls[0].Path)
 		}
+		if result.Response.Summary != "2 steps, 1 done" {
+			t.Errorf("expected completion in summary, got %q", result.Response.Summary)
+		}
 	})
 
 	t.Run("requires card flag", func(t *testing.T) {
@@ -222,3 +228,115 @@ func TestStepList(t *testing.T) {
 		assertExitCode(t, err, errors.ExitInvalidArgs)
 	})
 }
+
+func TestStepImport(t *testing.T) {
+	mock := NewMockClient()
+	mock.OnGet("/cards/42/steps.json", &client.APIResponse{StatusCode: 200, Data: []any{
+		map[string]any{"id": "step-1", "content": "Tests pass", "completed": true},
+		map[string]any{"id": "step-2", "content": "Docs Updated", "completed": false},
+		map[string]any{"id": "step-3", "content": "Old step", "completed": false},
+	}})
+	mock.PostResponse = &client.APIResponse{StatusCode: 201, Data: map[string]any{"id": "step-4"}}
+	mock.PatchResponse = &client.APIResponse{StatusCode: 200, Data: map[string]any{}}
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	checklist := "## Checklist\n\n- [ ] tests  pass\n- [x] Docs updated\n- [ ] Changelog\nNot a task\n"
+	stepImportCard = "42"
+	defer func() { stepImportCard = "" }()
+
+	t.Run("dry run changes nothing", func(t *testing.T) {
+		stepImportDryRun = true
+		stepImportCmd.SetIn(strings.NewReader(checklist))
+		err := stepImportCmd.RunE(stepImportCmd, []string{"-"})
+		stepImportDryRun = false
+		assertExitCode(t, err, 0)
+
+		if len(mock.PostCalls)+len(mock.PatchCalls) != 0 {
+			t.Errorf("expected no changes, got %d posts and %d patches", len(mock.PostCalls), len(mock.PatchCalls))
+		}
+	})
+
+	t.Run("refuses to change steps without approval", func(t *testing.T) {
+		stepImportCmd.SetIn(strings.NewReader(checklist))
+		err := stepImportCmd.RunE(stepImportCmd, []string{"-"})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+
+		if len(mock.PostCalls)+len(mock.PatchCalls) != 0 {
+			t.Errorf("expected no changes, got %d posts and %d patches", len(mock.PostCalls), len(mock.PatchCalls))
+		}
+	})
+
+	stepImportApprove = true
+	defer func() { stepImportApprove = false }()
+
+	t.Run("creates, checks, and unchecks steps matched by text", func(t *testing.T) {
+		stepImportCmd.SetIn(strings.NewReader(checklist))
+		err := stepImportCmd.RunE(stepImportCmd, []string{"-"})
+		assertExitCode(t, err, 0)
+
+		var actions []string
+		data := result.Response.Data.(map[string]any)
+		for _, item := range toMaps(data["steps"]) {
+			actions = append(actions, item["action"].(string))
+		}
+		if strings.Join(actions, ",") != "unchecked,checked,created" {
+			t.Errorf("expected unchecked, checked, created; got %v", actions)
+		}
+		if len(mock.PostCalls) != 1 || mock.PostCalls[0].Path != "/cards/42/steps.json" {
+			t.Errorf("expected one step created, got %v", mock.PostCalls)
+		}
+		if len(mock.PatchCalls) != 2 {
+			t.Fatalf("expected two step updates, got %v", mock.PatchCalls)
+		}
+		if body := mock.PatchCalls[0].Body.(map[string]any); mock.PatchCalls[0].Path != "/cards/42/steps/step-1" || body["completed"] != false {
+			t.Errorf("expected step-1 unchecked, got %s %v", mock.PatchCalls[0].Path, body)
+		}
+	})
+
+	t.Run("keeps going when a step fails to change", func(t *testing.T) {
+		mock.PostCalls, mock.PatchCalls = nil, nil
+		mock.PatchResponse = &client.APIResponse{StatusCode: 403, Data: map[string]any{"error": "Forbidden"}}
+		defer func() { mock.PatchResponse = &client.APIResponse{StatusCode: 200, Data: map[string]any{}} }()
+
+		stepImportCmd.SetIn(strings.NewReader(checklist))
+		err := stepImportCmd.RunE(stepImportCmd, []string{"-"})
+		if errors.ExitCodeOf(err) != errors.ExitPartial {
+			t.Fatalf("expected exit code %d, got %v", errors.ExitPartial, err)
+		}
+
+		if len(mock.PostCalls) != 1 || len(mock.PatchCalls) != 2 {
+			t.Errorf("expected every change attempted, got %d posts and %d patches", len(mock.PostCalls), len(mock.PatchCalls))
+		}
+		data := result.Response.Data.(map[string]any)
+		if len(data["succeeded"].([]any)) != 1 || len(data["failed"].([]any)) != 2 {
+			t.Errorf("expected 1 succeeded and 2 failed, got %v", data)
+		}
+	})
+
+	t.Run("reads the checklist from --file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "checklist.md")
+		if err := os.WriteFile(path, []byte("- [x] Tests pass\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		stepImportFile = path
+		err := stepImportCmd.RunE(stepImportCmd, []string{})
+		assertExitCode(t, err, 0)
+
+		err = stepImportCmd.RunE(stepImportCmd, []string{path})
+		stepImportFile = ""
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+
+	t.Run("requires a checklist", func(t *testing.T) {
+		err := stepImportCmd.RunE(stepImportCmd, []string{})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+
+	t.Run("rejects a file without task items", func(t *testing.T) {
+		stepImportCmd.SetIn(strings.NewReader("Just prose\n"))
+		err := stepImportCmd.RunE(stepImportCmd, []string{"-"})
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+}
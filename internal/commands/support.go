@@ -0,0 +1,185 @@
+package commands
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/fsutil"
+	"github.com/spf13/cobra"
+)
+
+// supportRedacted replaces secrets found anywhere in a support bundle.
+const supportRedacted = "[REDACTED]"
+
+var supportCmd = &cobra.Command{
+	Use:   "support",
+	Short: "Gather information for bug reports",
+}
+
+// Support bundle flags
+var supportBundleFile string
+var supportBundleHistory int
+var supportBundleTraces int
+var supportBundleNoDoctor bool
+
+var supportBundleCmd = &cobra.Command{
+	Use:   "bundle",
+	Short: "Zip up diagnostics to attach to a bug report",
+	Long: `Writes a zip with what maintainers need to reproduce a problem:
+
+  version.json  CLI version, Go version, OS, and architecture
+  config.json   the effective configuration and where each value came from
+  history.json  the last --history recorded commands ('fizzy history')
+  doctor.json   the results of 'fizzy doctor' (skipped with --no-doctor)
+  traces.json   the last --traces API requests: method, URL, status, timing
+
+Secrets are left out: tokens are reported only as configured or not,
+--token and --password values are redacted in history and in the commands
+recorded with traces, traces never include headers or bodies, and any token
+or custom header value that still appears is replaced with [REDACTED]. Card titles and other content can appear in URLs and
+history, so look the bundle over before posting it publicly.
+
+Request traces are recorded with history, so FIZZY_NO_HISTORY turns them off.`,
+	Example: `  fizzy support bundle
+  fizzy support bundle --file bug.zip --traces 50 --no-doctor`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if supportBundleHistory < 0 || supportBundleTraces < 0 {
+			return errors.NewInvalidArgsError("--history and --traces must be 0 or more")
+		}
+
+		history, err := loadHistory()
+		if err != nil {
+			return err
+		}
+		traces, err := loadTraces()
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		files := []supportFile{
+			{"version.json", map[string]any{
+				"version":      rootCmd.Version,
+				"go":           runtime.Version(),
+				"os":           runtime.GOOS,
+				"arch":         runtime.GOARCH,
+				"generated_at": now.Format(time.RFC3339),
+			}},
+			{"config.json", configShowData(true)},
+			{"history.json", lastN(history, supportBundleHistory)},
+			{"traces.json", lastN(traces, supportBundleTraces)},
+		}
+		if !supportBundleNoDoctor {
+			files = append(files, supportFile{"doctor.json", runDoctor(cmd.Context(), false, false)})
+		}
+
+		secrets := supportSecrets()
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		names := make([]string, 0, len(files))
+		for _, file := range files {
+			content, err := json.MarshalIndent(file.data, "", "  ")
+			if err != nil {
+				return errors.NewError(fmt.Sprintf("Could not encode %s: %v", file.name, err))
+			}
+			content = redactSupportContent(content, secrets)
+			w, err := zw.CreateHeader(&zip.FileHeader{Name: file.name, Method: zip.Deflate, Modified: now})
+			if err != nil {
+				return errors.NewError(fmt.Sprintf("Could not write support bundle: %v", err))
+			}
+			if _, err := w.Write(append(content, '\n')); err != nil {
+				return errors.NewError(fmt.Sprintf("Could not write support bundle: %v", err))
+			}
+			names = append(names, file.name)
+		}
+		if err := zw.Close(); err != nil {
+			return errors.NewError(fmt.Sprintf("Could not write support bundle: %v", err))
+		}
+
+		path := supportBundleFile
+		if path == "" {
+			path = fmt.Sprintf("fizzy-support-%s.zip", now.Format("20060102-150405"))
+		}
+		f, err := fsutil.CreateAtomic(path, 0o600)
+		if err != nil {
+			return errors.NewError(fmt.Sprintf("Could not write support bundle: %v", err))
+		}
+		if _, err := f.Write(buf.Bytes()); err != nil {
+			f.Discard()
+			return errors.NewError(fmt.Sprintf("Could not write support bundle: %v", err))
+		}
+		if err := f.Commit(); err != nil {
+			return errors.NewError(fmt.Sprintf("Could not write support bundle: %v", err))
+		}
+
+		result := map[string]any{
+			"file":    path,
+			"files":   names,
+			"bytes":   buf.Len(),
+			"history": len(lastN(history, supportBundleHistory)),
+			"traces":  len(lastN(traces, supportBundleTraces)),
+		}
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("history", "fizzy history", "Review recorded commands"),
+			breadcrumb("doctor", "fizzy doctor", "Run the health checks"),
+		}
+		printMutation(result, fmt.Sprintf("Wrote support bundle to %s", path), breadcrumbs)
+		return nil
+	},
+}
+
+// supportFile is one JSON file in a support bundle.
+type supportFile struct {
+	name string
+	data any
+}
+
+// lastN returns the last n items.
+func lastN[T any](items []T, n int) []T {
+	if len(items) > n {
+		return items[len(items)-n:]
+	}
+	return items
+}
+
+// supportSecrets returns the configured values a support bundle must never
+// contain: the tokens and any custom header values.
+func supportSecrets() []string {
+	var secrets []string
+	if cfg != nil {
+		secrets = append(secrets, cfg.Token, cfg.SecondaryToken)
+	}
+	for _, value := range requestHeaders() {
+		secrets = append(secrets, value)
+	}
+	return secrets
+}
+
+// redactSupportContent replaces every secret in content. Secrets shorter than
+// 8 characters are skipped so short values don't mangle unrelated text.
+func redactSupportContent(content []byte, secrets []string) []byte {
+	text := string(content)
+	for _, secret := range secrets {
+		if len(secret) < 8 {
+			continue
+		}
+		text = strings.ReplaceAll(text, secret, supportRedacted)
+	}
+	return []byte(text)
+}
+
+func init() {
+	supportBundleCmd.Flags().StringVar(&supportBundleFile, "file", "", "Bundle path (default: fizzy-support-TIMESTAMP.zip)")
+	supportBundleCmd.Flags().IntVar(&supportBundleHistory, "history", 50, "Number of recent commands to include")
+	supportBundleCmd.Flags().IntVar(&supportBundleTraces, "traces", 100, "Number of recent API requests to include")
+	supportBundleCmd.Flags().BoolVar(&supportBundleNoDoctor, "no-doctor", false, "Skip the doctor checks (they contact the API)")
+	supportCmd.AddCommand(supportBundleCmd)
+	rootCmd.AddCommand(supportCmd)
+}
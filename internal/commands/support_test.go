@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"archive/zip"
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+)
+
+func TestSupportBundle(t *testing.T) {
+	t.Run("zips version, config, history, and traces without secrets", func(t *testing.T) {
+		mock := NewMockClient()
+		result := SetTestModeWithSDK(mock)
+		SetTestConfig("secret-token-123", "account", "https://api.example.com")
+		defer resetTest()
+
+		requestTraces.OnRequestEnd(context.Background(),
+			fizzy.RequestInfo{Method: "GET", URL: "https://api.example.com/account/cards.json?indexed_by=closed", Attempt: 1},
+			fizzy.RequestResult{StatusCode: 200, Duration: 120 * time.Millisecond})
+		recordTraces(cardListCmd, []string{"card", "list", "--indexed-by", "closed", "--token", "secret-token-123"})
+		recordHistory(cardListCmd, []string{"card", "list", "--token", "secret-token-123"}, nil)
+
+		path := filepath.Join(t.TempDir(), "bundle.zip")
+		supportBundleFile = path
+		supportBundleNoDoctor = true
+		err := supportBundleCmd.RunE(supportBundleCmd, []string{})
+		supportBundleFile = ""
+		supportBundleNoDoctor = false
+		assertExitCode(t, err, 0)
+
+		data := result.Response.Data.(map[string]any)
+		if data["file"] != path || data["traces"] != float64(1) || data["history"] != float64(1) {
+			t.Errorf("unexpected result: %v", data)
+		}
+
+		zr, err := zip.OpenReader(path)
+		if err != nil {
+			t.Fatalf("expected a zip, got %v", err)
+		}
+		defer zr.Close()
+		contents := map[string]string{}
+		for _, f := range zr.File {
+			rc, err := f.Open()
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, _ := io.ReadAll(rc)
+			rc.Close()
+			contents[f.Name] = string(b)
+		}
+		for _, name := range []string{"version.json", "config.json", "history.json", "traces.json"} {
+			if _, ok := contents[name]; !ok {
+				t.Errorf("expected %s in the bundle", name)
+			}
+		}
+		if _, ok := contents["doctor.json"]; ok {
+			t.Error("expected --no-doctor to leave doctor.json out")
+		}
+		if !strings.Contains(contents["traces.json"], "indexed_by=closed") || !strings.Contains(contents["traces.json"], `"status": 200`) {
+			t.Errorf("expected the request trace, got %s", contents["traces.json"])
+		}
+		for name, content := range contents {
+			if strings.Contains(content, "secret-token-123") {
+				t.Errorf("expected %s to be redacted, got %s", name, content)
+			}
+		}
+	})
+
+	t.Run("does not trace skipped commands", func(t *testing.T) {
+		SetTestModeWithSDK(NewMockClient())
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		requestTraces.OnRequestEnd(context.Background(), fizzy.RequestInfo{Method: "GET", URL: "https://api.example.com/my/identity.json"}, fizzy.RequestResult{StatusCode: 200})
+		recordTraces(authLoginCmd, []string{"auth", "login", "secret"})
+
+		traces, err := loadTraces()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(traces) != 0 {
+			t.Errorf("expected no traces, got %v", traces)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/basecamp/cli/output"
+)
+
+// cfgTemplate is the --template flag: a Go template, or the name of one
+// under templates: in the config.
+var cfgTemplate string
+
+// outputTemplate is --template compiled, or nil when it isn't set.
+var outputTemplate *template.Template
+
+// templateFuncs are available to every --template on top of the builtins.
+var templateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"join": func(sep string, items []any) string {
+		parts := make([]string, len(items))
+		for i, item := range items {
+			parts[i] = fmt.Sprint(item)
+		}
+		return strings.Join(parts, sep)
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// compileTemplate compiles --template. A value matching a name in named is
+// replaced by that template first.
+func compileTemplate(value string, named map[string]string) (*template.Template, error) {
+	name, text := "template", value
+	if body, ok := named[value]; ok {
+		name, text = value, body
+	}
+	tmpl, err := template.New(name).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// writeTemplate executes --template against the response envelope in its
+// JSON form, so fields are named as in --json output ({{.data}},
+// {{.summary}}, {{range .data}}{{.number}}{{end}}).
+func writeTemplate(tmpl *template.Template, resp *output.Response) error {
+	envelope, err := envelopeJSON(resp)
+	if err != nil {
+		return err
+	}
+	if err := tmpl.Execute(outWriter, envelope); err != nil {
+		return &output.Error{Code: output.CodeUsage, Message: fmt.Sprintf("--template failed: %v", err)}
+	}
+	return nil
+}
+
+// envelopeJSON returns resp as its JSON form decodes, with the field names
+// and shapes --json prints.
+func envelopeJSON(resp *output.Response) (map[string]any, error) {
+	raw, err := json.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+	var envelope map[string]any
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
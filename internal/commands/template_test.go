@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+)
+
+func TestTemplateOutput(t *testing.T) {
+	newMock := func() *MockClient {
+		mock := NewMockClient()
+		mock.GetWithPaginationResponse = &client.APIResponse{
+			StatusCode: 200,
+			Data: []any{
+				map[string]any{"number": 1, "title": "Card 1", "tags": []any{"bug", "ui"}},
+				map[string]any{"number": 2, "title": "Card 2", "tags": []any{}},
+			},
+		}
+		return mock
+	}
+
+	tests := []struct {
+		name  string
+		value string
+		named map[string]string
+		want  string
+	}{
+		{
+			name:  "inline template",
+			value: "{{range .data}}{{.number}}: {{.title}}\n{{end}}",
+			want:  "1: Card 1\n2: Card 2\n",
+		},
+		{
+			name:  "named template from config",
+			value: "brief",
+			named: map[string]string{"brief": "{{len .data}} cards: {{range .data}}#{{.number}} {{end}}"},
+			want:  "2 cards: #1 #2 ",
+		},
+		{
+			name:  "helper functions",
+			value: `{{range .data}}{{upper .title}} [{{join "," .tags}}]{{"\n"}}{{end}}`,
+			want:  "CARD 1 [bug,ui]\nCARD 2 []\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetTestModeWithSDK(newMock())
+			SetTestConfig("token", "account", "https://api.example.com")
+			defer resetTest()
+
+			tmpl, err := compileTemplate(tt.value, tt.named)
+			if err != nil {
+				t.Fatalf("compileTemplate: %v", err)
+			}
+			outputTemplate = tmpl
+
+			err = cardListCmd.RunE(cardListCmd, []string{})
+			assertExitCode(t, err, 0)
+			if got := TestOutput(); got != tt.want {
+				t.Errorf("output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileTemplateInvalid(t *testing.T) {
+	if _, err := compileTemplate("{{range .data}}", nil); err == nil {
+		t.Error("expected an error for an unterminated template")
+	}
+}
+
+func TestTemplateFormatConflicts(t *testing.T) {
+	defer resetTest()
+	cfgTemplate = "{{.summary}}"
+	cfgJSON = true
+	if _, err := resolveFormat(); err == nil {
+		t.Error("expected --template with --json to be rejected")
+	}
+	cfgJSON = false
+	cfgJQ = ".data"
+	if _, err := resolveFormat(); err == nil {
+		t.Error("expected --template with --jq to be rejected")
+	}
+}
@@ -7,16 +7,26 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"strings"
+	"sync"
 
 	"github.com/basecamp/cli/output"
+	"github.com/basecamp/fizzy-cli/internal/cache"
 	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/state"
 	"github.com/spf13/cobra"
 )
 
 // testHTTPServer holds the current httptest server for cleanup.
 var testHTTPServer *httptest.Server
 
+// testCacheDir holds the per-test cache directory for cleanup.
+var testCacheDir string
+
+// testStateDir holds the per-test local state directory for cleanup.
+var testStateDir string
+
 // SetTestModeWithSDK configures both the old mock client and a new httptest-backed SDK.
 // The httptest server delegates to the MockClient so existing tests work unchanged.
 // Call resetTest() (or defer resetTest()) to clean up.
@@ -30,6 +40,15 @@ func SetTestModeWithSDK(mock *MockClient) *CommandResult {
 	// Wire up SDK to point at the httptest server
 	SetTestSDK(testHTTPServer.URL)
 
+	// Keep cached lookups (e.g. board columns) out of the user's cache dir
+	// and from leaking between tests
+	testCacheDir, _ = os.MkdirTemp("", "fizzy-cache-test-")
+	cache.SetTestDir(testCacheDir)
+
+	// Likewise keep local state (starred boards etc.) per test
+	testStateDir, _ = os.MkdirTemp("", "fizzy-state-test-")
+	state.SetTestDir(testStateDir)
+
 	// Set context on all commands so cmd.Context() doesn't return nil
 	// when tests call RunE directly instead of through cobra Execute
 	setContextOnAll(context.Background(), rootCmd)
@@ -51,12 +70,28 @@ func resetTest() {
 		testHTTPServer.Close()
 		testHTTPServer = nil
 	}
+	if testCacheDir != "" {
+		os.RemoveAll(testCacheDir)
+		testCacheDir = ""
+		cache.ResetTestDir()
+	}
+	if testStateDir != "" {
+		os.RemoveAll(testStateDir)
+		testStateDir = ""
+		state.ResetTestDir()
+	}
 	ResetTestMode()
 }
 
 // mockHandler creates an http.Handler that delegates to a MockClient.
 func mockHandler(mock *MockClient) http.Handler {
+	// Commands may issue requests concurrently; the mock records calls in
+	// plain slices, so serialize access.
+	var mu sync.Mutex
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
 		// Strip account prefix for account-scoped SDK paths: /<account>/path -> /path
 		path := r.URL.Path
 		if parts := strings.Split(strings.TrimPrefix(path, "/"), "/"); len(parts) > 1 {
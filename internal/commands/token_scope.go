@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/state"
+	fizzy "github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+	"github.com/spf13/cobra"
+)
+
+// readOnlyAnnotation marks commands that only ever read, such as exporters
+// and feeds. Running one with a write-capable token prints a least-privilege
+// warning, once per token.
+const readOnlyAnnotation = "fizzy_read_only"
+
+// tokenPermissionHeader is consulted when the identity payload does not
+// describe the token itself.
+const tokenPermissionHeader = "X-Fizzy-Token-Permission"
+
+// tokenPermission extracts the permission ("read" or "write") of the token
+// that made an identity request. Servers that do not expose it yield "".
+func tokenPermission(resp *fizzy.Response) string {
+	if resp == nil {
+		return ""
+	}
+	var payload struct {
+		AccessToken struct {
+			Permission string `json:"permission"`
+		} `json:"access_token"`
+	}
+	if len(resp.Data) > 0 && json.Unmarshal(resp.Data, &payload) == nil && payload.AccessToken.Permission != "" {
+		return strings.ToLower(payload.AccessToken.Permission)
+	}
+	if resp.Headers != nil {
+		return strings.ToLower(strings.TrimSpace(resp.Headers.Get(tokenPermissionHeader)))
+	}
+	return ""
+}
+
+// fetchTokenPermission asks the identity endpoint for the active token's
+// permission. Errors are treated as unknown since callers only use it for
+// display and advisory warnings.
+func fetchTokenPermission(ctx context.Context) string {
+	permission, _ := lookupTokenPermission(ctx)
+	return permission
+}
+
+// lookupTokenPermission is fetchTokenPermission, also reporting whether the
+// identity endpoint answered.
+func lookupTokenPermission(ctx context.Context) (string, bool) {
+	if cfg == nil || cfg.Token == "" || requireSDK() != nil || getSDKClient() == nil {
+		return "", false
+	}
+	_, resp, err := getSDKClient().Identity().GetMyIdentity(ctx)
+	if err != nil {
+		return "", false
+	}
+	return tokenPermission(resp), true
+}
+
+// leastPrivilegeWarning explains why a write-capable token is more access
+// than a read-only command needs, or returns "" when the token is fine.
+func leastPrivilegeWarning(permission string) string {
+	if permission != "write" {
+		return ""
+	}
+	return "This command only reads data but the active token can write. Create a read-only token with: fizzy token create --permission read"
+}
+
+// tokenCheckedKey is the state key recording that the active token's
+// permission was checked, named by a hash of the token.
+func tokenCheckedKey() string {
+	sum := sha256.Sum256([]byte(cfg.Token))
+	return "token-checked/" + hex.EncodeToString(sum[:12])
+}
+
+// warnIfOverPrivileged prints a least-privilege warning to stderr when cmd is
+// annotated read-only and the active token can write. Each token is checked
+// once, so later runs, such as a feed regenerated from cron, neither fetch
+// the identity again nor repeat the warning.
+func warnIfOverPrivileged(cmd *cobra.Command) {
+	if cmd.Annotations[readOnlyAnnotation] != "true" || cfg == nil || cfg.Token == "" {
+		return
+	}
+	var checked bool
+	if found, err := state.Load(tokenCheckedKey(), &checked); err == nil && found {
+		return
+	}
+	permission, ok := lookupTokenPermission(cmd.Context())
+	if !ok {
+		return
+	}
+	_ = state.Save(tokenCheckedKey(), true)
+	if w := leastPrivilegeWarning(permission); w != "" {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+	}
+}
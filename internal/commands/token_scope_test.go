@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	fizzy "github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+	"github.com/spf13/cobra"
+)
+
+func TestTokenPermission(t *testing.T) {
+	tests := []struct {
+		name string
+		resp *fizzy.Response
+		want string
+	}{
+		{name: "nil response", resp: nil, want: ""},
+		{name: "not exposed", resp: &fizzy.Response{Data: json.RawMessage(`{"id":"u1"}`)}, want: ""},
+		{name: "from payload", resp: &fizzy.Response{Data: json.RawMessage(`{"id":"u1","access_token":{"permission":"Write"}}`)}, want: "write"},
+		{
+			name: "from header",
+			resp: &fizzy.Response{Data: json.RawMessage(`{"id":"u1"}`), Headers: http.Header{"X-Fizzy-Token-Permission": []string{"read"}}},
+			want: "read",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tokenPermission(tt.resp); got != tt.want {
+				t.Errorf("tokenPermission() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLeastPrivilegeWarning(t *testing.T) {
+	if w := leastPrivilegeWarning("write"); w == "" {
+		t.Error("expected a warning for a write token")
+	}
+	for _, permission := range []string{"read", ""} {
+		if w := leastPrivilegeWarning(permission); w != "" {
+			t.Errorf("expected no warning for %q, got %q", permission, w)
+		}
+	}
+}
+
+func TestTokenPermissionInStatusAndIdentity(t *testing.T) {
+	mock := NewMockClient()
+	mock.OnGet("/my/identity.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+		"id":           "user-123",
+		"accounts":     []any{},
+		"access_token": map[string]any{"permission": "read"},
+	}})
+	result := SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", testHTTPServer.URL)
+	defer resetTest()
+
+	if err := identityShowCmd.RunE(identityShowCmd, []string{}); err != nil {
+		t.Fatalf("identity show: %v", err)
+	}
+	data := result.Response.Data.(map[string]any)
+	if data["token_permission"] != "read" {
+		t.Errorf("expected identity token_permission=read, got %v", data["token_permission"])
+	}
+
+	if err := authStatusCmd.RunE(authStatusCmd, []string{}); err != nil {
+		t.Fatalf("auth status: %v", err)
+	}
+	data = result.Response.Data.(map[string]any)
+	if data["token_permission"] != "read" {
+		t.Errorf("expected status token_permission=read, got %v", data["token_permission"])
+	}
+}
+
+func TestWarnIfOverPrivileged(t *testing.T) {
+	mock := NewMockClient()
+	mock.OnGet("/my/identity.json", &client.APIResponse{StatusCode: 200, Data: map[string]any{
+		"id":           "user-123",
+		"accounts":     []any{},
+		"access_token": map[string]any{"permission": "write"},
+	}})
+	SetTestModeWithSDK(mock)
+	SetTestConfig("token", "account", testHTTPServer.URL)
+	defer resetTest()
+
+	warning := func(cmd *cobra.Command) string {
+		t.Helper()
+		oldStderr := os.Stderr
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatalf("pipe: %v", err)
+		}
+		os.Stderr = w
+		cmd.SetContext(context.Background())
+		warnIfOverPrivileged(cmd)
+		_ = w.Close()
+		os.Stderr = oldStderr
+		body, _ := io.ReadAll(r)
+		return string(body)
+	}
+
+	if got := warning(boardSnapshotCmd); !strings.Contains(got, "fizzy token create --permission read") {
+		t.Errorf("expected a least-privilege warning for a read-only command, got %q", got)
+	}
+	if got := warning(cardCloseCmd); got != "" {
+		t.Errorf("expected no warning for a write command, got %q", got)
+	}
+
+	// The token was checked once; later runs neither fetch nor warn again.
+	before := len(mock.GetCalls)
+	if got := warning(boardSubscribeCmd); got != "" {
+		t.Errorf("expected the warning only once per token, got %q", got)
+	}
+	if len(mock.GetCalls) != before {
+		t.Errorf("expected no identity request after the first check, got %v", mock.GetCalls[before:])
+	}
+}
@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/state"
+	"github.com/basecamp/fizzy-sdk/go/pkg/fizzy"
+	"github.com/spf13/cobra"
+)
+
+// traceKey is where recent API request traces are stored, for support
+// bundles.
+const traceKey = "traces"
+
+// traceLimit caps how many request traces are kept.
+const traceLimit = 200
+
+// RequestTrace is one API request made by a recorded invocation. Only the
+// method, URL, and outcome are kept; headers and bodies never are.
+type RequestTrace struct {
+	At         time.Time `json:"at"`
+	Command    string    `json:"command"`
+	Method     string    `json:"method"`
+	URL        string    `json:"url"`
+	Attempt    int       `json:"attempt,omitempty"`
+	Status     int       `json:"status"`
+	DurationMS int64     `json:"duration_ms"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// traceHooks collects the SDK's requests during a run.
+type traceHooks struct {
+	fizzy.NoopHooks
+	mu     sync.Mutex
+	traces []RequestTrace
+}
+
+// requestTraces collects the requests of the current invocation.
+var requestTraces = &traceHooks{}
+
+func (h *traceHooks) OnRequestEnd(_ context.Context, info fizzy.RequestInfo, result fizzy.RequestResult) {
+	trace := RequestTrace{
+		At:         time.Now().UTC().Add(-result.Duration),
+		Method:     info.Method,
+		URL:        info.URL,
+		Status:     result.StatusCode,
+		DurationMS: result.Duration.Milliseconds(),
+	}
+	if info.Attempt > 1 {
+		trace.Attempt = info.Attempt
+	}
+	if result.Error != nil {
+		trace.Error = result.Error.Error()
+	}
+	h.mu.Lock()
+	h.traces = append(h.traces, trace)
+	h.mu.Unlock()
+}
+
+// take returns the collected traces and forgets them.
+func (h *traceHooks) take() []RequestTrace {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	traces := h.traces
+	h.traces = nil
+	return traces
+}
+
+func loadTraces() ([]RequestTrace, error) {
+	var traces []RequestTrace
+	if _, err := state.Load(traceKey, &traces); err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not read request traces: %v", err))
+	}
+	return traces, nil
+}
+
+// recordTraces appends the invocation's requests to the trace file. It is
+// best-effort and follows the same rules as history, so FIZZY_NO_HISTORY
+// disables it too.
+func recordTraces(cmd *cobra.Command, args []string) {
+	traces := requestTraces.take()
+	if len(traces) == 0 || !historyRecordable(cmd, args) {
+		return
+	}
+	redacted, _ := redactHistoryArgs(args)
+	command := HistoryEntry{Args: redacted}.Command()
+	for i := range traces {
+		traces[i].Command = command
+	}
+
+	stored, err := loadTraces()
+	if err != nil {
+		stored = nil
+	}
+	stored = append(stored, traces...)
+	if len(stored) > traceLimit {
+		stored = stored[len(stored)-traceLimit:]
+	}
+	_ = state.Save(traceKey, stored)
+}
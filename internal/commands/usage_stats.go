@@ -0,0 +1,204 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/basecamp/fizzy-cli/internal/state"
+	"github.com/spf13/cobra"
+)
+
+// usageStatsKey is where usage stats are stored. Like history they are shared
+// across profiles.
+const usageStatsKey = "stats/commands"
+
+// usageStatsSamples is how many recent durations are kept per command for
+// the median and 95th percentile.
+const usageStatsSamples = 100
+
+// commandStats is what is recorded about one command. Durations are in
+// milliseconds.
+type commandStats struct {
+	Runs    int       `json:"runs"`
+	Errors  int       `json:"errors"`
+	TotalMS int64     `json:"total_ms"`
+	MaxMS   int64     `json:"max_ms"`
+	Recent  []int64   `json:"recent_ms"`
+	LastRun time.Time `json:"last_run_at"`
+}
+
+// usageStats is the whole stats file.
+type usageStats struct {
+	Since    time.Time                `json:"since"`
+	Commands map[string]*commandStats `json:"commands"`
+}
+
+func loadUsageStats() (*usageStats, error) {
+	stats := &usageStats{}
+	if _, err := state.Load(usageStatsKey, stats); err != nil {
+		return nil, errors.NewError(fmt.Sprintf("Could not read usage stats: %v", err))
+	}
+	if stats.Commands == nil {
+		stats.Commands = map[string]*commandStats{}
+	}
+	return stats, nil
+}
+
+// recordUsageStats adds a finished command to the local usage stats when
+// usage_stats is on. It is best-effort, and skips shell completion and help.
+func recordUsageStats(cmd *cobra.Command, runErr error, elapsed time.Duration) {
+	if cmd == nil || !cmd.HasParent() || !effectiveConfig().UsageStats {
+		return
+	}
+	for c := cmd; c != nil && c.HasParent(); c = c.Parent() {
+		if c.Hidden || strings.HasPrefix(c.Name(), "__") || c.Name() == "help" || c.Name() == "completion" {
+			return
+		}
+	}
+
+	stats, err := loadUsageStats()
+	if err != nil {
+		return
+	}
+	now := time.Now().UTC()
+	if stats.Since.IsZero() {
+		stats.Since = now
+	}
+	name := strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+	entry := stats.Commands[name]
+	if entry == nil {
+		entry = &commandStats{}
+		stats.Commands[name] = entry
+	}
+
+	ms := elapsed.Milliseconds()
+	entry.Runs++
+	if runErr != nil && !errors.IsPrinted(runErr) {
+		entry.Errors++
+	}
+	entry.TotalMS += ms
+	entry.MaxMS = max(entry.MaxMS, ms)
+	entry.Recent = append(entry.Recent, ms)
+	if len(entry.Recent) > usageStatsSamples {
+		entry.Recent = entry.Recent[len(entry.Recent)-usageStatsSamples:]
+	}
+	entry.LastRun = now
+	_ = state.Save(usageStatsKey, stats)
+}
+
+// percentile returns the p-th percentile (0-100) of durations, by the
+// nearest-rank method.
+func percentile(durations []int64, p int) int64 {
+	if len(durations) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show local statistics",
+	Long:  "Shows statistics kept on this machine.",
+}
+
+// Stats cli flags
+var statsCLISort string
+var statsCLIReset bool
+
+var statsCLICmd = &cobra.Command{
+	Use:   "cli",
+	Short: "Show how often commands run and how long they take",
+	Long: `Shows how often each command has run and how long it took: runs, errors,
+and the average, median, 95th percentile, and slowest durations. Use it to spot
+slow commands worth caching or running with more concurrency.
+
+Stats are opt-in and never leave this machine. Turn them on with
+usage_stats: true in config.yaml or FIZZY_USAGE_STATS=1. Durations are
+wall-clock time for the whole command, including prompts. --reset clears
+them.`,
+	Example: `  fizzy stats cli
+  fizzy stats cli --sort p95 --limit 10`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if statsCLIReset {
+			if err := state.Delete(usageStatsKey); err != nil {
+				return errors.NewError(fmt.Sprintf("Could not clear usage stats: %v", err))
+			}
+			printMutation(map[string]any{"reset": true}, "Usage stats cleared", nil)
+			return nil
+		}
+
+		stats, err := loadUsageStats()
+		if err != nil {
+			return err
+		}
+
+		items := make([]map[string]any, 0, len(stats.Commands))
+		runs := 0
+		for name, entry := range stats.Commands {
+			runs += entry.Runs
+			items = append(items, map[string]any{
+				"command":     name,
+				"runs":        entry.Runs,
+				"errors":      entry.Errors,
+				"avg_ms":      entry.TotalMS / int64(max(entry.Runs, 1)),
+				"p50_ms":      percentile(entry.Recent, 50),
+				"p95_ms":      percentile(entry.Recent, 95),
+				"max_ms":      entry.MaxMS,
+				"last_run_at": entry.LastRun.Format(time.RFC3339),
+			})
+		}
+		key := "runs"
+		switch statsCLISort {
+		case "", "runs":
+		case "avg", "p50", "p95", "max":
+			key = statsCLISort + "_ms"
+		default:
+			return errors.NewInvalidArgsError(fmt.Sprintf("invalid --sort %q (use runs, avg, p50, p95, or max)", statsCLISort))
+		}
+		value := func(item map[string]any) int64 {
+			switch v := item[key].(type) {
+			case int:
+				return int64(v)
+			case int64:
+				return v
+			}
+			return 0
+		}
+		sort.SliceStable(items, func(i, j int) bool {
+			if a, b := value(items[i]), value(items[j]); a != b {
+				return a > b
+			}
+			return items[i]["command"].(string) < items[j]["command"].(string)
+		})
+		list := make([]any, len(items))
+		for i, item := range items {
+			list[i] = item
+		}
+
+		summary := fmt.Sprintf("%d %s, %d %s", len(items), pluralize(len(items), "command", "commands"), runs, pluralize(runs, "run", "runs"))
+		if !stats.Since.IsZero() {
+			summary += " since " + stats.Since.Format("2006-01-02")
+		}
+		if !effectiveConfig().UsageStats {
+			summary += " (recording is off; set usage_stats: true in config.yaml)"
+		}
+		printList(list, statsCLIColumns, summary, nil)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+
+	statsCLICmd.Flags().StringVar(&statsCLISort, "sort", "runs", "Sort by runs, avg, p50, p95, or max")
+	statsCLICmd.Flags().BoolVar(&statsCLIReset, "reset", false, "Clear the recorded stats")
+	statsCmd.AddCommand(statsCLICmd)
+}
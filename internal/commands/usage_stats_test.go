@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestUsageStats(t *testing.T) {
+	result := SetTestModeWithSDK(NewMockClient())
+	SetTestConfig("token", "account", "https://api.example.com")
+	defer resetTest()
+
+	// Nothing is recorded until usage_stats is on.
+	recordUsageStats(cardListCmd, nil, time.Second)
+	if stats, _ := loadUsageStats(); len(stats.Commands) != 0 {
+		t.Fatalf("expected no stats while off, got %v", stats.Commands)
+	}
+
+	cfg.UsageStats = true
+	recordUsageStats(cardListCmd, nil, 100*time.Millisecond)
+	recordUsageStats(cardListCmd, errors.NewNotFoundError("Board not found"), 300*time.Millisecond)
+	recordUsageStats(boardShowCmd, nil, 2*time.Second)
+	recordUsageStats(rootCmd, nil, time.Second)
+
+	statsCLISort = "max"
+	defer func() { statsCLISort = "runs" }()
+	err := statsCLICmd.RunE(statsCLICmd, []string{})
+	assertExitCode(t, err, 0)
+
+	items := toMaps(result.Response.Data)
+	if len(items) != 2 {
+		t.Fatalf("expected 2 commands, got %v", items)
+	}
+	if items[0]["command"] != "board show" || items[1]["command"] != "card list" {
+		t.Errorf("expected commands sorted by max duration, got %v", items)
+	}
+	list := items[1]
+	if list["runs"] != float64(2) || list["errors"] != float64(1) || list["avg_ms"] != float64(200) || list["max_ms"] != float64(300) {
+		t.Errorf("unexpected card list stats: %v", list)
+	}
+
+	statsCLIReset = true
+	defer func() { statsCLIReset = false }()
+	err = statsCLICmd.RunE(statsCLICmd, []string{})
+	assertExitCode(t, err, 0)
+	if stats, _ := loadUsageStats(); len(stats.Commands) != 0 {
+		t.Errorf("expected stats to be cleared, got %v", stats.Commands)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	durations := []int64{50, 10, 40, 20, 30}
+	if got := percentile(durations, 50); got != 30 {
+		t.Errorf("expected median 30, got %d", got)
+	}
+	if got := percentile(durations, 95); got != 50 {
+		t.Errorf("expected p95 50, got %d", got)
+	}
+	if got := percentile(nil, 95); got != 0 {
+		t.Errorf("expected 0 for no durations, got %d", got)
+	}
+}
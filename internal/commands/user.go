@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/basecamp/fizzy-cli/internal/errors"
 	"github.com/basecamp/fizzy-sdk/go/pkg/generated"
 	"github.com/spf13/cobra"
 )
@@ -17,11 +18,13 @@ var userCmd = &cobra.Command{
 // User list flags
 var userListPage int
 var userListAll bool
+var userListActive bool
+var userListInactive bool
 
 var userListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List users",
-	Long:  "Lists all users in your account.",
+	Long:  "Lists all users in your account. Use --active or --inactive to show only active or deactivated users.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if err := requireAuthAndAccount(); err != nil {
 			return err
@@ -29,6 +32,9 @@ var userListCmd = &cobra.Command{
 		if err := checkLimitAll(userListAll); err != nil {
 			return err
 		}
+		if userListActive && userListInactive {
+			return errors.NewInvalidArgsError("--active and --inactive cannot be used together")
+		}
 
 		ac := getSDK()
 		var items any
@@ -58,9 +64,18 @@ var userListCmd = &cobra.Command{
 			linkNext = parseSDKLinkNext(resp)
 		}
 
+		if userListActive || userListInactive {
+			items = usersByActive(items, userListActive)
+		}
+
 		// Build summary
 		count := dataCount(items)
 		summary := fmt.Sprintf("%d users", count)
+		if userListActive {
+			summary = fmt.Sprintf("%d active users", count)
+		} else if userListInactive {
+			summary = fmt.Sprintf("%d inactive users", count)
+		}
 		if userListAll {
 			summary += " (all)"
 		} else if userListPage > 0 {
@@ -87,6 +102,23 @@ var userListCmd = &cobra.Command{
 	},
 }
 
+// usersByActive keeps users whose active flag equals active. Users without an
+// active field are treated as active.
+func usersByActive(items any, active bool) any {
+	users := toMaps(items)
+	kept := make([]any, 0, len(users))
+	for _, user := range users {
+		isActive, ok := user["active"].(bool)
+		if !ok {
+			isActive = true
+		}
+		if isActive == active {
+			kept = append(kept, user)
+		}
+	}
+	return kept
+}
+
 var userShowCmd = &cobra.Command{
 	Use:   "show USER_ID",
 	Short: "Show a user",
@@ -492,6 +524,8 @@ func init() {
 	// List
 	userListCmd.Flags().IntVar(&userListPage, "page", 0, "Page number")
 	userListCmd.Flags().BoolVar(&userListAll, "all", false, "Fetch all pages")
+	userListCmd.Flags().BoolVar(&userListActive, "active", false, "Only active users")
+	userListCmd.Flags().BoolVar(&userListInactive, "inactive", false, "Only deactivated users")
 	userCmd.AddCommand(userListCmd)
 
 	// Show
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/errors"
+	"github.com/spf13/cobra"
+)
+
+var userFindCmd = &cobra.Command{
+	Use:   "find QUERY",
+	Short: "Find a user by email or name",
+	Long: `Finds a single user by email address or name and shows their details,
+including the ID needed for assignments and mentions.
+
+An exact email or name match (case-insensitive) wins. Otherwise QUERY is matched
+as a substring of names and email addresses; if more than one user matches, the
+candidates are listed so the query can be narrowed.`,
+	Example: `  fizzy user find alice@example.com
+  fizzy user find "Alice Smith" --jq '.data.id'`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAuthAndAccount(); err != nil {
+			return err
+		}
+
+		query := strings.TrimSpace(args[0])
+		if query == "" {
+			return errors.NewInvalidArgsError("query cannot be empty")
+		}
+
+		pages, err := getSDK().GetAll(cmd.Context(), "/users.json")
+		if err != nil {
+			return convertSDKError(err)
+		}
+
+		user, err := matchUser(toMaps(jsonAnySlice(pages)), query)
+		if err != nil {
+			return err
+		}
+
+		userID := fmt.Sprintf("%v", user["id"])
+		summary := fmt.Sprintf("User %s", userID)
+		if name, ok := user["name"].(string); ok && name != "" {
+			summary = fmt.Sprintf("%s (%s)", name, userID)
+		}
+
+		breadcrumbs := []Breadcrumb{
+			breadcrumb("show", fmt.Sprintf("fizzy user show %s", userID), "View user details"),
+			breadcrumb("assign", fmt.Sprintf("fizzy card assign <number> --user %s", userID), "Assign to card"),
+		}
+
+		printDetail(user, summary, breadcrumbs)
+		return nil
+	},
+}
+
+// matchUser picks the user matching query: an exact email or name match
+// first, then a unique substring match on either.
+func matchUser(users []map[string]any, query string) (map[string]any, error) {
+	lower := strings.ToLower(query)
+
+	var exact, partial []map[string]any
+	for _, user := range users {
+		email, _ := user["email_address"].(string)
+		name, _ := user["name"].(string)
+		switch {
+		case fmt.Sprintf("%v", user["id"]) == query,
+			strings.EqualFold(email, query),
+			strings.EqualFold(strings.TrimSpace(name), query):
+			exact = append(exact, user)
+		case strings.Contains(strings.ToLower(email), lower),
+			strings.Contains(strings.ToLower(name), lower):
+			partial = append(partial, user)
+		}
+	}
+
+	matches := exact
+	if len(matches) == 0 {
+		matches = partial
+	}
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		e := errors.NewNotFoundError(fmt.Sprintf("no user matches %q", query))
+		e.Hint = "Run 'fizzy user list --all' to see all users"
+		return nil, e
+	default:
+		candidates := make([]string, 0, len(matches))
+		for _, user := range matches {
+			candidates = append(candidates, fmt.Sprintf("%v <%v> (%v)", user["name"], user["email_address"], user["id"]))
+		}
+		return nil, errors.NewAmbiguousError("user "+query, candidates)
+	}
+}
+
+func init() {
+	userCmd.AddCommand(userFindCmd)
+}
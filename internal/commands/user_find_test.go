@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/basecamp/fizzy-cli/internal/client"
+	"github.com/basecamp/fizzy-cli/internal/errors"
+)
+
+func TestUserFind(t *testing.T) {
+	users := []any{
+		map[string]any{"id": "u1", "name": "Alice Smith", "email_address": "alice@example.com"},
+		map[string]any{"id": "u2", "name": "Alicia Keys", "email_address": "alicia@example.com"},
+		map[string]any{"id": "u3", "name": "Bob Jones", "email_address": "bob@example.com"},
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		wantID   string
+		wantExit int
+	}{
+		{name: "exact email", query: "ALICE@example.com", wantID: "u1"},
+		{name: "exact name wins over substring", query: "alice smith", wantID: "u1"},
+		{name: "unique substring", query: "bob", wantID: "u3"},
+		{name: "ambiguous substring", query: "ali", wantExit: errors.ExitAmbiguous},
+		{name: "no match", query: "carol", wantExit: errors.ExitNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := NewMockClient()
+			mock.GetWithPaginationResponse = &client.APIResponse{StatusCode: 200, Data: users}
+
+			result := SetTestModeWithSDK(mock)
+			SetTestConfig("token", "account", "https://api.example.com")
+			defer resetTest()
+
+			err := userFindCmd.RunE(userFindCmd, []string{tt.query})
+			assertExitCode(t, err, tt.wantExit)
+			if tt.wantExit != 0 {
+				return
+			}
+			if mock.GetWithPaginationCalls[0].Path != "/users.json" {
+				t.Errorf("expected /users.json, got %s", mock.GetWithPaginationCalls[0].Path)
+			}
+			user := result.Response.Data.(map[string]any)
+			if user["id"] != tt.wantID {
+				t.Errorf("expected user %s, got %v", tt.wantID, user["id"])
+			}
+		})
+	}
+}
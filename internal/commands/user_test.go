@@ -29,6 +29,56 @@ func TestUserList(t *testing.T) {
 		}
 	})
 
+	t.Run("filters by active state", func(t *testing.T) {
+		for _, tt := range []struct {
+			name     string
+			active   bool
+			inactive bool
+			wantID   string
+		}{
+			{name: "active", active: true, wantID: "1"},
+			{name: "inactive", inactive: true, wantID: "2"},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				mock := NewMockClient()
+				mock.GetWithPaginationResponse = &client.APIResponse{
+					StatusCode: 200,
+					Data: []any{
+						map[string]any{"id": "1", "name": "User 1", "active": true},
+						map[string]any{"id": "2", "name": "User 2", "active": false},
+					},
+				}
+
+				result := SetTestModeWithSDK(mock)
+				SetTestConfig("token", "account", "https://api.example.com")
+				defer resetTest()
+
+				userListActive, userListInactive = tt.active, tt.inactive
+				err := userListCmd.RunE(userListCmd, []string{})
+				userListActive, userListInactive = false, false
+
+				assertExitCode(t, err, 0)
+				users := result.Response.Data.([]any)
+				if len(users) != 1 || users[0].(map[string]any)["id"] != tt.wantID {
+					t.Errorf("expected only user %s, got %v", tt.wantID, users)
+				}
+			})
+		}
+	})
+
+	t.Run("rejects active with inactive", func(t *testing.T) {
+		mock := NewMockClient()
+		SetTestModeWithSDK(mock)
+		SetTestConfig("token", "account", "https://api.example.com")
+		defer resetTest()
+
+		userListActive, userListInactive = true, true
+		err := userListCmd.RunE(userListCmd, []string{})
+		userListActive, userListInactive = false, false
+
+		assertExitCode(t, err, errors.ExitInvalidArgs)
+	})
+
 	t.Run("passes page to GetAll", func(t *testing.T) {
 		mock := NewMockClient()
 		mock.GetWithPaginationResponse = &client.APIResponse{
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -52,6 +53,79 @@ type Config struct {
 	Account string `yaml:"account"`
 	APIURL  string `yaml:"api_url"`
 	Board   string `yaml:"board"`
+
+	// SecondaryToken is tried when the API rejects Token, so a token can be
+	// rotated without downtime. It comes from the credential store or
+	// FIZZY_SECONDARY_TOKEN and is never written to YAML.
+	SecondaryToken string `yaml:"-"`
+
+	// TLS settings for self-hosted instances behind a private CA or mTLS proxy.
+	CACert             string `yaml:"ca_cert,omitempty"`
+	ClientCert         string `yaml:"client_cert,omitempty"`
+	ClientKey          string `yaml:"client_key,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify,omitempty"`
+
+	// Headers are added to every API request, e.g. for an auth proxy.
+	// Values may reference environment variables as $VAR or ${VAR}.
+	Headers map[string]string `yaml:"headers,omitempty"`
+
+	// Locale selects the language of human-readable output (e.g. "es").
+	// Empty means detect from LC_ALL, LC_MESSAGES, or LANG.
+	Locale string `yaml:"locale,omitempty"`
+
+	// ArchiveBeforeDelete makes 'board delete' save a local snapshot of the
+	// board before deleting it.
+	ArchiveBeforeDelete bool `yaml:"archive_before_delete,omitempty"`
+
+	// DeleteGrace defers deletes of boards, cards, columns, comments, and
+	// steps: they are recorded locally and carried out by 'fizzy purge' once
+	// this long has passed (e.g. "72h"). Empty or zero deletes right away.
+	DeleteGrace string `yaml:"delete_grace,omitempty"`
+
+	// OfflineCache keeps a local copy of the cards the CLI fetches, so
+	// 'card show' and 'card list' can serve them with --offline or when
+	// the network is down.
+	OfflineCache bool `yaml:"offline_cache,omitempty"`
+
+	// UsageStats records how often each command runs and how long it
+	// takes, locally, for 'fizzy stats cli'. Nothing is ever uploaded.
+	UsageStats bool `yaml:"usage_stats,omitempty"`
+
+	// MaxParallelRequests caps the API requests in flight at once and
+	// RequestsPerSecond caps how fast they start, across everything one
+	// command does. Zero means no limit.
+	MaxParallelRequests int     `yaml:"max_parallel_requests,omitempty"`
+	RequestsPerSecond   float64 `yaml:"requests_per_second,omitempty"`
+
+	// Hooks are shell commands run before or after matching CLI commands.
+	// They are only read from the global config, never from .fizzy.yaml.
+	Hooks Hooks `yaml:"hooks,omitempty"`
+
+	// Templates are Go templates for --template, by name, so
+	// '--template brief' can stand for a longer template. A project's
+	// .fizzy.yaml can add or override them.
+	Templates map[string]string `yaml:"templates,omitempty"`
+
+	// Lint configures the rules of 'lint board'. A project's .fizzy.yaml
+	// can adjust them on top of the global config.
+	Lint Lint `yaml:"lint,omitempty"`
+}
+
+// Lint holds per-rule severities and the limits rules check against.
+type Lint struct {
+	// Rules maps a rule name to a severity (error, warning, info) or "off".
+	Rules map[string]string `yaml:"rules,omitempty"`
+	// WIPLimits caps the open cards in a column, by column name; "*" applies
+	// to every column without its own limit.
+	WIPLimits map[string]int `yaml:"wip_limits,omitempty"`
+	// StaleAfter is how long a golden card may go without activity, e.g. "336h".
+	StaleAfter string `yaml:"stale_after,omitempty"`
+}
+
+// Hooks maps command paths such as "card close" to shell commands.
+type Hooks struct {
+	Pre  map[string]string `yaml:"pre,omitempty"`
+	Post map[string]string `yaml:"post,omitempty"`
 }
 
 // globalConfigPaths returns the possible global configuration file paths in order of preference.
@@ -156,6 +230,57 @@ func Load() *Config {
 				if localCfg.Board != "" {
 					cfg.Board = localCfg.Board
 				}
+				// insecure_skip_verify is deliberately not honored from a
+				// project file, which may come from an untrusted checkout.
+				if localCfg.CACert != "" {
+					cfg.CACert = localCfg.CACert
+				}
+				if localCfg.ClientCert != "" {
+					cfg.ClientCert = localCfg.ClientCert
+				}
+				if localCfg.ClientKey != "" {
+					cfg.ClientKey = localCfg.ClientKey
+				}
+				if localCfg.Locale != "" {
+					cfg.Locale = localCfg.Locale
+				}
+				// A project can turn archiving on, never off.
+				if localCfg.ArchiveBeforeDelete {
+					cfg.ArchiveBeforeDelete = true
+				}
+				if localCfg.OfflineCache {
+					cfg.OfflineCache = true
+				}
+				// A project can tighten the request limits, never loosen them.
+				cfg.MaxParallelRequests = tighterLimit(cfg.MaxParallelRequests, localCfg.MaxParallelRequests)
+				cfg.RequestsPerSecond = tighterLimit(cfg.RequestsPerSecond, localCfg.RequestsPerSecond)
+				for rule, severity := range localCfg.Lint.Rules {
+					if cfg.Lint.Rules == nil {
+						cfg.Lint.Rules = map[string]string{}
+					}
+					cfg.Lint.Rules[rule] = severity
+				}
+				for column, limit := range localCfg.Lint.WIPLimits {
+					if cfg.Lint.WIPLimits == nil {
+						cfg.Lint.WIPLimits = map[string]int{}
+					}
+					cfg.Lint.WIPLimits[column] = limit
+				}
+				if localCfg.Lint.StaleAfter != "" {
+					cfg.Lint.StaleAfter = localCfg.Lint.StaleAfter
+				}
+				for name, value := range localCfg.Headers {
+					if cfg.Headers == nil {
+						cfg.Headers = map[string]string{}
+					}
+					cfg.Headers[name] = value
+				}
+				for name, body := range localCfg.Templates {
+					if cfg.Templates == nil {
+						cfg.Templates = map[string]string{}
+					}
+					cfg.Templates[name] = body
+				}
 			}
 		}
 	}
@@ -173,11 +298,52 @@ func Load() *Config {
 	if board := os.Getenv("FIZZY_BOARD"); board != "" {
 		cfg.Board = board
 	}
+	if caCert := os.Getenv("FIZZY_CA_CERT"); caCert != "" {
+		cfg.CACert = caCert
+	}
+	if clientCert := os.Getenv("FIZZY_CLIENT_CERT"); clientCert != "" {
+		cfg.ClientCert = clientCert
+	}
+	if clientKey := os.Getenv("FIZZY_CLIENT_KEY"); clientKey != "" {
+		cfg.ClientKey = clientKey
+	}
+	if locale := os.Getenv("FIZZY_LOCALE"); locale != "" {
+		cfg.Locale = locale
+	}
+	if insecure := os.Getenv("FIZZY_INSECURE_SKIP_VERIFY"); insecure != "" {
+		cfg.InsecureSkipVerify = insecure == "1" || strings.EqualFold(insecure, "true")
+	}
+	if archive := os.Getenv("FIZZY_ARCHIVE_BEFORE_DELETE"); archive != "" {
+		cfg.ArchiveBeforeDelete = archive == "1" || strings.EqualFold(archive, "true")
+	}
+	if grace := os.Getenv("FIZZY_DELETE_GRACE"); grace != "" {
+		cfg.DeleteGrace = grace
+	}
+	if offline := os.Getenv("FIZZY_OFFLINE_CACHE"); offline != "" {
+		cfg.OfflineCache = offline == "1" || strings.EqualFold(offline, "true")
+	}
+	if stats := os.Getenv("FIZZY_USAGE_STATS"); stats != "" {
+		cfg.UsageStats = stats == "1" || strings.EqualFold(stats, "true")
+	}
+	if parallel, err := strconv.Atoi(os.Getenv("FIZZY_MAX_PARALLEL_REQUESTS")); err == nil && parallel >= 0 {
+		cfg.MaxParallelRequests = parallel
+	}
+	if rate, err := strconv.ParseFloat(os.Getenv("FIZZY_REQUESTS_PER_SECOND"), 64); err == nil && rate >= 0 {
+		cfg.RequestsPerSecond = rate
+	}
 
 	ensureAPIURL(cfg)
 	return cfg
 }
 
+// tighterLimit returns the stricter of two limits where zero means none.
+func tighterLimit[T int | float64](current, proposed T) T {
+	if proposed > 0 && (current <= 0 || proposed < current) {
+		return proposed
+	}
+	return current
+}
+
 // LoadGlobal loads configuration only from the global config file(s) and defaults.
 // It does not apply local project config or environment variables.
 func LoadGlobal() *Config {
@@ -305,3 +471,34 @@ func (c *Config) SaveLocal() error {
 
 	return os.WriteFile(path, data, 0600)
 }
+
+// MigrateLegacyConfig moves a global config found only at the legacy
+// ~/.fizzy/config.yaml location to ~/.config/fizzy/config.yaml. It returns
+// the source and destination paths, or empty strings when there was nothing
+// to migrate (no legacy file, or a config already exists at the new path).
+func MigrateLegacyConfig() (from, to string, err error) {
+	paths := globalConfigPaths()
+	if len(paths) < 2 {
+		return "", "", nil
+	}
+	preferred, legacy := paths[0], paths[len(paths)-1]
+	if _, err := os.Stat(legacy); err != nil {
+		return "", "", nil
+	}
+	if _, err := os.Stat(preferred); err == nil {
+		return "", "", nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(preferred), 0700); err != nil {
+		return "", "", err
+	}
+	if err := os.Rename(legacy, preferred); err != nil {
+		return "", "", err
+	}
+	if err := os.Chmod(preferred, 0600); err != nil {
+		return "", "", err
+	}
+	// Best effort: drop the legacy directory if the config was all it held.
+	_ = os.Remove(filepath.Dir(legacy))
+	return legacy, preferred, nil
+}
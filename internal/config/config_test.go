@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 )
@@ -814,3 +815,280 @@ api_url: https://global.api.url
 		t.Errorf("expected APIURL 'https://env.api.url' (from env), got '%s'", cfg.APIURL)
 	}
 }
+
+func TestLoad_TLSAndHeaderSettings(t *testing.T) {
+	os.Unsetenv("FIZZY_CA_CERT")
+	os.Unsetenv("FIZZY_INSECURE_SKIP_VERIFY")
+
+	origHome := os.Getenv("HOME")
+	homeDir := t.TempDir()
+	projectDir := t.TempDir()
+	os.Setenv("HOME", homeDir)
+	defer os.Setenv("HOME", origHome)
+
+	globalConfigDir := filepath.Join(homeDir, ".fizzy")
+	os.MkdirAll(globalConfigDir, 0700)
+	globalContent := `ca_cert: /etc/fizzy/global-ca.pem
+client_cert: /etc/fizzy/client.pem
+client_key: /etc/fizzy/client-key.pem
+headers:
+  CF-Access-Client-Id: global-id
+  X-Gateway-Key: global-key
+`
+	os.WriteFile(filepath.Join(globalConfigDir, "config.yaml"), []byte(globalContent), 0600)
+
+	// A project file may override certificate paths but not disable verification.
+	localContent := `ca_cert: /etc/fizzy/local-ca.pem
+insecure_skip_verify: true
+headers:
+  X-Gateway-Key: local-key
+`
+	os.WriteFile(filepath.Join(projectDir, LocalConfigFile), []byte(localContent), 0600)
+
+	SetTestWorkingDir(projectDir)
+	defer ResetTestWorkingDir()
+
+	cfg := Load()
+
+	if cfg.CACert != "/etc/fizzy/local-ca.pem" {
+		t.Errorf("expected CACert from local config, got '%s'", cfg.CACert)
+	}
+	if cfg.ClientCert != "/etc/fizzy/client.pem" || cfg.ClientKey != "/etc/fizzy/client-key.pem" {
+		t.Errorf("expected client cert/key from global config, got '%s'/'%s'", cfg.ClientCert, cfg.ClientKey)
+	}
+	if cfg.InsecureSkipVerify {
+		t.Error("expected insecure_skip_verify to be ignored in local config")
+	}
+	if cfg.Headers["CF-Access-Client-Id"] != "global-id" || cfg.Headers["X-Gateway-Key"] != "local-key" {
+		t.Errorf("expected headers merged with local overriding global, got %v", cfg.Headers)
+	}
+
+	os.Setenv("FIZZY_CA_CERT", "/tmp/env-ca.pem")
+	os.Setenv("FIZZY_INSECURE_SKIP_VERIFY", "true")
+	defer os.Unsetenv("FIZZY_CA_CERT")
+	defer os.Unsetenv("FIZZY_INSECURE_SKIP_VERIFY")
+
+	cfg = Load()
+
+	if cfg.CACert != "/tmp/env-ca.pem" {
+		t.Errorf("expected CACert from env, got '%s'", cfg.CACert)
+	}
+	if !cfg.InsecureSkipVerify {
+		t.Error("expected InsecureSkipVerify from env")
+	}
+}
+
+func TestLoad_ArchiveBeforeDelete(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	projectDir := t.TempDir()
+	SetTestWorkingDir(projectDir)
+	defer ResetTestWorkingDir()
+
+	if cfg := Load(); cfg.ArchiveBeforeDelete {
+		t.Error("expected archive_before_delete to default to false")
+	}
+
+	if err := os.WriteFile(filepath.Join(projectDir, LocalConfigFile), []byte("archive_before_delete: true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if cfg := Load(); !cfg.ArchiveBeforeDelete {
+		t.Error("expected archive_before_delete from local config")
+	}
+
+	t.Setenv("FIZZY_ARCHIVE_BEFORE_DELETE", "0")
+	if cfg := Load(); cfg.ArchiveBeforeDelete {
+		t.Error("expected FIZZY_ARCHIVE_BEFORE_DELETE=0 to turn archiving off")
+	}
+}
+
+func TestLoad_OfflineCache(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	projectDir := t.TempDir()
+	SetTestWorkingDir(projectDir)
+	defer ResetTestWorkingDir()
+
+	if cfg := Load(); cfg.OfflineCache {
+		t.Error("expected offline_cache to default to false")
+	}
+
+	if err := os.WriteFile(filepath.Join(projectDir, LocalConfigFile), []byte("offline_cache: true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if cfg := Load(); !cfg.OfflineCache {
+		t.Error("expected offline_cache from local config")
+	}
+
+	t.Setenv("FIZZY_OFFLINE_CACHE", "false")
+	if cfg := Load(); cfg.OfflineCache {
+		t.Error("expected FIZZY_OFFLINE_CACHE=false to turn the offline store off")
+	}
+}
+
+func TestLoad_RequestLimits(t *testing.T) {
+	SetTestConfigDir(t.TempDir())
+	defer ResetTestConfigDir()
+	projectDir := t.TempDir()
+	SetTestWorkingDir(projectDir)
+	defer ResetTestWorkingDir()
+
+	global := "max_parallel_requests: 4\nrequests_per_second: 2\n"
+	if err := os.WriteFile(filepath.Join(testConfigDir, "config.yaml"), []byte(global), 0600); err != nil {
+		t.Fatal(err)
+	}
+	// The project lowers the parallel limit but can't raise the rate.
+	local := "max_parallel_requests: 2\nrequests_per_second: 10\n"
+	if err := os.WriteFile(filepath.Join(projectDir, LocalConfigFile), []byte(local), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := Load()
+	if cfg.MaxParallelRequests != 2 {
+		t.Errorf("expected max_parallel_requests 2, got %d", cfg.MaxParallelRequests)
+	}
+	if cfg.RequestsPerSecond != 2 {
+		t.Errorf("expected requests_per_second 2, got %v", cfg.RequestsPerSecond)
+	}
+
+	t.Setenv("FIZZY_MAX_PARALLEL_REQUESTS", "8")
+	t.Setenv("FIZZY_REQUESTS_PER_SECOND", "0.5")
+	cfg = Load()
+	if cfg.MaxParallelRequests != 8 || cfg.RequestsPerSecond != 0.5 {
+		t.Errorf("expected env overrides, got %d and %v", cfg.MaxParallelRequests, cfg.RequestsPerSecond)
+	}
+}
+
+func TestLoad_HooksIgnoredInLocalConfig(t *testing.T) {
+	SetTestConfigDir(t.TempDir())
+	defer ResetTestConfigDir()
+	projectDir := t.TempDir()
+	SetTestWorkingDir(projectDir)
+	defer ResetTestWorkingDir()
+
+	global := "hooks:\n  post:\n    card close: ./notify.sh\n"
+	if err := os.WriteFile(filepath.Join(testConfigDir, "config.yaml"), []byte(global), 0600); err != nil {
+		t.Fatal(err)
+	}
+	local := "hooks:\n  pre:\n    card list: ./evil.sh\n"
+	if err := os.WriteFile(filepath.Join(projectDir, LocalConfigFile), []byte(local), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := Load()
+	if cfg.Hooks.Post["card close"] != "./notify.sh" {
+		t.Errorf("expected global post hook, got %v", cfg.Hooks.Post)
+	}
+	if len(cfg.Hooks.Pre) != 0 {
+		t.Errorf("expected local hooks to be ignored, got %v", cfg.Hooks.Pre)
+	}
+}
+
+func TestLoad_LintMergesLocalConfig(t *testing.T) {
+	SetTestConfigDir(t.TempDir())
+	defer ResetTestConfigDir()
+	projectDir := t.TempDir()
+	SetTestWorkingDir(projectDir)
+	defer ResetTestWorkingDir()
+
+	global := "lint:\n  rules:\n    empty-description: off\n  wip_limits:\n    \"*\": 5\n"
+	if err := os.WriteFile(filepath.Join(testConfigDir, "config.yaml"), []byte(global), 0600); err != nil {
+		t.Fatal(err)
+	}
+	local := "lint:\n  rules:\n    wip-limit: warning\n  wip_limits:\n    Review: 2\n  stale_after: 72h\n"
+	if err := os.WriteFile(filepath.Join(projectDir, LocalConfigFile), []byte(local), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := Load()
+	if cfg.Lint.Rules["empty-description"] != "off" || cfg.Lint.Rules["wip-limit"] != "warning" {
+		t.Errorf("expected merged rules, got %v", cfg.Lint.Rules)
+	}
+	if cfg.Lint.WIPLimits["*"] != 5 || cfg.Lint.WIPLimits["Review"] != 2 {
+		t.Errorf("expected merged WIP limits, got %v", cfg.Lint.WIPLimits)
+	}
+	if cfg.Lint.StaleAfter != "72h" {
+		t.Errorf("expected local stale_after, got %q", cfg.Lint.StaleAfter)
+	}
+}
+
+func TestMigrateLegacyConfig(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("HOME", tempDir)
+
+	legacyDir := filepath.Join(tempDir, ".fizzy")
+	if err := os.MkdirAll(legacyDir, 0700); err != nil {
+		t.Fatalf("failed to create legacy dir: %v", err)
+	}
+	legacyFile := filepath.Join(legacyDir, "config.yaml")
+	if err := os.WriteFile(legacyFile, []byte("token: legacy\n"), 0644); err != nil {
+		t.Fatalf("failed to write legacy config: %v", err)
+	}
+
+	from, to, err := MigrateLegacyConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantTo := filepath.Join(tempDir, ".config", "fizzy", "config.yaml")
+	if from != legacyFile || to != wantTo {
+		t.Fatalf("expected %s -> %s, got %s -> %s", legacyFile, wantTo, from, to)
+	}
+	if _, err := os.Stat(legacyFile); !os.IsNotExist(err) {
+		t.Errorf("expected legacy config to be moved, stat err = %v", err)
+	}
+	data, err := os.ReadFile(wantTo)
+	if err != nil || string(data) != "token: legacy\n" {
+		t.Fatalf("expected migrated config contents, got %q (%v)", data, err)
+	}
+	if info, _ := os.Stat(wantTo); runtime.GOOS != "windows" && info.Mode().Perm() != 0600 {
+		t.Errorf("expected 0600 permissions, got %v", info.Mode().Perm())
+	}
+
+	// A second run has nothing left to migrate.
+	from, to, err = MigrateLegacyConfig()
+	if err != nil || from != "" || to != "" {
+		t.Errorf("expected no-op on second run, got %q -> %q (%v)", from, to, err)
+	}
+}
+
+func TestMigrateLegacyConfig_KeepsExistingPreferred(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("HOME", tempDir)
+
+	for _, dir := range []string{filepath.Join(tempDir, ".fizzy"), filepath.Join(tempDir, ".config", "fizzy")} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			t.Fatalf("failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("token: x\n"), 0600); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+	}
+
+	from, to, err := MigrateLegacyConfig()
+	if err != nil || from != "" || to != "" {
+		t.Errorf("expected no migration when both configs exist, got %q -> %q (%v)", from, to, err)
+	}
+}
+
+func TestLoad_TemplatesMergeLocalConfig(t *testing.T) {
+	SetTestConfigDir(t.TempDir())
+	defer ResetTestConfigDir()
+	projectDir := t.TempDir()
+	SetTestWorkingDir(projectDir)
+	defer ResetTestWorkingDir()
+
+	global := "templates:\n  brief: \"{{.summary}}\"\n  ids: \"{{range .data}}{{.id}} {{end}}\"\n"
+	if err := os.WriteFile(filepath.Join(testConfigDir, "config.yaml"), []byte(global), 0600); err != nil {
+		t.Fatal(err)
+	}
+	local := "templates:\n  brief: \"{{len .data}}\"\n"
+	if err := os.WriteFile(filepath.Join(projectDir, LocalConfigFile), []byte(local), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := Load()
+	if cfg.Templates["brief"] != "{{len .data}}" {
+		t.Errorf("expected local template to override, got %q", cfg.Templates["brief"])
+	}
+	if cfg.Templates["ids"] != "{{range .data}}{{.id}} {{end}}" {
+		t.Errorf("expected global template to be kept, got %q", cfg.Templates["ids"])
+	}
+}
@@ -24,6 +24,10 @@ const (
 	ExitNetwork   = output.ExitNetwork   // 6
 	ExitAPI       = output.ExitAPI       // 7
 	ExitAmbiguous = output.ExitAmbiguous // 8
+	ExitPartial   = 9                    // Bulk operation where some items failed
+	ExitFindings  = 10                   // Lint or verification found problems
+	ExitConflict  = 11                   // Request conflicts with the resource's current state (409)
+	ExitLocked    = 12                   // Resource is locked (423)
 
 	// Deprecated aliases — kept for compilation, values change.
 	ExitError       = output.ExitAPI   // was 1, now 7
@@ -59,11 +63,46 @@ func NewNotFoundError(message string) *CLIError {
 	return &output.Error{Code: output.CodeNotFound, Message: message, HTTPStatus: 404}
 }
 
+// NewAmbiguousError creates an error for a name that matches more than one resource.
+func NewAmbiguousError(resource string, matches []string) *CLIError {
+	return output.ErrAmbiguous(resource, matches)
+}
+
 // NewValidationError creates a validation error (mapped to API error).
 func NewValidationError(message string) *CLIError {
 	return &output.Error{Code: output.CodeAPI, Message: message, HTTPStatus: 422}
 }
 
+// CodeConflict is the error code of a request that conflicts with the
+// resource's current state (409), e.g. it changed since it was read.
+const CodeConflict = "conflict"
+
+// CodeLocked is the error code of a request for a locked resource (423).
+const CodeLocked = "locked"
+
+// NewConflictError creates a conflict error. Retrying the same request won't
+// help; it has to be redone against the resource's current state.
+func NewConflictError(message string) *CLIError {
+	return &output.Error{
+		Code:       CodeConflict,
+		Message:    message,
+		Hint:       "The resource changed or already exists. Fetch it again before retrying.",
+		HTTPStatus: 409,
+	}
+}
+
+// NewLockedError creates a locked-resource error, which clears once the
+// operation holding the lock finishes.
+func NewLockedError(message string) *CLIError {
+	return &output.Error{
+		Code:       CodeLocked,
+		Message:    message,
+		Hint:       "The resource is locked by another operation. Try again in a moment.",
+		HTTPStatus: 423,
+		Retryable:  true,
+	}
+}
+
 // NewNetworkError creates a network error with retryable hint.
 func NewNetworkError(message string) *CLIError {
 	e := output.ErrNetwork(fmt.Errorf("%s", message))
@@ -119,6 +158,97 @@ func IsJQError(err error) bool {
 	return errors.Is(err, errJQ)
 }
 
+// CodePartialFailure is the error code of a bulk operation where some items
+// failed.
+const CodePartialFailure = "partial_failure"
+
+// errPartialFailure is the sentinel cause of partial failures, which exit
+// with ExitPartial rather than the exit code of their error code.
+var errPartialFailure = errors.New("partial failure")
+
+// NewPartialFailureError reports that some items of a bulk operation failed.
+// The command has already printed its succeeded and failed items.
+func NewPartialFailureError(message string) *CLIError {
+	return &output.Error{
+		Code:    CodePartialFailure,
+		Message: message,
+		Hint:    "Retry the items listed under failed",
+		Cause:   errPartialFailure,
+	}
+}
+
+// IsPartialFailure returns true if err reports a partial bulk failure.
+func IsPartialFailure(err error) bool {
+	return errors.Is(err, errPartialFailure)
+}
+
+// CodeLintFindings is the error code of a lint run that found problems.
+const CodeLintFindings = "lint_findings"
+
+// errFindings is the sentinel cause of failing lint runs and verifications,
+// which exit with ExitFindings.
+var errFindings = errors.New("findings")
+
+// NewLintFindingsError reports that a lint run found problems severe enough
+// to fail. The command has already printed its findings.
+func NewLintFindingsError(message string) *CLIError {
+	return &output.Error{
+		Code:    CodeLintFindings,
+		Message: message,
+		Hint:    "Fix the findings listed, or raise --fail-on",
+		Cause:   errFindings,
+	}
+}
+
+// CodeVerifyMismatch is the error code of a verification that found
+// discrepancies.
+const CodeVerifyMismatch = "verify_mismatch"
+
+// NewVerifyMismatchError reports that a verification found discrepancies.
+// The command has already printed them.
+func NewVerifyMismatchError(message string) *CLIError {
+	return &output.Error{
+		Code:    CodeVerifyMismatch,
+		Message: message,
+		Hint:    "Review the discrepancies listed",
+		Cause:   errFindings,
+	}
+}
+
+// IsFindings returns true if err reports a failing lint run or a
+// verification that found discrepancies.
+func IsFindings(err error) bool {
+	return errors.Is(err, errFindings)
+}
+
+// IsPrinted returns true if err is reported through the exit code alone,
+// after the command has printed its result: a partial bulk failure, a
+// failing lint run, or a failed verification.
+func IsPrinted(err error) bool {
+	return IsPartialFailure(err) || IsFindings(err)
+}
+
+// ExitCodeOf returns the process exit code for err.
+func ExitCodeOf(err error) int {
+	if err == nil {
+		return ExitSuccess
+	}
+	if IsPartialFailure(err) {
+		return ExitPartial
+	}
+	if IsFindings(err) {
+		return ExitFindings
+	}
+	e := output.AsError(err)
+	switch e.Code {
+	case CodeConflict:
+		return ExitConflict
+	case CodeLocked:
+		return ExitLocked
+	}
+	return e.ExitCode()
+}
+
 // FromHTTPStatus creates an appropriate error from an HTTP status code.
 func FromHTTPStatus(status int, message string) *CLIError {
 	switch status {
@@ -130,8 +260,12 @@ func FromHTTPStatus(status int, message string) *CLIError {
 		return NewForbiddenError(message)
 	case 404:
 		return NewNotFoundError(message)
+	case 409:
+		return NewConflictError(message)
 	case 422:
 		return NewValidationError(message)
+	case 423:
+		return NewLockedError(message)
 	case 429:
 		e := output.ErrRateLimit(0)
 		if message != "" {
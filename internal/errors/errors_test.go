@@ -138,6 +138,62 @@ func TestNewNotFoundError(t *testing.T) {
 	}
 }
 
+func TestNewAmbiguousError(t *testing.T) {
+	err := NewAmbiguousError("column Review", []string{"c1", "c2"})
+
+	if err.Code != output.CodeAmbiguous {
+		t.Errorf("expected code %q, got %q", output.CodeAmbiguous, err.Code)
+	}
+	if err.Message != "Ambiguous column Review" {
+		t.Errorf("expected message 'Ambiguous column Review', got %q", err.Message)
+	}
+	if err.Hint == "" {
+		t.Error("expected hint listing matches")
+	}
+	if err.ExitCode() != ExitAmbiguous {
+		t.Errorf("expected exit code %d, got %d", ExitAmbiguous, err.ExitCode())
+	}
+}
+
+func TestNewPartialFailureError(t *testing.T) {
+	err := NewPartialFailureError("2 of 5 cards failed")
+
+	if err.Code != CodePartialFailure {
+		t.Errorf("expected code %q, got %q", CodePartialFailure, err.Code)
+	}
+	if !IsPartialFailure(err) || !IsPartialFailure(fmt.Errorf("wrapped: %w", err)) {
+		t.Error("expected IsPartialFailure to detect the error")
+	}
+	if got := ExitCodeOf(err); got != ExitPartial {
+		t.Errorf("expected exit code %d, got %d", ExitPartial, got)
+	}
+	if got := ExitCodeOf(NewNotFoundError("missing")); got != ExitNotFound {
+		t.Errorf("expected exit code %d for other errors, got %d", ExitNotFound, got)
+	}
+}
+
+func TestConflictAndLocked(t *testing.T) {
+	conflict := FromHTTPStatus(409, "Card was changed")
+	if conflict.Code != CodeConflict || conflict.HTTPStatus != 409 || conflict.Retryable {
+		t.Errorf("expected a non-retryable conflict, got %+v", conflict)
+	}
+	if got := ExitCodeOf(conflict); got != ExitConflict {
+		t.Errorf("expected exit code %d, got %d", ExitConflict, got)
+	}
+
+	locked := FromHTTPStatus(423, "Board is being moved")
+	if locked.Code != CodeLocked || !locked.Retryable {
+		t.Errorf("expected a retryable locked error, got %+v", locked)
+	}
+	if got := ExitCodeOf(fmt.Errorf("wrapped: %w", locked)); got != ExitLocked {
+		t.Errorf("expected exit code %d, got %d", ExitLocked, got)
+	}
+
+	if got := ExitCodeOf(FromHTTPStatus(429, "")); got != ExitRateLimit {
+		t.Errorf("expected exit code %d for rate limits, got %d", ExitRateLimit, got)
+	}
+}
+
 func TestNewValidationError(t *testing.T) {
 	err := NewValidationError("invalid input")
 
@@ -320,3 +376,29 @@ func TestFromHTTPStatus429IsRetryable(t *testing.T) {
 		t.Error("429 error should be retryable")
 	}
 }
+
+func TestLintFindingsError(t *testing.T) {
+	err := NewLintFindingsError("2 findings at or above error")
+	if !IsFindings(err) || !IsPrinted(err) {
+		t.Error("expected a printed lint findings error")
+	}
+	if ExitCodeOf(err) != ExitFindings {
+		t.Errorf("expected exit code %d, got %d", ExitFindings, ExitCodeOf(err))
+	}
+	if IsFindings(NewPartialFailureError("x")) {
+		t.Error("expected partial failures not to be lint findings")
+	}
+}
+
+func TestVerifyMismatchError(t *testing.T) {
+	err := NewVerifyMismatchError("3 discrepancies")
+	if !IsFindings(err) || !IsPrinted(err) {
+		t.Error("expected a printed verify error")
+	}
+	if ExitCodeOf(err) != ExitFindings {
+		t.Errorf("expected exit code %d, got %d", ExitFindings, ExitCodeOf(err))
+	}
+	if err.Code != CodeVerifyMismatch {
+		t.Errorf("expected code %q, got %q", CodeVerifyMismatch, err.Code)
+	}
+}
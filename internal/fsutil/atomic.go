@@ -0,0 +1,109 @@
+package fsutil
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// AtomicFile writes to a temporary file next to its path and renames it into
+// place on Commit, so readers never see a partial file.
+type AtomicFile struct {
+	tmp  *os.File
+	path string
+	perm os.FileMode
+}
+
+// CreateAtomic starts an atomic write of path. The file gets perm once
+// committed; until then it is a hidden temporary file in the same directory.
+func CreateAtomic(path string, perm os.FileMode) (*AtomicFile, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return nil, err
+	}
+	return &AtomicFile{tmp: tmp, path: path, perm: perm}, nil
+}
+
+// Path returns the path the file is committed to.
+func (f *AtomicFile) Path() string {
+	return f.path
+}
+
+func (f *AtomicFile) Write(p []byte) (int, error) {
+	return f.tmp.Write(p)
+}
+
+// Commit flushes the temporary file and moves it to its final path.
+func (f *AtomicFile) Commit() error {
+	if err := f.tmp.Sync(); err != nil {
+		f.Discard()
+		return err
+	}
+	if err := f.tmp.Close(); err != nil {
+		_ = os.Remove(f.tmp.Name())
+		return err
+	}
+	if err := os.Chmod(f.tmp.Name(), f.perm); err != nil {
+		_ = os.Remove(f.tmp.Name())
+		return err
+	}
+	if err := os.Rename(f.tmp.Name(), f.path); err != nil {
+		_ = os.Remove(f.tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// Discard removes the temporary file, leaving any existing file untouched.
+func (f *AtomicFile) Discard() {
+	_ = f.tmp.Close()
+	_ = os.Remove(f.tmp.Name())
+}
+
+// IsAtomicTemp reports whether name is a temporary file left behind by an
+// interrupted atomic write.
+func IsAtomicTemp(name string) bool {
+	return strings.HasPrefix(name, ".") && strings.Contains(name, ".tmp-")
+}
+
+// WriteFileAtomic replaces path with data, creating its directory if needed.
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	f, err := CreateAtomic(path, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Discard()
+		return err
+	}
+	return f.Commit()
+}
+
+// KeyPath maps a slash-separated key to a JSON file under dir. Each segment
+// is sanitized so keys built from user input can't escape dir.
+func KeyPath(dir, key string) string {
+	parts := strings.Split(key, "/")
+	for i, part := range parts {
+		parts[i] = SanitizeSegment(part)
+	}
+	return filepath.Join(dir, filepath.Join(parts...)+".json")
+}
+
+// SanitizeSegment reduces s to a single path element made of letters,
+// digits, '-', '_' and '.', replacing anything else with '_'.
+func SanitizeSegment(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		}
+		return '_'
+	}, s)
+	if s == "" || s == "." || s == ".." {
+		return "_"
+	}
+	return s
+}
@@ -0,0 +1,82 @@
+package fsutil
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "nested", "value.json")
+
+	for _, data := range []string{"first", "second"} {
+		if err := WriteFileAtomic(path, []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != data {
+			t.Errorf("expected %q, got %q", data, got)
+		}
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected no temporary files left behind, got %d entries", len(entries))
+	}
+}
+
+func TestAtomicFileDiscardKeepsExisting(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.txt")
+	if err := os.WriteFile(path, []byte("old"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := CreateAtomic(path, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte("new")); err != nil {
+		t.Fatal(err)
+	}
+	f.Discard()
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "old" {
+		t.Errorf("expected the existing file kept, got %q", got)
+	}
+}
+
+func TestKeyPathStaysInDir(t *testing.T) {
+	dir := t.TempDir()
+	path := KeyPath(dir, "../../etc/passwd")
+	if !strings.HasPrefix(path, dir+string(filepath.Separator)) {
+		t.Errorf("expected path inside %s, got %s", dir, path)
+	}
+	if got := SanitizeSegment("a b/c"); got != "a_b_c" {
+		t.Errorf("expected a_b_c, got %s", got)
+	}
+}
+
+func TestIsAtomicTemp(t *testing.T) {
+	for name, want := range map[string]bool{
+		".tmp-123":            true,
+		".value.json.tmp-456": true,
+		"value.json":          false,
+		"tmp-1.json":          false,
+	} {
+		if got := IsAtomicTemp(name); got != want {
+			t.Errorf("IsAtomicTemp(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Package fsutil provides filesystem helpers that behave correctly on both
+// Unix and Windows: safe download filenames, long-path handling, native line
+// endings for files users open in other tools, and atomic writes.
+package fsutil
+
+import (
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// windowsMaxPath is the length at which Win32 APIs start rejecting paths
+// without the \\?\ prefix (MAX_PATH minus room for an 8.3 filename).
+const windowsMaxPath = 248
+
+// windowsReserved are device names Windows refuses as file names, with or
+// without an extension.
+var windowsReserved = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true,
+	"COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true,
+	"LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// SafeFilename reduces a server-supplied filename to a single path element
+// that can be created in the current directory on this platform.
+func SafeFilename(name string) string {
+	return safeFilename(name, runtime.GOOS)
+}
+
+func safeFilename(name, goos string) string {
+	// Strip any directory part, whichever separator the server used.
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		if goos == "windows" && strings.ContainsRune(`<>:"|?*`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+
+	if goos == "windows" {
+		name = strings.TrimRight(name, ". ")
+		base := name
+		if i := strings.IndexByte(base, '.'); i >= 0 {
+			base = base[:i]
+		}
+		if windowsReserved[strings.ToUpper(strings.TrimSpace(base))] {
+			name = "_" + name
+		}
+	}
+
+	if name == "" || name == "." || name == ".." {
+		return "download"
+	}
+	return name
+}
+
+// LongPath returns path in a form Windows file APIs accept beyond MAX_PATH.
+// On other platforms, and for short paths, it returns path unchanged.
+func LongPath(path string) string {
+	if runtime.GOOS != "windows" {
+		return path
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	return longPath(abs)
+}
+
+// longPath adds the \\?\ prefix to a long absolute Windows path.
+func longPath(abs string) string {
+	if len(abs) < windowsMaxPath || strings.HasPrefix(abs, `\\?\`) {
+		return abs
+	}
+	if strings.HasPrefix(abs, `\\`) {
+		return `\\?\UNC\` + strings.TrimPrefix(abs, `\\`)
+	}
+	return `\\?\` + abs
+}
+
+// NativeLineEndings converts LF line endings to CRLF on Windows so text
+// written to files opens cleanly in Windows editors.
+func NativeLineEndings(s string) string {
+	return nativeLineEndings(s, runtime.GOOS)
+}
+
+func nativeLineEndings(s, goos string) string {
+	if goos != "windows" {
+		return s
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.ReplaceAll(s, "\n", "\r\n")
+}
@@ -0,0 +1,66 @@
+package fsutil
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSafeFilename(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		goos string
+		want string
+	}{
+		{"plain", "report.pdf", "linux", "report.pdf"},
+		{"unix traversal", "../../etc/passwd", "linux", "passwd"},
+		{"windows separators on unix", `..\..\evil.exe`, "linux", "evil.exe"},
+		{"control characters", "a\x00b\nc.txt", "linux", "abc.txt"},
+		{"colon kept on unix", "12:30 notes.txt", "linux", "12:30 notes.txt"},
+		{"empty", "", "linux", "download"},
+		{"dot dot", "..", "linux", "download"},
+		{"invalid characters on windows", `what?<now>|"x":*.txt`, "windows", "what__now___x___.txt"},
+		{"trailing dots and spaces on windows", "notes. . ", "windows", "notes"},
+		{"reserved name on windows", "CON", "windows", "_CON"},
+		{"reserved name with extension on windows", "nul.txt", "windows", "_nul.txt"},
+		{"reserved prefix is fine", "console.log", "windows", "console.log"},
+		{"only dots on windows", "...", "windows", "download"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := safeFilename(tt.in, tt.goos); got != tt.want {
+				t.Errorf("safeFilename(%q, %s) = %q, want %q", tt.in, tt.goos, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLongPath(t *testing.T) {
+	short := `C:\Users\me\file.txt`
+	if got := longPath(short); got != short {
+		t.Errorf("expected short path unchanged, got %q", got)
+	}
+
+	long := `C:\Users\me\` + strings.Repeat("a", 250) + `.txt`
+	if got := longPath(long); got != `\\?\`+long {
+		t.Errorf("expected \\\\?\\ prefix, got %q", got)
+	}
+	if got := longPath(`\\?\` + long); got != `\\?\`+long {
+		t.Errorf("expected prefixed path unchanged, got %q", got)
+	}
+
+	unc := `\\server\share\` + strings.Repeat("b", 250)
+	if got := longPath(unc); got != `\\?\UNC\server\share\`+strings.Repeat("b", 250) {
+		t.Errorf("expected UNC long path, got %q", got)
+	}
+}
+
+func TestNativeLineEndings(t *testing.T) {
+	in := "# Title\n\nBody\r\nmore\n"
+	if got := nativeLineEndings(in, "linux"); got != in {
+		t.Errorf("expected unchanged on linux, got %q", got)
+	}
+	if got := nativeLineEndings(in, "windows"); got != "# Title\r\n\r\nBody\r\nmore\r\n" {
+		t.Errorf("unexpected windows line endings %q", got)
+	}
+}
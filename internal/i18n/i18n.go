@@ -0,0 +1,173 @@
+// Package i18n translates human-readable CLI text: table headers, summaries,
+// and interactive prompts. Machine-readable output (JSON field names and
+// values) is never translated.
+//
+// Messages are keyed by their English source text, so untranslated strings
+// fall back to English unchanged. Catalogs live in locales/<lang>.json; keys
+// may contain %d or %s verbs, which lets dynamic summaries such as
+// "5 boards" match the "%d boards" entry.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//go:embed locales/*.json
+var localeFS embed.FS
+
+// DefaultLocale is the source language of all messages.
+const DefaultLocale = "en"
+
+var (
+	mu       sync.RWMutex
+	current  = DefaultLocale
+	catalog  map[string]string
+	patterns []pattern
+
+	loadOnce sync.Once
+	catalogs map[string]map[string]string
+)
+
+// pattern is a catalog entry whose key contains format verbs.
+type pattern struct {
+	re          *regexp.Regexp
+	translation string
+}
+
+var verbPattern = regexp.MustCompile(`%[ds]`)
+
+func loadCatalogs() {
+	catalogs = map[string]map[string]string{}
+	entries, err := localeFS.ReadDir("locales")
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		data, err := localeFS.ReadFile(path.Join("locales", entry.Name()))
+		if err != nil {
+			continue
+		}
+		var messages map[string]string
+		if json.Unmarshal(data, &messages) != nil {
+			continue
+		}
+		catalogs[strings.TrimSuffix(entry.Name(), ".json")] = messages
+	}
+}
+
+// Available returns the locales with a catalog, plus the default locale.
+func Available() []string {
+	loadOnce.Do(loadCatalogs)
+	locales := []string{DefaultLocale}
+	for name := range catalogs {
+		locales = append(locales, name)
+	}
+	sort.Strings(locales[1:])
+	return locales
+}
+
+// Detect picks a locale from configured (config file, FIZZY_LOCALE) or, when
+// empty, the standard LC_ALL, LC_MESSAGES, and LANG environment variables.
+func Detect(configured string) string {
+	candidates := []string{configured, os.Getenv("LC_ALL"), os.Getenv("LC_MESSAGES"), os.Getenv("LANG")}
+	for _, c := range candidates {
+		if tag := normalize(c); tag != "" {
+			return tag
+		}
+	}
+	return DefaultLocale
+}
+
+// normalize turns values like "es_ES.UTF-8" into "es-ES". The POSIX "C"
+// locale maps to English.
+func normalize(value string) string {
+	value = strings.TrimSpace(value)
+	if i := strings.IndexAny(value, ".@"); i >= 0 {
+		value = value[:i]
+	}
+	if value == "" {
+		return ""
+	}
+	if value == "C" || value == "POSIX" {
+		return DefaultLocale
+	}
+	return strings.ReplaceAll(value, "_", "-")
+}
+
+// SetLocale selects the catalog used by T. A region-specific tag falls back
+// to its base language ("pt-BR" → "pt"); unknown locales fall back to English.
+// It returns the locale actually in effect.
+func SetLocale(tag string) string {
+	loadOnce.Do(loadCatalogs)
+	mu.Lock()
+	defer mu.Unlock()
+
+	current, catalog, patterns = DefaultLocale, nil, nil
+	tag = normalize(tag)
+	for _, candidate := range []string{tag, strings.SplitN(tag, "-", 2)[0]} {
+		if messages, ok := catalogs[strings.ToLower(candidate)]; ok {
+			current = strings.ToLower(candidate)
+			catalog = messages
+			break
+		}
+	}
+
+	for key, translation := range catalog {
+		if !verbPattern.MatchString(key) {
+			continue
+		}
+		expr := verbPattern.ReplaceAllStringFunc(regexp.QuoteMeta(key), func(verb string) string {
+			if verb == "%d" {
+				return `(-?\d+)`
+			}
+			return `(.+?)`
+		})
+		patterns = append(patterns, pattern{re: regexp.MustCompile("^" + expr + "$"), translation: translation})
+	}
+	return current
+}
+
+// Locale returns the locale in effect.
+func Locale() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// T translates msg, returning it unchanged when no translation exists.
+func T(msg string) string {
+	if msg == "" {
+		return msg
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	if catalog == nil {
+		return msg
+	}
+	if translated, ok := catalog[msg]; ok && translated != "" {
+		return translated
+	}
+	for _, p := range patterns {
+		if m := p.re.FindStringSubmatch(msg); m != nil {
+			args := make([]any, len(m)-1)
+			for i, v := range m[1:] {
+				args[i] = v
+			}
+			return fmt.Sprintf(verbPattern.ReplaceAllString(p.translation, "%s"), args...)
+		}
+	}
+	return msg
+}
+
+// Tf translates format and then formats it with args.
+func Tf(format string, args ...any) string {
+	return fmt.Sprintf(T(format), args...)
+}
@@ -0,0 +1,99 @@
+package i18n
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSetLocale(t *testing.T) {
+	defer SetLocale(DefaultLocale)
+
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{"es", "es"},
+		{"es_MX.UTF-8", "es"},
+		{"de-AT", "de"},
+		{"fr", DefaultLocale},
+		{"", DefaultLocale},
+		{"C", DefaultLocale},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			if got := SetLocale(tt.tag); got != tt.want {
+				t.Errorf("SetLocale(%q) = %q, want %q", tt.tag, got, tt.want)
+			}
+			if Locale() != tt.want {
+				t.Errorf("Locale() = %q, want %q", Locale(), tt.want)
+			}
+		})
+	}
+}
+
+func TestT(t *testing.T) {
+	defer SetLocale(DefaultLocale)
+
+	if got := T("Title"); got != "Title" {
+		t.Errorf("expected English passthrough, got %q", got)
+	}
+
+	SetLocale("es")
+	tests := []struct {
+		msg  string
+		want string
+	}{
+		{"Title", "Título"},
+		{"5 boards", "5 tableros"},
+		{"3 comments on card #42", "3 comentarios en la tarjeta #42"},
+		{"12 notifications (2 unread)", "12 notificaciones (2 sin leer)"},
+		{"Untranslated message", "Untranslated message"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := T(tt.msg); got != tt.want {
+			t.Errorf("T(%q) = %q, want %q", tt.msg, got, tt.want)
+		}
+	}
+
+	if got := Tf("File already exists at %s. Overwrite?", "/tmp/x"); got != "Ya existe un archivo en /tmp/x. ¿Sobrescribir?" {
+		t.Errorf("unexpected Tf result %q", got)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_MESSAGES", "")
+	t.Setenv("LANG", "de_DE.UTF-8")
+
+	if got := Detect("es"); got != "es" {
+		t.Errorf("expected configured locale to win, got %q", got)
+	}
+	if got := Detect(""); got != "de-DE" {
+		t.Errorf("expected LANG locale, got %q", got)
+	}
+	t.Setenv("LC_ALL", "C.UTF-8")
+	if got := Detect(""); got != DefaultLocale {
+		t.Errorf("expected C locale to map to English, got %q", got)
+	}
+}
+
+// TestCatalogsKeepVerbs guards against translations that drop or add format
+// verbs, which would garble Tf output.
+func TestCatalogsKeepVerbs(t *testing.T) {
+	for _, locale := range Available()[1:] {
+		data, err := localeFS.ReadFile("locales/" + locale + ".json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var messages map[string]string
+		if err := json.Unmarshal(data, &messages); err != nil {
+			t.Fatalf("%s: %v", locale, err)
+		}
+		for key, value := range messages {
+			if len(verbPattern.FindAllString(key, -1)) != len(verbPattern.FindAllString(value, -1)) {
+				t.Errorf("%s: %q translation %q has mismatched format verbs", locale, key, value)
+			}
+		}
+	}
+}
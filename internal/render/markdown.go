@@ -3,15 +3,18 @@ package render
 import (
 	"fmt"
 	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/i18n"
 )
 
 // MarkdownList renders a slice of maps as a GFM table.
 func MarkdownList(data []map[string]any, cols Columns, summary string) string {
+	summary = i18n.T(summary)
 	if len(data) == 0 {
 		if summary != "" {
 			return summary + "\n"
 		}
-		return "No results.\n"
+		return i18n.T("No results.") + "\n"
 	}
 
 	var sb strings.Builder
@@ -24,7 +27,7 @@ func MarkdownList(data []map[string]any, cols Columns, summary string) string {
 	headers := make([]string, len(cols))
 	seps := make([]string, len(cols))
 	for i, c := range cols {
-		headers[i] = c.Header
+		headers[i] = i18n.T(c.Header)
 		seps[i] = "---"
 	}
 	sb.WriteString("| ")
@@ -49,8 +52,9 @@ func MarkdownList(data []map[string]any, cols Columns, summary string) string {
 
 // MarkdownDetail renders a single map as bold-label: value pairs.
 func MarkdownDetail(data map[string]any, summary string) string {
+	summary = i18n.T(summary)
 	if data == nil {
-		return "No data.\n"
+		return i18n.T("No data.") + "\n"
 	}
 
 	keys := sortedKeys(data)
@@ -71,6 +75,7 @@ func MarkdownDetail(data map[string]any, summary string) string {
 // MarkdownSummary renders a summary message for mutations.
 // If structured data is present, include it below the summary.
 func MarkdownSummary(data map[string]any, summary string) string {
+	summary = i18n.T(summary)
 	if summary != "" {
 		if len(data) == 0 {
 			return fmt.Sprintf("> %s\n", summary)
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/basecamp/fizzy-cli/internal/i18n"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // Column maps a display header to a JSON field name.
@@ -27,29 +30,55 @@ var headerStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12")
 // cellStyle is the style for table data cells in styled output.
 var cellStyle = lipgloss.NewStyle().PaddingRight(1)
 
-// StyledList renders a slice of maps as a styled terminal table.
+// CompactWidth is the terminal width below which styled lists stack each
+// item's fields instead of drawing a table, so they stay readable in split
+// panes and phone SSH clients.
+const CompactWidth = 80
+
+// width is the width of the terminal styled output is written to, or 0 when
+// it isn't known.
+var width int
+
+// SetWidth sets the terminal width styled output fits into. 0 leaves tables
+// at their natural width.
+func SetWidth(w int) {
+	width = w
+}
+
+// compact reports whether styled output uses the compact layout.
+func compact() bool {
+	return width > 0 && width < CompactWidth
+}
+
+// StyledList renders a slice of maps as a styled terminal table, or in the
+// compact layout on narrow terminals.
 func StyledList(data []map[string]any, cols Columns, summary string) string {
+	summary = i18n.T(summary)
 	if len(data) == 0 {
 		if summary != "" {
 			return summary + "\n"
 		}
-		return "No results.\n"
+		return i18n.T("No results.") + "\n"
 	}
 
 	headers := make([]string, len(cols))
 	for i, c := range cols {
-		headers[i] = c.Header
+		headers[i] = i18n.T(c.Header)
 	}
 
 	rows := make([][]string, 0, len(data))
 	for _, item := range data {
 		row := make([]string, len(cols))
 		for i, c := range cols {
-			row[i] = extractString(item, c.Field)
+			row[i] = styledValue(c.Field, extractString(item, c.Field))
 		}
 		rows = append(rows, row)
 	}
 
+	if compact() {
+		return compactList(headers, rows, summary)
+	}
+
 	t := table.New().
 		Headers(headers...).
 		Rows(rows...).
@@ -72,15 +101,56 @@ func StyledList(data []map[string]any, cols Columns, summary string) string {
 		sb.WriteString(summary)
 		sb.WriteString("\n\n")
 	}
-	sb.WriteString(t.String())
+	rendered := t.String()
+	if width > 0 && lipgloss.Width(rendered) > width {
+		// Too wide for the terminal: shrink the table, wrapping cells.
+		rendered = t.Width(width).String()
+	}
+	sb.WriteString(rendered)
 	sb.WriteString("\n")
 	return sb.String()
 }
 
+// compactList renders rows as blocks of stacked "Header: value" lines, with
+// values wrapped to the terminal width under their first line.
+func compactList(headers []string, rows [][]string, summary string) string {
+	labelWidth := 0
+	for _, h := range headers {
+		labelWidth = max(labelWidth, lipgloss.Width(h)+1)
+	}
+	valueWidth := max(width-labelWidth-1, 10)
+	indent := strings.Repeat(" ", labelWidth+1)
+
+	labelStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+	blocks := make([]string, 0, len(rows))
+	for _, row := range rows {
+		var b strings.Builder
+		for i, value := range row {
+			if value == "" {
+				continue
+			}
+			label := labelStyle.Render(headers[i] + ":")
+			padding := strings.Repeat(" ", labelWidth-lipgloss.Width(headers[i]+":"))
+			wrapped := strings.ReplaceAll(ansi.Wrap(value, valueWidth, ""), "\n", "\n"+indent)
+			fmt.Fprintf(&b, "%s%s %s\n", label, padding, wrapped)
+		}
+		blocks = append(blocks, b.String())
+	}
+
+	var sb strings.Builder
+	if summary != "" {
+		sb.WriteString(summary)
+		sb.WriteString("\n\n")
+	}
+	sb.WriteString(strings.Join(blocks, "\n"))
+	return sb.String()
+}
+
 // StyledDetail renders a single map as styled key-value pairs.
 func StyledDetail(data map[string]any, summary string) string {
+	summary = i18n.T(summary)
 	if data == nil {
-		return "No data.\n"
+		return i18n.T("No data.") + "\n"
 	}
 
 	keys := sortedKeys(data)
@@ -94,7 +164,12 @@ func StyledDetail(data map[string]any, summary string) string {
 	labelStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
 	for _, k := range keys {
 		label := labelStyle.Render(k + ":")
-		val := formatValue(data[k])
+		val := styledValue(k, formatValue(data[k]))
+		if compact() && lipgloss.Width(k)+2+lipgloss.Width(val) > width {
+			// Wrap long values onto indented lines of their own.
+			fmt.Fprintf(&sb, "%s\n  %s\n", label, strings.ReplaceAll(ansi.Wrap(val, width-2, ""), "\n", "\n  "))
+			continue
+		}
 		fmt.Fprintf(&sb, "%s %s\n", label, val)
 	}
 	return sb.String()
@@ -129,9 +204,9 @@ func formatValue(v any) string {
 		return val
 	case bool:
 		if val {
-			return "yes"
+			return i18n.T("yes")
 		}
-		return "no"
+		return i18n.T("no")
 	case float64:
 		if val == float64(int64(val)) {
 			return fmt.Sprintf("%d", int64(val))
@@ -152,9 +227,52 @@ func formatValue(v any) string {
 	}
 }
 
+// now is the clock relative timestamps are measured against.
+var now = time.Now
+
+// styledValue shows the timestamp fields (created_at, last_active_at, ...)
+// of styled output relative to now, which reads better in a terminal.
+// Markdown and JSON keep the exact time.
+func styledValue(field, value string) string {
+	if !strings.HasSuffix(field, "_at") {
+		return value
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	return relativeTime(t, now())
+}
+
+// relativeTime describes t as "5 minutes ago" up to a month back, and as a
+// date otherwise, including for times in the future.
+func relativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	switch {
+	case d < 0 || d >= 30*24*time.Hour:
+		return t.Local().Format("2006-01-02")
+	case d < time.Minute:
+		return i18n.T("just now")
+	case d < time.Hour:
+		return agoString(int(d/time.Minute), "1 minute ago", "%d minutes ago")
+	case d < 24*time.Hour:
+		return agoString(int(d/time.Hour), "1 hour ago", "%d hours ago")
+	default:
+		return agoString(int(d/(24*time.Hour)), "1 day ago", "%d days ago")
+	}
+}
+
+func agoString(n int, one, many string) string {
+	if n == 1 {
+		return i18n.T(one)
+	}
+	return i18n.Tf(many, n)
+}
+
 // StyledSummary renders a summary message for mutations.
 // If structured data is present, include it below the summary for human readability.
 func StyledSummary(data map[string]any, summary string) string {
+	summary = i18n.T(summary)
 	if summary != "" {
 		line := lipgloss.NewStyle().Bold(true).Render("✓ " + summary)
 		if len(data) == 0 {
@@ -3,6 +3,9 @@ package render
 import (
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
 )
 
 func TestStyledListEmpty(t *testing.T) {
@@ -122,3 +125,97 @@ func TestSortedKeys(t *testing.T) {
 		}
 	}
 }
+
+func TestRelativeTime(t *testing.T) {
+	base := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		ago  time.Duration
+		want string
+	}{
+		{30 * time.Second, "just now"},
+		{time.Minute, "1 minute ago"},
+		{45 * time.Minute, "45 minutes ago"},
+		{3 * time.Hour, "3 hours ago"},
+		{26 * time.Hour, "1 day ago"},
+		{9 * 24 * time.Hour, "9 days ago"},
+		{-2 * time.Hour, base.Add(2 * time.Hour).Local().Format("2006-01-02")},
+		{40 * 24 * time.Hour, base.Add(-40 * 24 * time.Hour).Local().Format("2006-01-02")},
+	}
+	for _, tt := range tests {
+		if got := relativeTime(base.Add(-tt.ago), base); got != tt.want {
+			t.Errorf("relativeTime(-%v) = %q, want %q", tt.ago, got, tt.want)
+		}
+	}
+}
+
+func TestStyledOutputShowsRelativeTimestamps(t *testing.T) {
+	now = func() time.Time { return time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	data := []map[string]any{{"title": "Fix login", "created_at": "2026-03-10T09:00:00Z"}}
+	list := StyledList(data, Columns{{Header: "Title", Field: "title"}, {Header: "Created", Field: "created_at"}}, "")
+	if !strings.Contains(list, "3 hours ago") {
+		t.Errorf("expected a relative timestamp in the list, got:\n%s", list)
+	}
+	detail := StyledDetail(map[string]any{"last_active_at": "2026-03-08T12:00:00Z", "title": "2026-03-08T12:00:00Z"}, "")
+	if !strings.Contains(detail, "2 days ago") || !strings.Contains(detail, "2026-03-08T12:00:00Z") {
+		t.Errorf("expected only timestamp fields to be relative, got:\n%s", detail)
+	}
+	if md := MarkdownList(data, Columns{{Header: "Created", Field: "created_at"}}, ""); !strings.Contains(md, "2026-03-10T09:00:00Z") {
+		t.Errorf("expected markdown to keep the exact time, got:\n%s", md)
+	}
+}
+
+func TestStyledListCompact(t *testing.T) {
+	SetWidth(30)
+	defer SetWidth(0)
+
+	data := []map[string]any{
+		{"number": 42, "title": "Fix the login flow when the session expires", "board": "Web"},
+		{"number": 43, "title": "Ship it"},
+	}
+	cols := Columns{
+		{Header: "#", Field: "number"},
+		{Header: "Title", Field: "title"},
+		{Header: "Board", Field: "board"},
+	}
+	result := ansi.Strip(StyledList(data, cols, "2 cards"))
+
+	want := `2 cards
+
+#:     42
+Title: Fix the login flow when
+       the session expires
+Board: Web
+
+#:     43
+Title: Ship it
+`
+	if result != want {
+		t.Errorf("unexpected compact list:\n%s\nwant:\n%s", result, want)
+	}
+}
+
+func TestStyledListShrinksToWidth(t *testing.T) {
+	SetWidth(90)
+	defer SetWidth(0)
+
+	data := []map[string]any{{"id": "1", "title": strings.Repeat("word ", 40)}}
+	cols := Columns{{Header: "ID", Field: "id"}, {Header: "Title", Field: "title"}}
+	for _, line := range strings.Split(StyledList(data, cols, ""), "\n") {
+		if w := ansi.StringWidth(line); w > 90 {
+			t.Errorf("line is %d wide, over the 90 column terminal: %q", w, line)
+		}
+	}
+}
+
+func TestStyledDetailCompactWrapsLongValues(t *testing.T) {
+	SetWidth(24)
+	defer SetWidth(0)
+
+	result := ansi.Strip(StyledDetail(map[string]any{"title": "A title much longer than the terminal"}, ""))
+	want := "title:\n  A title much longer\n  than the terminal\n"
+	if result != want {
+		t.Errorf("unexpected compact detail:\n%q\nwant:\n%q", result, want)
+	}
+}
@@ -0,0 +1,119 @@
+// Package state stores small pieces of local CLI state, such as starred
+// boards, that should persist across runs. Unlike the cache, state is not
+// disposable: clearing the cache never touches it.
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/basecamp/fizzy-cli/internal/fsutil"
+)
+
+// testDir is used to override the state directory for testing.
+var testDir string
+
+// SetTestDir sets a custom state directory for testing.
+func SetTestDir(dir string) {
+	testDir = dir
+}
+
+// ResetTestDir resets the state directory to default.
+func ResetTestDir() {
+	testDir = ""
+}
+
+// Dir returns the state directory (~/.config/fizzy/state).
+func Dir() (string, error) {
+	if testDir != "" {
+		return testDir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "fizzy", "state"), nil
+}
+
+// Load reads key into v. It reports whether the key existed; a missing key is
+// not an error, but an unreadable or undecodable one is.
+func Load(key string, v any) (bool, error) {
+	path, err := keyPath(key)
+	if err != nil {
+		return false, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Save writes v under key, replacing any existing value atomically.
+func Save(key string, v any) error {
+	path, err := keyPath(key)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fsutil.WriteFileAtomic(path, append(data, '\n'), 0600)
+}
+
+// Delete removes key. Removing a missing key is not an error.
+func Delete(key string) error {
+	path, err := keyPath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// List returns the keys directly under prefix, such as "daemons/123" for
+// prefix "daemons", sorted. A missing prefix has no keys.
+func List(prefix string) ([]string, error) {
+	path, err := keyPath(prefix)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(strings.TrimSuffix(path, ".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if entry.IsDir() || !ok || strings.HasPrefix(name, ".") {
+			continue
+		}
+		keys = append(keys, prefix+"/"+name)
+	}
+	return keys, nil
+}
+
+// keyPath maps a slash-separated key to a file under Dir. Each segment is
+// sanitized so keys built from user input can't escape the state directory.
+func keyPath(key string) (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return fsutil.KeyPath(dir, key), nil
+}
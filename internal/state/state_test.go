@@ -0,0 +1,103 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSaveAndLoad(t *testing.T) {
+	SetTestDir(t.TempDir())
+	defer ResetTestDir()
+
+	if err := Save("stars/acct", []string{"b1", "b2"}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	var got []string
+	found, err := Load("stars/acct", &got)
+	if err != nil || !found {
+		t.Fatalf("expected value, got found=%v err=%v", found, err)
+	}
+	if len(got) != 2 || got[0] != "b1" || got[1] != "b2" {
+		t.Errorf("unexpected value: %v", got)
+	}
+}
+
+func TestLoadMissing(t *testing.T) {
+	SetTestDir(t.TempDir())
+	defer ResetTestDir()
+
+	var got []string
+	found, err := Load("missing", &got)
+	if err != nil || found {
+		t.Errorf("expected clean miss, got found=%v err=%v", found, err)
+	}
+}
+
+func TestLoadCorrupt(t *testing.T) {
+	dir := t.TempDir()
+	SetTestDir(dir)
+	defer ResetTestDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	if _, err := Load("broken", &got); err == nil {
+		t.Error("expected error for undecodable state")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	SetTestDir(t.TempDir())
+	defer ResetTestDir()
+
+	if err := Save("k", 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := Delete("k"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := Delete("k"); err != nil {
+		t.Errorf("deleting a missing key should not fail: %v", err)
+	}
+}
+
+func TestList(t *testing.T) {
+	SetTestDir(t.TempDir())
+	defer ResetTestDir()
+
+	keys, err := List("daemons")
+	if err != nil || len(keys) != 0 {
+		t.Fatalf("expected no keys for a missing prefix, got %v err=%v", keys, err)
+	}
+	for _, key := range []string{"daemons/2", "daemons/1", "daemons/1/nested", "other"} {
+		if err := Save(key, true); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	keys, err = List("daemons")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if strings.Join(keys, ",") != "daemons/1,daemons/2" {
+		t.Errorf("unexpected keys: %v", keys)
+	}
+}
+
+func TestKeyPathStaysInDir(t *testing.T) {
+	dir := t.TempDir()
+	SetTestDir(dir)
+	defer ResetTestDir()
+
+	path, err := keyPath("../../etc/passwd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(path, dir) {
+		t.Errorf("expected %s to stay under %s", path, dir)
+	}
+}